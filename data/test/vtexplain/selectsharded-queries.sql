@@ -6,6 +6,7 @@ select * from user where name = 'bob' or nickname = 'bob' /* vindex lookup */;
 
 select u.id, u.name, u.nickname, n.info from user u join name_info n on u.name = n.name /* join on varchar */;
 select m.id, m.song, e.extra from music m join music_extra e on m.id = e.id where m.user_id = 100 /* join on int */;
+select m.id, m.song, e.extra from music m join music_extra e on m.id = e.id /* scatter join on int */;
 
 select count(*) from user where id = 1 /* point aggregate */;
 select count(*) from user where name in ('alice','bob') /* scatter aggregate */;
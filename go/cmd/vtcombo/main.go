@@ -67,6 +67,10 @@ func main() {
 	dbconfigs.RegisterFlags(dbconfigFlags)
 	mysqlctl.RegisterFlags()
 	servenv.ParseFlags("vtcombo")
+	if err := mysqlctl.VerifyBackupCompression(); err != nil {
+		log.Errorf("invalid backup compression: %v", err)
+		exit.Return(1)
+	}
 
 	// parse the input topology
 	tpb := &vttestpb.VTTestTopology{}
@@ -40,6 +40,13 @@ var (
 	replicationMode = flag.String("replication-mode", "ROW", "The replication mode to simulate -- must be set to either ROW or STATEMENT")
 	normalize       = flag.Bool("normalize", false, "Whether to enable vtgate normalization")
 	outputMode      = flag.String("output-mode", "text", "Output in human-friendly text or json")
+	estimateCost    = flag.Bool("cost", false, "Whether to estimate the rows, round trips and latency of the queries")
+	statsFlag       = flag.String("table-stats", "", "The optional table statistics, as JSON, e.g. {\"user\": {\"Rows\": 1000000}}")
+	statsFileFlag   = flag.String("table-stats-file", "", "Identifies the file that contains the table statistics")
+	workloadFile    = flag.String("workload-file", "", "Identifies a query log, with one statement per line, to analyze as a whole instead of -sql")
+	roundTrip       = flag.Duration("latency-round-trip", vtexplain.DefaultLatencyModel.RoundTrip, "The simulated latency of a query sent to a single tablet")
+	perShard        = flag.Duration("latency-per-shard", vtexplain.DefaultLatencyModel.PerShard, "The simulated latency added by every additional shard of a query")
+	perRow          = flag.Duration("latency-per-row", vtexplain.DefaultLatencyModel.PerRow, "The simulated latency added by every row returned by a shard")
 
 	// vtexplainFlags lists all the flags that should show in usage
 	vtexplainFlags = []string{
@@ -53,6 +60,13 @@ var (
 		"sql-file",
 		"vschema",
 		"vschema-file",
+		"cost",
+		"table-stats",
+		"table-stats-file",
+		"workload-file",
+		"latency-round-trip",
+		"latency-per-shard",
+		"latency-per-row",
 	}
 )
 
@@ -133,9 +147,22 @@ func main() {
 }
 
 func parseAndRun() error {
-	sql, err := getFileParam(*sqlFlag, *sqlFileFlag, "sql")
-	if err != nil {
-		return err
+	var sql string
+	var err error
+	if *workloadFile != "" {
+		if *sqlFlag != "" || *sqlFileFlag != "" {
+			return fmt.Errorf("action requires only one of sql, sql-file or workload-file")
+		}
+		data, err := ioutil.ReadFile(*workloadFile)
+		if err != nil {
+			return fmt.Errorf("Cannot read file %v: %v", *workloadFile, err)
+		}
+		sql = string(data)
+	} else {
+		sql, err = getFileParam(*sqlFlag, *sqlFileFlag, "sql")
+		if err != nil {
+			return err
+		}
 	}
 
 	schema, err := getFileParam(*schemaFlag, *schemaFileFlag, "schema")
@@ -153,6 +180,23 @@ func parseAndRun() error {
 		ReplicationMode: *replicationMode,
 		NumShards:       *numShards,
 		Normalize:       *normalize,
+		EstimateCost:    *estimateCost,
+		Latency: &vtexplain.LatencyModel{
+			RoundTrip: *roundTrip,
+			PerShard:  *perShard,
+			PerRow:    *perRow,
+		},
+	}
+
+	if *statsFlag != "" || *statsFileFlag != "" {
+		stats, err := getFileParam(*statsFlag, *statsFileFlag, "table-stats")
+		if err != nil {
+			return err
+		}
+		opts.TableStats, err = vtexplain.ParseTableStats(stats)
+		if err != nil {
+			return fmt.Errorf("invalid table statistics: %v", err)
+		}
 	}
 
 	log.V(100).Infof("sql %s\n", sql)
@@ -164,6 +208,19 @@ func parseAndRun() error {
 		return err
 	}
 
+	if *workloadFile != "" {
+		report, err := vtexplain.RunWorkload(sql)
+		if err != nil {
+			return err
+		}
+		if *outputMode == "text" {
+			fmt.Print(vtexplain.WorkloadReportAsText(report))
+		} else {
+			fmt.Print(vtexplain.WorkloadReportAsJSON(report))
+		}
+		return nil
+	}
+
 	plans, err := vtexplain.Run(sql)
 	if err != nil {
 		return err
@@ -57,6 +57,9 @@ func main() {
 	if err := tabletenv.VerifyConfig(); err != nil {
 		log.Exitf("invalid config: %v", err)
 	}
+	if err := mysqlctl.VerifyBackupCompression(); err != nil {
+		log.Exitf("invalid backup compression: %v", err)
+	}
 
 	tabletenv.Init()
 
@@ -17,8 +17,14 @@ limitations under the License.
 package mysql
 
 import (
+	"bytes"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"net"
 
@@ -43,7 +49,10 @@ type AuthServer interface {
 	// given user. If this returns MysqlNativePassword
 	// (mysql_native_password), then ValidateHash() will be
 	// called, and no further roundtrip with the client is
-	// expected. If anything else is returned, Negotiate()
+	// expected. If this returns CachingSha2Password or
+	// Sha256Password, the AuthServer must implement
+	// CachingSha2AuthServer, and the framework handles the
+	// packets. If anything else is returned, Negotiate()
 	// will be called on the connection, and the AuthServer
 	// needs to handle the packets.
 	AuthMethod(user string) (string, error)
@@ -75,6 +84,28 @@ type AuthServer interface {
 	Negotiate(c *Conn, user string, remoteAddr net.Addr) (Getter, error)
 }
 
+// CachingSha2AuthServer is implemented by the AuthServers that support
+// the caching_sha2_password and sha256_password methods. If AuthMethod
+// returns one of these methods, the AuthServer must implement this
+// interface, and the framework runs the negotiation with the client.
+type CachingSha2AuthServer interface {
+	AuthServer
+
+	// ValidateCachingSha2Hash validates the scramble sent by a
+	// caching_sha2_password client (fast authentication). If the
+	// server cannot validate the scramble, for instance because it
+	// does not have the SHA256 hash of the password, it should
+	// return a nil Getter and no error: the framework then asks
+	// the client for the password (full authentication).
+	ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, error)
+
+	// ValidatePassword validates the password sent by the client
+	// during full authentication. The framework only receives the
+	// password over a secure channel: TLS, a unix socket, or
+	// encrypted with the RSA public key of the server.
+	ValidatePassword(user, password string, remoteAddr net.Addr) (Getter, error)
+}
+
 // authServers is a registry of AuthServer implementations.
 var authServers = make(map[string]AuthServer)
 
@@ -142,6 +173,170 @@ func scramblePassword(salt, password []byte) []byte {
 	return scramble
 }
 
+// Constants for the sha2 methods.
+const (
+	// sha256RequestPublicKey is sent by a sha256_password client to
+	// ask for the public key of the server.
+	sha256RequestPublicKey = 0x01
+
+	// cachingSha2RequestPublicKey is sent by a caching_sha2_password
+	// client to ask for the public key of the server.
+	cachingSha2RequestPublicKey = 0x02
+
+	// cachingSha2FastAuthOK is sent in an AuthMoreData packet when
+	// the scramble was validated, right before the OK packet.
+	cachingSha2FastAuthOK = 0x03
+
+	// cachingSha2FullAuth is sent in an AuthMoreData packet to ask
+	// the client for the password.
+	cachingSha2FullAuth = 0x04
+)
+
+// CachingSha2PasswordHash returns the hash the server keeps to validate
+// caching_sha2_password scrambles: SHA256(SHA256(password)).
+func CachingSha2PasswordHash(password []byte) []byte {
+	stage1 := sha256.Sum256(password)
+	hash := sha256.Sum256(stage1[:])
+	return hash[:]
+}
+
+// scrambleCachingSha2Password computes the caching_sha2_password
+// scramble of the password:
+// SHA256(password) XOR SHA256(SHA256(SHA256(password)) + salt).
+func scrambleCachingSha2Password(salt, password []byte) []byte {
+	if len(password) == 0 {
+		return nil
+	}
+
+	stage1 := sha256.Sum256(password)
+	hash := sha256.Sum256(stage1[:])
+
+	crypt := sha256.New()
+	crypt.Write(hash[:])
+	crypt.Write(salt)
+	scramble := crypt.Sum(nil)
+
+	for i := range scramble {
+		scramble[i] ^= stage1[i]
+	}
+	return scramble
+}
+
+// validateCachingSha2Scramble checks a caching_sha2_password scramble
+// against the SHA256(SHA256(password)) hash the server knows.
+func validateCachingSha2Scramble(salt, scramble, hash []byte) bool {
+	if len(scramble) != sha256.Size || len(hash) != sha256.Size {
+		return false
+	}
+
+	// scramble XOR SHA256(hash + salt) gives back SHA256(password),
+	// which must hash to the stored hash.
+	crypt := sha256.New()
+	crypt.Write(hash)
+	crypt.Write(salt)
+	stage1 := crypt.Sum(nil)
+	for i := range stage1 {
+		stage1[i] ^= scramble[i]
+	}
+	candidate := sha256.Sum256(stage1)
+	return subtle.ConstantTimeCompare(candidate[:], hash) == 1
+}
+
+// isSecureTransport returns true if the password can be sent in clear
+// text on the connection: it uses TLS, or a unix socket.
+func (c *Conn) isSecureTransport() bool {
+	if c.Capabilities&CapabilityClientSSL > 0 {
+		return true
+	}
+	_, ok := c.RemoteAddr().(*net.UnixAddr)
+	return ok
+}
+
+// xorPassword returns the null terminated password XOR'ed with the
+// salt, as the sha2 methods do before the RSA encryption.
+func xorPassword(password, salt []byte) []byte {
+	result := make([]byte, len(password)+1)
+	copy(result, password)
+	for i := range result {
+		result[i] ^= salt[i%len(salt)]
+	}
+	return result
+}
+
+// encryptPassword encrypts the password with the RSA public key of the
+// server, for the sha2 methods over an insecure channel.
+func encryptPassword(password, salt []byte, pub *rsa.PublicKey) ([]byte, error) {
+	return rsa.EncryptOAEP(sha1.New(), rand.Reader, pub, xorPassword(password, salt), nil)
+}
+
+// decryptPassword is the reverse of encryptPassword.
+func decryptPassword(data, salt []byte, priv *rsa.PrivateKey) (string, error) {
+	plain, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, priv, data, nil)
+	if err != nil {
+		return "", err
+	}
+	for i := range plain {
+		plain[i] ^= salt[i%len(salt)]
+	}
+	if len(plain) == 0 || plain[len(plain)-1] != 0 {
+		return "", fmt.Errorf("invalid encrypted password")
+	}
+	return string(plain[:len(plain)-1]), nil
+}
+
+// marshalPublicKey returns the RSA public key in the PEM format the
+// MySQL clients expect.
+func marshalPublicKey(pub *rsa.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: der,
+	}), nil
+}
+
+// parsePublicKey parses a PEM encoded RSA public key, as sent by the
+// server.
+func parsePublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(bytes.TrimSpace(data))
+	if block == nil {
+		return nil, fmt.Errorf("invalid public key: no PEM data")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %v", err)
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid public key: not an RSA key")
+	}
+	return rsaPub, nil
+}
+
+// ParseRSAPrivateKey parses a PEM encoded RSA private key, in either the
+// PKCS #1 or PKCS #8 format. It is used to load the key the server uses
+// for the sha2 methods.
+func ParseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("invalid private key: no PEM data")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %v", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key: not an RSA key")
+	}
+	return rsaKey, nil
+}
+
 // Constants for the dialog plugin.
 const (
 	mysqlDialogMessage = "Enter password: "
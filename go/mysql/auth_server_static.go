@@ -18,6 +18,8 @@ package mysql
 
 import (
 	"bytes"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -47,6 +49,8 @@ type AuthServerStatic struct {
 	// - MysqlNativePassword
 	// - MysqlClearPassword
 	// - MysqlDialog
+	// - CachingSha2Password
+	// - Sha256Password
 	// It defaults to MysqlNativePassword.
 	Method string
 
@@ -55,10 +59,32 @@ type AuthServerStatic struct {
 }
 
 // AuthServerStaticEntry stores the values for a given user.
+// CachingSha2Password can be set instead of Password: it is the
+// SHA256(SHA256(password)) hash, in hex, as returned by
+// UPPER(SHA2(UNHEX(SHA2('password', 256)), 256)). Such entries can
+// only be used with the CachingSha2Password and Sha256Password methods.
 type AuthServerStaticEntry struct {
-	Password   string
-	UserData   string
-	SourceHost string
+	Password            string
+	CachingSha2Password string
+	UserData            string
+	SourceHost          string
+}
+
+// hasPassword returns true if the entry can be validated using the
+// password, i.e. it is not configured with a hash only.
+func (entry *AuthServerStaticEntry) hasPassword() bool {
+	return entry.Password != "" || entry.CachingSha2Password == ""
+}
+
+// cachingSha2Hash returns the SHA256(SHA256(password)) hash of the
+// entry.
+func (entry *AuthServerStaticEntry) cachingSha2Hash() []byte {
+	if entry.CachingSha2Password == "" {
+		return CachingSha2PasswordHash([]byte(entry.Password))
+	}
+	// validateConfig made sure this is valid.
+	hash, _ := hex.DecodeString(entry.CachingSha2Password)
+	return hash
 }
 
 // InitAuthServerStatic Handles initializing the AuthServerStatic if necessary.
@@ -159,6 +185,11 @@ func validateConfig(config map[string][]*AuthServerStaticEntry) error {
 			if entry.SourceHost != "" && entry.SourceHost != localhostName {
 				return fmt.Errorf("Invalid SourceHost found (only localhost is supported): %v", entry.SourceHost)
 			}
+			if entry.CachingSha2Password != "" {
+				if hash, err := hex.DecodeString(entry.CachingSha2Password); err != nil || len(hash) != 32 {
+					return fmt.Errorf("Invalid CachingSha2Password found (expected 64 hexadecimal characters): %v", entry.CachingSha2Password)
+				}
+			}
 		}
 	}
 	return nil
@@ -183,6 +214,9 @@ func (a *AuthServerStatic) ValidateHash(salt []byte, user string, authResponse [
 	}
 
 	for _, entry := range entries {
+		if !entry.hasPassword() {
+			continue
+		}
 		computedAuthResponse := scramblePassword(salt, []byte(entry.Password))
 		// Validate the password.
 		if matchSourceHost(remoteAddr, entry.SourceHost) && bytes.Compare(authResponse, computedAuthResponse) == 0 {
@@ -209,7 +243,42 @@ func (a *AuthServerStatic) Negotiate(c *Conn, user string, remoteAddr net.Addr)
 	}
 	for _, entry := range entries {
 		// Validate the password.
-		if matchSourceHost(remoteAddr, entry.SourceHost) && entry.Password == password {
+		if matchSourceHost(remoteAddr, entry.SourceHost) && entry.hasPassword() && entry.Password == password {
+			return &StaticUserData{entry.UserData}, nil
+		}
+	}
+	return &StaticUserData{""}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+}
+
+// ValidateCachingSha2Hash is part of the CachingSha2AuthServer interface.
+// All entries are cached: if the scramble doesn't match, the password
+// is wrong.
+func (a *AuthServerStatic) ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, error) {
+	// Find the entry.
+	entries, ok := a.Entries[user]
+	if !ok {
+		return &StaticUserData{""}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+	for _, entry := range entries {
+		// Validate the scramble.
+		if matchSourceHost(remoteAddr, entry.SourceHost) && validateCachingSha2Scramble(salt, authResponse, entry.cachingSha2Hash()) {
+			return &StaticUserData{entry.UserData}, nil
+		}
+	}
+	return &StaticUserData{""}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+}
+
+// ValidatePassword is part of the CachingSha2AuthServer interface.
+func (a *AuthServerStatic) ValidatePassword(user, password string, remoteAddr net.Addr) (Getter, error) {
+	// Find the entry.
+	entries, ok := a.Entries[user]
+	if !ok {
+		return &StaticUserData{""}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+	hash := CachingSha2PasswordHash([]byte(password))
+	for _, entry := range entries {
+		// Validate the password.
+		if matchSourceHost(remoteAddr, entry.SourceHost) && subtle.ConstantTimeCompare(hash, entry.cachingSha2Hash()) == 1 {
 			return &StaticUserData{entry.UserData}, nil
 		}
 	}
@@ -17,10 +17,12 @@ limitations under the license.
 package mysql
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
@@ -56,6 +58,25 @@ func TestJsonConfigParser(t *testing.T) {
 	}
 }
 
+func TestJsonConfigParserCachingSha2(t *testing.T) {
+	config := make(map[string][]*AuthServerStaticEntry)
+	jsonConfig := "{\"mysql_user\":[{\"CachingSha2Password\":\"57DBA77E45795221E816C10310F9A2F6A1186A0EFA6C07B762F1349797399519\", \"UserData\":\"dummy\"}]}"
+	if err := parseConfig([]byte(jsonConfig), &config); err != nil {
+		t.Fatalf("should not get an error, but got: %v", err)
+	}
+	if got, want := config["mysql_user"][0].cachingSha2Hash(), CachingSha2PasswordHash([]byte("password2")); !bytes.Equal(got, want) {
+		t.Errorf("cachingSha2Hash() = %x, want %x", got, want)
+	}
+	if config["mysql_user"][0].hasPassword() {
+		t.Errorf("hasPassword() = true for an entry with a hash only")
+	}
+
+	jsonConfig = "{\"mysql_user\":[{\"CachingSha2Password\":\"57DBA77E\", \"UserData\":\"dummy\"}]}"
+	if err := parseConfig([]byte(jsonConfig), &config); err == nil || !strings.Contains(err.Error(), "Invalid CachingSha2Password") {
+		t.Errorf("parseConfig with an invalid hash returned: %v", err)
+	}
+}
+
 func TestHostMatcher(t *testing.T) {
 	ip := net.ParseIP("192.168.0.1")
 	addr := &net.TCPAddr{IP: ip, Port: 9999}
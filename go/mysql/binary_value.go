@@ -0,0 +1,432 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file contains the encoding and decoding of values in the
+// binary protocol. It is used by prepared statements, both for the
+// parameters sent with COM_STMT_EXECUTE, and for the rows of a
+// binary result set.
+// See https://dev.mysql.com/doc/internals/en/binary-protocol-value.html
+
+// binaryParamUnsigned is the flag set in the high byte of a parameter
+// type in COM_STMT_EXECUTE if the value is unsigned.
+const binaryParamUnsigned = 0x8000
+
+// binaryParamType returns the type to send for a value in
+// COM_STMT_EXECUTE, including the unsigned flag.
+func binaryParamType(v sqltypes.Value) uint16 {
+	typ, _ := sqltypes.TypeToMySQL(v.Type())
+	if v.IsUnsigned() {
+		return uint16(typ) | binaryParamUnsigned
+	}
+	return uint16(typ)
+}
+
+// binaryParamToType converts a parameter type sent in
+// COM_STMT_EXECUTE to a Vitess type.
+func binaryParamToType(paramType int32) (querypb.Type, error) {
+	var flags int64
+	if paramType&binaryParamUnsigned != 0 {
+		// Borrow the unsigned column flag from the type mapping.
+		_, flags = sqltypes.TypeToMySQL(sqltypes.Uint64)
+	}
+	return sqltypes.MySQLToType(int64(paramType&0xff), flags)
+}
+
+// appendBinaryValue appends the binary protocol encoding of a non-NULL
+// value to data, and returns the extended buffer.
+func appendBinaryValue(data []byte, v sqltypes.Value) ([]byte, error) {
+	raw := v.Raw()
+	switch v.Type() {
+	case sqltypes.Int8:
+		i, err := strconv.ParseInt(string(raw), 10, 8)
+		if err != nil {
+			return nil, err
+		}
+		return append(data, byte(i)), nil
+	case sqltypes.Uint8:
+		i, err := strconv.ParseUint(string(raw), 10, 8)
+		if err != nil {
+			return nil, err
+		}
+		return append(data, byte(i)), nil
+	case sqltypes.Int16:
+		i, err := strconv.ParseInt(string(raw), 10, 16)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint16(data, uint16(i)), nil
+	case sqltypes.Uint16, sqltypes.Year:
+		i, err := strconv.ParseUint(string(raw), 10, 16)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint16(data, uint16(i)), nil
+	case sqltypes.Int24, sqltypes.Int32:
+		i, err := strconv.ParseInt(string(raw), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint32(data, uint32(i)), nil
+	case sqltypes.Uint24, sqltypes.Uint32:
+		i, err := strconv.ParseUint(string(raw), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint32(data, uint32(i)), nil
+	case sqltypes.Int64:
+		i, err := strconv.ParseInt(string(raw), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint64(data, uint64(i)), nil
+	case sqltypes.Uint64:
+		i, err := strconv.ParseUint(string(raw), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint64(data, i), nil
+	case sqltypes.Float32:
+		f, err := strconv.ParseFloat(string(raw), 32)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint32(data, math.Float32bits(float32(f))), nil
+	case sqltypes.Float64:
+		f, err := strconv.ParseFloat(string(raw), 64)
+		if err != nil {
+			return nil, err
+		}
+		return appendUint64(data, math.Float64bits(f)), nil
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp:
+		return appendBinaryDatetime(data, string(raw))
+	case sqltypes.Time:
+		return appendBinaryTime(data, string(raw))
+	}
+
+	// Everything else, including DECIMAL, is sent as a
+	// length-encoded string.
+	data = appendLenEncInt(data, uint64(len(raw)))
+	return append(data, raw...), nil
+}
+
+// appendBinaryDatetime encodes a DATE, DATETIME or TIMESTAMP value
+// in its text form 'YYYY-MM-DD[ hh:mm:ss[.ffffff]]'.
+func appendBinaryDatetime(data []byte, value string) ([]byte, error) {
+	datePart, timePart := value, ""
+	if i := strings.IndexByte(value, ' '); i != -1 {
+		datePart, timePart = value[:i], value[i+1:]
+	}
+	dateFields := strings.Split(datePart, "-")
+	if len(dateFields) != 3 {
+		return nil, fmt.Errorf("invalid date value: %v", value)
+	}
+	year, err := strconv.ParseUint(dateFields[0], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date value %v: %v", value, err)
+	}
+	month, err := strconv.ParseUint(dateFields[1], 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date value %v: %v", value, err)
+	}
+	day, err := strconv.ParseUint(dateFields[2], 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date value %v: %v", value, err)
+	}
+	var hour, minute, second, micro uint64
+	if timePart != "" {
+		hour, minute, second, micro, err = parseTimeOfDay(timePart)
+		if err != nil {
+			return nil, fmt.Errorf("invalid datetime value %v: %v", value, err)
+		}
+	}
+
+	var length byte
+	switch {
+	case micro != 0:
+		length = 11
+	case hour != 0 || minute != 0 || second != 0:
+		length = 7
+	case year != 0 || month != 0 || day != 0:
+		length = 4
+	}
+	data = append(data, length)
+	if length == 0 {
+		// All zero: '0000-00-00 00:00:00'.
+		return data, nil
+	}
+	data = appendUint16(data, uint16(year))
+	data = append(data, byte(month), byte(day))
+	if length == 4 {
+		return data, nil
+	}
+	data = append(data, byte(hour), byte(minute), byte(second))
+	if length == 7 {
+		return data, nil
+	}
+	return appendUint32(data, uint32(micro)), nil
+}
+
+// appendBinaryTime encodes a TIME value in its text form
+// '[-]hhh:mm:ss[.ffffff]'.
+func appendBinaryTime(data []byte, value string) ([]byte, error) {
+	negative := strings.HasPrefix(value, "-")
+	hour, minute, second, micro, err := parseTimeOfDay(strings.TrimPrefix(value, "-"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid time value %v: %v", value, err)
+	}
+	if hour == 0 && minute == 0 && second == 0 && micro == 0 {
+		return append(data, 0), nil
+	}
+	if micro == 0 {
+		data = append(data, 8)
+	} else {
+		data = append(data, 12)
+	}
+	if negative {
+		data = append(data, 1)
+	} else {
+		data = append(data, 0)
+	}
+	data = appendUint32(data, uint32(hour/24))
+	data = append(data, byte(hour%24), byte(minute), byte(second))
+	if micro == 0 {
+		return data, nil
+	}
+	return appendUint32(data, uint32(micro)), nil
+}
+
+// parseTimeOfDay parses 'hh:mm:ss[.ffffff]'. The hour can be bigger
+// than 23 for TIME values.
+func parseTimeOfDay(value string) (hour, minute, second, micro uint64, err error) {
+	frac := ""
+	if i := strings.IndexByte(value, '.'); i != -1 {
+		value, frac = value[:i], value[i+1:]
+	}
+	fields := strings.Split(value, ":")
+	if len(fields) != 3 {
+		return 0, 0, 0, 0, fmt.Errorf("expected hh:mm:ss, got %v", value)
+	}
+	if hour, err = strconv.ParseUint(fields[0], 10, 32); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if minute, err = strconv.ParseUint(fields[1], 10, 8); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if second, err = strconv.ParseUint(fields[2], 10, 8); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if frac != "" {
+		if len(frac) > 6 {
+			return 0, 0, 0, 0, fmt.Errorf("too many fractional digits: %v", frac)
+		}
+		// Right-pad to microseconds.
+		frac += strings.Repeat("0", 6-len(frac))
+		if micro, err = strconv.ParseUint(frac, 10, 32); err != nil {
+			return 0, 0, 0, 0, err
+		}
+	}
+	return hour, minute, second, micro, nil
+}
+
+// readBinaryValue decodes a non-NULL binary protocol value of the
+// provided type, starting at pos. decimals is the number of
+// fractional digits to use for temporal types, as found in the column
+// definition. If it is 0, microseconds are only displayed if not zero.
+// The returned value may point into data.
+func readBinaryValue(data []byte, pos int, typ querypb.Type, decimals uint32) (sqltypes.Value, int, bool) {
+	switch typ {
+	case sqltypes.Int8:
+		b, pos, ok := readByte(data, pos)
+		return sqltypes.MakeTrusted(typ, strconv.AppendInt(nil, int64(int8(b)), 10)), pos, ok
+	case sqltypes.Uint8:
+		b, pos, ok := readByte(data, pos)
+		return sqltypes.MakeTrusted(typ, strconv.AppendUint(nil, uint64(b), 10)), pos, ok
+	case sqltypes.Int16:
+		i, pos, ok := readUint16(data, pos)
+		return sqltypes.MakeTrusted(typ, strconv.AppendInt(nil, int64(int16(i)), 10)), pos, ok
+	case sqltypes.Uint16:
+		i, pos, ok := readUint16(data, pos)
+		return sqltypes.MakeTrusted(typ, strconv.AppendUint(nil, uint64(i), 10)), pos, ok
+	case sqltypes.Year:
+		i, pos, ok := readUint16(data, pos)
+		return sqltypes.MakeTrusted(typ, []byte(fmt.Sprintf("%04d", i))), pos, ok
+	case sqltypes.Int24, sqltypes.Int32:
+		i, pos, ok := readUint32(data, pos)
+		return sqltypes.MakeTrusted(typ, strconv.AppendInt(nil, int64(int32(i)), 10)), pos, ok
+	case sqltypes.Uint24, sqltypes.Uint32:
+		i, pos, ok := readUint32(data, pos)
+		return sqltypes.MakeTrusted(typ, strconv.AppendUint(nil, uint64(i), 10)), pos, ok
+	case sqltypes.Int64:
+		i, pos, ok := readUint64(data, pos)
+		return sqltypes.MakeTrusted(typ, strconv.AppendInt(nil, int64(i), 10)), pos, ok
+	case sqltypes.Uint64:
+		i, pos, ok := readUint64(data, pos)
+		return sqltypes.MakeTrusted(typ, strconv.AppendUint(nil, i, 10)), pos, ok
+	case sqltypes.Float32:
+		i, pos, ok := readUint32(data, pos)
+		f := math.Float32frombits(i)
+		return sqltypes.MakeTrusted(typ, strconv.AppendFloat(nil, float64(f), 'g', -1, 32)), pos, ok
+	case sqltypes.Float64:
+		i, pos, ok := readUint64(data, pos)
+		f := math.Float64frombits(i)
+		return sqltypes.MakeTrusted(typ, strconv.AppendFloat(nil, f, 'g', -1, 64)), pos, ok
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp:
+		return readBinaryDatetime(data, pos, typ, decimals)
+	case sqltypes.Time:
+		return readBinaryTime(data, pos, decimals)
+	case sqltypes.Null:
+		return sqltypes.NULL, pos, true
+	}
+
+	// Everything else is a length-encoded string.
+	val, pos, ok := readLenEncStringAsBytes(data, pos)
+	return sqltypes.MakeTrusted(typ, val), pos, ok
+}
+
+// readBinaryDatetime decodes a DATE, DATETIME or TIMESTAMP value.
+func readBinaryDatetime(data []byte, pos int, typ querypb.Type, decimals uint32) (sqltypes.Value, int, bool) {
+	length, pos, ok := readByte(data, pos)
+	if !ok {
+		return sqltypes.NULL, 0, false
+	}
+	var year uint16
+	var month, day, hour, minute, second byte
+	var micro uint32
+	if length >= 4 {
+		if year, pos, ok = readUint16(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+		if month, pos, ok = readByte(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+		if day, pos, ok = readByte(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+	}
+	if length >= 7 {
+		if hour, pos, ok = readByte(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+		if minute, pos, ok = readByte(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+		if second, pos, ok = readByte(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+	}
+	if length == 11 {
+		if micro, pos, ok = readUint32(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+	}
+
+	result := fmt.Sprintf("%04d-%02d-%02d", year, month, day)
+	if typ != sqltypes.Date {
+		result += fmt.Sprintf(" %02d:%02d:%02d", hour, minute, second) + formatMicroseconds(micro, decimals)
+	}
+	return sqltypes.MakeTrusted(typ, []byte(result)), pos, true
+}
+
+// readBinaryTime decodes a TIME value.
+func readBinaryTime(data []byte, pos int, decimals uint32) (sqltypes.Value, int, bool) {
+	length, pos, ok := readByte(data, pos)
+	if !ok {
+		return sqltypes.NULL, 0, false
+	}
+	var negative, hour, minute, second byte
+	var days, micro uint32
+	if length >= 8 {
+		if negative, pos, ok = readByte(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+		if days, pos, ok = readUint32(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+		if hour, pos, ok = readByte(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+		if minute, pos, ok = readByte(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+		if second, pos, ok = readByte(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+	}
+	if length == 12 {
+		if micro, pos, ok = readUint32(data, pos); !ok {
+			return sqltypes.NULL, 0, false
+		}
+	}
+
+	sign := ""
+	if negative == 1 {
+		sign = "-"
+	}
+	result := fmt.Sprintf("%s%02d:%02d:%02d", sign, days*24+uint32(hour), minute, second) + formatMicroseconds(micro, decimals)
+	return sqltypes.MakeTrusted(sqltypes.Time, []byte(result)), pos, true
+}
+
+// formatMicroseconds returns the fractional part of a temporal
+// value. If decimals is set, that many digits are displayed, the
+// same way MySQL does in the text protocol.
+func formatMicroseconds(micro uint32, decimals uint32) string {
+	if decimals == 0 || decimals > 6 {
+		if micro == 0 {
+			return ""
+		}
+		decimals = 6
+	}
+	return "." + fmt.Sprintf("%06d", micro)[:decimals]
+}
+
+// appendLenEncInt is the append version of writeLenEncInt.
+func appendLenEncInt(data []byte, i uint64) []byte {
+	var buf [9]byte
+	n := writeLenEncInt(buf[:], 0, i)
+	return append(data, buf[:n]...)
+}
+
+func appendUint16(data []byte, value uint16) []byte {
+	var buf [2]byte
+	binary.LittleEndian.PutUint16(buf[:], value)
+	return append(data, buf[:]...)
+}
+
+func appendUint32(data []byte, value uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], value)
+	return append(data, buf[:]...)
+}
+
+func appendUint64(data []byte, value uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], value)
+	return append(data, buf[:]...)
+}
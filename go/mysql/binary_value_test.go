@@ -0,0 +1,208 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestBinaryValues(t *testing.T) {
+	testcases := []struct {
+		typ      querypb.Type
+		decimals uint32
+		in       string
+		out      string
+		length   int
+	}{{
+		typ:    sqltypes.Int8,
+		in:     "-128",
+		length: 1,
+	}, {
+		typ:    sqltypes.Uint8,
+		in:     "255",
+		length: 1,
+	}, {
+		typ:    sqltypes.Int16,
+		in:     "-32768",
+		length: 2,
+	}, {
+		typ:    sqltypes.Uint16,
+		in:     "65535",
+		length: 2,
+	}, {
+		typ:    sqltypes.Year,
+		in:     "2018",
+		length: 2,
+	}, {
+		typ:    sqltypes.Int24,
+		in:     "-8388608",
+		length: 4,
+	}, {
+		typ:    sqltypes.Int32,
+		in:     "-2147483648",
+		length: 4,
+	}, {
+		typ:    sqltypes.Uint32,
+		in:     "4294967295",
+		length: 4,
+	}, {
+		typ:    sqltypes.Int64,
+		in:     "-9223372036854775808",
+		length: 8,
+	}, {
+		typ:    sqltypes.Uint64,
+		in:     "18446744073709551615",
+		length: 8,
+	}, {
+		typ:    sqltypes.Float32,
+		in:     "1.5",
+		length: 4,
+	}, {
+		typ:    sqltypes.Float64,
+		in:     "-3.14159",
+		length: 8,
+	}, {
+		typ:    sqltypes.Decimal,
+		in:     "123.45",
+		length: 7,
+	}, {
+		typ:    sqltypes.VarChar,
+		in:     "abcd",
+		length: 5,
+	}, {
+		typ:    sqltypes.Blob,
+		in:     "",
+		length: 1,
+	}, {
+		typ:    sqltypes.Date,
+		in:     "0000-00-00",
+		length: 1,
+	}, {
+		typ:    sqltypes.Date,
+		in:     "2018-03-04",
+		length: 5,
+	}, {
+		typ:    sqltypes.Datetime,
+		in:     "2018-03-04",
+		out:    "2018-03-04 00:00:00",
+		length: 5,
+	}, {
+		typ:    sqltypes.Datetime,
+		in:     "2018-03-04 05:06:07",
+		length: 8,
+	}, {
+		typ:    sqltypes.Timestamp,
+		in:     "2018-03-04 05:06:07.000123",
+		length: 12,
+	}, {
+		typ:      sqltypes.Datetime,
+		decimals: 3,
+		in:       "2018-03-04 05:06:07.5",
+		out:      "2018-03-04 05:06:07.500",
+		length:   12,
+	}, {
+		typ:    sqltypes.Time,
+		in:     "00:00:00",
+		length: 1,
+	}, {
+		typ:    sqltypes.Time,
+		in:     "-838:59:59",
+		length: 9,
+	}, {
+		typ:    sqltypes.Time,
+		in:     "12:34:56.789",
+		out:    "12:34:56.789000",
+		length: 13,
+	}, {
+		typ:      sqltypes.Time,
+		decimals: 2,
+		in:       "01:02:03",
+		out:      "01:02:03.00",
+		length:   9,
+	}}
+	for _, tcase := range testcases {
+		in := sqltypes.MakeTrusted(tcase.typ, []byte(tcase.in))
+		data, err := appendBinaryValue(nil, in)
+		if err != nil {
+			t.Errorf("appendBinaryValue(%v) failed: %v", in, err)
+			continue
+		}
+		if len(data) != tcase.length {
+			t.Errorf("appendBinaryValue(%v) = %v, want length %v", in, data, tcase.length)
+		}
+		got, pos, ok := readBinaryValue(data, 0, tcase.typ, tcase.decimals)
+		if !ok || pos != len(data) {
+			t.Errorf("readBinaryValue(%v) = %v %v, want %v true", data, pos, ok, len(data))
+			continue
+		}
+		want := tcase.out
+		if want == "" {
+			want = tcase.in
+		}
+		if got.Type() != tcase.typ || got.ToString() != want {
+			t.Errorf("readBinaryValue(%v) = %v, want %v(%v)", data, got, tcase.typ, want)
+		}
+	}
+}
+
+func TestBinaryValueErrors(t *testing.T) {
+	testcases := []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.Int8, []byte("128")),
+		sqltypes.MakeTrusted(sqltypes.Uint32, []byte("-1")),
+		sqltypes.MakeTrusted(sqltypes.Float64, []byte("abc")),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2018-03")),
+		sqltypes.MakeTrusted(sqltypes.Time, []byte("12:xx:00")),
+	}
+	for _, v := range testcases {
+		if _, err := appendBinaryValue(nil, v); err == nil {
+			t.Errorf("appendBinaryValue(%v) succeeded, want error", v)
+		}
+	}
+
+	// Truncated values.
+	if _, _, ok := readBinaryValue([]byte{1, 2}, 0, sqltypes.Int32, 0); ok {
+		t.Errorf("readBinaryValue on truncated INT32 succeeded")
+	}
+	if _, _, ok := readBinaryValue([]byte{7, 0xe2, 0x07, 3}, 0, sqltypes.Datetime, 0); ok {
+		t.Errorf("readBinaryValue on truncated DATETIME succeeded")
+	}
+}
+
+func TestBinaryParamType(t *testing.T) {
+	testcases := []querypb.Type{
+		sqltypes.Int8,
+		sqltypes.Uint8,
+		sqltypes.Int64,
+		sqltypes.Uint64,
+		sqltypes.Float64,
+		sqltypes.Datetime,
+		// VARBINARY is sent as VAR_STRING too, and there is no
+		// charset for parameters to tell them apart.
+		sqltypes.VarChar,
+	}
+	for _, typ := range testcases {
+		v := sqltypes.MakeTrusted(typ, nil)
+		got, err := binaryParamToType(int32(binaryParamType(v)))
+		if err != nil || got != typ {
+			t.Errorf("binaryParamToType(binaryParamType(%v)) = %v, %v, want %v", typ, got, err, typ)
+		}
+	}
+}
@@ -19,6 +19,7 @@ package mysql
 import (
 	"crypto/tls"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"strconv"
 	"strings"
@@ -58,6 +59,13 @@ func Connect(ctx context.Context, params *ConnParams) (*Conn, error) {
 		return nil, err
 	}
 
+	// Check the compression algorithm.
+	if params.Compression != "" {
+		if _, err := compressionCapabilities([]string{params.Compression}); err != nil {
+			return nil, NewSQLError(CRUnknownError, SSUnknownSQLState, "%v", err)
+		}
+	}
+
 	// Start a background connection routine.  It first
 	// establishes a network connection, returns it on the channel,
 	// then starts the negotiation, and returns the result on the channel.
@@ -198,7 +206,7 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 	if err != nil {
 		return NewSQLError(CRServerLost, "", "initial packet read failed: %v", err)
 	}
-	capabilities, salt, err := c.parseInitialHandshakePacket(data)
+	capabilities, salt, authMethod, err := c.parseInitialHandshakePacket(data)
 	if err != nil {
 		return err
 	}
@@ -212,6 +220,13 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 	// later in the protocol.
 	c.Capabilities = capabilities & (CapabilityClientDeprecateEOF)
 
+	// Ask for compression if the server supports it. If it
+	// doesn't, we just don't use compression.
+	if params.Compression != "" {
+		compressionCapabilities, _ := compressionCapabilities([]string{params.Compression})
+		c.Capabilities |= capabilities & compressionCapabilities
+	}
+
 	// Handle switch to SSL if necessary.
 	if params.Flags&CapabilityClientSSL > 0 {
 		// If client asked for SSL, but server doesn't support it,
@@ -254,58 +269,29 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 		c.Capabilities |= CapabilityClientSSL
 	}
 
-	// Password encryption.
-	scrambledPassword := scramblePassword(salt, []byte(params.Pass))
+	// Compute the auth response for the method the server advertised.
+	authResponse, err := c.authResponse(authMethod, salt, params)
+	if err != nil {
+		return err
+	}
 
 	// Build and send our handshake response 41.
 	// Note this one will never have SSL flag on.
-	if err := c.writeHandshakeResponse41(capabilities, scrambledPassword, characterSet, params); err != nil {
+	if err := c.writeHandshakeResponse41(capabilities, authResponse, authMethod, characterSet, params); err != nil {
 		return err
 	}
 
-	// Read the server response.
-	response, err := c.readPacket()
-	if err != nil {
-		return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
-	}
-	switch response[0] {
-	case OKPacket:
-		// OK packet, we are authenticated. Save the user, keep going.
-		c.User = params.Uname
-	case AuthSwitchRequestPacket:
-		// Server is asking to use a different auth method. We
-		// only support cleartext plugin.
-		pluginName, _, err := parseAuthSwitchRequest(response)
-		if err != nil {
-			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse auth switch request: %v", err)
-		}
-		if pluginName != MysqlClearPassword {
-			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "server asked for unsupported auth method: %v", pluginName)
-		}
-
-		// Write the password packet.
-		if err := c.writeClearTextPassword(params); err != nil {
-			return err
-		}
+	// Finish the auth negotiation with the server.
+	if err := c.clientAuth(salt, authMethod, params); err != nil {
+		return err
+	}
+	c.User = params.Uname
 
-		// Wait for OK packet.
-		response, err = c.readPacket()
-		if err != nil {
-			return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
-		}
-		switch response[0] {
-		case OKPacket:
-			// OK packet, we are authenticated. Save the user, keep going.
-			c.User = params.Uname
-		case ErrPacket:
-			return ParseErrorPacket(response)
-		default:
-			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "initial server response cannot be parsed: %v", response)
+	// Switch to the compressed protocol if it was negotiated.
+	if algorithm := compressionAlgorithm(c.Capabilities); algorithm != "" {
+		if err := c.enableCompression(algorithm, DefaultZstdCompressionLevel); err != nil {
+			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot enable %v compression: %v", algorithm, err)
 		}
-	case ErrPacket:
-		return ParseErrorPacket(response)
-	default:
-		return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "initial server response cannot be parsed: %v", response)
 	}
 
 	// If the server didn't support DbName in its handshake, set
@@ -338,72 +324,72 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 
 // parseInitialHandshakePacket parses the initial handshake from the server.
 // It returns a SQLError with the right code.
-func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, error) {
+func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, string, error) {
 	pos := 0
 
 	// Protocol version.
 	pver, pos, ok := readByte(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRVersionError, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no protocol version")
+		return 0, nil, "", NewSQLError(CRVersionError, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no protocol version")
 	}
 	if pver != protocolVersion {
-		return 0, nil, NewSQLError(CRVersionError, SSUnknownSQLState, "bad protocol version: %v", pver)
+		return 0, nil, "", NewSQLError(CRVersionError, SSUnknownSQLState, "bad protocol version: %v", pver)
 	}
 
 	// Read the server version.
 	c.ServerVersion, pos, ok = readNullString(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no server version")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no server version")
 	}
 	c.fillFlavor()
 
 	// Read the connection id.
 	c.ConnectionID, pos, ok = readUint32(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no conneciton id")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no conneciton id")
 	}
 
 	// Read the first part of the auth-plugin-data
 	authPluginData, pos, ok := readBytes(data, pos, 8)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no auth-plugin-data-part-1")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no auth-plugin-data-part-1")
 	}
 
 	// One byte filler, 0. We don't really care about the value.
 	_, pos, ok = readByte(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no filler")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no filler")
 	}
 
 	// Lower 2 bytes of the capability flags.
 	capLower, pos, ok := readUint16(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no capability flags (lower 2 bytes)")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no capability flags (lower 2 bytes)")
 	}
 	var capabilities = uint32(capLower)
 
 	// The packet can end here.
 	if pos == len(data) {
-		return capabilities, authPluginData, nil
+		return capabilities, authPluginData, MysqlNativePassword, nil
 	}
 
 	// Character set.
 	characterSet, pos, ok := readByte(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no character set")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no character set")
 	}
 	c.CharacterSet = characterSet
 
 	// Status flags. Ignored.
 	_, pos, ok = readUint16(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no status flags")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no status flags")
 	}
 
 	// Upper 2 bytes of the capability flags.
 	capUpper, pos, ok := readUint16(data, pos)
 	if !ok {
-		return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no capability flags (upper 2 bytes)")
+		return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no capability flags (upper 2 bytes)")
 	}
 	capabilities += uint32(capUpper) << 16
 
@@ -413,13 +399,13 @@ func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, error)
 	if capabilities&CapabilityClientPluginAuth != 0 {
 		authPluginDataLength, pos, ok = readByte(data, pos)
 		if !ok {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no length of auth-plugin-data")
+			return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no length of auth-plugin-data")
 		}
 	} else {
 		// One byte filler, 0. We don't really care about the value.
 		_, pos, ok = readByte(data, pos)
 		if !ok {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no length of auth-plugin-data filler")
+			return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no length of auth-plugin-data filler")
 		}
 	}
 
@@ -436,17 +422,18 @@ func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, error)
 		var authPluginDataPart2 []byte
 		authPluginDataPart2, pos, ok = readBytes(data, pos, l)
 		if !ok {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no auth-plugin-data-part-2")
+			return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no auth-plugin-data-part-2")
 		}
 
 		// The last byte has to be 0, and is not part of the data.
 		if authPluginDataPart2[l-1] != 0 {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: auth-plugin-data-part-2 is not 0 terminated")
+			return 0, nil, "", NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: auth-plugin-data-part-2 is not 0 terminated")
 		}
 		authPluginData = append(authPluginData, authPluginDataPart2[0:l-1]...)
 	}
 
 	// Auth-plugin name.
+	authMethod := MysqlNativePassword
 	if capabilities&CapabilityClientPluginAuth != 0 {
 		authPluginName, _, ok := readNullString(data, pos)
 		if !ok {
@@ -455,12 +442,16 @@ func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, error)
 			authPluginName = string(data[pos : len(data)-1])
 		}
 
-		if authPluginName != MysqlNativePassword {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: only support %v auth plugin name, but got %v", MysqlNativePassword, authPluginName)
+		// For the methods we don't support, we start with
+		// mysql_native_password, and let the server switch
+		// us to another method if it wants to.
+		switch authPluginName {
+		case CachingSha2Password, Sha256Password:
+			authMethod = authPluginName
 		}
 	}
 
-	return capabilities, authPluginData, nil
+	return capabilities, authPluginData, authMethod, nil
 }
 
 // writeSSLRequest writes the SSLRequest packet. It's just a truncated
@@ -478,8 +469,12 @@ func (c *Conn) writeSSLRequest(capabilities uint32, characterSet uint8, params *
 		// If the server supported
 		// CapabilityClientDeprecateEOF, we also support it.
 		c.Capabilities&CapabilityClientDeprecateEOF |
+		// The compression we negotiated.
+		c.Capabilities&(CapabilityClientCompress|CapabilityClientZstdCompressionAlgorithm) |
 		// Pass-through ClientFoundRows flag.
-		CapabilityClientFoundRows&uint32(params.Flags)
+		CapabilityClientFoundRows&uint32(params.Flags) |
+		// Pass-through the multi statements flags.
+		(CapabilityClientMultiStatements|CapabilityClientMultiResults)&uint32(params.Flags)
 
 	length :=
 		4 + // Client capability flags.
@@ -511,9 +506,10 @@ func (c *Conn) writeSSLRequest(capabilities uint32, characterSet uint8, params *
 	return nil
 }
 
-// writeHandshakeResponse41 writes the handshake response.
+// writeHandshakeResponse41 writes the handshake response, using the
+// provided auth method.
 // Returns a SQLError.
-func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword []byte, characterSet uint8, params *ConnParams) error {
+func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword []byte, authMethod string, characterSet uint8, params *ConnParams) error {
 	// Build our flags.
 	var flags uint32 = CapabilityClientLongPassword |
 		CapabilityClientLongFlag |
@@ -525,10 +521,12 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		// If the server supported
 		// CapabilityClientDeprecateEOF, we also support it.
 		c.Capabilities&CapabilityClientDeprecateEOF |
+		// The compression we negotiated.
+		c.Capabilities&(CapabilityClientCompress|CapabilityClientZstdCompressionAlgorithm) |
 		// Pass-through ClientFoundRows flag.
-		CapabilityClientFoundRows&uint32(params.Flags)
-
-	// FIXME(alainjobart) add multi statement.
+		CapabilityClientFoundRows&uint32(params.Flags) |
+		// Pass-through the multi statements flags.
+		(CapabilityClientMultiStatements|CapabilityClientMultiResults)&uint32(params.Flags)
 
 	length :=
 		4 + // Client capability flags.
@@ -538,8 +536,7 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 			lenNullString(params.Uname) +
 			// length of scrambled passsword is handled below.
 			len(scrambledPassword) +
-			21 + // "mysql_native_password" string.
-			1 // terminating zero.
+			lenNullString(authMethod)
 
 	// Add the DB name if the server supports it.
 	if params.DbName != "" && (capabilities&CapabilityClientConnectWithDB != 0) {
@@ -553,6 +550,11 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		length++
 	}
 
+	// The zstd compression level.
+	if flags&CapabilityClientZstdCompressionAlgorithm != 0 {
+		length++
+	}
+
 	data := c.startEphemeralPacket(length)
 	pos := 0
 
@@ -587,8 +589,13 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		c.SchemaName = params.DbName
 	}
 
-	// Assume native client during response
-	pos = writeNullString(data, pos, MysqlNativePassword)
+	// Auth method used for the response.
+	pos = writeNullString(data, pos, authMethod)
+
+	// The zstd compression level.
+	if flags&CapabilityClientZstdCompressionAlgorithm != 0 {
+		pos = writeByte(data, pos, DefaultZstdCompressionLevel)
+	}
 
 	// Sanity-check the length.
 	if pos != len(data) {
@@ -611,6 +618,164 @@ func parseAuthSwitchRequest(data []byte) (string, []byte, error) {
 	return pluginName, data[pos:], nil
 }
 
+// authResponse returns the data to send to the server when starting
+// the auth negotiation for the provided method.
+func (c *Conn) authResponse(authMethod string, salt []byte, params *ConnParams) ([]byte, error) {
+	switch authMethod {
+	case CachingSha2Password:
+		return scrambleCachingSha2Password(salt, []byte(params.Pass)), nil
+	case Sha256Password:
+		switch {
+		case params.Pass == "":
+			return []byte{0}, nil
+		case c.isSecureTransport():
+			return append([]byte(params.Pass), 0), nil
+		default:
+			return c.insecurePassword(authMethod, salt, params)
+		}
+	default:
+		return scramblePassword(salt, []byte(params.Pass)), nil
+	}
+}
+
+// insecurePassword returns the response of the sha2 methods when
+// they send the password over a connection that is not secure: the
+// password encrypted with params.ServerPublicKey, or a request for
+// the public key of the server if params.GetServerPublicKey is set.
+// Returns a SQLError.
+func (c *Conn) insecurePassword(authMethod string, salt []byte, params *ConnParams) ([]byte, error) {
+	switch {
+	case params.ServerPublicKey != "":
+		data, err := ioutil.ReadFile(params.ServerPublicKey)
+		if err != nil {
+			return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot read server public key: %v", err)
+		}
+		pub, err := parsePublicKey(data)
+		if err != nil {
+			return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse server public key: %v", err)
+		}
+		encrypted, err := encryptPassword([]byte(params.Pass), salt, pub)
+		if err != nil {
+			return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot encrypt password: %v", err)
+		}
+		return encrypted, nil
+	case params.GetServerPublicKey:
+		if authMethod == CachingSha2Password {
+			return []byte{cachingSha2RequestPublicKey}, nil
+		}
+		return []byte{sha256RequestPublicKey}, nil
+	default:
+		return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "auth method %v requires a secure connection, or the public key of the server", authMethod)
+	}
+}
+
+// clientAuth reads the server responses after the handshake response,
+// until the server accepts or rejects the connection. It handles the
+// AuthSwitchRequest and AuthMoreData packets along the way.
+// Returns a SQLError.
+func (c *Conn) clientAuth(salt []byte, authMethod string, params *ConnParams) error {
+	for {
+		response, err := c.readPacket()
+		if err != nil {
+			return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+		}
+		switch response[0] {
+		case OKPacket:
+			// OK packet, we are authenticated.
+			return nil
+		case ErrPacket:
+			return ParseErrorPacket(response)
+		case AuthSwitchRequestPacket:
+			// Server is asking to use a different auth method.
+			pluginName, pluginData, err := parseAuthSwitchRequest(response)
+			if err != nil {
+				return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse auth switch request: %v", err)
+			}
+			switch pluginName {
+			case MysqlClearPassword:
+				// Write the password packet.
+				if err := c.writeClearTextPassword(params); err != nil {
+					return err
+				}
+			case MysqlNativePassword, CachingSha2Password, Sha256Password:
+				// The plugin data is a new salt, null terminated.
+				salt = pluginData
+				if len(salt) > 0 && salt[len(salt)-1] == 0 {
+					salt = salt[:len(salt)-1]
+				}
+				authMethod = pluginName
+				authResponse, err := c.authResponse(authMethod, salt, params)
+				if err != nil {
+					return err
+				}
+				if err := c.writeAuthResponse(authResponse); err != nil {
+					return err
+				}
+			default:
+				return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "server asked for unsupported auth method: %v", pluginName)
+			}
+		case AuthMoreDataPacket:
+			if err := c.handleAuthMoreData(salt, authMethod, response[1:], params); err != nil {
+				return err
+			}
+		default:
+			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "initial server response cannot be parsed: %v", response)
+		}
+	}
+}
+
+// handleAuthMoreData handles an AuthMoreData packet sent by the server
+// for the sha2 methods.
+// Returns a SQLError.
+func (c *Conn) handleAuthMoreData(salt []byte, authMethod string, data []byte, params *ConnParams) error {
+	if authMethod != CachingSha2Password && authMethod != Sha256Password {
+		return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "unexpected AuthMoreData packet for auth method %v", authMethod)
+	}
+
+	switch {
+	case authMethod == CachingSha2Password && len(data) == 1 && data[0] == cachingSha2FastAuthOK:
+		// The scramble was accepted, the OK packet follows.
+		return nil
+	case authMethod == CachingSha2Password && len(data) == 1 && data[0] == cachingSha2FullAuth:
+		// The server needs the password.
+		if c.isSecureTransport() {
+			return c.writeClearTextPassword(params)
+		}
+		authResponse, err := c.insecurePassword(authMethod, salt, params)
+		if err != nil {
+			return err
+		}
+		return c.writeAuthResponse(authResponse)
+	}
+
+	// The server sent its public key, encrypt the password with it.
+	// We only accept it if we asked for it.
+	if params.ServerPublicKey != "" || !params.GetServerPublicKey {
+		return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "unexpected public key sent by the server")
+	}
+	pub, err := parsePublicKey(data)
+	if err != nil {
+		return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse server public key: %v", err)
+	}
+	encrypted, err := encryptPassword([]byte(params.Pass), salt, pub)
+	if err != nil {
+		return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot encrypt password: %v", err)
+	}
+	return c.writeAuthResponse(encrypted)
+}
+
+// writeAuthResponse writes raw auth data, as a response to an
+// AuthSwitchRequest or AuthMoreData packet.
+// Returns a SQLError.
+func (c *Conn) writeAuthResponse(authResponse []byte) error {
+	data := c.startEphemeralPacket(len(authResponse))
+	copy(data, authResponse)
+	if err := c.writeEphemeralPacket(true /* direct */); err != nil {
+		return NewSQLError(CRServerLost, SSUnknownSQLState, "cannot send auth response: %v", err)
+	}
+	return nil
+}
+
 // writeClearTextPassword writes the clear text password.
 // Returns a SQLError.
 func (c *Conn) writeClearTextPassword(params *ConnParams) error {
@@ -0,0 +1,339 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// This file implements the compressed protocol (CLIENT_COMPRESS and
+// CLIENT_ZSTD_COMPRESSION_ALGORITHM).
+//
+// Once the handshake is done, the regular packets are sent inside
+// compressed packets. A compressed packet has a 7 bytes header:
+// - 3 bytes: length of the compressed payload.
+// - 1 byte: sequence of the compressed packet.
+// - 3 bytes: length of the payload before compression, or 0 if the
+//   payload is not compressed.
+// The payload is a zlib or zstd frame, holding one or more regular
+// packets, with their own header. A regular packet can also span
+// multiple compressed packets.
+//
+// The compressed packets have their own sequence, reset with the
+// regular sequence at the beginning of each command. Like MySQL does:
+// - the sequence of the regular packets read from compressed packets
+// is not checked, it is replaced by the compressed sequence.
+// - when flushing, the regular sequence is synchronized with the
+// compressed sequence.
+
+// Compression algorithms, for ConnParams.Compression and
+// Listener.Compression.
+const (
+	// CompressionZlib is the historical compression, negotiated
+	// with CLIENT_COMPRESS.
+	CompressionZlib = "zlib"
+
+	// CompressionZstd is negotiated with
+	// CLIENT_ZSTD_COMPRESSION_ALGORITHM. It is supported by MySQL
+	// 8.0.18 and later.
+	CompressionZstd = "zstd"
+)
+
+const (
+	// compressedHeaderSize is the size of the header of the
+	// compressed packets.
+	compressedHeaderSize = 7
+
+	// minCompressLength is the minimum payload size we compress.
+	// Smaller payloads are sent as is, like MySQL does.
+	minCompressLength = 50
+
+	// DefaultZstdCompressionLevel is the zstd level used when none
+	// is specified.
+	DefaultZstdCompressionLevel = 3
+)
+
+var (
+	// zstdDecoder is used by all connections: DecodeAll can be
+	// called concurrently.
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderOnce sync.Once
+	zstdDecoderErr  error
+
+	// zstdEncoders contains an encoder per level, they are shared
+	// by all connections: EncodeAll can be called concurrently.
+	zstdEncodersMu sync.Mutex
+	zstdEncoders   = make(map[zstd.EncoderLevel]*zstd.Encoder)
+)
+
+// compressionCapabilities returns the capability flags for the
+// provided compression algorithms.
+func compressionCapabilities(algorithms []string) (uint32, error) {
+	var capabilities uint32
+	for _, algorithm := range algorithms {
+		switch algorithm {
+		case CompressionZlib:
+			capabilities |= CapabilityClientCompress
+		case CompressionZstd:
+			capabilities |= CapabilityClientZstdCompressionAlgorithm
+		default:
+			return 0, fmt.Errorf("unknown compression algorithm: %v", algorithm)
+		}
+	}
+	return capabilities, nil
+}
+
+// compressionAlgorithm returns the compression algorithm to use for
+// the negotiated capabilities, or "" if compression is not used.
+// Like MySQL, we prefer zlib if the client asked for both.
+func compressionAlgorithm(capabilities uint32) string {
+	switch {
+	case capabilities&CapabilityClientCompress != 0:
+		return CompressionZlib
+	case capabilities&CapabilityClientZstdCompressionAlgorithm != 0:
+		return CompressionZstd
+	default:
+		return ""
+	}
+}
+
+// getZstdDecoder returns the shared zstd decoder.
+func getZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil)
+	})
+	return zstdDecoder, zstdDecoderErr
+}
+
+// getZstdEncoder returns the shared zstd encoder for the level.
+func getZstdEncoder(level int) (*zstd.Encoder, error) {
+	encoderLevel := zstd.EncoderLevelFromZstd(level)
+
+	zstdEncodersMu.Lock()
+	defer zstdEncodersMu.Unlock()
+	if encoder, ok := zstdEncoders[encoderLevel]; ok {
+		return encoder, nil
+	}
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(encoderLevel))
+	if err != nil {
+		return nil, err
+	}
+	zstdEncoders[encoderLevel] = encoder
+	return encoder, nil
+}
+
+// enableCompression switches the connection to the compressed
+// protocol. It is called right after the handshake, when there is
+// nothing left to write on the connection. zstdLevel is only used by
+// CompressionZstd.
+func (c *Conn) enableCompression(algorithm string, zstdLevel int) error {
+	cr := &compressedReader{
+		c:         c,
+		r:         c.reader,
+		algorithm: algorithm,
+	}
+	cw := &compressedWriter{
+		c:         c,
+		w:         c.conn,
+		algorithm: algorithm,
+	}
+	if algorithm == CompressionZstd {
+		var err error
+		if cr.decoder, err = getZstdDecoder(); err != nil {
+			return err
+		}
+		if cw.encoder, err = getZstdEncoder(zstdLevel); err != nil {
+			return err
+		}
+	}
+
+	c.compression = algorithm
+	c.compressedSequence = 0
+	c.reader = bufio.NewReaderSize(cr, connBufferSize)
+	c.writer = bufio.NewWriterSize(cw, connBufferSize)
+	return nil
+}
+
+// compressedReader reads the compressed packets from the connection,
+// and returns their uncompressed payload.
+type compressedReader struct {
+	c         *Conn
+	r         io.Reader
+	algorithm string
+	decoder   *zstd.Decoder
+
+	// data is the payload left from the last compressed packet.
+	data []byte
+}
+
+// Read is part of the io.Reader interface.
+func (cr *compressedReader) Read(p []byte) (int, error) {
+	for len(cr.data) == 0 {
+		if err := cr.readCompressedPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, cr.data)
+	cr.data = cr.data[n:]
+	return n, nil
+}
+
+// readCompressedPacket reads the next compressed packet into cr.data.
+func (cr *compressedReader) readCompressedPacket() error {
+	var header [compressedHeaderSize]byte
+	if _, err := io.ReadFull(cr.r, header[:]); err != nil {
+		// Propagate io.EOF as is, for readEphemeralPacket.
+		return err
+	}
+
+	sequence := uint8(header[3])
+	if sequence != cr.c.compressedSequence {
+		return fmt.Errorf("invalid compressed sequence, expected %v got %v", cr.c.compressedSequence, sequence)
+	}
+	cr.c.compressedSequence++
+
+	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+	uncompressedLength := int(uint32(header[4]) | uint32(header[5])<<8 | uint32(header[6])<<16)
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(cr.r, payload); err != nil {
+		return fmt.Errorf("io.ReadFull(compressed packet body of length %v) failed: %v", length, err)
+	}
+
+	if uncompressedLength == 0 {
+		// The payload was not compressed.
+		cr.data = payload
+		return nil
+	}
+
+	data := make([]byte, uncompressedLength)
+	switch cr.algorithm {
+	case CompressionZlib:
+		zr, err := zlib.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("cannot uncompress packet: %v", err)
+		}
+		if _, err := io.ReadFull(zr, data); err != nil {
+			return fmt.Errorf("cannot uncompress packet of length %v: %v", uncompressedLength, err)
+		}
+	case CompressionZstd:
+		result, err := cr.decoder.DecodeAll(payload, data[:0])
+		if err != nil {
+			return fmt.Errorf("cannot uncompress packet: %v", err)
+		}
+		if len(result) != uncompressedLength {
+			return fmt.Errorf("cannot uncompress packet: got %v bytes expected %v", len(result), uncompressedLength)
+		}
+		data = result
+	}
+	cr.data = data
+	return nil
+}
+
+// compressedWriter compresses what is written to it into compressed
+// packets, and writes them to the connection. It is used under a
+// bufio.Writer, so each Write produces a compressed packet, unless
+// it is too big for one.
+type compressedWriter struct {
+	c         *Conn
+	w         io.Writer
+	algorithm string
+	encoder   *zstd.Encoder
+
+	// zlibWriter is re-used across packets.
+	zlibWriter *zlib.Writer
+	zlibBuffer bytes.Buffer
+}
+
+// Write is part of the io.Writer interface.
+func (cw *compressedWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > MaxPacketSize {
+			chunk = chunk[:MaxPacketSize]
+		}
+		if err := cw.writeCompressedPacket(chunk); err != nil {
+			return written, err
+		}
+		written += len(chunk)
+		p = p[len(chunk):]
+	}
+	return written, nil
+}
+
+// writeCompressedPacket writes a compressed packet for data.
+func (cw *compressedWriter) writeCompressedPacket(data []byte) error {
+	payload := data
+	uncompressedLength := 0
+	if len(data) >= minCompressLength {
+		compressed, err := cw.compress(data)
+		if err != nil {
+			return err
+		}
+		// Only use the compressed payload if it helps.
+		if len(compressed) < len(data) {
+			payload = compressed
+			uncompressedLength = len(data)
+		}
+	}
+
+	packet := make([]byte, compressedHeaderSize+len(payload))
+	packet[0] = byte(len(payload))
+	packet[1] = byte(len(payload) >> 8)
+	packet[2] = byte(len(payload) >> 16)
+	packet[3] = cw.c.compressedSequence
+	packet[4] = byte(uncompressedLength)
+	packet[5] = byte(uncompressedLength >> 8)
+	packet[6] = byte(uncompressedLength >> 16)
+	copy(packet[compressedHeaderSize:], payload)
+	cw.c.compressedSequence++
+
+	if n, err := cw.w.Write(packet); err != nil {
+		return fmt.Errorf("Write(compressed packet) failed: %v", err)
+	} else if n != len(packet) {
+		return fmt.Errorf("Write(compressed packet) returned a short write: %v < %v", n, len(packet))
+	}
+	return nil
+}
+
+// compress returns the compressed data.
+func (cw *compressedWriter) compress(data []byte) ([]byte, error) {
+	if cw.algorithm == CompressionZstd {
+		return cw.encoder.EncodeAll(data, nil), nil
+	}
+
+	cw.zlibBuffer.Reset()
+	if cw.zlibWriter == nil {
+		cw.zlibWriter = zlib.NewWriter(&cw.zlibBuffer)
+	} else {
+		cw.zlibWriter.Reset(&cw.zlibBuffer)
+	}
+	if _, err := cw.zlibWriter.Write(data); err != nil {
+		return nil, fmt.Errorf("cannot compress packet: %v", err)
+	}
+	if err := cw.zlibWriter.Close(); err != nil {
+		return nil, fmt.Errorf("cannot compress packet: %v", err)
+	}
+	return cw.zlibBuffer.Bytes(), nil
+}
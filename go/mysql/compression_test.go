@@ -0,0 +1,198 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+// TestCompressedPackets writes and reads back packets using the
+// compressed protocol.
+func TestCompressedPackets(t *testing.T) {
+	for _, algorithm := range []string{CompressionZlib, CompressionZstd} {
+		t.Run(algorithm, func(t *testing.T) {
+			listener, sConn, cConn := createSocketPair(t)
+			defer func() {
+				listener.Close()
+				sConn.Close()
+				cConn.Close()
+			}()
+			if err := sConn.enableCompression(algorithm, DefaultZstdCompressionLevel); err != nil {
+				t.Fatalf("enableCompression failed: %v", err)
+			}
+			if err := cConn.enableCompression(algorithm, DefaultZstdCompressionLevel); err != nil {
+				t.Fatalf("enableCompression failed: %v", err)
+			}
+
+			// Small packets are not compressed, big ones are,
+			// and some don't fit in a compressed packet.
+			for _, length := range []int{0, 10, minCompressLength, 1000, connBufferSize + 10, 3 * connBufferSize} {
+				data := bytes.Repeat([]byte("compressible "), length/13+1)[:length]
+				sConn.resetSequence()
+				cConn.resetSequence()
+				if err := cConn.writePacket(data); err != nil {
+					t.Fatalf("writePacket(%v) failed: %v", length, err)
+				}
+				if err := cConn.flush(); err != nil {
+					t.Fatalf("flush failed: %v", err)
+				}
+				got, err := sConn.readPacket()
+				if err != nil {
+					t.Fatalf("readPacket(%v) failed: %v", length, err)
+				}
+				if !bytes.Equal(got, data) && (length != 0 || len(got) != 0) {
+					t.Errorf("readPacket(%v) returned %v bytes", length, len(got))
+				}
+			}
+		})
+	}
+}
+
+// TestCompression connects our client to our server with compression.
+func TestCompression(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic()
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{
+		{Password: "password1"},
+	}
+
+	l, err := NewListener("tcp", ":0", authServer, th)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	l.Compression = []string{CompressionZlib, CompressionZstd}
+	host := l.Addr().(*net.TCPAddr).IP.String()
+	port := l.Addr().(*net.TCPAddr).Port
+	go func() {
+		l.Accept()
+	}()
+
+	for _, algorithm := range []string{CompressionZlib, CompressionZstd} {
+		t.Run(algorithm, func(t *testing.T) {
+			params := &ConnParams{
+				Host:        host,
+				Port:        port,
+				Uname:       "user1",
+				Pass:        "password1",
+				DbName:      "db1",
+				Compression: algorithm,
+			}
+			ctx := context.Background()
+			conn, err := Connect(ctx, params)
+			if err != nil {
+				t.Fatalf("Connect failed: %v", err)
+			}
+			defer conn.Close()
+			if conn.compression != algorithm {
+				t.Errorf("client compression: %v, want %v", conn.compression, algorithm)
+			}
+
+			// Run the queries a few times, to go through the
+			// sequence resets.
+			for i := 0; i < 3; i++ {
+				result, err := conn.ExecuteFetch("compression echo", 10, false)
+				if err != nil {
+					t.Fatalf("ExecuteFetch(compression echo) failed: %v", err)
+				}
+				if got := result.Rows[0][0].ToString(); got != algorithm {
+					t.Errorf("server compression: %v, want %v", got, algorithm)
+				}
+
+				result, err = conn.ExecuteFetch("schema echo", 10, false)
+				if err != nil {
+					t.Fatalf("ExecuteFetch(schema echo) failed: %v", err)
+				}
+				if got := result.Rows[0][0].ToString(); got != "db1" {
+					t.Errorf("schema echo: %v, want db1", got)
+				}
+
+				result, err = conn.ExecuteFetch("large rows", 10000, true)
+				if err != nil {
+					t.Fatalf("ExecuteFetch(large rows) failed: %v", err)
+				}
+				if !reflect.DeepEqual(result, largeRowsResult) {
+					t.Errorf("Got wrong result from ExecuteFetch(large rows): %v rows", len(result.Rows))
+				}
+
+				// A query bigger than the buffers.
+				query := "large query " + strings.Repeat("x", 3*connBufferSize)
+				if _, err := conn.ExecuteFetch(query, 10, false); err != nil {
+					t.Fatalf("ExecuteFetch(large query) failed: %v", err)
+				}
+
+				// Streaming.
+				if err := conn.ExecuteStreamFetch("large rows"); err != nil {
+					t.Fatalf("ExecuteStreamFetch(large rows) failed: %v", err)
+				}
+				count := 0
+				for {
+					row, err := conn.FetchNext()
+					if err != nil {
+						t.Fatalf("FetchNext failed: %v", err)
+					}
+					if row == nil {
+						break
+					}
+					count++
+				}
+				conn.CloseResult()
+				if count != len(largeRowsResult.Rows) {
+					t.Errorf("streamed %v rows, want %v", count, len(largeRowsResult.Rows))
+				}
+			}
+
+			conn.writeComQuit()
+		})
+	}
+
+	// The server doesn't support zstd: no compression.
+	l.Compression = []string{CompressionZlib}
+	params := &ConnParams{
+		Host:        host,
+		Port:        port,
+		Uname:       "user1",
+		Pass:        "password1",
+		Compression: CompressionZstd,
+	}
+	conn, err := Connect(context.Background(), params)
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer conn.Close()
+	result, err := conn.ExecuteFetch("compression echo", 10, false)
+	if err != nil {
+		t.Fatalf("ExecuteFetch(compression echo) failed: %v", err)
+	}
+	if conn.compression != "" || result.Rows[0][0].ToString() != "" {
+		t.Errorf("compression was used: client %v, server %v", conn.compression, result.Rows[0][0].ToString())
+	}
+	conn.writeComQuit()
+
+	// Unknown algorithm.
+	params.Compression = "lz4"
+	if _, err := Connect(context.Background(), params); err == nil || !strings.Contains(err.Error(), "unknown compression algorithm: lz4") {
+		t.Errorf("Connect with unknown compression returned: %v", err)
+	}
+}
@@ -99,8 +99,8 @@ type Conn struct {
 	// the client and the server, and currently in use.
 	// It is set during the initial handshake.
 	//
-	// It is only used for CapabilityClientDeprecateEOF
-	// and CapabilityClientFoundRows.
+	// It is only used for CapabilityClientDeprecateEOF,
+	// CapabilityClientFoundRows, and the compression capabilities.
 	Capabilities uint32
 
 	// CharacterSet is the character set used by the other side of the
@@ -143,11 +143,32 @@ type Conn struct {
 	// avoid maps indexed by ConnectionID for instance.
 	ClientData interface{}
 
+	// StatementID is the last statement ID handed out by the
+	// server for a COM_STMT_PREPARE. It is only used by the server.
+	StatementID uint32
+
+	// PrepareData is the map of prepared statements, indexed by
+	// statement ID. Entries are added on COM_STMT_PREPARE and
+	// removed on COM_STMT_CLOSE. It is only used by the server.
+	PrepareData map[uint32]*PrepareData
+
 	// Packet encoding variables.
 	reader   *bufio.Reader
 	writer   *bufio.Writer
 	sequence uint8
 
+	// compression is the compression algorithm used for the
+	// packets, or empty if they are not compressed. It is set at
+	// the end of the handshake, see compression.go.
+	compression string
+
+	// compressionLevel is the zstd level a client asks for in its
+	// handshake. It is only used by the server.
+	compressionLevel int
+
+	// compressedSequence is the sequence of the compressed packets.
+	compressedSequence uint8
+
 	// fields contains the fields definitions for an on-going
 	// streaming query. It is set by ExecuteStreamFetch, and
 	// cleared by the last FetchNext().  It is nil if no streaming
@@ -186,6 +207,35 @@ type Conn struct {
 	currentEphemeralBuffer *[]byte
 }
 
+// PrepareData is the state of a prepared statement. It is used on
+// both sides of the connection: the server keeps one per statement ID
+// in Conn.PrepareData, and the client gets one back from Prepare.
+type PrepareData struct {
+	// StatementID is the ID the server assigned to the statement.
+	StatementID uint32
+
+	// PrepareStmt is the query that was prepared. Its placeholders
+	// are '?', which sqlparser turns into :v1, :v2, ...
+	PrepareStmt string
+
+	// ParamsCount is the number of placeholders in the statement.
+	ParamsCount uint16
+
+	// ParamsType contains the MySQL type of each parameter, as sent
+	// by the client in the last COM_STMT_EXECUTE that bound new
+	// parameter types. The unsigned flag is in the high byte.
+	ParamsType []int32
+
+	// Fields are the columns the statement returns, if known at
+	// prepare time.
+	Fields []*querypb.Field
+
+	// BindVars contains the bind variables for the next execution,
+	// named v1, v2, ... Parameters sent with COM_STMT_SEND_LONG_DATA
+	// accumulate here until the statement is executed or reset.
+	BindVars map[string]*querypb.BindVariable
+}
+
 // bufPool is used to allocate and free buffers in an efficient way.
 var bufPool = sync.Pool{}
 
@@ -199,6 +249,8 @@ func newConn(conn net.Conn) *Conn {
 		writer:   bufio.NewWriterSize(conn, connBufferSize),
 		sequence: 0,
 		buffer:   make([]byte, connBufferSize),
+
+		PrepareData: make(map[uint32]*PrepareData),
 	}
 }
 
@@ -221,13 +273,10 @@ func (c *Conn) readPacketDirect() ([]byte, error) {
 		return nil, fmt.Errorf("io.ReadFull(header size) failed: %v", err)
 	}
 
-	sequence := uint8(header[3])
-	if sequence != c.sequence {
-		return nil, fmt.Errorf("invalid sequence, expected %v got %v", c.sequence, sequence)
+	if err := c.checkSequence(uint8(header[3])); err != nil {
+		return nil, err
 	}
 
-	c.sequence++
-
 	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
 	if length <= cap(c.buffer) {
 		// Fast path: read into buffer, we're good.
@@ -251,6 +300,29 @@ func (c *Conn) readPacketDirect() ([]byte, error) {
 	return data, nil
 }
 
+// resetSequence resets the sequences of the packets, at the beginning
+// of a new command.
+func (c *Conn) resetSequence() {
+	c.sequence = 0
+	c.compressedSequence = 0
+}
+
+// checkSequence checks the sequence of a packet we read, and updates
+// ours.
+func (c *Conn) checkSequence(sequence uint8) error {
+	if c.compression != "" {
+		// The compressed packets have their own sequence, and
+		// ours follows it.
+		c.sequence = c.compressedSequence
+		return nil
+	}
+	if sequence != c.sequence {
+		return fmt.Errorf("invalid sequence, expected %v got %v", c.sequence, sequence)
+	}
+	c.sequence++
+	return nil
+}
+
 // readEphemeralPacket attempts to read a packet into c.buffer.  Do
 // not use this method if the contents of the packet needs to be kept
 // after the next readEphemeralPacket.  If the packet is bigger than
@@ -287,13 +359,10 @@ func (c *Conn) readEphemeralPacket() ([]byte, error) {
 		return nil, fmt.Errorf("io.ReadFull(header size) failed: %v", err)
 	}
 
-	sequence := uint8(header[3])
-	if sequence != c.sequence {
-		return nil, fmt.Errorf("invalid sequence, expected %v got %v", c.sequence, sequence)
+	if err := c.checkSequence(uint8(header[3])); err != nil {
+		return nil, err
 	}
 
-	c.sequence++
-
 	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
 	if length == 0 {
 		// This can be caused by the packet after a packet of
@@ -395,13 +464,10 @@ func (c *Conn) readOnePacket() ([]byte, error) {
 		return nil, fmt.Errorf("io.ReadFull(header size) failed: %v", err)
 	}
 
-	sequence := uint8(header[3])
-	if sequence != c.sequence {
-		return nil, fmt.Errorf("invalid sequence, expected %v got %v", c.sequence, sequence)
+	if err := c.checkSequence(uint8(header[3])); err != nil {
+		return nil, err
 	}
 
-	c.sequence++
-
 	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
 	if length == 0 {
 		// This can be caused by the packet after a packet of
@@ -564,13 +630,15 @@ func (c *Conn) startEphemeralPacket(length int) []byte {
 // writeEphemeralPacket writes the packet that was allocated by
 // startEphemeralPacket. If 'direct' is set, we write to the
 // underlying connection directly, by-passing the write buffer.
+// With compression, the packet goes through the write buffer, and is
+// flushed right away.
 func (c *Conn) writeEphemeralPacket(direct bool) error {
 	defer func() {
 		c.currentEphemeralPolicy = ephemeralUnused
 	}()
 
 	var w io.Writer = c.writer
-	if direct {
+	if direct && c.compression == "" {
 		w = c.conn
 	}
 
@@ -606,6 +674,9 @@ func (c *Conn) writeEphemeralPacket(direct bool) error {
 		panic(fmt.Errorf("trying to call writeEphemeralPacket while currentEphemeralPolicy is %v", c.currentEphemeralPolicy))
 	}
 
+	if direct && c.compression != "" {
+		return c.flush()
+	}
 	return nil
 }
 
@@ -615,6 +686,11 @@ func (c *Conn) flush() error {
 	if err := c.writer.Flush(); err != nil {
 		return fmt.Errorf("Flush() failed: %v", err)
 	}
+	if c.compression != "" {
+		// Like MySQL, synchronize our sequence with the
+		// compressed sequence.
+		c.sequence = c.compressedSequence
+	}
 	return nil
 }
 
@@ -624,7 +700,7 @@ func (c *Conn) flush() error {
 // Returns SQLError(CRServerGone) if it can't.
 func (c *Conn) writeComQuit() error {
 	// This is a new command, need to reset the sequence.
-	c.sequence = 0
+	c.resetSequence()
 
 	data := c.startEphemeralPacket(1)
 	data[0] = ComQuit
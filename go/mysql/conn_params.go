@@ -27,12 +27,29 @@ type ConnParams struct {
 	Charset    string `json:"charset"`
 	Flags      uint64 `json:"flags"`
 
+	// Compression is the compression algorithm to use for the
+	// packets, if the server supports it: CompressionZlib or
+	// CompressionZstd. Empty means no compression.
+	Compression string `json:"compression"`
+
 	// The following SSL flags are only used when flags |= 2048
 	// is set (CapabilityClientSSL).
 	SslCa     string `json:"ssl_ca"`
 	SslCaPath string `json:"ssl_ca_path"`
 	SslCert   string `json:"ssl_cert"`
 	SslKey    string `json:"ssl_key"`
+
+	// ServerPublicKey is the path of a PEM file with the RSA public
+	// key of the server. It's used by the caching_sha2_password and
+	// sha256_password methods to encrypt the password, over a
+	// connection that is neither TLS nor a unix socket.
+	ServerPublicKey string `json:"server_public_key"`
+
+	// GetServerPublicKey allows these methods to request the public
+	// key from the server instead, if ServerPublicKey is not set. The
+	// key is not verified, so the password is not protected from a
+	// man in the middle. Without any of the two, they fail.
+	GetServerPublicKey bool `json:"get_server_public_key"`
 }
 
 // EnableSSL will set the right flag on the parameters.
@@ -49,3 +66,10 @@ func (cp *ConnParams) SslEnabled() bool {
 func (cp *ConnParams) EnableClientFoundRows() {
 	cp.Flags |= CapabilityClientFoundRows
 }
+
+// EnableMultiStatements sets the flags for CLIENT_MULTI_STATEMENTS and
+// CLIENT_MULTI_RESULTS, so queries can contain multiple statements.
+// Their results are read with ExecuteFetchMulti and ReadQueryResult.
+func (cp *ConnParams) EnableMultiStatements() {
+	cp.Flags |= CapabilityClientMultiStatements | CapabilityClientMultiResults
+}
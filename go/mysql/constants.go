@@ -37,6 +37,16 @@ const (
 	// MysqlDialog uses the dialog plugin on the client side.
 	// It transmits data in the clear.
 	MysqlDialog = "dialog"
+
+	// CachingSha2Password uses a salt and transmits a SHA256 hash
+	// on the wire. If the server cannot validate the hash, the
+	// password is transmitted over TLS, or encrypted with the RSA
+	// public key of the server.
+	CachingSha2Password = "caching_sha2_password"
+
+	// Sha256Password transmits the password over TLS, or encrypted
+	// with the RSA public key of the server.
+	Sha256Password = "sha256_password"
 )
 
 // Capability flags.
@@ -62,8 +72,10 @@ const (
 	// CLIENT_NO_SCHEMA 1 << 4
 	// Do not permit database.table.column. We do permit it.
 
-	// CLIENT_COMPRESS 1 << 5
-	// We do not support compression. CPU is usually our bottleneck.
+	// CapabilityClientCompress is CLIENT_COMPRESS.
+	// Use zlib compression for the packets, see compression.go.
+	// It is only used if enabled on the client or server side.
+	CapabilityClientCompress = 1 << 5
 
 	// CLIENT_ODBC 1 << 6
 	// No special behavior since 3.22.
@@ -102,13 +114,14 @@ const (
 	// New 4.1 authentication. Always set, expected, never checked.
 	CapabilityClientSecureConnection = 1 << 15
 
-	// CLIENT_MULTI_STATEMENTS 1 << 16
-	// Can handle multiple statements per COM_QUERY and COM_STMT_PREPARE.
-	// Not yet supported.
+	// CapabilityClientMultiStatements is CLIENT_MULTI_STATEMENTS.
+	// Can handle multiple statements per COM_QUERY.
+	// We do not support them in COM_STMT_PREPARE.
+	CapabilityClientMultiStatements = 1 << 16
 
-	// CLIENT_MULTI_RESULTS 1 << 17
+	// CapabilityClientMultiResults is CLIENT_MULTI_RESULTS.
 	// Can send multiple resultsets for COM_QUERY.
-	// Not yet supported.
+	CapabilityClientMultiResults = 1 << 17
 
 	// CLIENT_PS_MULTI_RESULTS 1 << 18
 	// Can send multiple resultsets for COM_STMT_EXECUTE.
@@ -118,9 +131,10 @@ const (
 	// Client supports plugin authentication.
 	CapabilityClientPluginAuth = 1 << 19
 
-	// CLIENT_CONNECT_ATTRS 1 << 20
+	// CapabilityClientConnectAttrs is CLIENT_CONNECT_ATTRS.
 	// Permits connection attributes in Protocol::HandshakeResponse41.
-	// Not yet supported.
+	// We never set it, and skip the attributes if a client sends them.
+	CapabilityClientConnectAttrs = 1 << 20
 
 	// CapabilityClientPluginAuthLenencClientData is CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA
 	CapabilityClientPluginAuthLenencClientData = 1 << 21
@@ -137,6 +151,12 @@ const (
 	// CapabilityClientDeprecateEOF is CLIENT_DEPRECATE_EOF
 	// Expects an OK (instead of EOF) after the resultset rows of a Text Resultset.
 	CapabilityClientDeprecateEOF = 1 << 24
+
+	// CapabilityClientZstdCompressionAlgorithm is
+	// CLIENT_ZSTD_COMPRESSION_ALGORITHM.
+	// Use zstd compression for the packets, see compression.go.
+	// It is only used if enabled on the client or server side.
+	CapabilityClientZstdCompressionAlgorithm = 1 << 26
 )
 
 // Packet types.
@@ -154,6 +174,21 @@ const (
 	// ComPing is COM_PING.
 	ComPing = 0x0e
 
+	// ComPrepare is COM_STMT_PREPARE.
+	ComPrepare = 0x16
+
+	// ComStmtExecute is COM_STMT_EXECUTE.
+	ComStmtExecute = 0x17
+
+	// ComStmtSendLongData is COM_STMT_SEND_LONG_DATA.
+	ComStmtSendLongData = 0x18
+
+	// ComStmtClose is COM_STMT_CLOSE.
+	ComStmtClose = 0x19
+
+	// ComStmtReset is COM_STMT_RESET.
+	ComStmtReset = 0x1a
+
 	// ComBinlogDump is COM_BINLOG_DUMP.
 	ComBinlogDump = 0x12
 
@@ -169,6 +204,11 @@ const (
 	// AuthSwitchRequestPacket is used to switch auth method.
 	AuthSwitchRequestPacket = 0xfe
 
+	// AuthMoreDataPacket is used by the server to send extra auth
+	// data, like the RSA public key or the result of the
+	// caching_sha2_password fast authentication.
+	AuthMoreDataPacket = 0x01
+
 	// ErrPacket is the header of the error packet.
 	ErrPacket = 0xff
 
@@ -225,6 +265,11 @@ const (
 
 	// CRMalformedPacket is CR_MALFORMED_PACKET
 	CRMalformedPacket = 2027
+
+	// CRParamsNotBound is CR_PARAMS_NOT_BOUND
+	// Returned when executing a prepared statement without the
+	// right number of arguments.
+	CRParamsNotBound = 2031
 )
 
 // Error codes return in SQLErrors generated by vitess. These error codes
@@ -402,6 +447,7 @@ const (
 	ERIncorrectGlobalLocalVar      = 1238
 	ERWrongFKDef                   = 1239
 	ERKeyRefDoNotMatchTableRef     = 1240
+	ERUnknownStmtHandler           = 1243
 	ERCyclicReference              = 1245
 	ERCollationCharsetMismatch     = 1253
 	ERCantAggregate2Collations     = 1267
@@ -473,6 +519,10 @@ const (
 const (
 	// ServerStatusAutocommit is SERVER_STATUS_AUTOCOMMIT.
 	ServerStatusAutocommit = 0x0002
+
+	// ServerMoreResultsExists is SERVER_MORE_RESULTS_EXISTS.
+	// Set in the last packet of a result set if another one follows.
+	ServerMoreResultsExists = 0x0008
 )
 
 // A few interesting character set values.
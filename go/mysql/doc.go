@@ -83,20 +83,43 @@ packet should be (still 0xff 0xff 0xff or the max packet size).
 CLIENT_CONNECT_ATTRS
 
 The client can send up optional connection attributes with this flags.
-I don't see a use for them yet.
+I don't see a use for them yet. Our server doesn't advertise the flag,
+and skips the attributes if a client sends them anyway.
+
+--
+CLIENT_COMPRESS and CLIENT_ZSTD_COMPRESSION_ALGORITHM:
+
+See: https://dev.mysql.com/doc/internals/en/compression.html
+
+The server advertises the algorithms set in Listener.Compression, and the
+client asks for the one set in ConnParams.Compression, if the server
+supports it. With zstd, the client also sends the compression level at
+the end of its handshake response. The compressed protocol is used
+right after the OK packet that concludes the authentication.
+See compression.go for the details, in particular how the sequence
+numbers work.
 
 --
 Multi result sets:
 
-Only used by stored procedures returning multiple result sets.
-Unclear if it is also used when the CLIENT_MULTI_STATEMENTS flag is used.
+Used by stored procedures returning multiple result sets, and when the
+CLIENT_MULTI_STATEMENTS flag is used.
 See: http://dev.mysql.com/doc/internals/en/multi-resultset.html
 
-The flags returned is then used to mark if there are more result sets
+The SERVER_MORE_RESULTS_EXISTS status flag, in the OK or EOF packet that
+ends a result, is then used to mark if there are more result sets
 coming up.
 
-We do not support any of this yet. It would be nice to plumb that for
-ExecuteBatch later on though.
+The server advertises CLIENT_MULTI_STATEMENTS and CLIENT_MULTI_RESULTS.
+If the client sets CLIENT_MULTI_STATEMENTS, a COM_QUERY is split into
+its statements with the sqlparser tokenizer, and each of them is passed
+to Handler.ComQuery in turn. If one fails, its error packet ends the
+response, and the remaining statements are not run. We do not support
+multiple statements in COM_STMT_PREPARE.
+
+The client sets both flags with ConnParams.EnableMultiStatements, and
+reads the results with ExecuteFetchMulti and ReadQueryResult.
+ExecuteFetch reads and discards any extra result, and returns an error.
 
 --
 Character sets:
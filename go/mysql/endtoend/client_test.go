@@ -173,6 +173,75 @@ func TestTLS(t *testing.T) {
 	}
 }
 
+// TestCompression tests our client can use the compressed protocol.
+func TestCompression(t *testing.T) {
+	for _, algorithm := range []string{mysql.CompressionZlib, mysql.CompressionZstd} {
+		t.Run(algorithm, func(t *testing.T) {
+			params := connParams
+			params.Compression = algorithm
+
+			if algorithm == mysql.CompressionZlib {
+				// First make sure the official 'mysql' client
+				// can use compression.
+				output, ok := runMysql(t, &params, "SHOW SESSION STATUS LIKE 'Compression'")
+				if !ok {
+					t.Fatalf("'mysql --compress' failed: %v", output)
+				}
+				if !strings.Contains(output, "ON") {
+					t.Fatalf("'mysql --compress' didn't use compression: %v", output)
+				}
+			}
+
+			// Now connect with our client.
+			ctx := context.Background()
+			conn, err := mysql.Connect(ctx, &params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+			if algorithm == mysql.CompressionZstd && conn.Capabilities&mysql.CapabilityClientZstdCompressionAlgorithm == 0 {
+				t.Skip("MySQL doesn't support zstd compression")
+			}
+
+			result, err := conn.ExecuteFetch("SHOW SESSION STATUS LIKE 'Compression'", 10, false)
+			if err != nil {
+				t.Fatalf("SHOW SESSION STATUS LIKE 'Compression' failed: %v", err)
+			}
+			if len(result.Rows) != 1 || result.Rows[0][1].ToString() != "ON" {
+				t.Fatalf("SHOW SESSION STATUS LIKE 'Compression' returned unexpected result: %v", result)
+			}
+
+			// Send enough data both ways to span multiple
+			// compressed packets, a few times.
+			if _, err := conn.ExecuteFetch(fmt.Sprintf("create table compression_%v(id int, name varchar(128), primary key(id))", algorithm), 0, false); err != nil {
+				t.Fatalf("create table failed: %v", err)
+			}
+			values := make([]string, 0, 1000)
+			for i := 0; i < 1000; i++ {
+				values = append(values, fmt.Sprintf("(%v, '%v')", i, strings.Repeat(fmt.Sprintf("name %v ", i), 10)))
+			}
+			for i := 0; i < 3; i++ {
+				if _, err := conn.ExecuteFetch(fmt.Sprintf("delete from compression_%v", algorithm), 0, false); err != nil {
+					t.Fatalf("delete failed: %v", err)
+				}
+				if _, err := conn.ExecuteFetch(fmt.Sprintf("insert into compression_%v(id, name) values %v", algorithm, strings.Join(values, ", ")), 0, false); err != nil {
+					t.Fatalf("insert failed: %v", err)
+				}
+				result, err := conn.ExecuteFetch(fmt.Sprintf("select id, name from compression_%v order by id", algorithm), 10000, false)
+				if err != nil {
+					t.Fatalf("select failed: %v", err)
+				}
+				if len(result.Rows) != 1000 {
+					t.Fatalf("select returned %v rows, want 1000", len(result.Rows))
+				}
+				if got, want := result.Rows[999][1].ToString(), strings.Repeat("name 999 ", 10); got != want {
+					t.Errorf("select returned %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
 func TestSlaveStatus(t *testing.T) {
 	params := connParams
 	ctx := context.Background()
@@ -96,6 +96,9 @@ func runMysql(t *testing.T, params *mysql.ConnParams, command string) (string, b
 	if params.DbName != "" {
 		args = append(args, "-D", params.DbName)
 	}
+	if params.Compression == mysql.CompressionZlib {
+		args = append(args, "--compress")
+	}
 	if params.Flags&mysql.CapabilityClientSSL > 0 {
 		args = append(args,
 			"--ssl",
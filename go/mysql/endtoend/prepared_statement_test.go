@@ -0,0 +1,284 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endtoend
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+const preparedTableSchema = `create table prepared(
+id bigint,
+tiny tinyint,
+utiny tinyint unsigned,
+small smallint,
+medium mediumint,
+num int unsigned,
+big bigint unsigned,
+f float,
+d double,
+dcm decimal(10,3),
+y year,
+dt date,
+dtm datetime,
+dtm3 datetime(3),
+ts timestamp(6) null,
+tm time,
+tm2 time(2),
+vc varchar(128),
+vb varbinary(128),
+txt text,
+bl blob,
+e enum('a', 'b'),
+primary key(id))`
+
+const preparedInsert = "insert into prepared values(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+
+var preparedRows = [][]sqltypes.Value{{
+	sqltypes.NewInt64(1),
+	sqltypes.MakeTrusted(sqltypes.Int8, []byte("-128")),
+	sqltypes.NewUint64(255),
+	sqltypes.NewInt64(-32768),
+	sqltypes.NewInt64(8388607),
+	sqltypes.NewUint64(4294967295),
+	sqltypes.NewUint64(18446744073709551615),
+	sqltypes.NewFloat64(1.5),
+	sqltypes.NewFloat64(-3.25),
+	sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1234.567")),
+	sqltypes.NewInt64(2018),
+	sqltypes.MakeTrusted(sqltypes.Date, []byte("2018-03-04")),
+	sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2018-03-04 05:06:07")),
+	sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2018-03-04 05:06:07.890")),
+	sqltypes.MakeTrusted(sqltypes.Timestamp, []byte("2018-03-04 05:06:07.123456")),
+	sqltypes.MakeTrusted(sqltypes.Time, []byte("-838:59:59")),
+	sqltypes.MakeTrusted(sqltypes.Time, []byte("12:34:56.78")),
+	sqltypes.NewVarChar("nice name"),
+	sqltypes.NewVarBinary("\x00\x01\xff"),
+	sqltypes.NewVarChar("some text"),
+	sqltypes.NewVarBinary("some blob"),
+	sqltypes.NewVarChar("b"),
+}, {
+	sqltypes.NewInt64(2),
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+	sqltypes.NULL,
+}}
+
+// createPreparedTable creates the prepared table, and fills it using
+// client side prepared statements.
+func createPreparedTable(t *testing.T, conn *mysql.Conn) {
+	if _, err := conn.ExecuteFetch(preparedTableSchema, 0, false); err != nil {
+		t.Fatalf("create table failed: %v", err)
+	}
+	insert, err := conn.Prepare(preparedInsert)
+	if err != nil {
+		t.Fatalf("Prepare(%v) failed: %v", preparedInsert, err)
+	}
+	defer conn.ClosePrepared(insert)
+	for _, row := range preparedRows {
+		result, err := conn.ExecutePrepared(insert, row, 0, false)
+		if err != nil {
+			t.Fatalf("ExecutePrepared(%v) failed: %v", row, err)
+		}
+		if result.RowsAffected != 1 {
+			t.Errorf("unexpected result for insert: %v", result)
+		}
+	}
+}
+
+// Test the client side of prepared statements against MySQL: the
+// rows read with the binary protocol must be the same as the ones
+// read with the text protocol.
+func TestPreparedStatements(t *testing.T) {
+	ctx := context.Background()
+	conn, err := mysql.Connect(ctx, &connParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	createPreparedTable(t, conn)
+	defer conn.ExecuteFetch("drop table prepared", 0, false)
+
+	textResult, err := conn.ExecuteFetch("select * from prepared order by id", 1000, true)
+	if err != nil {
+		t.Fatalf("select failed: %v", err)
+	}
+
+	sel, err := conn.Prepare("select * from prepared where id >= ? order by id")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	if len(sel.Fields) != len(preparedRows[0]) || sel.ParamsCount != 1 {
+		t.Errorf("Prepare returned %v fields and %v params", len(sel.Fields), sel.ParamsCount)
+	}
+	binaryResult, err := conn.ExecutePrepared(sel, []sqltypes.Value{sqltypes.NewInt64(0)}, 1000, true)
+	if err != nil {
+		t.Fatalf("ExecutePrepared failed: %v", err)
+	}
+	if !reflect.DeepEqual(binaryResult, textResult) {
+		t.Errorf("binary protocol result:\n%v\ndoes not match text protocol result:\n%v", binaryResult, textResult)
+	}
+	if err := conn.ClosePrepared(sel); err != nil {
+		t.Fatalf("ClosePrepared failed: %v", err)
+	}
+
+	// The statement is gone.
+	_, err = conn.ExecutePrepared(sel, []sqltypes.Value{sqltypes.NewInt64(0)}, 1000, true)
+	if sqlErr, ok := err.(*mysql.SQLError); !ok || sqlErr.Number() != mysql.ERUnknownStmtHandler {
+		t.Errorf("ExecutePrepared on closed statement returned %v", err)
+	}
+}
+
+// proxyHandler is a mysql.Handler that sends all queries to MySQL,
+// using the text protocol. Prepared statements have their parameters
+// substituted before they are sent.
+type proxyHandler struct {
+	conn *mysql.Conn
+}
+
+func (ph *proxyHandler) NewConnection(c *mysql.Conn) {
+}
+
+func (ph *proxyHandler) ConnectionClosed(c *mysql.Conn) {
+}
+
+func (ph *proxyHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	result, err := ph.conn.ExecuteFetch(query, 1000, true)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+func (ph *proxyHandler) ComPrepare(c *mysql.Conn, query string) ([]*querypb.Field, error) {
+	return nil, nil
+}
+
+func (ph *proxyHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	stmt, err := sqlparser.Parse(prepare.PrepareStmt)
+	if err != nil {
+		return err
+	}
+	query, err := sqlparser.NewParsedQuery(stmt).GenerateQuery(prepare.BindVars, nil)
+	if err != nil {
+		return err
+	}
+	return ph.ComQuery(c, string(query), callback)
+}
+
+// Test the server side of prepared statements: a client using the
+// binary protocol through a Listener must see the same results as
+// when it talks to MySQL directly.
+func TestPreparedStatementsThroughListener(t *testing.T) {
+	ctx := context.Background()
+	conn, err := mysql.Connect(ctx, &connParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	createPreparedTable(t, conn)
+	defer conn.ExecuteFetch("drop table prepared", 0, false)
+
+	authServer := mysql.NewAuthServerStatic()
+	authServer.Entries["user1"] = []*mysql.AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	l, err := mysql.NewListener("tcp", ":0", authServer, &proxyHandler{conn: conn})
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+
+	params := &mysql.ConnParams{
+		Host:  "127.0.0.1",
+		Port:  l.Addr().(*net.TCPAddr).Port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+	proxyConn, err := mysql.Connect(ctx, params)
+	if err != nil {
+		t.Fatalf("Connect to listener failed: %v", err)
+	}
+	defer proxyConn.Close()
+
+	query := "select * from prepared where id = ?"
+	direct, err := conn.Prepare(query)
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	proxied, err := proxyConn.Prepare(query)
+	if err != nil {
+		t.Fatalf("Prepare through listener failed: %v", err)
+	}
+	for _, row := range preparedRows {
+		args := []sqltypes.Value{row[0]}
+		want, err := conn.ExecutePrepared(direct, args, 1000, true)
+		if err != nil {
+			t.Fatalf("ExecutePrepared failed: %v", err)
+		}
+		got, err := proxyConn.ExecutePrepared(proxied, args, 1000, true)
+		if err != nil {
+			t.Fatalf("ExecutePrepared through listener failed: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("result through listener:\n%v\ndoes not match MySQL result:\n%v", got, want)
+		}
+	}
+
+	// Statements returning no rows are sent an OK packet.
+	insert, err := proxyConn.Prepare("insert into prepared(id, vc) values(?, ?)")
+	if err != nil {
+		t.Fatalf("Prepare through listener failed: %v", err)
+	}
+	result, err := proxyConn.ExecutePrepared(insert, []sqltypes.Value{sqltypes.NewInt64(3), sqltypes.NewVarChar("three")}, 0, false)
+	if err != nil {
+		t.Fatalf("ExecutePrepared through listener failed: %v", err)
+	}
+	if result.RowsAffected != 1 {
+		t.Errorf("unexpected result for insert: %v", result)
+	}
+}
@@ -58,6 +58,10 @@ type flavor interface {
 	// a change master command.
 	changeMasterArg() string
 
+	// startSlaveUntilAfterCommand returns the command to start
+	// replication, and stop it after the given position is applied.
+	startSlaveUntilAfterCommand(pos Position) string
+
 	// status returns the result of 'SHOW SLAVE STATUS',
 	// with parsed replication position.
 	status(c *Conn) (SlaveStatus, error)
@@ -157,6 +161,13 @@ func (c *Conn) SetSlavePositionCommands(pos Position) []string {
 	return c.flavor.setSlavePositionCommands(pos)
 }
 
+// StartSlaveUntilAfterCommand returns the command to start replication,
+// and stop it right after the transactions of the given position
+// are applied.
+func (c *Conn) StartSlaveUntilAfterCommand(pos Position) string {
+	return c.flavor.startSlaveUntilAfterCommand(pos)
+}
+
 // SetMasterCommand returns the command to use the provided master
 // as the new master (without changing any GTID position).
 // It is guaranteed to be called with replication stopped.
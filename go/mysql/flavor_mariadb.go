@@ -105,6 +105,11 @@ func (mariadbFlavor) changeMasterArg() string {
 	return "MASTER_USE_GTID = current_pos"
 }
 
+// startSlaveUntilAfterCommand is part of the Flavor interface.
+func (mariadbFlavor) startSlaveUntilAfterCommand(pos Position) string {
+	return fmt.Sprintf("START SLAVE UNTIL master_gtid_pos = '%s'", pos)
+}
+
 // status is part of the Flavor interface.
 func (mariadbFlavor) status(c *Conn) (SlaveStatus, error) {
 	qr, err := c.ExecuteFetch("SHOW ALL SLAVES STATUS", 100, true /* wantfields */)
@@ -75,3 +75,14 @@ func TestMariadbSetMasterCommandsSSL(t *testing.T) {
 		t.Errorf("mariadbFlavor.SetMasterCommands(%#v, %#v, %#v, %#v) = %#v, want %#v", params, masterHost, masterPort, masterConnectRetry, got, want)
 	}
 }
+
+func TestMariadbStartSlaveUntilAfterCommand(t *testing.T) {
+	pos := MustParsePosition(mariadbFlavorID, "0-1-5")
+	want := "START SLAVE UNTIL master_gtid_pos = '0-1-5'"
+
+	conn := &Conn{flavor: mariadbFlavor{}}
+	got := conn.StartSlaveUntilAfterCommand(pos)
+	if got != want {
+		t.Errorf("mariadbFlavor.StartSlaveUntilAfterCommand(%v) = %#v, want %#v", pos, got, want)
+	}
+}
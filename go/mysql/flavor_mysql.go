@@ -72,6 +72,11 @@ func (mysqlFlavor) changeMasterArg() string {
 	return "MASTER_AUTO_POSITION = 1"
 }
 
+// startSlaveUntilAfterCommand is part of the Flavor interface.
+func (mysqlFlavor) startSlaveUntilAfterCommand(pos Position) string {
+	return fmt.Sprintf("START SLAVE UNTIL SQL_AFTER_GTIDS = '%s'", pos)
+}
+
 // status is part of the Flavor interface.
 func (mysqlFlavor) status(c *Conn) (SlaveStatus, error) {
 	qr, err := c.ExecuteFetch("SHOW SLAVE STATUS", 100, true /* wantfields */)
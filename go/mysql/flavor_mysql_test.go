@@ -73,3 +73,14 @@ func TestMysql56SetMasterCommandsSSL(t *testing.T) {
 		t.Errorf("mysqlFlavor.SetMasterCommands(%#v, %#v, %#v, %#v) = %#v, want %#v", params, masterHost, masterPort, masterConnectRetry, got, want)
 	}
 }
+
+func TestMysql56StartSlaveUntilAfterCommand(t *testing.T) {
+	pos := MustParsePosition(mysql56FlavorID, "00010203-0405-0607-0809-0a0b0c0d0e0f:1-5")
+	want := "START SLAVE UNTIL SQL_AFTER_GTIDS = '00010203-0405-0607-0809-0a0b0c0d0e0f:1-5'"
+
+	conn := &Conn{flavor: mysqlFlavor{}}
+	got := conn.StartSlaveUntilAfterCommand(pos)
+	if got != want {
+		t.Errorf("mysqlFlavor.StartSlaveUntilAfterCommand(%v) = %#v, want %#v", pos, got, want)
+	}
+}
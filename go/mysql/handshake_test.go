@@ -90,6 +90,267 @@ func TestClearTextClientAuth(t *testing.T) {
 	conn.writeComQuit()
 }
 
+// fullAuthServer is an AuthServerStatic that never validates
+// caching_sha2_password scrambles, so clients have to go through full
+// authentication.
+type fullAuthServer struct {
+	*AuthServerStatic
+}
+
+func (a *fullAuthServer) ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, error) {
+	return nil, nil
+}
+
+// TestSha2ClientAuth tests the caching_sha2_password and sha256_password
+// methods over a non-TLS connection.
+func TestSha2ClientAuth(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic()
+	authServer.Method = CachingSha2Password
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{
+		{Password: "password1"},
+	}
+	authServer.Entries["user2"] = []*AuthServerStaticEntry{
+		// SHA256(SHA256("password2")).
+		{CachingSha2Password: "57DBA77E45795221E816C10310F9A2F6A1186A0EFA6C07B762F1349797399519"},
+	}
+	fullAuth := &fullAuthServer{authServer}
+
+	// Create the listener.
+	l, err := NewListener("tcp", ":0", fullAuth, th)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	host := l.Addr().(*net.TCPAddr).IP.String()
+	port := l.Addr().(*net.TCPAddr).Port
+	go func() {
+		l.Accept()
+	}()
+
+	// Save the public key of the server in a file.
+	key, err := l.rsaKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := marshalPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile, err := ioutil.TempFile("", "server_public_key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(keyFile.Name())
+	if _, err := keyFile.Write(pub); err != nil {
+		t.Fatal(err)
+	}
+	keyFile.Close()
+
+	cases := []struct {
+		name       string
+		authServer AuthServer
+		method     string
+		user       string
+		password   string
+		keyFile    bool
+		getKey     bool
+		err        string
+	}{{
+		name:       "caching_sha2 fast auth",
+		authServer: authServer,
+		method:     CachingSha2Password,
+		user:       "user1",
+		password:   "password1",
+	}, {
+		name:       "caching_sha2 fast auth with hash",
+		authServer: authServer,
+		method:     CachingSha2Password,
+		user:       "user2",
+		password:   "password2",
+	}, {
+		name:       "caching_sha2 fast auth wrong password",
+		authServer: authServer,
+		method:     CachingSha2Password,
+		user:       "user1",
+		password:   "bad",
+		err:        "Access denied for user 'user1'",
+	}, {
+		name:       "caching_sha2 full auth",
+		authServer: fullAuth,
+		method:     CachingSha2Password,
+		user:       "user1",
+		password:   "password1",
+		getKey:     true,
+	}, {
+		name:       "caching_sha2 full auth with hash",
+		authServer: fullAuth,
+		method:     CachingSha2Password,
+		user:       "user2",
+		password:   "password2",
+		getKey:     true,
+	}, {
+		name:       "caching_sha2 full auth wrong password",
+		authServer: fullAuth,
+		method:     CachingSha2Password,
+		user:       "user2",
+		password:   "bad",
+		getKey:     true,
+		err:        "Access denied for user 'user2'",
+	}, {
+		name:       "caching_sha2 full auth with key file",
+		authServer: fullAuth,
+		method:     CachingSha2Password,
+		user:       "user1",
+		password:   "password1",
+		keyFile:    true,
+	}, {
+		name:       "caching_sha2 full auth without key",
+		authServer: fullAuth,
+		method:     CachingSha2Password,
+		user:       "user1",
+		password:   "password1",
+		err:        "auth method caching_sha2_password requires a secure connection, or the public key of the server",
+	}, {
+		name:       "sha256",
+		authServer: authServer,
+		method:     Sha256Password,
+		user:       "user1",
+		password:   "password1",
+		getKey:     true,
+	}, {
+		name:       "sha256 wrong password",
+		authServer: authServer,
+		method:     Sha256Password,
+		user:       "user1",
+		password:   "bad",
+		getKey:     true,
+		err:        "Access denied for user 'user1'",
+	}, {
+		name:       "sha256 with key file",
+		authServer: authServer,
+		method:     Sha256Password,
+		user:       "user1",
+		password:   "password1",
+		keyFile:    true,
+	}, {
+		name:       "sha256 without key",
+		authServer: authServer,
+		method:     Sha256Password,
+		user:       "user1",
+		password:   "password1",
+		err:        "auth method sha256_password requires a secure connection, or the public key of the server",
+	}, {
+		name:       "native with hash only",
+		authServer: authServer,
+		method:     MysqlNativePassword,
+		user:       "user2",
+		password:   "",
+		err:        "Access denied for user 'user2'",
+	}}
+	for _, tcase := range cases {
+		t.Run(tcase.name, func(t *testing.T) {
+			l.authServer = tcase.authServer
+			authServer.Method = tcase.method
+			params := &ConnParams{
+				Host:               host,
+				Port:               port,
+				Uname:              tcase.user,
+				Pass:               tcase.password,
+				GetServerPublicKey: tcase.getKey,
+			}
+			if tcase.keyFile {
+				params.ServerPublicKey = keyFile.Name()
+			}
+			ctx := context.Background()
+			conn, err := Connect(ctx, params)
+			if tcase.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tcase.err) {
+					t.Fatalf("unexpected connection error: %v, want %v", err, tcase.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected connection error: %v", err)
+			}
+			defer conn.Close()
+			if conn.User != tcase.user {
+				t.Errorf("Invalid conn.User, got %v was expecting %v", conn.User, tcase.user)
+			}
+
+			// Run a 'select rows' command with results.
+			result, err := conn.ExecuteFetch("select rows", 10000, true)
+			if err != nil {
+				t.Fatalf("ExecuteFetch failed: %v", err)
+			}
+			if !reflect.DeepEqual(result, selectRowsResult) {
+				t.Errorf("Got wrong result from ExecuteFetch(select rows): %v", result)
+			}
+
+			// Send a ComQuit to avoid the error message on the server side.
+			conn.writeComQuit()
+		})
+	}
+}
+
+// TestSha2EmptyPassword tests that an empty password packet is
+// rejected, with or without a secure transport.
+func TestSha2EmptyPassword(t *testing.T) {
+	l := &Listener{}
+	for _, c := range []*Conn{{}, {Capabilities: CapabilityClientSSL}} {
+		_, err := l.readSha2Password(c, nil, CachingSha2Password, []byte{})
+		want := "received empty password packet (errno 2012) (sqlstate HY000)"
+		if err == nil || err.Error() != want {
+			t.Errorf("readSha2Password(capabilities: %v): %v, want %v", c.Capabilities, err, want)
+		}
+	}
+}
+
+// TestSha2ClientAuthUnixSocket tests the sha2 methods send the password
+// in clear text over unix sockets.
+func TestSha2ClientAuthUnixSocket(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic()
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{
+		{Password: "password1"},
+	}
+
+	unixSocket, err := ioutil.TempFile("", "mysql_vitess_test.sock")
+	if err != nil {
+		t.Fatalf("Failed to create temp file")
+	}
+	os.Remove(unixSocket.Name())
+
+	l, err := NewListener("unix", unixSocket.Name(), &fullAuthServer{authServer}, th)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+
+	params := &ConnParams{
+		UnixSocket: unixSocket.Name(),
+		Uname:      "user1",
+		Pass:       "password1",
+	}
+	for _, method := range []string{CachingSha2Password, Sha256Password} {
+		authServer.Method = method
+		conn, err := Connect(context.Background(), params)
+		if err != nil {
+			t.Fatalf("%v: unexpected connection error: %v", method, err)
+		}
+		conn.writeComQuit()
+		conn.Close()
+	}
+
+	// The password was never encrypted.
+	if l.RSAKey != nil {
+		t.Errorf("RSAKey was generated for unix socket connections")
+	}
+}
+
 // TestSSLConnection creates a server with TLS support, a client that
 // also has SSL support, and connects them.
 func TestSSLConnection(t *testing.T) {
@@ -154,6 +415,17 @@ func TestSSLConnection(t *testing.T) {
 		authServer.Method = MysqlClearPassword
 		testSSLConnectionClearText(t, params)
 	})
+
+	// Make sure the sha2 methods send the password over SSL.
+	t.Run("Sha256", func(t *testing.T) {
+		authServer.Method = Sha256Password
+		testSSLConnectionClearText(t, params)
+	})
+	t.Run("CachingSha2FullAuth", func(t *testing.T) {
+		authServer.Method = CachingSha2Password
+		l.authServer = &fullAuthServer{authServer}
+		testSSLConnectionClearText(t, params)
+	})
 }
 
 func testSSLConnectionClearText(t *testing.T, params *ConnParams) {
@@ -0,0 +1,510 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file contains the methods related to prepared statements,
+// using the binary protocol:
+// COM_STMT_PREPARE, COM_STMT_EXECUTE, COM_STMT_SEND_LONG_DATA,
+// COM_STMT_RESET and COM_STMT_CLOSE.
+// See https://dev.mysql.com/doc/internals/en/prepared-statements.html
+
+//
+// Client side methods.
+//
+
+// Prepare sends a COM_STMT_PREPARE for the query, and returns the
+// description of the statement the server sent back.
+// Returns a SQLError.
+func (c *Conn) Prepare(query string) (prepare *PrepareData, err error) {
+	defer func() {
+		if err != nil {
+			if sqlerr, ok := err.(*SQLError); ok {
+				sqlerr.Query = query
+			}
+		}
+	}()
+
+	// This is a new command, need to reset the sequence.
+	c.resetSequence()
+
+	data := c.startEphemeralPacket(len(query) + 1)
+	data[0] = ComPrepare
+	copy(data[1:], query)
+	if err := c.writeEphemeralPacket(true); err != nil {
+		return nil, NewSQLError(CRServerGone, SSUnknownSQLState, err.Error())
+	}
+
+	return c.readPrepareResponse(query)
+}
+
+// readPrepareResponse reads the COM_STMT_PREPARE_OK packet and the
+// parameter and column definitions that follow it.
+func (c *Conn) readPrepareResponse(query string) (*PrepareData, error) {
+	data, err := c.readEphemeralPacket()
+	if err != nil {
+		return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+	}
+	if len(data) == 0 {
+		c.recycleReadPacket()
+		return nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid empty COM_STMT_PREPARE response packet")
+	}
+	switch data[0] {
+	case OKPacket:
+		// This is what we expect.
+	case ErrPacket:
+		defer c.recycleReadPacket()
+		return nil, ParseErrorPacket(data)
+	default:
+		defer c.recycleReadPacket()
+		return nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "unexpected COM_STMT_PREPARE response: %v", data)
+	}
+
+	pos := 1
+	statementID, pos, ok := readUint32(data, pos)
+	if !ok {
+		c.recycleReadPacket()
+		return nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid COM_STMT_PREPARE response statement ID: %v", data)
+	}
+	columnCount, pos, ok := readUint16(data, pos)
+	if !ok {
+		c.recycleReadPacket()
+		return nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid COM_STMT_PREPARE response column count: %v", data)
+	}
+	paramsCount, _, ok := readUint16(data, pos)
+	if !ok {
+		c.recycleReadPacket()
+		return nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid COM_STMT_PREPARE response params count: %v", data)
+	}
+	c.recycleReadPacket()
+
+	prepare := &PrepareData{
+		StatementID: statementID,
+		PrepareStmt: query,
+		ParamsCount: paramsCount,
+	}
+
+	// The parameter definitions carry no useful information, skip them.
+	if paramsCount > 0 {
+		for i := 0; i < int(paramsCount); i++ {
+			if _, err := c.readEphemeralPacket(); err != nil {
+				return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+			}
+			c.recycleReadPacket()
+		}
+		if err := c.readMetadataEOF(); err != nil {
+			return nil, err
+		}
+	}
+
+	if columnCount > 0 {
+		fields := make([]querypb.Field, columnCount)
+		prepare.Fields = make([]*querypb.Field, columnCount)
+		for i := range fields {
+			prepare.Fields[i] = &fields[i]
+			if err := c.readColumnDefinition(prepare.Fields[i], i); err != nil {
+				return nil, err
+			}
+		}
+		if err := c.readMetadataEOF(); err != nil {
+			return nil, err
+		}
+	}
+
+	return prepare, nil
+}
+
+// readMetadataEOF reads the EOF packet that terminates a list of
+// column definitions, if CapabilityClientDeprecateEOF is not set.
+func (c *Conn) readMetadataEOF() error {
+	if c.Capabilities&CapabilityClientDeprecateEOF != 0 {
+		return nil
+	}
+	data, err := c.readEphemeralPacket()
+	if err != nil {
+		return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+	}
+	defer c.recycleReadPacket()
+	switch data[0] {
+	case EOFPacket:
+		return nil
+	case ErrPacket:
+		return ParseErrorPacket(data)
+	}
+	return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "unexpected packet after column definitions: %v", data)
+}
+
+// ExecutePrepared executes a statement returned by Prepare with the
+// provided arguments, and returns the result. The result rows are
+// sent by the server in the binary format, and converted back to the
+// usual sqltypes.Value representation.
+// Returns a SQLError.
+func (c *Conn) ExecutePrepared(prepare *PrepareData, args []sqltypes.Value, maxrows int, wantfields bool) (result *sqltypes.Result, err error) {
+	defer func() {
+		if err != nil {
+			if sqlerr, ok := err.(*SQLError); ok {
+				sqlerr.Query = prepare.PrepareStmt
+			}
+		}
+	}()
+
+	if len(args) != int(prepare.ParamsCount) {
+		return nil, NewSQLError(CRParamsNotBound, SSUnknownSQLState, "statement has %v parameters but %v arguments were provided", prepare.ParamsCount, len(args))
+	}
+	if err := c.writeComStmtExecute(prepare, args); err != nil {
+		return nil, err
+	}
+	result, _, err = c.readQueryResult(maxrows, wantfields, true)
+	return result, err
+}
+
+// writeComStmtExecute writes a COM_STMT_EXECUTE packet. We never ask
+// for a cursor, and always bind the parameter types.
+// Client -> Server.
+// Returns SQLError(CRServerGone) if it can't.
+func (c *Conn) writeComStmtExecute(prepare *PrepareData, args []sqltypes.Value) error {
+	payload := make([]byte, 0, 10)
+	payload = append(payload, ComStmtExecute)
+	payload = appendUint32(payload, prepare.StatementID)
+	// Flags: CURSOR_TYPE_NO_CURSOR.
+	payload = append(payload, 0)
+	// Iteration count, always 1.
+	payload = appendUint32(payload, 1)
+
+	if len(args) > 0 {
+		nullBitmap := make([]byte, (len(args)+7)/8)
+		types := make([]byte, 0, 2*len(args))
+		var values []byte
+		for i, arg := range args {
+			types = appendUint16(types, binaryParamType(arg))
+			if arg.IsNull() {
+				nullBitmap[i/8] |= 1 << uint(i%8)
+				continue
+			}
+			var err error
+			values, err = appendBinaryValue(values, arg)
+			if err != nil {
+				return NewSQLError(CRUnknownError, SSUnknownSQLState, "cannot encode argument %v: %v", i, err)
+			}
+		}
+		payload = append(payload, nullBitmap...)
+		// new-params-bound-flag: we always send the types.
+		payload = append(payload, 1)
+		payload = append(payload, types...)
+		payload = append(payload, values...)
+	}
+
+	// This is a new command, need to reset the sequence.
+	c.resetSequence()
+
+	data := c.startEphemeralPacket(len(payload))
+	copy(data, payload)
+	if err := c.writeEphemeralPacket(true); err != nil {
+		return NewSQLError(CRServerGone, SSUnknownSQLState, err.Error())
+	}
+	return nil
+}
+
+// ClosePrepared sends a COM_STMT_CLOSE for the statement. The server
+// does not reply to it.
+// Returns SQLError(CRServerGone) if it can't.
+func (c *Conn) ClosePrepared(prepare *PrepareData) error {
+	// This is a new command, need to reset the sequence.
+	c.resetSequence()
+
+	data := c.startEphemeralPacket(5)
+	pos := writeByte(data, 0, ComStmtClose)
+	writeUint32(data, pos, prepare.StatementID)
+	if err := c.writeEphemeralPacket(true); err != nil {
+		return NewSQLError(CRServerGone, SSUnknownSQLState, err.Error())
+	}
+	return nil
+}
+
+// parseBinaryRow parses an individual row of a binary result set.
+// Returns a SQLError.
+func (c *Conn) parseBinaryRow(data []byte, fields []*querypb.Field) ([]sqltypes.Value, error) {
+	colNumber := len(fields)
+	result := make([]sqltypes.Value, colNumber)
+
+	// The packet header is followed by the NULL bitmap, which
+	// has an offset of 2 bits.
+	pos := 1 + (colNumber+7+2)/8
+	if pos > len(data) {
+		return nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "binary row too short for NULL bitmap: %v", data)
+	}
+	for i := 0; i < colNumber; i++ {
+		bit := uint(i + 2)
+		if data[1+bit/8]&(1<<(bit%8)) != 0 {
+			continue
+		}
+		var ok bool
+		result[i], pos, ok = readBinaryValue(data, pos, fields[i].Type, fields[i].Decimals)
+		if !ok {
+			return nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "decoding binary value for column %v failed", i)
+		}
+	}
+	return result, nil
+}
+
+//
+// Server side methods.
+//
+
+func (c *Conn) parseComPrepare(data []byte) string {
+	return string(data[1:])
+}
+
+// countParams returns the number of '?' placeholders in a query,
+// or a SQLError if the query cannot be parsed.
+func countParams(query string) (uint16, error) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return 0, NewSQLError(ERParseError, SSUnknownSQLState, "%v", err)
+	}
+	count := uint16(0)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if val, ok := node.(*sqlparser.SQLVal); ok && val.Type == sqlparser.ValArg && strings.HasPrefix(string(val.Val), ":v") {
+			count++
+		}
+		return true, nil
+	}, stmt)
+	return count, nil
+}
+
+// writePrepare writes the response to a COM_STMT_PREPARE: a
+// COM_STMT_PREPARE_OK packet, followed by the parameter and column
+// definitions.
+func (c *Conn) writePrepare(prepare *PrepareData) error {
+	data := c.startEphemeralPacket(12)
+	pos := 0
+	pos = writeByte(data, pos, OKPacket)
+	pos = writeUint32(data, pos, prepare.StatementID)
+	pos = writeUint16(data, pos, uint16(len(prepare.Fields)))
+	pos = writeUint16(data, pos, prepare.ParamsCount)
+	pos = writeByte(data, pos, 0) // reserved
+	writeUint16(data, pos, 0)     // warning count
+	if err := c.writeEphemeralPacket(false); err != nil {
+		return err
+	}
+
+	if prepare.ParamsCount > 0 {
+		for i := uint16(0); i < prepare.ParamsCount; i++ {
+			if err := c.writeColumnDefinition(&querypb.Field{
+				Name:    "?",
+				Type:    sqltypes.VarBinary,
+				Charset: CharacterSetBinary,
+			}); err != nil {
+				return err
+			}
+		}
+		if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
+			if err := c.writeEOFPacket(c.StatusFlags, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(prepare.Fields) > 0 {
+		for _, field := range prepare.Fields {
+			if err := c.writeColumnDefinition(field); err != nil {
+				return err
+			}
+		}
+		if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
+			if err := c.writeEOFPacket(c.StatusFlags, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	return c.flush()
+}
+
+// parseComStmtExecute parses a COM_STMT_EXECUTE packet, and stores
+// the parameters in the BindVars of the statement. It returns the
+// statement ID. The cursor flags are ignored: we always send the
+// entire result set.
+// Returns a SQLError.
+func (c *Conn) parseComStmtExecute(data []byte) (uint32, error) {
+	pos := 1
+	statementID, pos, ok := readUint32(data, pos)
+	if !ok {
+		return 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading statement ID failed")
+	}
+	prepare, ok := c.PrepareData[statementID]
+	if !ok {
+		return statementID, NewSQLError(ERUnknownStmtHandler, SSUnknownSQLState, "unknown prepared statement handler (%v) given to mysqld_stmt_execute", statementID)
+	}
+
+	// Cursor flags, 1 byte, and iteration count, 4 bytes.
+	pos += 5
+	if pos > len(data) {
+		return statementID, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading cursor flags and iteration count failed")
+	}
+
+	if prepare.ParamsCount == 0 {
+		return statementID, nil
+	}
+
+	nullBitmap, pos, ok := readBytes(data, pos, int((prepare.ParamsCount+7)/8))
+	if !ok {
+		return statementID, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading NULL bitmap failed")
+	}
+	newParamsBound, pos, ok := readByte(data, pos)
+	if !ok {
+		return statementID, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading new-params-bound flag failed")
+	}
+	if newParamsBound == 1 {
+		for i := range prepare.ParamsType {
+			var paramType uint16
+			paramType, pos, ok = readUint16(data, pos)
+			if !ok {
+				return statementID, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "reading type of parameter %v failed", i)
+			}
+			prepare.ParamsType[i] = int32(paramType)
+		}
+	}
+
+	for i := 0; i < int(prepare.ParamsCount); i++ {
+		name := paramName(i)
+		if nullBitmap[i/8]&(1<<uint(i%8)) != 0 {
+			prepare.BindVars[name] = sqltypes.NullBindVariable
+			continue
+		}
+		if _, ok := prepare.BindVars[name]; ok {
+			// The value was sent by COM_STMT_SEND_LONG_DATA.
+			continue
+		}
+		typ, err := binaryParamToType(prepare.ParamsType[i])
+		if err != nil {
+			return statementID, NewSQLError(ERUnknownError, SSUnknownSQLState, "parameter %v: %v", i, err)
+		}
+		var val sqltypes.Value
+		val, pos, ok = readBinaryValue(data, pos, typ, 0)
+		if !ok {
+			return statementID, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "decoding parameter %v failed", i)
+		}
+		// The packet is ephemeral, so we need to copy the value.
+		prepare.BindVars[name] = &querypb.BindVariable{
+			Type:  val.Type(),
+			Value: append([]byte(nil), val.Raw()...),
+		}
+	}
+	return statementID, nil
+}
+
+// parseComStmtSendLongData parses a COM_STMT_SEND_LONG_DATA packet.
+// It returns the statement ID, the parameter index, and a copy of the
+// data chunk.
+func (c *Conn) parseComStmtSendLongData(data []byte) (uint32, uint16, []byte, bool) {
+	pos := 1
+	statementID, pos, ok := readUint32(data, pos)
+	if !ok {
+		return 0, 0, nil, false
+	}
+	paramID, pos, ok := readUint16(data, pos)
+	if !ok {
+		return 0, 0, nil, false
+	}
+	chunk := make([]byte, len(data)-pos)
+	copy(chunk, data[pos:])
+	return statementID, paramID, chunk, true
+}
+
+// parseComStmtClose returns the statement ID of a COM_STMT_CLOSE packet.
+func (c *Conn) parseComStmtClose(data []byte) (uint32, bool) {
+	statementID, _, ok := readUint32(data, 1)
+	return statementID, ok
+}
+
+// parseComStmtReset returns the statement ID of a COM_STMT_RESET packet.
+func (c *Conn) parseComStmtReset(data []byte) (uint32, bool) {
+	statementID, _, ok := readUint32(data, 1)
+	return statementID, ok
+}
+
+// appendLongData adds a chunk of COM_STMT_SEND_LONG_DATA to a
+// parameter of the statement.
+func (prepare *PrepareData) appendLongData(paramID uint16, chunk []byte) error {
+	if paramID >= prepare.ParamsCount {
+		return fmt.Errorf("parameter %v out of range, statement has %v parameters", paramID, prepare.ParamsCount)
+	}
+	name := paramName(int(paramID))
+	if bv, ok := prepare.BindVars[name]; ok {
+		bv.Value = append(bv.Value, chunk...)
+		return nil
+	}
+	prepare.BindVars[name] = sqltypes.BytesBindVariable(chunk)
+	return nil
+}
+
+// resetBindVars clears the parameters of the statement. It is called
+// after each execution, and by COM_STMT_RESET.
+func (prepare *PrepareData) resetBindVars() {
+	prepare.BindVars = make(map[string]*querypb.BindVariable, prepare.ParamsCount)
+}
+
+// paramName returns the bind variable name sqlparser uses for the
+// i-th (0-based) '?' placeholder.
+func paramName(i int) string {
+	return fmt.Sprintf("v%d", i+1)
+}
+
+// writeBinaryRow writes a row of a binary result set. The values are
+// encoded according to the type of their field.
+func (c *Conn) writeBinaryRow(fields []*querypb.Field, row []sqltypes.Value) error {
+	// Packet header, then the NULL bitmap with an offset of 2 bits.
+	buf := make([]byte, 1+(len(row)+7+2)/8, 64)
+	for i, val := range row {
+		if val.IsNull() {
+			bit := uint(i + 2)
+			buf[1+bit/8] |= 1 << (bit % 8)
+			continue
+		}
+		var err error
+		buf, err = appendBinaryValue(buf, sqltypes.MakeTrusted(fields[i].Type, val.Raw()))
+		if err != nil {
+			return fmt.Errorf("internal error encoding column %v (%v) of binary row: %v", i, fields[i].Name, err)
+		}
+	}
+
+	data := c.startEphemeralPacket(len(buf))
+	copy(data, buf)
+	return c.writeEphemeralPacket(false)
+}
+
+// writeBinaryRows sends the rows of a Result in the binary format.
+// The fields are passed separately, as streaming results only
+// contain them in the first Result.
+func (c *Conn) writeBinaryRows(fields []*querypb.Field, result *sqltypes.Result) error {
+	for _, row := range result.Rows {
+		if err := c.writeBinaryRow(fields, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
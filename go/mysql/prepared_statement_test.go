@@ -0,0 +1,259 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// connectPrepareTest starts a Listener using testHandler, and
+// returns a client connection to it.
+func connectPrepareTest(t *testing.T) (*Listener, *Conn) {
+	authServer := NewAuthServerStatic()
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	l, err := NewListener("tcp", ":0", authServer, &testHandler{})
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+	c, err := Connect(context.Background(), params)
+	if err != nil {
+		l.Close()
+		t.Fatalf("Connect failed: %v", err)
+	}
+	return l, c
+}
+
+// writeStmtCommand sends a COM_STMT_* packet built by the test.
+func writeStmtCommand(t *testing.T, c *Conn, payload []byte) {
+	c.sequence = 0
+	data := c.startEphemeralPacket(len(payload))
+	copy(data, payload)
+	if err := c.writeEphemeralPacket(true); err != nil {
+		t.Fatalf("writeEphemeralPacket failed: %v", err)
+	}
+}
+
+func TestPreparedStatement(t *testing.T) {
+	l, c := connectPrepareTest(t)
+	defer l.Close()
+	defer c.Close()
+
+	prepare, err := c.Prepare("select a, b from t where id = ? and name = ? and c in (?, ?)")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	if prepare.ParamsCount != 4 || prepare.StatementID != 1 {
+		t.Errorf("Prepare returned %v params and statement ID %v, want 4 and 1", prepare.ParamsCount, prepare.StatementID)
+	}
+
+	args := []sqltypes.Value{
+		sqltypes.NewInt64(-123),
+		sqltypes.NewVarChar("abc"),
+		sqltypes.NULL,
+		sqltypes.NewUint64(18446744073709551615),
+	}
+	result, err := c.ExecutePrepared(prepare, args, 10, true)
+	if err != nil {
+		t.Fatalf("ExecutePrepared failed: %v", err)
+	}
+	if len(result.Fields) != 4 || result.Fields[0].Name != "v1" || result.Fields[3].Name != "v4" {
+		t.Errorf("ExecutePrepared returned fields %v", result.Fields)
+	}
+	want := [][]sqltypes.Value{args}
+	if !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("ExecutePrepared returned rows %v, want %v", result.Rows, want)
+	}
+
+	// The statement can be executed again, with other values.
+	args = []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NULL,
+		sqltypes.NewFloat64(2.5),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2018-03-04 05:06:07")),
+	}
+	result, err = c.ExecutePrepared(prepare, args, 10, false)
+	if err != nil {
+		t.Fatalf("ExecutePrepared failed: %v", err)
+	}
+	want = [][]sqltypes.Value{args}
+	if !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("ExecutePrepared returned rows %v, want %v", result.Rows, want)
+	}
+
+	// Wrong number of arguments is caught by the client.
+	_, err = c.ExecutePrepared(prepare, args[:1], 10, false)
+	if sqlErr, ok := err.(*SQLError); !ok || sqlErr.Number() != CRParamsNotBound {
+		t.Errorf("ExecutePrepared with missing arguments returned %v", err)
+	}
+
+	// A statement without parameters.
+	noParams, err := c.Prepare("select id, name from t")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	if noParams.StatementID != 2 {
+		t.Errorf("Prepare returned statement ID %v, want 2", noParams.StatementID)
+	}
+	result, err = c.ExecutePrepared(noParams, nil, 10, true)
+	if err != nil {
+		t.Fatalf("ExecutePrepared failed: %v", err)
+	}
+	if !reflect.DeepEqual(result.Rows, selectRowsResult.Rows) {
+		t.Errorf("ExecutePrepared returned rows %v, want %v", result.Rows, selectRowsResult.Rows)
+	}
+
+	// A statement returning an OK packet.
+	insert, err := c.Prepare("insert into t(a, b) values (?, ?)")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	result, err = c.ExecutePrepared(insert, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, 10, true)
+	if err != nil {
+		t.Fatalf("ExecutePrepared failed: %v", err)
+	}
+	if result.RowsAffected != 1 || result.InsertID != 2 {
+		t.Errorf("ExecutePrepared returned %v, want 1 row affected and insert ID 2", result)
+	}
+
+	// After closing the statement, it is unknown to the server.
+	if err := c.ClosePrepared(prepare); err != nil {
+		t.Fatalf("ClosePrepared failed: %v", err)
+	}
+	_, err = c.ExecutePrepared(prepare, args, 10, false)
+	if sqlErr, ok := err.(*SQLError); !ok || sqlErr.Number() != ERUnknownStmtHandler {
+		t.Errorf("ExecutePrepared on closed statement returned %v", err)
+	}
+
+	// The connection is still usable.
+	if _, err := c.ExecuteFetch("select rows", 10, false); err != nil {
+		t.Errorf("ExecuteFetch failed: %v", err)
+	}
+}
+
+func TestPrepareParseError(t *testing.T) {
+	l, c := connectPrepareTest(t)
+	defer l.Close()
+	defer c.Close()
+
+	_, err := c.Prepare("selec a from t where id = ?")
+	if sqlErr, ok := err.(*SQLError); !ok || sqlErr.Number() != ERParseError {
+		t.Errorf("Prepare with invalid query returned %v", err)
+	}
+
+	// The statement ID was not used.
+	prepare, err := c.Prepare("select a from t where id = ?")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+	if prepare.StatementID != 1 {
+		t.Errorf("Prepare returned statement ID %v, want 1", prepare.StatementID)
+	}
+}
+
+func TestPreparedStatementLongData(t *testing.T) {
+	l, c := connectPrepareTest(t)
+	defer l.Close()
+	defer c.Close()
+
+	prepare, err := c.Prepare("select a from t where id = ? and b = ?")
+	if err != nil {
+		t.Fatalf("Prepare failed: %v", err)
+	}
+
+	sendLongData := func(paramID uint16, chunk string) {
+		payload := []byte{ComStmtSendLongData}
+		payload = appendUint32(payload, prepare.StatementID)
+		payload = appendUint16(payload, paramID)
+		payload = append(payload, chunk...)
+		writeStmtCommand(t, c, payload)
+	}
+
+	// Clients do not send a value for a parameter sent as long
+	// data. As it is the last one here, the server never reads it.
+	sendLongData(1, "long ")
+	sendLongData(1, "data")
+	args := []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewVarChar("ignored"),
+	}
+	result, err := c.ExecutePrepared(prepare, args, 10, false)
+	if err != nil {
+		t.Fatalf("ExecutePrepared failed: %v", err)
+	}
+	want := [][]sqltypes.Value{{
+		sqltypes.NewInt64(1),
+		sqltypes.NewVarBinary("long data"),
+	}}
+	if !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("ExecutePrepared returned rows %v, want %v", result.Rows, want)
+	}
+
+	// The long data is only used for one execution.
+	result, err = c.ExecutePrepared(prepare, args, 10, false)
+	if err != nil {
+		t.Fatalf("ExecutePrepared failed: %v", err)
+	}
+	want = [][]sqltypes.Value{args}
+	if !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("ExecutePrepared returned rows %v, want %v", result.Rows, want)
+	}
+
+	// COM_STMT_RESET drops the long data.
+	sendLongData(1, "dropped")
+	payload := []byte{ComStmtReset}
+	payload = appendUint32(payload, prepare.StatementID)
+	writeStmtCommand(t, c, payload)
+	data, err := c.ReadPacket()
+	if err != nil || len(data) == 0 || data[0] != OKPacket {
+		t.Fatalf("COM_STMT_RESET returned %v %v, want OK packet", data, err)
+	}
+	result, err = c.ExecutePrepared(prepare, args, 10, false)
+	if err != nil {
+		t.Fatalf("ExecutePrepared failed: %v", err)
+	}
+	if !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("ExecutePrepared returned rows %v, want %v", result.Rows, want)
+	}
+
+	// COM_STMT_RESET on an unknown statement is an error.
+	payload = []byte{ComStmtReset}
+	payload = appendUint32(payload, 1234)
+	writeStmtCommand(t, c, payload)
+	data, err = c.ReadPacket()
+	if err != nil || len(data) == 0 || data[0] != ErrPacket {
+		t.Fatalf("COM_STMT_RESET returned %v %v, want error packet", data, err)
+	}
+	if sqlErr, ok := ParseErrorPacket(data).(*SQLError); !ok || sqlErr.Number() != ERUnknownStmtHandler {
+		t.Errorf("COM_STMT_RESET returned %v", ParseErrorPacket(data))
+	}
+}
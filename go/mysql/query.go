@@ -35,7 +35,7 @@ import (
 // Returns SQLError(CRServerGone) if it can't.
 func (c *Conn) WriteComQuery(query string) error {
 	// This is a new command, need to reset the sequence.
-	c.sequence = 0
+	c.resetSequence()
 
 	data := c.startEphemeralPacket(len(query) + 1)
 	data[0] = ComQuery
@@ -50,6 +50,9 @@ func (c *Conn) WriteComQuery(query string) error {
 // Client -> Server.
 // Returns SQLError(CRServerGone) if it can't.
 func (c *Conn) writeComInitDB(db string) error {
+	// This is a new command, need to reset the sequence.
+	c.resetSequence()
+
 	data := c.startEphemeralPacket(len(db) + 1)
 	data[0] = ComInitDB
 	copy(data[1:], db)
@@ -277,7 +280,32 @@ func (c *Conn) parseRow(data []byte, fields []*querypb.Field) ([]sqltypes.Value,
 //
 // 2. if the server closes the connection when a command is in flight,
 //    readComQueryResponse will fail, and we'll return CRServerLost(2013).
-func (c *Conn) ExecuteFetch(query string, maxrows int, wantfields bool) (result *sqltypes.Result, err error) {
+//
+// The query must return a single result. Use ExecuteFetchMulti for
+// multiple statements.
+func (c *Conn) ExecuteFetch(query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
+	result, more, err := c.ExecuteFetchMulti(query, maxrows, wantfields)
+	if more {
+		// Read the other results, so the connection can
+		// still be used.
+		if derr := c.drainMoreResults(); derr != nil {
+			return nil, derr
+		}
+		if err == nil {
+			err = NewSQLError(CRUnknownError, SSUnknownSQLState, "unexpected multiple results, use ExecuteFetchMulti")
+		}
+		return nil, err
+	}
+	return result, err
+}
+
+// ExecuteFetchMulti executes a query that can contain multiple
+// statements, if the connection was opened with
+// CapabilityClientMultiStatements. It returns the first result, and
+// more is set if other results follow. They are read with
+// ReadQueryResult. If a statement fails, the error is returned in
+// place of its result, and the remaining statements are not executed.
+func (c *Conn) ExecuteFetchMulti(query string, maxrows int, wantfields bool) (result *sqltypes.Result, more bool, err error) {
 	defer func() {
 		if err != nil {
 			if sqlerr, ok := err.(*SQLError); ok {
@@ -288,25 +316,32 @@ func (c *Conn) ExecuteFetch(query string, maxrows int, wantfields bool) (result
 
 	// Send the query as a COM_QUERY packet.
 	if err = c.WriteComQuery(query); err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	return c.ReadQueryResult(maxrows, wantfields)
 }
 
-// ReadQueryResult gets the result from the last written query.
-func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (result *sqltypes.Result, err error) {
+// ReadQueryResult gets the next result from the last written query.
+// more is set if another result follows.
+func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (result *sqltypes.Result, more bool, err error) {
+	return c.readQueryResult(maxrows, wantfields, false)
+}
+
+// readQueryResult reads a result set. If binaryRows is set, the rows
+// use the binary protocol of prepared statements.
+func (c *Conn) readQueryResult(maxrows int, wantfields bool, binaryRows bool) (result *sqltypes.Result, more bool, err error) {
 	// Get the result.
-	affectedRows, lastInsertID, colNumber, err := c.readComQueryResponse()
+	affectedRows, lastInsertID, colNumber, more, err := c.readComQueryResponse()
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	if colNumber == 0 {
 		// OK packet, means no results. Just use the numbers.
 		return &sqltypes.Result{
 			RowsAffected: affectedRows,
 			InsertID:     lastInsertID,
-		}, nil
+		}, more, nil
 	}
 
 	fields := make([]querypb.Field, colNumber)
@@ -319,13 +354,15 @@ func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (result *sqltypes.R
 	for i := 0; i < colNumber; i++ {
 		result.Fields[i] = &fields[i]
 
-		if wantfields {
+		// Binary rows need the decimals of temporal types,
+		// so we always read the full definition for them.
+		if wantfields || binaryRows {
 			if err := c.readColumnDefinition(result.Fields[i], i); err != nil {
-				return nil, err
+				return nil, false, err
 			}
 		} else {
 			if err := c.readColumnDefinitionType(result.Fields[i], i); err != nil {
-				return nil, err
+				return nil, false, err
 			}
 		}
 	}
@@ -334,7 +371,7 @@ func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (result *sqltypes.R
 		// EOF is only present here if it's not deprecated.
 		data, err := c.readEphemeralPacket()
 		if err != nil {
-			return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+			return nil, false, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
 		}
 		switch data[0] {
 		case EOFPacket:
@@ -345,10 +382,10 @@ func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (result *sqltypes.R
 		case ErrPacket:
 			// Error packet.
 			defer c.recycleReadPacket()
-			return nil, ParseErrorPacket(data)
+			return nil, false, ParseErrorPacket(data)
 		default:
 			defer c.recycleReadPacket()
-			return nil, fmt.Errorf("unexpected packet after fields: %v", data)
+			return nil, false, fmt.Errorf("unexpected packet after fields: %v", data)
 		}
 	}
 
@@ -356,103 +393,164 @@ func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (result *sqltypes.R
 	for {
 		data, err := c.ReadPacket()
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		switch data[0] {
 		case EOFPacket:
-			// This packet may be one of two kinds:
-			// - an EOF packet,
-			// - an OK packet with an EOF header if
-			// CapabilityClientDeprecateEOF is set.
-			// We do not parse it anyway, so it doesn't matter.
+			// End of the result set, it tells us if
+			// there are more.
+			more, err := c.parseEndResult(data)
+			if err != nil {
+				return nil, false, err
+			}
 
 			// Strip the partial Fields before returning.
 			if !wantfields {
 				result.Fields = nil
 			}
 			result.RowsAffected = uint64(len(result.Rows))
-			return result, nil
+			return result, more, nil
 		case ErrPacket:
 			// Error packet.
-			return nil, ParseErrorPacket(data)
+			return nil, false, ParseErrorPacket(data)
 		}
 
 		// Check we're not over the limit before we add more.
 		if len(result.Rows) == maxrows {
-			if err := c.drainResults(); err != nil {
-				return nil, err
+			more, err := c.drainResults()
+			if err != nil {
+				return nil, false, err
 			}
-			return nil, &SQLError{
+			return nil, more, &SQLError{
 				Num:     ERVitessMaxRowsExceeded,
 				Message: fmt.Sprintf("Row count exceeded %d", maxrows),
 			}
 		}
 
 		// Regular row.
-		row, err := c.parseRow(data, result.Fields)
+		var row []sqltypes.Value
+		if binaryRows {
+			row, err = c.parseBinaryRow(data, result.Fields)
+		} else {
+			row, err = c.parseRow(data, result.Fields)
+		}
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		result.Rows = append(result.Rows, row)
 	}
 }
 
 // drainResults will read all packets for a result set and ignore them.
-func (c *Conn) drainResults() error {
+// It returns true if more results follow.
+func (c *Conn) drainResults() (bool, error) {
 	for {
 		data, err := c.readEphemeralPacket()
 		if err != nil {
-			return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+			return false, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
 		}
 		switch data[0] {
 		case EOFPacket:
-			// This packet may be one of two kinds:
-			// - an EOF packet,
-			// - an OK packet with an EOF header if
-			// CapabilityClientDeprecateEOF is set.
-			// We do not parse it anyway, so it doesn't matter.
-			c.recycleReadPacket()
-			return nil
+			defer c.recycleReadPacket()
+			return c.parseEndResult(data)
 		case ErrPacket:
 			// Error packet.
 			defer c.recycleReadPacket()
-			return ParseErrorPacket(data)
+			return false, ParseErrorPacket(data)
 		}
 		c.recycleReadPacket()
 	}
 }
 
-func (c *Conn) readComQueryResponse() (uint64, uint64, int, error) {
+// drainMoreResults reads all the remaining results of a
+// multi-statement query, and ignores them.
+func (c *Conn) drainMoreResults() error {
+	for more := true; more; {
+		_, _, colNumber, m, err := c.readComQueryResponse()
+		if err != nil {
+			return err
+		}
+		more = m
+		if colNumber == 0 {
+			continue
+		}
+
+		// Skip the column definitions, and the EOF packet
+		// after them if it's not deprecated.
+		skip := colNumber
+		if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
+			skip++
+		}
+		for i := 0; i < skip; i++ {
+			if _, err := c.readEphemeralPacket(); err != nil {
+				return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+			}
+			c.recycleReadPacket()
+		}
+		if more, err = c.drainResults(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseEndResult parses the packet that ends the rows of a result set,
+// and returns true if ServerMoreResultsExists is set. It is either an
+// EOF packet, or an OK packet with an EOF header if
+// CapabilityClientDeprecateEOF is set.
+func (c *Conn) parseEndResult(data []byte) (bool, error) {
+	var statusFlags uint16
+	if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
+		// Skip the type and the warnings.
+		var ok bool
+		statusFlags, _, ok = readUint16(data, 3)
+		if !ok {
+			return false, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid EOF packet statusFlags: %v", data)
+		}
+	} else {
+		var err error
+		_, _, statusFlags, _, err = parseOKPacket(data)
+		if err != nil {
+			return false, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "%v", err)
+		}
+	}
+	return statusFlags&ServerMoreResultsExists != 0, nil
+}
+
+// readComQueryResponse reads the first packet of a query response. It
+// returns the affected rows, last insert id and whether more results
+// follow for an OK packet, or the number of columns of a result set.
+func (c *Conn) readComQueryResponse() (uint64, uint64, int, bool, error) {
 	data, err := c.readEphemeralPacket()
 	if err != nil {
-		return 0, 0, 0, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+		return 0, 0, 0, false, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
 	}
 	defer c.recycleReadPacket()
 	if len(data) == 0 {
-		return 0, 0, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid empty COM_QUERY response packet")
+		return 0, 0, 0, false, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "invalid empty COM_QUERY response packet")
 	}
 
 	switch data[0] {
 	case OKPacket:
-		affectedRows, lastInsertID, _, _, err := parseOKPacket(data)
-		return affectedRows, lastInsertID, 0, err
+		affectedRows, lastInsertID, statusFlags, _, err := parseOKPacket(data)
+		return affectedRows, lastInsertID, 0, statusFlags&ServerMoreResultsExists != 0, err
 	case ErrPacket:
 		// Error
-		return 0, 0, 0, ParseErrorPacket(data)
+		return 0, 0, 0, false, ParseErrorPacket(data)
 	case 0xfb:
 		// Local infile
-		return 0, 0, 0, fmt.Errorf("not implemented")
+		return 0, 0, 0, false, fmt.Errorf("not implemented")
 	}
 
 	n, pos, ok := readLenEncInt(data, 0)
 	if !ok {
-		return 0, 0, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "cannot get column number")
+		return 0, 0, 0, false, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "cannot get column number")
 	}
 	if pos != len(data) {
-		return 0, 0, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "extra data in COM_QUERY response")
+		return 0, 0, 0, false, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "extra data in COM_QUERY response")
 	}
-	return 0, 0, int(n), nil
+	return 0, 0, int(n), false, nil
 }
 
 //
@@ -586,13 +684,13 @@ func (c *Conn) writeRows(result *sqltypes.Result) error {
 	return nil
 }
 
-// writeEndResult concludes the sending of a Result.
-func (c *Conn) writeEndResult() error {
+// writeEndResult concludes the sending of a Result, with the provided
+// status flags. They include ServerMoreResultsExists if another
+// result follows. See doc.go.
+func (c *Conn) writeEndResult(flags uint16) error {
 	// Send either an EOF, or an OK packet.
-	// FIXME(alainjobart) if multi result is set, can send more after this.
-	// See doc.go.
 	if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
-		if err := c.writeEOFPacket(c.StatusFlags, 0); err != nil {
+		if err := c.writeEOFPacket(flags, 0); err != nil {
 			return err
 		}
 		if err := c.flush(); err != nil {
@@ -600,7 +698,7 @@ func (c *Conn) writeEndResult() error {
 		}
 	} else {
 		// This will flush too.
-		if err := c.writeOKPacketWithEOFHeader(0, 0, c.StatusFlags, 0); err != nil {
+		if err := c.writeOKPacketWithEOFHeader(0, 0, flags, 0); err != nil {
 			return err
 		}
 	}
@@ -19,6 +19,7 @@ package mysql
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 
@@ -269,6 +270,119 @@ func TestQueries(t *testing.T) {
 	})
 }
 
+// TestMultiResults checks the client reads all the results of a
+// multi-statement query, with and without CapabilityClientDeprecateEOF.
+func TestMultiResults(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	results := []*sqltypes.Result{
+		selectRowsResult,
+		{
+			RowsAffected: 1,
+			InsertID:     2,
+		},
+		{
+			Fields: selectRowsResult.Fields,
+		},
+		selectRowsResult,
+	}
+
+	// writeResults reads a query, and sends all the results.
+	writeResults := func(results []*sqltypes.Result) error {
+		if _, err := sConn.ReadPacket(); err != nil {
+			return err
+		}
+		for i, result := range results {
+			flags := sConn.StatusFlags
+			if i < len(results)-1 {
+				flags |= ServerMoreResultsExists
+			}
+			if len(result.Fields) == 0 {
+				if err := sConn.writeOKPacket(result.RowsAffected, result.InsertID, flags, 0); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := sConn.writeFields(result); err != nil {
+				return err
+			}
+			if err := sConn.writeRows(result); err != nil {
+				return err
+			}
+			if err := sConn.writeEndResult(flags); err != nil {
+				return err
+			}
+		}
+		sConn.resetSequence()
+		return nil
+	}
+
+	for _, capabilities := range []uint32{0, CapabilityClientDeprecateEOF} {
+		sConn.Capabilities = capabilities
+		cConn.Capabilities = capabilities
+
+		// Read all the results.
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- writeResults(results)
+		}()
+		var got []*sqltypes.Result
+		result, more, err := cConn.ExecuteFetchMulti("multi", 10, false)
+		for {
+			if err != nil {
+				t.Fatalf("ExecuteFetchMulti(%v) failed: %v", capabilities, err)
+			}
+			got = append(got, result)
+			if !more {
+				break
+			}
+			result, more, err = cConn.ReadQueryResult(10, false)
+		}
+		if err := <-errChan; err != nil {
+			t.Fatalf("writeResults failed: %v", err)
+		}
+		if len(got) != len(results) {
+			t.Fatalf("ExecuteFetchMulti(%v) returned %v results, want %v", capabilities, len(got), len(results))
+		}
+		for i, result := range results {
+			if got[i].RowsAffected != result.RowsAffected || got[i].InsertID != result.InsertID || !reflect.DeepEqual(got[i].Rows, result.Rows) {
+				t.Errorf("ExecuteFetchMulti(%v) result %v: %v, want %v", capabilities, i, got[i], result)
+			}
+		}
+
+		// ExecuteFetch drains the results, and fails.
+		go func() {
+			errChan <- writeResults(results)
+		}()
+		if _, err := cConn.ExecuteFetch("multi", 10, false); err == nil || !strings.Contains(err.Error(), "unexpected multiple results") {
+			t.Errorf("ExecuteFetch(%v) returned: %v", capabilities, err)
+		}
+		if err := <-errChan; err != nil {
+			t.Fatalf("writeResults failed: %v", err)
+		}
+
+		// The connection is still usable.
+		go func() {
+			errChan <- writeResults(results[:1])
+		}()
+		result, err = cConn.ExecuteFetch("single", 10, false)
+		if err != nil {
+			t.Fatalf("ExecuteFetch(%v) failed: %v", capabilities, err)
+		}
+		if !reflect.DeepEqual(result.Rows, selectRowsResult.Rows) {
+			t.Errorf("ExecuteFetch(%v): %v, want %v", capabilities, result, selectRowsResult)
+		}
+		if err := <-errChan; err != nil {
+			t.Fatalf("writeResults failed: %v", err)
+		}
+	}
+}
+
 func checkQuery(t *testing.T, query string, sConn, cConn *Conn, result *sqltypes.Result) {
 	// The protocol depends on the CapabilityClientDeprecateEOF flag.
 	// So we want to test both cases.
@@ -427,7 +541,7 @@ func writeResult(conn *Conn, result *sqltypes.Result) error {
 	if err := conn.writeRows(result); err != nil {
 		return err
 	}
-	return conn.writeEndResult()
+	return conn.writeEndResult(conn.StatusFlags)
 }
 
 func RowString(row []sqltypes.Value) string {
@@ -17,17 +17,23 @@ limitations under the License.
 package mysql
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
 	"net"
+	"sync"
 	"time"
 
 	log "github.com/golang/glog"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/tb"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 const (
@@ -36,8 +42,10 @@ const (
 	DefaultServerVersion = "5.5.10-Vitess"
 
 	// timing metric keys
-	connectTimingKey = "Connect"
-	queryTimingKey   = "Query"
+	connectTimingKey     = "Connect"
+	queryTimingKey       = "Query"
+	prepareTimingKey     = "Prepare"
+	stmtExecuteTimingKey = "StmtExecute"
 )
 
 var (
@@ -74,6 +82,20 @@ type Handler interface {
 	// the first call to callback. So the Handler should not
 	// hang on to the byte slice.
 	ComQuery(c *Conn, query string, callback func(*sqltypes.Result) error) error
+
+	// ComPrepare is called when a connection receives a
+	// COM_STMT_PREPARE. The Listener has already checked the query
+	// parses, and counted its parameters. The handler returns the
+	// fields of the result set the statement will produce, or nil
+	// if it cannot tell before the statement is executed.
+	ComPrepare(c *Conn, query string) ([]*querypb.Field, error)
+
+	// ComStmtExecute is called when a connection receives a
+	// COM_STMT_EXECUTE. The parameters are in prepare.BindVars,
+	// named v1, v2, ... the same way sqlparser names the '?'
+	// placeholders of prepare.PrepareStmt. The same rules as
+	// ComQuery apply to the callback.
+	ComStmtExecute(c *Conn, prepare *PrepareData, callback func(*sqltypes.Result) error) error
 }
 
 // Listener is the MySQL server protocol listener.
@@ -110,6 +132,20 @@ type Listener struct {
 	// beyond which a warning is logged to identify the slow connection
 	SlowConnectWarnThreshold time.Duration
 
+	// RSAKey is the key used by the caching_sha2_password and
+	// sha256_password methods to receive the password over
+	// non-TLS connections. If nil, a key is generated the first
+	// time one is needed.
+	RSAKey *rsa.PrivateKey
+
+	// rsaKeyMu protects the generation of RSAKey.
+	rsaKeyMu sync.Mutex
+
+	// Compression contains the compression algorithms the server
+	// supports: CompressionZlib and/or CompressionZstd. If empty,
+	// the packets are never compressed.
+	Compression []string
+
 	// The following parameters are changed by the Accept routine.
 
 	// Incrementing ID for connection id.
@@ -186,8 +222,15 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	// Adjust the count of open connections
 	defer connCount.Add(-1)
 
+	// Advertise the compression algorithms we support.
+	compressionCapabilities, err := compressionCapabilities(l.Compression)
+	if err != nil {
+		log.Errorf("Invalid Listener.Compression for %s: %v", c, err)
+		return
+	}
+
 	// First build and send the server handshake packet.
-	salt, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.TLSConfig != nil)
+	salt, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.TLSConfig != nil, compressionCapabilities)
 	if err != nil {
 		log.Errorf("Cannot send HandshakeV10 packet to %s: %v", c, err)
 		return
@@ -255,6 +298,18 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		c.writeErrorPacket(CRServerHandshakeErr, SSUnknownSQLState, "Client asked for auth %v, but server wants auth mysql_native_password", authMethod)
 		return
 
+	case authServerMethod == CachingSha2Password || authServerMethod == Sha256Password:
+		// The sha2 methods never send the password in clear text
+		// over insecure connections, the framework handles them.
+		userData, err := l.negotiateSha2(c, salt, user, authServerMethod, authMethod, authResponse)
+		if err != nil {
+			log.Warningf("Error authenticating user using %v: %v", authServerMethod, err)
+			c.writeErrorPacketFromError(err)
+			return
+		}
+		c.User = user
+		c.UserData = userData
+
 	default:
 		// The server wants to use something else, re-negotiate.
 
@@ -292,6 +347,14 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		return
 	}
 
+	// Switch to the compressed protocol if the client asked for it.
+	if algorithm := compressionAlgorithm(c.Capabilities); algorithm != "" {
+		if err := c.enableCompression(algorithm, c.compressionLevel); err != nil {
+			log.Errorf("Cannot enable %v compression for %s: %v", algorithm, c, err)
+			return
+		}
+	}
+
 	// Record how long we took to establish the connection
 	timings.Record(connectTimingKey, acceptTime)
 
@@ -303,7 +366,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	}
 
 	for {
-		c.sequence = 0
+		c.resetSequence()
 		data, err := c.readEphemeralPacket()
 		if err != nil {
 			// Don't log EOF errors. They cause too much spam.
@@ -335,30 +398,105 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 			queryStart := time.Now()
 			query := c.parseComQuery(data)
 			c.recycleReadPacket()
-			fieldSent := false
-			// sendFinished is set if the response should just be an OK packet.
-			sendFinished := false
-			err := l.handler.ComQuery(c, query, func(qr *sqltypes.Result) error {
-				if sendFinished {
-					// Failsafe: Unreachable if server is well-behaved.
-					return io.EOF
+
+			queries := []string{query}
+			if c.Capabilities&CapabilityClientMultiStatements != 0 {
+				// If the query cannot be split, we let the
+				// handler return the error for it.
+				if pieces, err := sqlparser.SplitStatementToPieces(query); err == nil && len(pieces) > 0 {
+					queries = pieces
+				}
+			}
+			for index, sql := range queries {
+				more := index < len(queries)-1
+				ok, err := l.execQuery(c, sql, more)
+				if err != nil {
+					log.Errorf("Error handling query from %s: %v", c, err)
+					return
+				}
+				if !ok {
+					// An error was returned, we don't
+					// run the remaining statements.
+					break
+				}
+			}
+
+			timings.Record(queryTimingKey, queryStart)
+
+		case ComPrepare:
+			prepareStart := time.Now()
+			query := c.parseComPrepare(data)
+			c.recycleReadPacket()
+
+			paramsCount, err := countParams(query)
+			var fields []*querypb.Field
+			if err == nil {
+				fields, err = l.handler.ComPrepare(c, query)
+			}
+			if err != nil {
+				if werr := c.writeErrorPacketFromError(err); werr != nil {
+					log.Errorf("Error writing prepare error to %s: %v", c, werr)
+					return
 				}
+				continue
+			}
 
-				if !fieldSent {
-					fieldSent = true
+			c.StatementID++
+			prepare := &PrepareData{
+				StatementID: c.StatementID,
+				PrepareStmt: query,
+				ParamsCount: paramsCount,
+				ParamsType:  make([]int32, paramsCount),
+				Fields:      fields,
+			}
+			prepare.resetBindVars()
+			c.PrepareData[prepare.StatementID] = prepare
 
-					if len(qr.Fields) == 0 {
-						sendFinished = true
-						// We should not send any more packets after this.
-						return c.writeOKPacket(qr.RowsAffected, qr.InsertID, c.StatusFlags, 0)
+			if err := c.writePrepare(prepare); err != nil {
+				log.Errorf("Error writing prepare data to %s: %v", c, err)
+				return
+			}
+
+			timings.Record(prepareTimingKey, prepareStart)
+
+		case ComStmtExecute:
+			queryStart := time.Now()
+			statementID, err := c.parseComStmtExecute(data)
+			c.recycleReadPacket()
+			prepare := c.PrepareData[statementID]
+
+			fieldSent := false
+			// sendFinished is set if the response should just be an OK packet.
+			sendFinished := false
+			var fields []*querypb.Field
+			if err == nil {
+				err = l.handler.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
+					if sendFinished {
+						// Failsafe: Unreachable if server is well-behaved.
+						return io.EOF
 					}
-					if err := c.writeFields(qr); err != nil {
-						return err
+
+					if !fieldSent {
+						fieldSent = true
+
+						if len(qr.Fields) == 0 {
+							sendFinished = true
+							// We should not send any more packets after this.
+							return c.writeOKPacket(qr.RowsAffected, qr.InsertID, c.StatusFlags, 0)
+						}
+						fields = qr.Fields
+						if err := c.writeFields(qr); err != nil {
+							return err
+						}
 					}
-				}
 
-				return c.writeRows(qr)
-			})
+					return c.writeBinaryRows(fields, qr)
+				})
+			}
+			// The parameters are only valid for one execution.
+			if prepare != nil {
+				prepare.resetBindVars()
+			}
 
 			// If no field was sent, we expect an error.
 			if !fieldSent {
@@ -383,13 +521,56 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 
 			// Send the end packet only sendFinished is false (results were streamed).
 			if !sendFinished {
-				if err := c.writeEndResult(); err != nil {
+				if err := c.writeEndResult(c.StatusFlags); err != nil {
 					log.Errorf("Error writing result to %s: %v", c, err)
 					return
 				}
 			}
 
-			timings.Record(queryTimingKey, queryStart)
+			timings.Record(stmtExecuteTimingKey, queryStart)
+
+		case ComStmtSendLongData:
+			// There is no response to this command, even on error.
+			// An invalid chunk will make the next execute fail.
+			statementID, paramID, chunk, ok := c.parseComStmtSendLongData(data)
+			c.recycleReadPacket()
+			if !ok {
+				log.Errorf("Got malformed COM_STMT_SEND_LONG_DATA packet from %s", c)
+				continue
+			}
+			prepare, ok := c.PrepareData[statementID]
+			if !ok {
+				log.Warningf("Got COM_STMT_SEND_LONG_DATA for unknown statement %v from %s", statementID, c)
+				continue
+			}
+			if err := prepare.appendLongData(paramID, chunk); err != nil {
+				log.Warningf("Invalid COM_STMT_SEND_LONG_DATA from %s: %v", c, err)
+			}
+
+		case ComStmtReset:
+			statementID, ok := c.parseComStmtReset(data)
+			c.recycleReadPacket()
+			prepare, found := c.PrepareData[statementID]
+			if !ok || !found {
+				if err := c.writeErrorPacket(ERUnknownStmtHandler, SSUnknownSQLState, "unknown prepared statement handler (%v) given to mysqld_stmt_reset", statementID); err != nil {
+					log.Errorf("Error writing ComStmtReset error to %s: %v", c, err)
+					return
+				}
+				continue
+			}
+			prepare.resetBindVars()
+			if err := c.writeOKPacket(0, 0, c.StatusFlags, 0); err != nil {
+				log.Errorf("Error writing ComStmtReset result to %s: %v", c, err)
+				return
+			}
+
+		case ComStmtClose:
+			// There is no response to this command.
+			statementID, ok := c.parseComStmtClose(data)
+			c.recycleReadPacket()
+			if ok {
+				delete(c.PrepareData, statementID)
+			}
 
 		case ComPing:
 			// No payload to that one, just return OKPacket.
@@ -410,6 +591,70 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	}
 }
 
+// execQuery runs a single query through the handler, and writes its
+// result. If more is set, the result is flagged with
+// ServerMoreResultsExists, as another one follows.
+// It returns false if an error packet was sent instead of a result,
+// and an error if the connection cannot be used any more.
+func (l *Listener) execQuery(c *Conn, query string, more bool) (bool, error) {
+	flags := c.StatusFlags
+	if more {
+		flags |= ServerMoreResultsExists
+	}
+
+	fieldSent := false
+	// sendFinished is set if the response should just be an OK packet.
+	sendFinished := false
+	err := l.handler.ComQuery(c, query, func(qr *sqltypes.Result) error {
+		if sendFinished {
+			// Failsafe: Unreachable if server is well-behaved.
+			return io.EOF
+		}
+
+		if !fieldSent {
+			fieldSent = true
+
+			if len(qr.Fields) == 0 {
+				sendFinished = true
+				// We should not send any more packets after this.
+				return c.writeOKPacket(qr.RowsAffected, qr.InsertID, flags, 0)
+			}
+			if err := c.writeFields(qr); err != nil {
+				return err
+			}
+		}
+
+		return c.writeRows(qr)
+	})
+
+	// If no field was sent, we expect an error.
+	if !fieldSent {
+		// This is just a failsafe. Should never happen.
+		if err == nil || err == io.EOF {
+			err = NewSQLErrorFromError(errors.New("unexpected: query ended without no results and no error"))
+		}
+		if werr := c.writeErrorPacketFromError(err); werr != nil {
+			// If we can't even write the error, we're done.
+			return false, fmt.Errorf("error writing query error: %v", werr)
+		}
+		return false, nil
+	}
+
+	if err != nil {
+		// We can't send an error in the middle of a stream.
+		// All we can do is abort the send, which will cause a 2013.
+		return false, fmt.Errorf("error in the middle of a stream: %v", err)
+	}
+
+	// Send the end packet only sendFinished is false (results were streamed).
+	if !sendFinished {
+		if err := c.writeEndResult(flags); err != nil {
+			return false, fmt.Errorf("error writing result: %v", err)
+		}
+	}
+	return true, nil
+}
+
 // Close stops the listener, and closes all connections.
 func (l *Listener) Close() {
 	l.listener.Close()
@@ -417,7 +662,7 @@ func (l *Listener) Close() {
 
 // writeHandshakeV10 writes the Initial Handshake Packet, server side.
 // It returns the salt data.
-func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS bool) ([]byte, error) {
+func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS bool, compressionCapabilities uint32) ([]byte, error) {
 	capabilities := CapabilityClientLongPassword |
 		CapabilityClientLongFlag |
 		CapabilityClientConnectWithDB |
@@ -426,10 +671,13 @@ func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, en
 		CapabilityClientSecureConnection |
 		CapabilityClientPluginAuth |
 		CapabilityClientPluginAuthLenencClientData |
-		CapabilityClientDeprecateEOF
+		CapabilityClientDeprecateEOF |
+		CapabilityClientMultiStatements |
+		CapabilityClientMultiResults
 	if enableTLS {
 		capabilities |= CapabilityClientSSL
 	}
+	capabilities |= int(compressionCapabilities)
 
 	length :=
 		1 + // protocol version
@@ -527,7 +775,15 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 	// later in the protocol. If we re-received the handshake packet
 	// after SSL negotiation, do not overwrite capabilities.
 	if firstTime {
-		c.Capabilities = clientFlags & (CapabilityClientDeprecateEOF | CapabilityClientFoundRows)
+		c.Capabilities = clientFlags & (CapabilityClientDeprecateEOF | CapabilityClientFoundRows | CapabilityClientMultiStatements | CapabilityClientMultiResults)
+
+		// Only keep the compression the client asked for, and we
+		// advertised.
+		compressionCapabilities, err := compressionCapabilities(l.Compression)
+		if err != nil {
+			return "", "", nil, err
+		}
+		c.Capabilities |= clientFlags & compressionCapabilities
 	}
 
 	// Max packet size. Don't do anything with this now.
@@ -621,11 +877,174 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 		authMethod = MysqlNativePassword
 	}
 
+	// Connection attributes. We don't advertise them, but skip
+	// them if they are there.
 	// FIXME(alainjobart) Add CLIENT_CONNECT_ATTRS parsing if we need it.
+	if clientFlags&CapabilityClientConnectAttrs != 0 {
+		var l uint64
+		l, pos, ok = readLenEncInt(data, pos)
+		if !ok {
+			return "", "", nil, fmt.Errorf("parseClientHandshakePacket: can't read connection attributes length")
+		}
+		pos += int(l)
+	}
+
+	// zstd compression level.
+	c.compressionLevel = DefaultZstdCompressionLevel
+	if clientFlags&CapabilityClientZstdCompressionAlgorithm != 0 {
+		var level byte
+		level, _, ok = readByte(data, pos)
+		if !ok {
+			return "", "", nil, fmt.Errorf("parseClientHandshakePacket: can't read zstd compression level")
+		}
+		c.compressionLevel = int(level)
+	}
 
 	return username, authMethod, authResponse, nil
 }
 
+// negotiateSha2 runs the caching_sha2_password or sha256_password
+// authentication for the user. clientMethod and authResponse are what
+// the client sent in its handshake response.
+func (l *Listener) negotiateSha2(c *Conn, salt []byte, user, method, clientMethod string, authResponse []byte) (Getter, error) {
+	authServer, ok := l.authServer.(CachingSha2AuthServer)
+	if !ok {
+		return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "AuthServer does not support auth %v", method)
+	}
+
+	response := authResponse
+	if clientMethod != method {
+		// Switch the client to the method. The data is the salt,
+		// null terminated.
+		data := make([]byte, len(salt)+1)
+		copy(data, salt)
+		if err := c.writeAuthSwitchRequest(method, data); err != nil {
+			return nil, err
+		}
+		var err error
+		response, err = c.ReadPacket()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// An empty password is sent as is, or as a single 0 byte.
+	if len(response) == 0 || (len(response) == 1 && response[0] == 0) {
+		return authServer.ValidatePassword(user, "", c.RemoteAddr())
+	}
+
+	if method == CachingSha2Password {
+		// Fast authentication: the response is the scramble.
+		userData, err := authServer.ValidateCachingSha2Hash(salt, user, response, c.RemoteAddr())
+		if err != nil {
+			return nil, err
+		}
+		if userData != nil {
+			if err := c.writeAuthMoreData([]byte{cachingSha2FastAuthOK}); err != nil {
+				return nil, err
+			}
+			return userData, nil
+		}
+
+		// Full authentication: ask for the password.
+		if err := c.writeAuthMoreData([]byte{cachingSha2FullAuth}); err != nil {
+			return nil, err
+		}
+		response, err = c.ReadPacket()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	password, err := l.readSha2Password(c, salt, method, response)
+	if err != nil {
+		return nil, err
+	}
+	return authServer.ValidatePassword(user, password, c.RemoteAddr())
+}
+
+// readSha2Password returns the password sent by a client using one of
+// the sha2 methods, data being the first packet the client sent.
+// Over TLS and unix sockets, the password is sent in clear text.
+// Otherwise, the client can ask for our public key, and then sends the
+// password encrypted with it.
+func (l *Listener) readSha2Password(c *Conn, salt []byte, method string, data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "received empty password packet")
+	}
+	if c.isSecureTransport() {
+		if data[len(data)-1] != 0 {
+			return "", NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "received invalid password packet, datalen=%v", len(data))
+		}
+		return string(data[:len(data)-1]), nil
+	}
+
+	key, err := l.rsaKey()
+	if err != nil {
+		return "", err
+	}
+
+	requestPublicKey := byte(sha256RequestPublicKey)
+	if method == CachingSha2Password {
+		requestPublicKey = cachingSha2RequestPublicKey
+	}
+	if len(data) == 1 && data[0] == requestPublicKey {
+		pub, err := marshalPublicKey(&key.PublicKey)
+		if err != nil {
+			return "", err
+		}
+		if err := c.writeAuthMoreData(pub); err != nil {
+			return "", err
+		}
+		data, err = c.ReadPacket()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	password, err := decryptPassword(data, salt, key)
+	if err != nil {
+		return "", NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot decrypt password: %v", err)
+	}
+	return password, nil
+}
+
+// rsaKey returns RSAKey, generating it if needed.
+func (l *Listener) rsaKey() (*rsa.PrivateKey, error) {
+	l.rsaKeyMu.Lock()
+	defer l.rsaKeyMu.Unlock()
+	if l.RSAKey == nil {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, err
+		}
+		l.RSAKey = key
+	}
+	return l.RSAKey, nil
+}
+
+// writeAuthMoreData writes an AuthMoreData packet, used by the sha2
+// methods.
+func (c *Conn) writeAuthMoreData(pluginData []byte) error {
+	length := 1 + // AuthMoreDataPacket
+		len(pluginData)
+
+	data := c.startEphemeralPacket(length)
+	pos := 0
+
+	// Packet header.
+	pos = writeByte(data, pos, AuthMoreDataPacket)
+
+	// Copy auth data.
+	pos += copy(data[pos:], pluginData)
+
+	// Sanity check.
+	if pos != len(data) {
+		return fmt.Errorf("error building AuthMoreDataPacket packet: got %v bytes expected %v", pos, len(data))
+	}
+	return c.writeEphemeralPacket(true)
+}
+
 // writeAuthSwitchRequest writes an auth switch request packet.
 func (c *Conn) writeAuthSwitchRequest(pluginName string, pluginData []byte) error {
 	length := 1 + // AuthSwitchRequestPacket
@@ -23,6 +23,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -63,6 +64,30 @@ var selectRowsResult = &sqltypes.Result{
 	RowsAffected: 2,
 }
 
+// largeRowsResult spans many packets, and compressed packets.
+var largeRowsResult = func() *sqltypes.Result {
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{
+				Name: "id",
+				Type: querypb.Type_INT32,
+			},
+			{
+				Name: "name",
+				Type: querypb.Type_VARCHAR,
+			},
+		},
+	}
+	for i := 0; i < 1000; i++ {
+		result.Rows = append(result.Rows, []sqltypes.Value{
+			sqltypes.MakeTrusted(querypb.Type_INT32, []byte(fmt.Sprintf("%v", i))),
+			sqltypes.MakeTrusted(querypb.Type_VARCHAR, []byte(strings.Repeat(fmt.Sprintf("name %v ", i), 20))),
+		})
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result
+}()
+
 type testHandler struct {
 	lastConn *Conn
 	err      error
@@ -123,6 +148,22 @@ func (th *testHandler) ComQuery(c *Conn, query string, callback func(*sqltypes.R
 				},
 			},
 		})
+	case "compression echo":
+		callback(&sqltypes.Result{
+			Fields: []*querypb.Field{
+				{
+					Name: "compression",
+					Type: querypb.Type_VARCHAR,
+				},
+			},
+			Rows: [][]sqltypes.Value{
+				{
+					sqltypes.MakeTrusted(querypb.Type_VARCHAR, []byte(c.compression)),
+				},
+			},
+		})
+	case "large rows":
+		callback(largeRowsResult)
 	case "userData echo":
 		callback(&sqltypes.Result{
 			Fields: []*querypb.Field{
@@ -148,6 +189,39 @@ func (th *testHandler) ComQuery(c *Conn, query string, callback func(*sqltypes.R
 	return nil
 }
 
+func (th *testHandler) ComPrepare(c *Conn, query string) ([]*querypb.Field, error) {
+	return nil, nil
+}
+
+// ComStmtExecute echoes the parameters of the statement back as a
+// single row, with one column per parameter.
+func (th *testHandler) ComStmtExecute(c *Conn, prepare *PrepareData, callback func(*sqltypes.Result) error) error {
+	if strings.HasPrefix(prepare.PrepareStmt, "insert") {
+		return callback(&sqltypes.Result{
+			RowsAffected: 1,
+			InsertID:     uint64(len(prepare.BindVars)),
+		})
+	}
+	result := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{}},
+	}
+	for i := 0; i < int(prepare.ParamsCount); i++ {
+		bv, ok := prepare.BindVars[paramName(i)]
+		if !ok {
+			return NewSQLError(CRParamsNotBound, SSUnknownSQLState, "parameter %v not bound", i)
+		}
+		result.Fields = append(result.Fields, &querypb.Field{
+			Name: paramName(i),
+			Type: bv.Type,
+		})
+		result.Rows[0] = append(result.Rows[0], sqltypes.MakeTrusted(bv.Type, bv.Value))
+	}
+	if len(result.Fields) == 0 {
+		result = selectRowsResult
+	}
+	return callback(result)
+}
+
 func getHostPort(t *testing.T, a net.Addr) (string, int) {
 	// For the host name, we resolve 'localhost' into an address.
 	// This works around a few travis issues where IPv6 is not 100% enabled.
@@ -361,6 +435,92 @@ func TestClientFoundRows(t *testing.T) {
 	c.Close()
 }
 
+// TestMultiStatements runs multiple statements in a single query.
+func TestMultiStatements(t *testing.T) {
+	th := &testHandler{
+		err: NewSQLError(ERUnknownComError, SSUnknownComError, "forced query error"),
+	}
+
+	authServer := NewAuthServerStatic()
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	l, err := NewListener("tcp", ":0", authServer, th)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+
+	// Without the flag, the query is not split.
+	c, err := Connect(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.lastConn.Capabilities&CapabilityClientMultiStatements != 0 {
+		t.Errorf("MultiStatements flag: %x, must not be set", th.lastConn.Capabilities)
+	}
+	result, more, err := c.ExecuteFetchMulti("select rows; insert", 10, false)
+	if err != nil || more || len(result.Rows) != 0 {
+		t.Errorf("ExecuteFetchMulti without flag: %v %v %v", result, more, err)
+	}
+	c.Close()
+
+	params.EnableMultiStatements()
+	c, err = Connect(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if th.lastConn.Capabilities&CapabilityClientMultiStatements == 0 {
+		t.Errorf("MultiStatements flag: %x, must be set", th.lastConn.Capabilities)
+	}
+
+	// All the statements are run, in order.
+	var got []*sqltypes.Result
+	result, more, err = c.ExecuteFetchMulti("select rows; insert;\n select rows;", 10, false)
+	for {
+		if err != nil {
+			t.Fatalf("ExecuteFetchMulti failed: %v", err)
+		}
+		got = append(got, result)
+		if !more {
+			break
+		}
+		result, more, err = c.ReadQueryResult(10, false)
+	}
+	if len(got) != 3 ||
+		!reflect.DeepEqual(got[0].Rows, selectRowsResult.Rows) ||
+		got[1].RowsAffected != 123 || got[1].InsertID != 123456789 ||
+		!reflect.DeepEqual(got[2].Rows, selectRowsResult.Rows) {
+		t.Errorf("ExecuteFetchMulti returned: %v", got)
+	}
+
+	// An error stops the execution.
+	result, more, err = c.ExecuteFetchMulti("select rows; error; insert", 10, false)
+	if err != nil || !more || !reflect.DeepEqual(result.Rows, selectRowsResult.Rows) {
+		t.Fatalf("ExecuteFetchMulti(error): %v %v %v", result, more, err)
+	}
+	result, more, err = c.ReadQueryResult(10, false)
+	if err == nil || !strings.Contains(err.Error(), "forced query error") || more {
+		t.Errorf("ReadQueryResult(error): %v %v %v", result, more, err)
+	}
+
+	// The connection is still usable.
+	result, err = c.ExecuteFetch("insert", 10, false)
+	if err != nil || result.RowsAffected != 123 {
+		t.Errorf("ExecuteFetch(insert): %v %v", result, err)
+	}
+}
+
 func TestServer(t *testing.T) {
 	th := &testHandler{}
 
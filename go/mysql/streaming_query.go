@@ -47,7 +47,7 @@ func (c *Conn) ExecuteStreamFetch(query string) (err error) {
 	}
 
 	// Get the result.
-	_, _, colNumber, err := c.readComQueryResponse()
+	_, _, colNumber, _, err := c.readComQueryResponse()
 	if err != nil {
 		return err
 	}
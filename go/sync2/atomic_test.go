@@ -56,4 +56,14 @@ func TestAtomicBool(t *testing.T) {
 	if !b.Get() {
 		t.Error("b.Get: false, want true")
 	}
+
+	if swapped := b.CompareAndSwap(false, true); swapped {
+		t.Error("b.CompareAndSwap false, true: true, want false")
+	}
+	if swapped := b.CompareAndSwap(true, false); !swapped {
+		t.Error("b.CompareAndSwap true, false: false, want true")
+	}
+	if b.Get() {
+		t.Error("b.Get: true, want false")
+	}
 }
@@ -0,0 +1,117 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binlog
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+)
+
+// PositionAtTimestamp returns the replication position of the mysqld
+// of cp right after the last transaction timestamped at or before
+// timestamp (in seconds since the epoch). The binlogs are read from
+// startPos, which must be before that transaction. If all the
+// transactions are before timestamp, the current position of the
+// mysqld is returned.
+//
+// It is used by point-in-time recoveries, to turn a time into a
+// position replication can stop at.
+func PositionAtTimestamp(ctx context.Context, cp *mysql.ConnParams, startPos mysql.Position, timestamp int64) (mysql.Position, error) {
+	sc, err := NewSlaveConnection(cp)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	defer sc.Close()
+
+	// Read the current position first: the dump waits for
+	// new events at the end of the binlogs, so we need to
+	// know where to stop.
+	endPos, err := sc.Conn.MasterPosition()
+	if err != nil {
+		return mysql.Position{}, fmt.Errorf("failed to get master position: %v", err)
+	}
+	if startPos.AtLeast(endPos) {
+		return startPos, nil
+	}
+
+	events, err := sc.StartBinlogDumpFromPosition(ctx, startPos)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	return positionAtTimestamp(ctx, events, startPos, endPos, timestamp)
+}
+
+// positionAtTimestamp reads the events until it finds a transaction
+// timestamped after timestamp, or it reaches endPos.
+func positionAtTimestamp(ctx context.Context, events <-chan mysql.BinlogEvent, startPos, endPos mysql.Position, timestamp int64) (mysql.Position, error) {
+	var format mysql.BinlogFormat
+	var err error
+	pos := startPos
+	for {
+		var ev mysql.BinlogEvent
+		var ok bool
+
+		select {
+		case ev, ok = <-events:
+			if !ok {
+				return pos, fmt.Errorf("binlog stream ended at %v, before %v", pos, endPos)
+			}
+		case <-ctx.Done():
+			return pos, ctx.Err()
+		}
+
+		if !ev.IsValid() {
+			return pos, fmt.Errorf("can't parse binlog event, invalid data: %#v", ev)
+		}
+		if ev.IsFormatDescription() {
+			format, err = ev.Format()
+			if err != nil {
+				return pos, fmt.Errorf("can't parse FORMAT_DESCRIPTION_EVENT: %v, event data: %#v", err, ev)
+			}
+			continue
+		}
+		if format.IsZero() {
+			// Only the fake ROTATE_EVENT comes before the
+			// FORMAT_DESCRIPTION_EVENT.
+			continue
+		}
+		ev, _, err = ev.StripChecksum(format)
+		if err != nil {
+			return pos, fmt.Errorf("can't strip checksum from binlog event: %v, event data: %#v", err, ev)
+		}
+
+		// Each transaction starts with a GTID_EVENT: it's the
+		// only one we need to look at.
+		if !ev.IsGTID() {
+			continue
+		}
+		if int64(ev.Timestamp()) > timestamp {
+			return pos, nil
+		}
+		gtid, _, err := ev.GTID(format)
+		if err != nil {
+			return pos, fmt.Errorf("can't get GTID from binlog event: %v, event data: %#v", err, ev)
+		}
+		pos = mysql.AppendGTID(pos, gtid)
+		if pos.AtLeast(endPos) {
+			return pos, nil
+		}
+	}
+}
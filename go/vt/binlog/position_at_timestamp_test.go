@@ -0,0 +1,102 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binlog
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+)
+
+func TestPositionAtTimestamp(t *testing.T) {
+	f := mysql.NewMariaDBBinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+	s.ServerID = 62344
+
+	// Three transactions, at 100, 200 and 300.
+	var input []mysql.BinlogEvent
+	input = append(input,
+		mysql.NewRotateEvent(f, s, 0, ""),
+		mysql.NewFormatDescriptionEvent(f, s),
+	)
+	for i, ts := range []uint32{100, 200, 300} {
+		s.Timestamp = ts
+		input = append(input,
+			mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 0, Server: 62344, Sequence: uint64(10 + i)}, true /* hasBegin */),
+			mysql.NewQueryEvent(f, s, mysql.Query{
+				Database: "vt_test_keyspace",
+				SQL:      "insert into vt_a(eid, id) values (1, 1)"}),
+			mysql.NewXIDEvent(f, s),
+		)
+	}
+
+	position := func(sequence uint64) mysql.Position {
+		return mysql.Position{GTIDSet: mysql.MariadbGTID{Domain: 0, Server: 62344, Sequence: sequence}}
+	}
+	startPos := position(9)
+	endPos := position(12)
+
+	testcases := []struct {
+		timestamp int64
+		want      mysql.Position
+	}{{
+		timestamp: 50,
+		want:      startPos,
+	}, {
+		timestamp: 100,
+		want:      position(10),
+	}, {
+		timestamp: 250,
+		want:      position(11),
+	}, {
+		timestamp: 1000,
+		want:      endPos,
+	}}
+	for _, tcase := range testcases {
+		events := make(chan mysql.BinlogEvent, len(input))
+		for _, ev := range input {
+			events <- ev
+		}
+		got, err := positionAtTimestamp(context.Background(), events, startPos, endPos, tcase.timestamp)
+		if err != nil {
+			t.Errorf("positionAtTimestamp(%v): %v", tcase.timestamp, err)
+			continue
+		}
+		if !got.Equal(tcase.want) {
+			t.Errorf("positionAtTimestamp(%v): %v, want %v", tcase.timestamp, got, tcase.want)
+		}
+	}
+}
+
+func TestPositionAtTimestampEOF(t *testing.T) {
+	f := mysql.NewMariaDBBinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+
+	events := make(chan mysql.BinlogEvent, 2)
+	events <- mysql.NewRotateEvent(f, s, 0, "")
+	events <- mysql.NewFormatDescriptionEvent(f, s)
+	close(events)
+
+	endPos := mysql.Position{GTIDSet: mysql.MariadbGTID{Domain: 0, Server: 1, Sequence: 12}}
+	_, err := positionAtTimestamp(context.Background(), events, mysql.Position{}, endPos, 1000)
+	want := "binlog stream ended at <nil>, before 0-1-12"
+	if err == nil || err.Error() != want {
+		t.Errorf("positionAtTimestamp: %v, want %v", err, want)
+	}
+}
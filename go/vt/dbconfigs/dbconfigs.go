@@ -64,10 +64,13 @@ func registerConnFlags(connParams *mysql.ConnParams, name string) {
 	flag.StringVar(&connParams.UnixSocket, "db-config-"+name+"-unixsocket", "", "db "+name+" connection unix socket")
 	flag.StringVar(&connParams.Charset, "db-config-"+name+"-charset", "", "db "+name+" connection charset")
 	flag.Uint64Var(&connParams.Flags, "db-config-"+name+"-flags", 0, "db "+name+" connection flags")
+	flag.StringVar(&connParams.Compression, "db-config-"+name+"-compression", "", "db "+name+" connection compression algorithm, if the server supports it: zlib or zstd")
 	flag.StringVar(&connParams.SslCa, "db-config-"+name+"-ssl-ca", "", "db "+name+" connection ssl ca")
 	flag.StringVar(&connParams.SslCaPath, "db-config-"+name+"-ssl-ca-path", "", "db "+name+" connection ssl ca path")
 	flag.StringVar(&connParams.SslCert, "db-config-"+name+"-ssl-cert", "", "db "+name+" connection ssl certificate")
 	flag.StringVar(&connParams.SslKey, "db-config-"+name+"-ssl-key", "", "db "+name+" connection ssl key")
+	flag.StringVar(&connParams.ServerPublicKey, "db-config-"+name+"-server-public-key", "", "db "+name+" connection: path of the RSA public key of the server, to send the password without TLS")
+	flag.BoolVar(&connParams.GetServerPublicKey, "db-config-"+name+"-get-server-public-key", false, "db "+name+" connection: request the RSA public key from the server, to send the password without TLS. The key is not verified")
 }
 
 // RegisterFlags registers the flags for the given DBConfigFlag.
@@ -18,6 +18,8 @@ package mysqlctl
 
 import (
 	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -29,11 +31,11 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	log "github.com/golang/glog"
 	"golang.org/x/net/context"
 
-	"vitess.io/vitess/go/cgzip"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sync2"
@@ -74,8 +76,8 @@ var (
 	// and used as the transform hook name again.
 	backupStorageHook = flag.String("backup_storage_hook", "", "if set, we send the contents of the backup files through this hook.")
 
-	// backupStorageCompress can be set to false to not use gzip
-	// on the backups. Usually would be set if a hook is used, and
+	// backupStorageCompress can be set to false to not compress
+	// the backups. Usually would be set if a hook is used, and
 	// the hook compresses the data.
 	backupStorageCompress = flag.Bool("backup_storage_compress", true, "if set, the backup files will be compressed (default is true). Set to false for instance if a backup_storage_hook is specified and it compresses the data.")
 )
@@ -94,6 +96,12 @@ type FileEntry struct {
 	// Hash is the hash of the final data (transformed and
 	// compressed if specified) stored in the BackupStorage.
 	Hash string
+
+	// ContentHash is the SHA-256 of the content of the file, before
+	// it was compressed, encrypted or transformed. It checks the
+	// whole way back from the BackupStorage. Backups taken before
+	// it was recorded don't have it.
+	ContentHash string `json:",omitempty"`
 }
 
 func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
@@ -142,11 +150,100 @@ type BackupManifest struct {
 	// TransformHook that was used on the files, if any.
 	TransformHook string
 
-	// SkipCompress can be set if the backup files were not run
-	// through gzip. It is the negative of the flag, so old
+	// SkipCompress can be set if the backup files were not
+	// compressed. It is the negative of the flag, so old
 	// backups that don't have this flag are assumed to be
 	// compressed.
 	SkipCompress bool
+
+	// CompressionEngine is the name of the CompressionEngine the
+	// files were compressed with. Backups compressed before it was
+	// recorded used gzip.
+	CompressionEngine string `json:",omitempty"`
+
+	// EncryptionKeyID is the ID of the key the files were encrypted
+	// with, if any. The key is given by the BackupKeyProvider.
+	EncryptionKeyID string `json:",omitempty"`
+
+	// BackupTime is the time Position was read, in RFC 3339 format.
+	// All the transactions of the backup are from before then.
+	// Backups taken before it was recorded don't have it.
+	BackupTime string `json:",omitempty"`
+
+	// BackupMethod is the name of the BackupEngine that took the
+	// backup, and restores it. Backups taken before it was recorded
+	// were taken by the builtin engine.
+	BackupMethod string `json:",omitempty"`
+}
+
+// decoders returns the CompressionEngine the files of the backup were
+// compressed with, and the key they were encrypted with, if any.
+func (bm *BackupManifest) decoders() (CompressionEngine, []byte, error) {
+	var ce CompressionEngine
+	if !bm.SkipCompress {
+		compressionEngine := bm.CompressionEngine
+		if compressionEngine == "" {
+			compressionEngine = gzipCompressionEngineName
+		}
+		var err error
+		if ce, err = getCompressionEngine(compressionEngine); err != nil {
+			return nil, nil, err
+		}
+	}
+	var key []byte
+	if bm.EncryptionKeyID != "" {
+		var err error
+		if key, err = backupKey(bm.EncryptionKeyID); err != nil {
+			return nil, nil, err
+		}
+	}
+	return ce, key, nil
+}
+
+// RecoveryTarget is the point a point-in-time recovery recovers to.
+// The most recent backup taken before it is restored, and the binlogs
+// are replayed up to it. Only one of Position and Time is set. The
+// zero RecoveryTarget restores the most recent backup.
+type RecoveryTarget struct {
+	// Position is the replication position to recover to.
+	Position mysql.Position
+
+	// Time is the time to recover to. The binlogs are replayed up
+	// to the last transaction timestamped at or before it.
+	Time time.Time
+}
+
+// IsZero returns true if there is no target.
+func (rt RecoveryTarget) IsZero() bool {
+	return rt.Position.IsZero() && rt.Time.IsZero()
+}
+
+// String is part of the Stringer interface.
+func (rt RecoveryTarget) String() string {
+	switch {
+	case !rt.Position.IsZero():
+		return fmt.Sprintf("position %v", rt.Position)
+	case !rt.Time.IsZero():
+		return fmt.Sprintf("time %v", rt.Time.UTC().Format(time.RFC3339))
+	}
+	return "latest backup"
+}
+
+// isAfter returns true if the backup of bm can be restored to
+// recover to the target.
+func (rt RecoveryTarget) isAfter(bm *BackupManifest) bool {
+	switch {
+	case !rt.Position.IsZero():
+		return rt.Position.AtLeast(bm.Position)
+	case !rt.Time.IsZero():
+		backupTime, err := time.Parse(time.RFC3339, bm.BackupTime)
+		if err != nil {
+			// Old backups don't have a time, we can't use them.
+			return false
+		}
+		return !backupTime.After(rt.Time)
+	}
+	return true
 }
 
 // isDbDir returns true if the given directory contains a DB
@@ -220,9 +317,13 @@ func findFilesToBackup(cnf *Mycnf) ([]FileEntry, error) {
 
 // Backup is the main entry point for a backup:
 // - uses the BackupStorage service to store a new backup
-// - shuts down Mysqld during the backup
-// - remember if we were replicating, restore the exact same state
+// - takes the backup with the -backup_engine_implementation engine
 func Backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir, name string, backupConcurrency int, hookExtraEnv map[string]string) error {
+	be, err := GetBackupEngine()
+	if err != nil {
+		return err
+	}
+
 	// Start the backup with the BackupStorage.
 	bs, err := backupstorage.GetBackupStorage()
 	if err != nil {
@@ -235,7 +336,7 @@ func Backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir,
 	}
 
 	// Take the backup, and either AbortBackup or EndBackup.
-	usable, err := backup(ctx, mysqld, logger, bh, backupConcurrency, hookExtraEnv)
+	usable, err := be.ExecuteBackup(ctx, mysqld, logger, bh, backupConcurrency, hookExtraEnv)
 	var finishErr error
 	if usable {
 		finishErr = bh.EndBackup(ctx)
@@ -257,7 +358,10 @@ func Backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir,
 	return finishErr
 }
 
-// backup returns a boolean that indicates if the backup is usable,
+// backup is the ExecuteBackup of the builtin engine:
+// - shuts down Mysqld during the backup
+// - remember if we were replicating, restore the exact same state
+// It returns a boolean that indicates if the backup is usable,
 // and an overall error.
 func backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, backupConcurrency int, hookExtraEnv map[string]string) (bool, error) {
 	// Save initial state so we can restore.
@@ -310,6 +414,7 @@ func backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh b
 		replicationPosition = slaveStatus.Position
 	}
 	logger.Infof("using replication position: %v", replicationPosition)
+	backupTime := time.Now()
 
 	// shutdown mysqld
 	err = mysqld.Shutdown(ctx, true)
@@ -318,7 +423,7 @@ func backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh b
 	}
 
 	// Backup everything, capture the error.
-	backupErr := backupFiles(ctx, mysqld, logger, bh, replicationPosition, backupConcurrency, hookExtraEnv)
+	backupErr := backupFiles(ctx, mysqld, logger, bh, replicationPosition, backupTime, backupConcurrency, hookExtraEnv)
 	usable := backupErr == nil
 
 	// Try to restart mysqld
@@ -365,7 +470,7 @@ func backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh b
 }
 
 // backupFiles finds the list of files to backup, and creates the backup.
-func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, replicationPosition mysql.Position, backupConcurrency int, hookExtraEnv map[string]string) (err error) {
+func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, replicationPosition mysql.Position, backupTime time.Time, backupConcurrency int, hookExtraEnv map[string]string) (err error) {
 	// Get the files to backup.
 	fes, err := findFilesToBackup(mysqld.Cnf())
 	if err != nil {
@@ -373,6 +478,12 @@ func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 	}
 	logger.Infof("found %v files to backup", len(fes))
 
+	// Get the compression engine and the encryption key, if any.
+	compressionEngine, ce, keyID, key, err := backupEncoders()
+	if err != nil {
+		return err
+	}
+
 	// Backup with the provided concurrency.
 	sema := sync2.NewSemaphore(backupConcurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
@@ -392,7 +503,7 @@ func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 
 			// Backup the individual file.
 			name := fmt.Sprintf("%v", i)
-			rec.RecordError(backupFile(ctx, mysqld, logger, bh, &fes[i], name, hookExtraEnv))
+			rec.RecordError(backupFile(ctx, mysqld.Cnf(), logger, bh, &fes[i], name, ce, key, hookExtraEnv))
 		}(i)
 	}
 
@@ -401,6 +512,40 @@ func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 		return rec.Error()
 	}
 
+	return writeManifest(ctx, bh, &BackupManifest{
+		FileEntries:       fes,
+		Position:          replicationPosition,
+		TransformHook:     *backupStorageHook,
+		SkipCompress:      !*backupStorageCompress,
+		CompressionEngine: compressionEngine,
+		EncryptionKeyID:   keyID,
+		BackupTime:        backupTime.UTC().Format(time.RFC3339),
+		BackupMethod:      builtinBackupEngineName,
+	})
+}
+
+// backupEncoders returns the CompressionEngine and its name, and the
+// encryption key and its ID, to take a backup with, if any.
+func backupEncoders() (string, CompressionEngine, string, []byte, error) {
+	var ce CompressionEngine
+	compressionEngine := ""
+	if *backupStorageCompress {
+		compressionEngine = *backupCompressionEngine
+		if err := verifyCompression(compressionEngine, *backupCompressionLevel); err != nil {
+			return "", nil, "", nil, err
+		}
+		ce = CompressionEngines[compressionEngine]
+	}
+	keyID, key, err := currentBackupKey()
+	if err != nil {
+		return "", nil, "", nil, err
+	}
+	return compressionEngine, ce, keyID, key, nil
+}
+
+// writeManifest adds the MANIFEST of the backup. It is the last file
+// added: a backup without a MANIFEST is incomplete.
+func writeManifest(ctx context.Context, bh backupstorage.BackupHandle, bm *BackupManifest) (err error) {
 	// open the MANIFEST
 	wc, err := bh.AddFile(ctx, backupManifest)
 	if err != nil {
@@ -413,12 +558,6 @@ func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 	}()
 
 	// JSON-encode and write the MANIFEST
-	bm := &BackupManifest{
-		FileEntries:   fes,
-		Position:      replicationPosition,
-		TransformHook: *backupStorageHook,
-		SkipCompress:  !*backupStorageCompress,
-	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
 		return fmt.Errorf("cannot JSON encode %v: %v", backupManifest, err)
@@ -430,20 +569,29 @@ func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 	return nil
 }
 
-// backupFile backs up an individual file.
-func backupFile(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, fe *FileEntry, name string, hookExtraEnv map[string]string) (err error) {
+// backupFile backs up an individual file. If ce is set, the file is
+// compressed with it. If key is set, the file is encrypted after
+// being compressed.
+func backupFile(ctx context.Context, cnf *Mycnf, logger logutil.Logger, bh backupstorage.BackupHandle, fe *FileEntry, name string, ce CompressionEngine, key []byte, hookExtraEnv map[string]string) error {
 	// Open the source file for reading.
-	var source *os.File
-	source, err = fe.open(mysqld.Cnf(), true)
+	source, err := fe.open(cnf, true)
 	if err != nil {
 		return err
 	}
 	defer source.Close()
 
+	fe.Hash, fe.ContentHash, err = writeBackupFile(ctx, logger, bh, name, source, ce, key, hookExtraEnv)
+	return err
+}
+
+// writeBackupFile adds the content read from source to the backup as
+// the file name, compressed, encrypted and transformed as requested.
+// It returns the hash of the stored data, and the hash of the content.
+func writeBackupFile(ctx context.Context, logger logutil.Logger, bh backupstorage.BackupHandle, name string, source io.Reader, ce CompressionEngine, key []byte, hookExtraEnv map[string]string) (hash, contentHash string, err error) {
 	// Open the destination file for writing, and a buffer.
 	wc, err := bh.AddFile(ctx, name)
 	if err != nil {
-		return fmt.Errorf("cannot add file: %v", err)
+		return "", "", fmt.Errorf("cannot add file: %v", err)
 	}
 	defer func() {
 		if rerr := wc.Close(); rerr != nil {
@@ -469,57 +617,75 @@ func backupFile(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 		h.ExtraEnv = hookExtraEnv
 		pipe, wait, _, err = h.ExecuteAsWritePipe(writer)
 		if err != nil {
-			return fmt.Errorf("'%v' hook returned error: %v", *backupStorageHook, err)
+			return "", "", fmt.Errorf("'%v' hook returned error: %v", *backupStorageHook, err)
 		}
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *cgzip.Writer
-	if *backupStorageCompress {
-		gzip, err = cgzip.NewWriterLevel(writer, cgzip.Z_BEST_SPEED)
+	// Create the encryption pipe, if necessary.
+	var encrypt io.WriteCloser
+	if key != nil {
+		encrypt, err = newEncryptWriter(writer, key)
+		if err != nil {
+			return "", "", fmt.Errorf("cannot create encrypter: %v", err)
+		}
+		writer = encrypt
+	}
+
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
+	if ce != nil {
+		compressor, err = ce.NewWriter(writer)
 		if err != nil {
-			return fmt.Errorf("cannot create gziper: %v", err)
+			return "", "", fmt.Errorf("cannot create compressor: %v", err)
 		}
-		writer = gzip
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
-	// optional pipe, tee, output file and hasher).
-	_, err = io.Copy(writer, source)
+	// Copy from the source file, through the content hasher, to
+	// writer (optional compression, optional encryption, optional
+	// pipe, tee, output file and hasher).
+	contentHasher := sha256.New()
+	_, err = io.Copy(writer, io.TeeReader(source, contentHasher))
 	if err != nil {
-		return fmt.Errorf("cannot copy data: %v", err)
+		return "", "", fmt.Errorf("cannot copy data: %v", err)
+	}
+
+	// Close the compressor to flush it, after that all data is sent
+	// to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return "", "", fmt.Errorf("cannot close compressor: %v", err)
+		}
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return fmt.Errorf("cannot close gzip: %v", err)
+	// Close the encrypter to write the last chunk.
+	if encrypt != nil {
+		if err = encrypt.Close(); err != nil {
+			return "", "", fmt.Errorf("cannot close encrypter: %v", err)
 		}
 	}
 
 	// Close the hook pipe if necessary.
 	if pipe != nil {
 		if err := pipe.Close(); err != nil {
-			return fmt.Errorf("cannot close hook pipe: %v", err)
+			return "", "", fmt.Errorf("cannot close hook pipe: %v", err)
 		}
 		stderr, err := wait()
 		if stderr != "" {
 			logger.Infof("'%v' hook returned stderr: %v", *backupStorageHook, stderr)
 		}
 		if err != nil {
-			return fmt.Errorf("'%v' returned error: %v", *backupStorageHook, err)
+			return "", "", fmt.Errorf("'%v' returned error: %v", *backupStorageHook, err)
 		}
 	}
 
 	// Flush the buffer to finish writing on destination.
 	if err = dst.Flush(); err != nil {
-		return fmt.Errorf("cannot flush dst: %v", err)
+		return "", "", fmt.Errorf("cannot flush dst: %v", err)
 	}
 
-	// Save the hash.
-	fe.Hash = hasher.HashString()
-	return nil
+	return hasher.HashString(), hex.EncodeToString(contentHasher.Sum(nil)), nil
 }
 
 // checkNoDB makes sure there is no user data already there.
@@ -557,7 +723,7 @@ func checkNoDB(ctx context.Context, mysqld MysqlDaemon, dbName string) (bool, er
 
 // restoreFiles will copy all the files from the BackupStorage to the
 // right place.
-func restoreFiles(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle, fes []FileEntry, transformHook string, compress bool, restoreConcurrency int, hookExtraEnv map[string]string) error {
+func restoreFiles(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle, fes []FileEntry, transformHook string, ce CompressionEngine, key []byte, restoreConcurrency int, hookExtraEnv map[string]string) error {
 	sema := sync2.NewSemaphore(restoreConcurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
 	wg := sync.WaitGroup{}
@@ -576,23 +742,16 @@ func restoreFiles(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle
 
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
-			rec.RecordError(restoreFile(ctx, cnf, bh, &fes[i], transformHook, compress, name, hookExtraEnv))
+			rec.RecordError(restoreFile(ctx, cnf, bh, &fes[i], transformHook, ce, key, name, hookExtraEnv))
 		}(i)
 	}
 	wg.Wait()
 	return rec.Error()
 }
 
-// restoreFile restores an individual file.
-func restoreFile(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string, hookExtraEnv map[string]string) (err error) {
-	// Open the source file for reading.
-	var source io.ReadCloser
-	source, err = bh.ReadFile(ctx, name)
-	if err != nil {
-		return err
-	}
-	defer source.Close()
-
+// restoreFile restores an individual file. If key is set, the file
+// is decrypted before being uncompressed with ce, if set.
+func restoreFile(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, ce CompressionEngine, key []byte, name string, hookExtraEnv map[string]string) (err error) {
 	// Open the destination file for writing.
 	dstFile, err := fe.open(cnf, false)
 	if err != nil {
@@ -612,11 +771,32 @@ func restoreFile(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle,
 	// Create a buffering output.
 	dst := bufio.NewWriterSize(dstFile, 2*1024*1024)
 
+	// Read the file into it, checking the hashes.
+	if err := readBackupFile(ctx, bh, fe, transformHook, ce, key, name, hookExtraEnv, dst); err != nil {
+		return err
+	}
+
+	// Flush the buffer.
+	return dst.Flush()
+}
+
+// readBackupFile reads an individual file from the BackupStorage,
+// and writes its original content to dst. It checks the hash of the
+// stored data, and the hash of the content if the backup recorded it.
+func readBackupFile(ctx context.Context, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, ce CompressionEngine, key []byte, name string, hookExtraEnv map[string]string, dst io.Writer) (err error) {
+	// Open the source file for reading.
+	var source io.ReadCloser
+	source, err = bh.ReadFile(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
 	// Create hash to write the compressed data to.
 	hasher := newHasher()
 
 	// Create a Tee: we split the input into the hasher
-	// and into the gunziper.
+	// and into the decompressor.
 	reader := io.TeeReader(source, hasher)
 
 	// Create the external read pipe, if any.
@@ -630,27 +810,36 @@ func restoreFile(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle,
 		}
 	}
 
+	// Create the decrypter if needed.
+	if key != nil {
+		reader, err = newDecryptReader(reader, key)
+		if err != nil {
+			return fmt.Errorf("cannot decrypt %v: %v", fe.Name, err)
+		}
+	}
+
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := cgzip.NewReader(reader)
+	if ce != nil {
+		decompressor, err := ce.NewReader(reader)
 		if err != nil {
 			return err
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if err != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gunziper %v: %v", name, cerr)
+					log.Errorf("failed to close decompressor %v: %v", name, cerr)
 				} else {
 					err = cerr
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
-	// Copy the data. Will also write to the hasher.
-	if _, err = io.Copy(dst, reader); err != nil {
+	// Copy the data. Will also write to the hashers.
+	contentHasher := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(dst, contentHasher), reader); err != nil {
 		return err
 	}
 
@@ -665,14 +854,18 @@ func restoreFile(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle,
 		}
 	}
 
-	// Check the hash.
+	// Check the hashes.
 	hash := hasher.HashString()
 	if hash != fe.Hash {
 		return fmt.Errorf("hash mismatch for %v, got %v expected %v", fe.Name, hash, fe.Hash)
 	}
-
-	// Flush the buffer.
-	return dst.Flush()
+	if fe.ContentHash != "" {
+		contentHash := hex.EncodeToString(contentHasher.Sum(nil))
+		if contentHash != fe.ContentHash {
+			return fmt.Errorf("content hash mismatch for %v, got %v expected %v", fe.Name, contentHash, fe.ContentHash)
+		}
+	}
+	return nil
 }
 
 // removeExistingFiles will delete existing files in the data dir to prevent
@@ -727,6 +920,8 @@ func removeExistingFiles(cnf *Mycnf) error {
 // Restore is the main entry point for backup restore.  If there is no
 // appropriate backup on the BackupStorage, Restore logs an error
 // and returns ErrNoBackup. Any other error is returned.
+// If target is set, the most recent backup taken before it is
+// restored, and an error is returned if there is none.
 func Restore(
 	ctx context.Context,
 	mysqld MysqlDaemon,
@@ -736,7 +931,8 @@ func Restore(
 	localMetadata map[string]string,
 	logger logutil.Logger,
 	deleteBeforeRestore bool,
-	dbName string) (mysql.Position, error) {
+	dbName string,
+	target RecoveryTarget) (mysql.Position, error) {
 
 	// Wait for mysqld to be ready, in case it was launched in parallel with us.
 	if err := mysqld.Wait(ctx); err != nil {
@@ -756,7 +952,7 @@ func Restore(
 		return mysql.Position{}, fmt.Errorf("ListBackups failed: %v", err)
 	}
 
-	if len(bhs) == 0 {
+	if len(bhs) == 0 && target.IsZero() {
 		// There are no backups (not even broken/incomplete ones).
 		logger.Errorf("No backup to restore on BackupStorage for directory %v. Starting up empty.", dir)
 		if err = populateMetadataTables(mysqld, localMetadata); err == nil {
@@ -776,6 +972,7 @@ func Restore(
 			continue
 		}
 
+		bm = BackupManifest{}
 		err = json.NewDecoder(rc).Decode(&bm)
 		rc.Close()
 		if err != nil {
@@ -783,9 +980,17 @@ func Restore(
 			continue
 		}
 
+		if !target.isAfter(&bm) {
+			logger.Infof("Restore: skipping backup %v %v, it is not before the %v to recover to", bh.Directory(), bh.Name(), target)
+			continue
+		}
+
 		logger.Infof("Restore: found backup %v %v to restore with %v files", bh.Directory(), bh.Name(), len(bm.FileEntries))
 		break
 	}
+	if toRestore < 0 && !target.IsZero() {
+		return mysql.Position{}, fmt.Errorf("no backup to restore before the %v to recover to", target)
+	}
 	if toRestore < 0 {
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
@@ -793,6 +998,20 @@ func Restore(
 		return mysql.Position{}, errors.New("backup(s) found but none could be read, unsafe to start up empty, restart to retry restore")
 	}
 
+	// Get the compression engine and the encryption key before
+	// changing anything, so a missing one doesn't leave us without data.
+	if bm.EncryptionKeyID != "" {
+		logger.Infof("Restore: backup is encrypted with key %v", bm.EncryptionKeyID)
+	}
+	ce, key, err := bm.decoders()
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	be, err := getBackupEngine(bm.BackupMethod)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+
 	if !deleteBeforeRestore {
 		logger.Infof("Restore: checking no existing data is present")
 		ok, err := checkNoDB(ctx, mysqld, dbName)
@@ -810,27 +1029,38 @@ func Restore(
 
 	// Starting from here we won't be able to recover if we get stopped by a cancelled
 	// context. Thus we use the background context to get through to the finish.
+	logger.Infof("Restore: restoring backup %v with the %v engine", bh.Name(), be.Name())
+	if err := be.ExecuteRestore(context.Background(), mysqld, logger, bh, &bm, ce, key, restoreConcurrency, hookExtraEnv, localMetadata); err != nil {
+		return mysql.Position{}, err
+	}
+
+	return bm.Position, nil
+}
 
+// restore is the ExecuteRestore of the builtin engine: it shuts down
+// mysqld, replaces its files with the ones of the backup, and runs
+// mysql_upgrade before restarting it.
+func restore(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, bm *BackupManifest, ce CompressionEngine, key []byte, restoreConcurrency int, hookExtraEnv map[string]string, localMetadata map[string]string) error {
 	logger.Infof("Restore: shutdown mysqld")
-	err = mysqld.Shutdown(context.Background(), true)
+	err := mysqld.Shutdown(ctx, true)
 	if err != nil {
-		return mysql.Position{}, err
+		return err
 	}
 
 	logger.Infof("Restore: deleting existing files")
 	if err := removeExistingFiles(mysqld.Cnf()); err != nil {
-		return mysql.Position{}, err
+		return err
 	}
 
 	logger.Infof("Restore: reinit config file")
-	err = mysqld.ReinitConfig(context.Background())
+	err = mysqld.ReinitConfig(ctx)
 	if err != nil {
-		return mysql.Position{}, err
+		return err
 	}
 
 	logger.Infof("Restore: copying all files")
-	if err := restoreFiles(context.Background(), mysqld.Cnf(), bh, bm.FileEntries, bm.TransformHook, !bm.SkipCompress, restoreConcurrency, hookExtraEnv); err != nil {
-		return mysql.Position{}, err
+	if err := restoreFiles(ctx, mysqld.Cnf(), bh, bm.FileEntries, bm.TransformHook, ce, key, restoreConcurrency, hookExtraEnv); err != nil {
+		return err
 	}
 
 	// mysqld needs to be running in order for mysql_upgrade to work.
@@ -842,14 +1072,14 @@ func Restore(
 	// of those who can connect.
 	logger.Infof("Restore: starting mysqld for mysql_upgrade")
 	// Note Start will use dba user for waiting, this is fine, it will be allowed.
-	err = mysqld.Start(context.Background(), "--skip-grant-tables", "--skip-networking")
+	err = mysqld.Start(ctx, "--skip-grant-tables", "--skip-networking")
 	if err != nil {
-		return mysql.Position{}, err
+		return err
 	}
 
 	logger.Infof("Restore: running mysql_upgrade")
 	if err := mysqld.RunMysqlUpgrade(); err != nil {
-		return mysql.Position{}, fmt.Errorf("mysql_upgrade failed: %v", err)
+		return fmt.Errorf("mysql_upgrade failed: %v", err)
 	}
 
 	// Populate local_metadata before starting without --skip-networking,
@@ -857,20 +1087,15 @@ func Restore(
 	logger.Infof("Restore: populating local_metadata")
 	err = populateMetadataTables(mysqld, localMetadata)
 	if err != nil {
-		return mysql.Position{}, err
+		return err
 	}
 
 	// The MySQL manual recommends restarting mysqld after running mysql_upgrade,
 	// so that any changes made to system tables take effect.
 	logger.Infof("Restore: restarting mysqld after mysql_upgrade")
-	err = mysqld.Shutdown(context.Background(), true)
-	if err != nil {
-		return mysql.Position{}, err
-	}
-	err = mysqld.Start(context.Background())
+	err = mysqld.Shutdown(ctx, true)
 	if err != nil {
-		return mysql.Position{}, err
+		return err
 	}
-
-	return bm.Position, nil
+	return mysqld.Start(ctx)
 }
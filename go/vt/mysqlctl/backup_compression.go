@@ -0,0 +1,179 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"runtime"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/pierrec/lz4"
+
+	"vitess.io/vitess/go/cgzip"
+)
+
+const (
+	// gzipCompressionEngineName is the engine used by default, and
+	// by the backups that don't record their engine in the manifest.
+	gzipCompressionEngineName  = "gzip"
+	pgzipCompressionEngineName = "pgzip"
+	zstdCompressionEngineName  = "zstd"
+	lz4CompressionEngineName   = "lz4"
+)
+
+var (
+	// backupCompressionEngine is the name of the CompressionEngine
+	// used on the backup files. It is only used at backup time. Then
+	// it is put in the manifest, and used again at restore time.
+	backupCompressionEngine = flag.String("backup_compression_engine", gzipCompressionEngineName, "compression engine used for the backup files, if -backup_storage_compress is set: gzip, pgzip (parallel block gzip), zstd or lz4.")
+
+	// backupCompressionLevel is the compression level. Its meaning
+	// depends on the engine.
+	backupCompressionLevel = flag.Int("backup_compression_level", 1, "compression level of the backup files. For gzip and pgzip, this is the zlib level (1 to 9). For zstd, this is the zstd level (1 to 22). It is ignored by lz4.")
+
+	// backupCompressionBlockSize and backupCompressionConcurrency
+	// are used by the pgzip engine, which compresses the blocks of
+	// a file in parallel.
+	backupCompressionBlockSize   = flag.Int("backup_compression_block_size", 1024*1024, "size of the blocks that are compressed in parallel by the pgzip engine.")
+	backupCompressionConcurrency = flag.Int("backup_compression_concurrency", 0, "number of blocks compressed in parallel for each file by the pgzip engine. Defaults to GOMAXPROCS.")
+)
+
+// CompressionEngine compresses and uncompresses the backup files.
+type CompressionEngine interface {
+	// NewWriter returns a writer that compresses the data into w.
+	// Closing it flushes the data, but doesn't close w.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+
+	// NewReader returns a reader that uncompresses the data read from r.
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+// CompressionEngines contains the registered implementations
+// of CompressionEngine.
+var CompressionEngines = map[string]CompressionEngine{
+	gzipCompressionEngineName:  gzipEngine{},
+	pgzipCompressionEngineName: pgzipEngine{},
+	zstdCompressionEngineName:  zstdEngine{},
+	lz4CompressionEngineName:   lz4Engine{},
+}
+
+// getCompressionEngine returns the engine with the given name.
+func getCompressionEngine(name string) (CompressionEngine, error) {
+	ce, ok := CompressionEngines[name]
+	if !ok {
+		return nil, fmt.Errorf("no registered compression engine named %v", name)
+	}
+	return ce, nil
+}
+
+// compressionLevels are the valid values of -backup_compression_level
+// for the engines that use it.
+var compressionLevels = map[string]struct{ min, max int }{
+	gzipCompressionEngineName:  {1, 9},
+	pgzipCompressionEngineName: {1, 9},
+	zstdCompressionEngineName:  {1, 22},
+}
+
+// VerifyBackupCompression checks that the backup compression flags
+// are valid. It's called at startup, so that a bad value is reported
+// before any backup is taken.
+func VerifyBackupCompression() error {
+	if !*backupStorageCompress {
+		return nil
+	}
+	return verifyCompression(*backupCompressionEngine, *backupCompressionLevel)
+}
+
+// verifyCompression checks that the engine exists, and that it
+// supports the level.
+func verifyCompression(name string, level int) error {
+	if _, err := getCompressionEngine(name); err != nil {
+		return err
+	}
+	levels, ok := compressionLevels[name]
+	if !ok {
+		return nil
+	}
+	if level < levels.min || level > levels.max {
+		return fmt.Errorf("invalid -backup_compression_level %v for compression engine %v: must be between %v and %v", level, name, levels.min, levels.max)
+	}
+	return nil
+}
+
+// gzipEngine uses cgzip. It is the historical format of the backups.
+type gzipEngine struct{}
+
+func (gzipEngine) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return cgzip.NewWriterLevel(w, *backupCompressionLevel)
+}
+
+func (gzipEngine) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return cgzip.NewReader(r)
+}
+
+// pgzipEngine compresses blocks of the file in parallel. The output is
+// a regular gzip stream.
+type pgzipEngine struct{}
+
+func (pgzipEngine) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	gz, err := pgzip.NewWriterLevel(w, *backupCompressionLevel)
+	if err != nil {
+		return nil, err
+	}
+	concurrency := *backupCompressionConcurrency
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	if err := gz.SetConcurrency(*backupCompressionBlockSize, concurrency); err != nil {
+		return nil, err
+	}
+	return gz, nil
+}
+
+func (pgzipEngine) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return pgzip.NewReader(r)
+}
+
+// zstdEngine uses zstd.
+type zstdEngine struct{}
+
+func (zstdEngine) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(*backupCompressionLevel)))
+}
+
+func (zstdEngine) NewReader(r io.Reader) (io.ReadCloser, error) {
+	d, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return d.IOReadCloser(), nil
+}
+
+// lz4Engine uses the lz4 frame format.
+type lz4Engine struct{}
+
+func (lz4Engine) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return lz4.NewWriter(w), nil
+}
+
+func (lz4Engine) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return ioutil.NopCloser(lz4.NewReader(r)), nil
+}
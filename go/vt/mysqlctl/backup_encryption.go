@@ -0,0 +1,384 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// This file handles the encryption of the backup files.
+//
+// An encrypted file starts with a header made of encryptionMagic and
+// a random salt. The file key is derived from the backup key and the
+// salt, so that each file has its own key. The data follows, as a
+// sequence of chunks sealed with AES-GCM. Each chunk is made of a flag
+// byte, which is set for the last chunk, the length of the sealed
+// data, and the sealed data. The nonce of a chunk is its sequence
+// number. The flag is authenticated, so that a truncated file
+// can't be restored.
+
+const (
+	encryptionMagic     = "VTBE1"
+	encryptionSaltSize  = 32
+	encryptionChunkSize = 64 * 1024
+
+	// the key provider that reads keys from a local file
+	fileKeyProviderName = "file"
+)
+
+var (
+	// backupEncryptionKeyProvider is the name of the BackupKeyProvider
+	// used to encrypt the backup files. If not set, the files are not
+	// encrypted. It is used at backup time, and at restore time for
+	// encrypted backups: the ID of the key is put in the manifest,
+	// and is used to get the key back.
+	backupEncryptionKeyProvider = flag.String("backup_encryption_key_provider", "", "if set, the backup files are encrypted with a key given by this provider. The built-in provider is 'file', which reads the keys from -backup_encryption_key_file.")
+
+	// backupEncryptionKeyFile is the file read by the 'file' provider.
+	backupEncryptionKeyFile = flag.String("backup_encryption_key_file", "", "file that contains the backup encryption keys, one per line as '<key id> <hex-encoded 32 byte key>'. The last key is used to encrypt new backups.")
+)
+
+// BackupKeyProvider gives the keys used to encrypt the backup files.
+type BackupKeyProvider interface {
+	// CurrentKey returns the key to encrypt new backups with, and its ID.
+	CurrentKey() (id string, key []byte, err error)
+
+	// Key returns the key with the given ID, to restore a backup.
+	Key(id string) ([]byte, error)
+}
+
+// BackupKeyProviders contains the registered implementations
+// of BackupKeyProvider.
+var BackupKeyProviders = map[string]BackupKeyProvider{
+	fileKeyProviderName: fileKeyProvider{},
+}
+
+// getBackupKeyProvider returns the BackupKeyProvider set by the flag,
+// or nil if the backups are not encrypted.
+func getBackupKeyProvider() (BackupKeyProvider, error) {
+	if *backupEncryptionKeyProvider == "" {
+		return nil, nil
+	}
+	kp, ok := BackupKeyProviders[*backupEncryptionKeyProvider]
+	if !ok {
+		return nil, fmt.Errorf("no registered backup key provider named %v", *backupEncryptionKeyProvider)
+	}
+	return kp, nil
+}
+
+// currentBackupKey returns the key to encrypt a new backup with,
+// and its ID. It returns an empty ID if encryption is disabled.
+func currentBackupKey() (string, []byte, error) {
+	kp, err := getBackupKeyProvider()
+	if err != nil || kp == nil {
+		return "", nil, err
+	}
+	id, key, err := kp.CurrentKey()
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot get backup encryption key: %v", err)
+	}
+	if err := checkBackupKey(id, key); err != nil {
+		return "", nil, err
+	}
+	return id, key, nil
+}
+
+// backupKey returns the key of an encrypted backup.
+func backupKey(id string) ([]byte, error) {
+	kp, err := getBackupKeyProvider()
+	if err != nil {
+		return nil, err
+	}
+	if kp == nil {
+		return nil, fmt.Errorf("backup is encrypted with key %v, but no backup_encryption_key_provider is set", id)
+	}
+	key, err := kp.Key(id)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get backup encryption key %v: %v", id, err)
+	}
+	if err := checkBackupKey(id, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func checkBackupKey(id string, key []byte) error {
+	if len(key) != 32 {
+		return fmt.Errorf("backup encryption key %v has %v bytes, 32 are required", id, len(key))
+	}
+	return nil
+}
+
+// fileKeyProvider reads the keys from the backup_encryption_key_file.
+// The file is read each time, so keys can be added without a restart.
+type fileKeyProvider struct{}
+
+// CurrentKey is part of the BackupKeyProvider interface.
+func (fileKeyProvider) CurrentKey() (string, []byte, error) {
+	ids, keys, err := readKeyFile(*backupEncryptionKeyFile)
+	if err != nil {
+		return "", nil, err
+	}
+	last := ids[len(ids)-1]
+	return last, keys[last], nil
+}
+
+// Key is part of the BackupKeyProvider interface.
+func (fileKeyProvider) Key(id string) ([]byte, error) {
+	_, keys, err := readKeyFile(*backupEncryptionKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := keys[id]
+	if !ok {
+		return nil, fmt.Errorf("key %v not found in %v", id, *backupEncryptionKeyFile)
+	}
+	return key, nil
+}
+
+// readKeyFile parses a key file. It returns the key IDs in the order
+// of the file, and the keys by ID.
+func readKeyFile(name string) ([]string, map[string][]byte, error) {
+	if name == "" {
+		return nil, nil, errors.New("backup_encryption_key_file is not set")
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	var ids []string
+	keys := make(map[string][]byte)
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, nil, fmt.Errorf("%v:%v: expected '<key id> <hex key>'", name, lineNum)
+		}
+		key, err := hex.DecodeString(fields[1])
+		if err != nil {
+			return nil, nil, fmt.Errorf("%v:%v: cannot decode key: %v", name, lineNum, err)
+		}
+		if _, ok := keys[fields[0]]; ok {
+			return nil, nil, fmt.Errorf("%v:%v: duplicate key id %v", name, lineNum, fields[0])
+		}
+		ids = append(ids, fields[0])
+		keys[fields[0]] = key
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil, fmt.Errorf("no key in %v", name)
+	}
+	return ids, keys, nil
+}
+
+// newFileCipher returns the AES-GCM cipher of a file, whose key
+// is derived from the backup key and the salt of the file.
+func newFileCipher(key, salt []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(salt)
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce returns the nonce of the chunk with the given
+// sequence number.
+func chunkNonce(aead cipher.AEAD, seq uint64) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], seq)
+	return nonce
+}
+
+// encryptWriter encrypts the data written to it. It must be
+// closed to write the last chunk.
+type encryptWriter struct {
+	w    io.Writer
+	aead cipher.AEAD
+	seq  uint64
+	buf  []byte
+	out  []byte
+}
+
+// newEncryptWriter writes the header of an encrypted file to w, and
+// returns the writer of its data.
+func newEncryptWriter(w io.Writer, key []byte) (io.WriteCloser, error) {
+	salt := make([]byte, encryptionSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	aead, err := newFileCipher(key, salt)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(w, encryptionMagic); err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(salt); err != nil {
+		return nil, err
+	}
+	return &encryptWriter{
+		w:    w,
+		aead: aead,
+		buf:  make([]byte, 0, encryptionChunkSize),
+	}, nil
+}
+
+// Write is part of the io.Writer interface. A full chunk is only
+// written when more data comes, since it may be the last one.
+func (ew *encryptWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if len(ew.buf) == encryptionChunkSize {
+			if err := ew.writeChunk(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(ew.buf[len(ew.buf):cap(ew.buf)], p)
+		ew.buf = ew.buf[:len(ew.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+// Close is part of the io.Closer interface. It doesn't close
+// the underlying writer.
+func (ew *encryptWriter) Close() error {
+	return ew.writeChunk(true)
+}
+
+func (ew *encryptWriter) writeChunk(last bool) error {
+	var header [5]byte
+	if last {
+		header[0] = 1
+	}
+	ew.out = ew.aead.Seal(ew.out[:0], chunkNonce(ew.aead, ew.seq), ew.buf, header[:1])
+	binary.BigEndian.PutUint32(header[1:], uint32(len(ew.out)))
+	if _, err := ew.w.Write(header[:]); err != nil {
+		return err
+	}
+	if _, err := ew.w.Write(ew.out); err != nil {
+		return err
+	}
+	ew.seq++
+	ew.buf = ew.buf[:0]
+	return nil
+}
+
+// decryptReader decrypts the data of an encrypted file.
+type decryptReader struct {
+	r    io.Reader
+	aead cipher.AEAD
+	seq  uint64
+	in   []byte
+	buf  []byte
+	last bool
+}
+
+// newDecryptReader reads the header of an encrypted file from r, and
+// returns the reader of its data.
+func newDecryptReader(r io.Reader, key []byte) (io.Reader, error) {
+	header := make([]byte, len(encryptionMagic)+encryptionSaltSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("cannot read encryption header: %v", err)
+	}
+	if string(header[:len(encryptionMagic)]) != encryptionMagic {
+		return nil, errors.New("file is not encrypted")
+	}
+	aead, err := newFileCipher(key, header[len(encryptionMagic):])
+	if err != nil {
+		return nil, err
+	}
+	return &decryptReader{
+		r:    r,
+		aead: aead,
+	}, nil
+}
+
+// Read is part of the io.Reader interface.
+func (dr *decryptReader) Read(p []byte) (int, error) {
+	for len(dr.buf) == 0 {
+		if dr.last {
+			return 0, io.EOF
+		}
+		if err := dr.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, dr.buf)
+	dr.buf = dr.buf[n:]
+	return n, nil
+}
+
+func (dr *decryptReader) readChunk() error {
+	var header [5]byte
+	if _, err := io.ReadFull(dr.r, header[:]); err != nil {
+		if err == io.EOF {
+			return errors.New("encrypted file is truncated")
+		}
+		return err
+	}
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > uint32(encryptionChunkSize+dr.aead.Overhead()) {
+		return fmt.Errorf("invalid encrypted chunk size %v", size)
+	}
+	if cap(dr.in) < int(size) {
+		dr.in = make([]byte, size)
+	}
+	dr.in = dr.in[:size]
+	if _, err := io.ReadFull(dr.r, dr.in); err != nil {
+		return fmt.Errorf("cannot read encrypted chunk: %v", err)
+	}
+	buf, err := dr.aead.Open(dr.in[:0], chunkNonce(dr.aead, dr.seq), dr.in, header[:1])
+	if err != nil {
+		return fmt.Errorf("cannot decrypt chunk %v: %v", dr.seq, err)
+	}
+	dr.seq++
+	dr.buf = buf
+	dr.last = header[0] == 1
+	if dr.last {
+		// Nothing can follow the last chunk.
+		var extra [1]byte
+		if n, _ := io.ReadFull(dr.r, extra[:]); n != 0 {
+			return errors.New("encrypted file has data after the last chunk")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func encryptForTest(t *testing.T, data, key []byte) []byte {
+	buf := &bytes.Buffer{}
+	w, err := newEncryptWriter(buf, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Write in odd sizes to cross the chunk boundaries.
+	for len(data) > 0 {
+		n := 1000
+		if n > len(data) {
+			n = len(data)
+		}
+		if _, err := w.Write(data[:n]); err != nil {
+			t.Fatal(err)
+		}
+		data = data[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decryptForTest(encrypted, key []byte) ([]byte, error) {
+	r, err := newDecryptReader(bytes.NewReader(encrypted), key)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	for _, size := range []int{0, 1, encryptionChunkSize, encryptionChunkSize + 1, 3*encryptionChunkSize - 5} {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(i * 31)
+		}
+		encrypted := encryptForTest(t, data, key)
+		if size > 16 && bytes.Contains(encrypted, data) {
+			t.Errorf("size %v: data is not encrypted", size)
+		}
+		got, err := decryptForTest(encrypted, key)
+		if err != nil {
+			t.Errorf("size %v: decrypt failed: %v", size, err)
+			continue
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("size %v: decrypted data doesn't match", size)
+		}
+	}
+
+	// Each file has its own salt.
+	a := encryptForTest(t, []byte("same data"), key)
+	b := encryptForTest(t, []byte("same data"), key)
+	if bytes.Equal(a, b) {
+		t.Errorf("the same data was encrypted twice the same way")
+	}
+}
+
+func TestEncryptionErrors(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+	data := bytes.Repeat([]byte("abcdefgh"), encryptionChunkSize/4)
+	encrypted := encryptForTest(t, data, key)
+
+	testcases := []struct {
+		name      string
+		encrypted []byte
+		key       []byte
+		want      string
+	}{{
+		name:      "wrong key",
+		encrypted: encrypted,
+		key:       bytes.Repeat([]byte{8}, 32),
+		want:      "cannot decrypt chunk 0",
+	}, {
+		name:      "tampered",
+		encrypted: append(append([]byte{}, encrypted[:100]...), append([]byte{encrypted[100] ^ 1}, encrypted[101:]...)...),
+		key:       key,
+		want:      "cannot decrypt chunk 0",
+	}, {
+		// The file is cut right after the first chunk.
+		name:      "truncated",
+		encrypted: encrypted[:len(encryptionMagic)+encryptionSaltSize+5+encryptionChunkSize+16],
+		key:       key,
+		want:      "encrypted file is truncated",
+	}, {
+		name:      "trailing data",
+		encrypted: append(append([]byte{}, encrypted...), 0),
+		key:       key,
+		want:      "encrypted file has data after the last chunk",
+	}, {
+		name:      "not encrypted",
+		encrypted: bytes.Repeat([]byte{0}, 100),
+		key:       key,
+		want:      "file is not encrypted",
+	}}
+	for _, tcase := range testcases {
+		_, err := decryptForTest(tcase.encrypted, tcase.key)
+		if err == nil || !strings.Contains(err.Error(), tcase.want) {
+			t.Errorf("%v: decrypt: %v, want %v", tcase.name, err, tcase.want)
+		}
+	}
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	f, err := ioutil.TempFile("", "backupkeys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	content := "# old key\n" +
+		"k1 " + strings.Repeat("01", 32) + "\n" +
+		"\n" +
+		"k2 " + strings.Repeat("02", 32) + "\n"
+	if _, err := f.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	savedProvider, savedFile := *backupEncryptionKeyProvider, *backupEncryptionKeyFile
+	defer func() {
+		*backupEncryptionKeyProvider, *backupEncryptionKeyFile = savedProvider, savedFile
+	}()
+	*backupEncryptionKeyProvider = ""
+	id, key, err := currentBackupKey()
+	if id != "" || key != nil || err != nil {
+		t.Errorf("currentBackupKey without provider: %v %v %v, want no key", id, key, err)
+	}
+
+	*backupEncryptionKeyProvider = "file"
+	*backupEncryptionKeyFile = f.Name()
+	id, key, err = currentBackupKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "k2" || !bytes.Equal(key, bytes.Repeat([]byte{2}, 32)) {
+		t.Errorf("currentBackupKey: %v %x, want the last key", id, key)
+	}
+	key, err = backupKey("k1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(key, bytes.Repeat([]byte{1}, 32)) {
+		t.Errorf("backupKey(k1): %x", key)
+	}
+	if _, err := backupKey("k3"); err == nil || !strings.Contains(err.Error(), "key k3 not found") {
+		t.Errorf("backupKey(k3): %v, want not found", err)
+	}
+
+	*backupEncryptionKeyProvider = "unknown"
+	if _, err := backupKey("k1"); err == nil || !strings.Contains(err.Error(), "no registered backup key provider named unknown") {
+		t.Errorf("backupKey with unknown provider: %v", err)
+	}
+}
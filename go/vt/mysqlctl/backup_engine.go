@@ -0,0 +1,111 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"flag"
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+const (
+	// builtinBackupEngineName is the engine used by default, and
+	// by the backups that don't record their method in the manifest.
+	builtinBackupEngineName = "builtin"
+	logicalBackupEngineName = "logical"
+)
+
+var (
+	// backupEngineImplementation is the name of the BackupEngine
+	// the backups are taken with. It is put in the manifest, and
+	// the backup is restored with the same engine.
+	backupEngineImplementation = flag.String("backup_engine_implementation", builtinBackupEngineName, "which implementation to use to take backups: builtin (copies the files of mysqld, which is shut down during the backup) or logical (dumps the data from a consistent snapshot, while mysqld keeps running)")
+)
+
+// BackupEngine takes backups into, and restores them from, the
+// BackupStorage. The name of the engine that took a backup is
+// recorded in its MANIFEST, so it is restored by the same engine.
+type BackupEngine interface {
+	// Name returns the name the engine is registered with.
+	Name() string
+
+	// ExecuteBackup takes a backup of mysqld into bh, and adds its
+	// MANIFEST last. It returns a boolean that indicates if the
+	// backup is usable, even if an error occurred after it was
+	// taken, and an overall error.
+	ExecuteBackup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, backupConcurrency int, hookExtraEnv map[string]string) (bool, error)
+
+	// ExecuteRestore replaces the data of mysqld with the backup
+	// bh, described by bm. Its files are uncompressed with ce and
+	// decrypted with key, if set. The local metadata tables are
+	// populated with localMetadata. mysqld is running when it
+	// returns.
+	ExecuteRestore(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, bm *BackupManifest, ce CompressionEngine, key []byte, restoreConcurrency int, hookExtraEnv map[string]string, localMetadata map[string]string) error
+
+	// ShouldDrainForBackup returns true if the tablet must stop
+	// serving while the engine takes a backup.
+	ShouldDrainForBackup() bool
+}
+
+// BackupEngines contains the registered implementations of
+// BackupEngine.
+var BackupEngines = map[string]BackupEngine{
+	builtinBackupEngineName: builtinBackupEngine{},
+	logicalBackupEngineName: logicalBackupEngine{},
+}
+
+// GetBackupEngine returns the BackupEngine set by
+// -backup_engine_implementation, to take backups with.
+func GetBackupEngine() (BackupEngine, error) {
+	return getBackupEngine(*backupEngineImplementation)
+}
+
+// getBackupEngine returns the engine with the given name. Backups that
+// don't record their method were taken by the builtin engine.
+func getBackupEngine(name string) (BackupEngine, error) {
+	if name == "" {
+		name = builtinBackupEngineName
+	}
+	be, ok := BackupEngines[name]
+	if !ok {
+		return nil, fmt.Errorf("no registered backup engine named %v", name)
+	}
+	return be, nil
+}
+
+// builtinBackupEngine shuts down mysqld, and copies its files.
+type builtinBackupEngine struct{}
+
+func (builtinBackupEngine) Name() string {
+	return builtinBackupEngineName
+}
+
+func (builtinBackupEngine) ExecuteBackup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, backupConcurrency int, hookExtraEnv map[string]string) (bool, error) {
+	return backup(ctx, mysqld, logger, bh, backupConcurrency, hookExtraEnv)
+}
+
+func (builtinBackupEngine) ExecuteRestore(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, bm *BackupManifest, ce CompressionEngine, key []byte, restoreConcurrency int, hookExtraEnv map[string]string, localMetadata map[string]string) error {
+	return restore(ctx, mysqld, logger, bh, bm, ce, key, restoreConcurrency, hookExtraEnv, localMetadata)
+}
+
+func (builtinBackupEngine) ShouldDrainForBackup() bool {
+	return true
+}
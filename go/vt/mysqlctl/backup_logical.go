@@ -0,0 +1,684 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+// This file implements the logical BackupEngine. It dumps the data as
+// SQL statements, read from a consistent snapshot while mysqld keeps
+// running, and restores it by executing them.
+//
+// The statements of a file of the backup are written as they are,
+// each followed by a delimiter and a newline. The delimiter is ';',
+// unless the statement has a line that ends with ';', like the body
+// of a stored routine can. Then a longer delimiter is set by a
+// DELIMITER line before the statement, like mysqldump does.
+
+const (
+	// The bases of the files of a logical backup. The databases
+	// are restored first, then the tables, then the stored
+	// routines, the views, the triggers and the events.
+	logicalBackupDatabase = "LogicalDatabase"
+	logicalBackupTable    = "LogicalTable"
+	logicalBackupRoutine  = "LogicalRoutine"
+	logicalBackupView     = "LogicalView"
+	logicalBackupTrigger  = "LogicalTrigger"
+	logicalBackupEvent    = "LogicalEvent"
+
+	// logicalBackupInsertSize is the size after which the rows of
+	// a table go into another INSERT statement.
+	logicalBackupInsertSize = 1024 * 1024
+)
+
+// logicalBackupSystemDatabases are the databases a logical backup
+// doesn't dump, and a logical restore doesn't drop.
+var logicalBackupSystemDatabases = map[string]bool{
+	"information_schema": true,
+	"mysql":              true,
+	"performance_schema": true,
+	"sys":                true,
+}
+
+// logicalBackupObject is a type of stored object of a database, other
+// than tables and views, that a logical backup dumps.
+type logicalBackupObject struct {
+	// base is the base of the files of the objects.
+	base string
+	// objectType is the type of the object for SHOW CREATE.
+	objectType string
+	// listQuery returns the names of the objects of the database
+	// passed as a string literal.
+	listQuery string
+	// createColumn is the column of SHOW CREATE that has the
+	// statement that creates the object. Its column 1 is the
+	// sql_mode of the object.
+	createColumn int
+}
+
+var logicalBackupObjects = []logicalBackupObject{{
+	base:         logicalBackupRoutine,
+	objectType:   "PROCEDURE",
+	listQuery:    "SELECT ROUTINE_NAME FROM information_schema.ROUTINES WHERE ROUTINE_TYPE = 'PROCEDURE' AND ROUTINE_SCHEMA = %v",
+	createColumn: 2,
+}, {
+	base:         logicalBackupRoutine,
+	objectType:   "FUNCTION",
+	listQuery:    "SELECT ROUTINE_NAME FROM information_schema.ROUTINES WHERE ROUTINE_TYPE = 'FUNCTION' AND ROUTINE_SCHEMA = %v",
+	createColumn: 2,
+}, {
+	base:         logicalBackupTrigger,
+	objectType:   "TRIGGER",
+	listQuery:    "SELECT TRIGGER_NAME FROM information_schema.TRIGGERS WHERE TRIGGER_SCHEMA = %v",
+	createColumn: 2,
+}, {
+	// Column 2 of SHOW CREATE EVENT is the time_zone of the event.
+	base:         logicalBackupEvent,
+	objectType:   "EVENT",
+	listQuery:    "SELECT EVENT_NAME FROM information_schema.EVENTS WHERE EVENT_SCHEMA = %v",
+	createColumn: 3,
+}}
+
+// logicalBackupEngine dumps the databases from a transaction started
+// WITH CONSISTENT SNAPSHOT. The tables are only locked while the
+// snapshot is started and its position read.
+type logicalBackupEngine struct{}
+
+func (logicalBackupEngine) Name() string {
+	return logicalBackupEngineName
+}
+
+// ExecuteBackup is part of the BackupEngine interface. All the tables
+// are read from the same snapshot, on a single connection, so
+// backupConcurrency is not used.
+func (logicalBackupEngine) ExecuteBackup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, backupConcurrency int, hookExtraEnv map[string]string) (bool, error) {
+	compressionEngine, ce, keyID, key, err := backupEncoders()
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := mysqld.GetDbaConnection()
+	if err != nil {
+		return false, fmt.Errorf("can't get dba connection: %v", err)
+	}
+	defer conn.Close()
+
+	// Start the snapshot while no transaction can commit, so
+	// its position is the one we read.
+	logger.Infof("starting a consistent snapshot")
+	for _, query := range []string{
+		"SET SESSION TRANSACTION ISOLATION LEVEL REPEATABLE READ",
+		"FLUSH TABLES WITH READ LOCK",
+		"START TRANSACTION WITH CONSISTENT SNAPSHOT",
+	} {
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			return false, fmt.Errorf("%v failed: %v", query, err)
+		}
+	}
+	replicationPosition, err := mysqld.MasterPosition()
+	backupTime := time.Now()
+	if _, uerr := conn.ExecuteFetch("UNLOCK TABLES", 0, false); uerr != nil && err == nil {
+		err = fmt.Errorf("UNLOCK TABLES failed: %v", uerr)
+	}
+	if err != nil {
+		return false, fmt.Errorf("can't get replication position: %v", err)
+	}
+	logger.Infof("using replication position: %v", replicationPosition)
+
+	ld := &logicalDump{
+		ctx:          ctx,
+		conn:         conn,
+		logger:       logger,
+		bh:           bh,
+		ce:           ce,
+		key:          key,
+		hookExtraEnv: hookExtraEnv,
+	}
+	if err := ld.dumpDatabases(); err != nil {
+		return false, err
+	}
+
+	if err := writeManifest(ctx, bh, &BackupManifest{
+		FileEntries:       ld.fes,
+		Position:          replicationPosition,
+		TransformHook:     *backupStorageHook,
+		SkipCompress:      !*backupStorageCompress,
+		CompressionEngine: compressionEngine,
+		EncryptionKeyID:   keyID,
+		BackupTime:        backupTime.UTC().Format(time.RFC3339),
+		BackupMethod:      logicalBackupEngineName,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ExecuteRestore is part of the BackupEngine interface. mysqld keeps
+// running: its databases are dropped, and the ones of the backup are
+// created and loaded, without being written to the binlogs.
+func (logicalBackupEngine) ExecuteRestore(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, bm *BackupManifest, ce CompressionEngine, key []byte, restoreConcurrency int, hookExtraEnv map[string]string, localMetadata map[string]string) error {
+	logger.Infof("Restore: stopping replication")
+	if err := StopSlave(mysqld, hookExtraEnv); err != nil {
+		return err
+	}
+
+	conn, err := getLogicalRestoreConnection(mysqld)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	rows, err := fetchAllRows(conn, "SHOW DATABASES")
+	if err != nil {
+		return fmt.Errorf("SHOW DATABASES failed: %v", err)
+	}
+	for _, row := range rows {
+		dbName := row[0].ToString()
+		if logicalBackupSystemDatabases[dbName] {
+			continue
+		}
+		logger.Infof("Restore: dropping database %v", dbName)
+		if _, err := conn.ExecuteFetch("DROP DATABASE "+sqlescape.EscapeID(dbName), 0, false); err != nil {
+			return fmt.Errorf("cannot drop database %v: %v", dbName, err)
+		}
+	}
+
+	restoreFile := func(conn *dbconnpool.DBConnection, i int) error {
+		return restoreLogicalFile(ctx, conn, bh, &bm.FileEntries[i], bm.TransformHook, ce, key, fmt.Sprintf("%v", i), hookExtraEnv)
+	}
+	var tables, routines, views, triggers, events []int
+	for i, fe := range bm.FileEntries {
+		switch fe.Base {
+		case logicalBackupDatabase:
+			logger.Infof("Restore: creating database %v", fe.Name)
+			if err := restoreFile(conn, i); err != nil {
+				return err
+			}
+		case logicalBackupTable:
+			tables = append(tables, i)
+		case logicalBackupRoutine:
+			routines = append(routines, i)
+		case logicalBackupView:
+			views = append(views, i)
+		case logicalBackupTrigger:
+			triggers = append(triggers, i)
+		case logicalBackupEvent:
+			events = append(events, i)
+		default:
+			return fmt.Errorf("unknown base for a logical backup file: %v", fe.Base)
+		}
+	}
+
+	// The tables are independent: load them concurrently, each
+	// on its own connection.
+	logger.Infof("Restore: loading %v tables", len(tables))
+	sema := sync2.NewSemaphore(restoreConcurrency, 0)
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for _, i := range tables {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// Wait until we are ready to go, skip if we already
+			// encountered an error.
+			sema.Acquire()
+			defer sema.Release()
+			if rec.HasErrors() {
+				return
+			}
+
+			tableConn, err := getLogicalRestoreConnection(mysqld)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+			defer tableConn.Close()
+			rec.RecordError(restoreFile(tableConn, i))
+		}(i)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return rec.Error()
+	}
+
+	// Views can call stored functions: create the routines first.
+	logger.Infof("Restore: creating %v stored routines", len(routines))
+	for _, i := range routines {
+		if err := restoreFile(conn, i); err != nil {
+			return err
+		}
+	}
+
+	// Views can depend on other views: create the ones that fail
+	// again after the others, until there is no progress.
+	logger.Infof("Restore: creating %v views", len(views))
+	for len(views) > 0 {
+		var failed []int
+		var lastErr error
+		for _, i := range views {
+			if err := restoreFile(conn, i); err != nil {
+				failed = append(failed, i)
+				lastErr = err
+			}
+		}
+		if len(failed) == len(views) {
+			return lastErr
+		}
+		views = failed
+	}
+
+	// The triggers are created after the rows are loaded, so they
+	// don't run for them.
+	logger.Infof("Restore: creating %v triggers and %v events", len(triggers), len(events))
+	for _, i := range append(triggers, events...) {
+		if err := restoreFile(conn, i); err != nil {
+			return err
+		}
+	}
+
+	logger.Infof("Restore: populating local_metadata")
+	return populateMetadataTables(mysqld, localMetadata)
+}
+
+// ShouldDrainForBackup is part of the BackupEngine interface.
+func (logicalBackupEngine) ShouldDrainForBackup() bool {
+	return false
+}
+
+// getLogicalRestoreConnection returns a dba connection that doesn't
+// write to the binlogs, nor check the foreign keys of the rows.
+func getLogicalRestoreConnection(mysqld MysqlDaemon) (*dbconnpool.DBConnection, error) {
+	conn, err := mysqld.GetDbaConnection()
+	if err != nil {
+		return nil, fmt.Errorf("can't get dba connection: %v", err)
+	}
+	for _, query := range []string{
+		"SET SESSION sql_log_bin = 0",
+		"SET SESSION foreign_key_checks = 0",
+		"SET SESSION unique_checks = 0",
+	} {
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("%v failed: %v", query, err)
+		}
+	}
+	return conn, nil
+}
+
+// restoreLogicalFile executes the statements of a file of a logical
+// backup on conn, as they are read from the BackupStorage.
+func restoreLogicalFile(ctx context.Context, conn *dbconnpool.DBConnection, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, ce CompressionEngine, key []byte, name string, hookExtraEnv map[string]string) error {
+	pr, pw := io.Pipe()
+	// Closing the reader stops the read if a statement fails.
+	defer pr.Close()
+	go func() {
+		pw.CloseWithError(readBackupFile(ctx, bh, fe, transformHook, ce, key, name, hookExtraEnv, pw))
+	}()
+
+	r := bufio.NewReaderSize(pr, 2*logicalBackupInsertSize)
+	if err := readStatements(r, func(stmt string) error {
+		_, err := conn.ExecuteFetch(stmt, 0, false)
+		return err
+	}); err != nil {
+		return fmt.Errorf("cannot restore %v/%v: %v", fe.Base, fe.Name, err)
+	}
+	return nil
+}
+
+// readStatements reads the statements written by writeStatement,
+// and calls execute for each of them.
+func readStatements(r *bufio.Reader, execute func(stmt string) error) error {
+	delimiter := ";"
+	stmt := &bytes.Buffer{}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		switch {
+		case stmt.Len() == 0 && strings.HasPrefix(line, "DELIMITER "):
+			delimiter = strings.TrimSuffix(strings.TrimPrefix(line, "DELIMITER "), "\n")
+		case strings.HasSuffix(line, delimiter+"\n"):
+			stmt.WriteString(strings.TrimSuffix(line, delimiter+"\n"))
+			if err := execute(stmt.String()); err != nil {
+				return err
+			}
+			stmt.Reset()
+		default:
+			stmt.WriteString(line)
+		}
+		if err == io.EOF {
+			if stmt.Len() != 0 {
+				return fmt.Errorf("statement without delimiter at the end of the file")
+			}
+			return nil
+		}
+	}
+}
+
+// logicalDump dumps the databases read on conn into the files of a
+// backup.
+type logicalDump struct {
+	ctx          context.Context
+	conn         *dbconnpool.DBConnection
+	logger       logutil.Logger
+	bh           backupstorage.BackupHandle
+	ce           CompressionEngine
+	key          []byte
+	hookExtraEnv map[string]string
+
+	// fes are the files of the backup, in the order they were
+	// added.
+	fes []FileEntry
+}
+
+// dumpDatabases dumps all the databases but the system ones, and their
+// views last.
+func (ld *logicalDump) dumpDatabases() error {
+	rows, err := fetchAllRows(ld.conn, "SHOW DATABASES")
+	if err != nil {
+		return fmt.Errorf("SHOW DATABASES failed: %v", err)
+	}
+	var views [][2]string
+	for _, row := range rows {
+		dbName := row[0].ToString()
+		if logicalBackupSystemDatabases[dbName] {
+			continue
+		}
+		dbViews, err := ld.dumpDatabase(dbName)
+		if err != nil {
+			return err
+		}
+		for _, view := range dbViews {
+			views = append(views, [2]string{dbName, view})
+		}
+	}
+
+	for _, view := range views {
+		dbName, viewName := view[0], view[1]
+		if err := ld.addFile(logicalBackupView, dbName+"/"+viewName, func(w io.Writer) error {
+			create, err := ld.showCreate("VIEW", sqlescape.EscapeID(dbName)+"."+sqlescape.EscapeID(viewName))
+			if err != nil {
+				return err
+			}
+			if err := writeStatement(w, "USE "+sqlescape.EscapeID(dbName)); err != nil {
+				return err
+			}
+			return writeStatement(w, create)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dumpDatabase dumps the database, its tables, its stored routines, its
+// triggers and its events, and returns its views.
+func (ld *logicalDump) dumpDatabase(dbName string) ([]string, error) {
+	escapedDB := sqlescape.EscapeID(dbName)
+	if err := ld.addFile(logicalBackupDatabase, dbName, func(w io.Writer) error {
+		create, err := ld.showCreate("DATABASE", escapedDB)
+		if err != nil {
+			return err
+		}
+		return writeStatement(w, create)
+	}); err != nil {
+		return nil, err
+	}
+
+	rows, err := fetchAllRows(ld.conn, "SHOW FULL TABLES FROM "+escapedDB)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list the tables of %v: %v", dbName, err)
+	}
+	var views []string
+	for _, row := range rows {
+		tableName := row[0].ToString()
+		if row[1].ToString() == "VIEW" {
+			views = append(views, tableName)
+			continue
+		}
+		ld.logger.Infof("dumping table %v.%v", dbName, tableName)
+		if err := ld.addFile(logicalBackupTable, dbName+"/"+tableName, func(w io.Writer) error {
+			return ld.dumpTable(w, dbName, tableName)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, object := range logicalBackupObjects {
+		rows, err := fetchAllRows(ld.conn, fmt.Sprintf(object.listQuery, encodeString(dbName)))
+		if err != nil {
+			return nil, fmt.Errorf("cannot list the %v objects of %v: %v", object.objectType, dbName, err)
+		}
+		for _, row := range rows {
+			name := row[0].ToString()
+			ld.logger.Infof("dumping %v %v.%v", strings.ToLower(object.objectType), dbName, name)
+			if err := ld.addFile(object.base, dbName+"/"+name, func(w io.Writer) error {
+				return ld.dumpObject(w, dbName, name, object)
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return views, nil
+}
+
+// dumpObject writes the statement that creates a stored routine, a
+// trigger or an event. It's executed with the sql_mode the object was
+// created with, and the time_zone of an event.
+func (ld *logicalDump) dumpObject(w io.Writer, dbName, name string, object logicalBackupObject) error {
+	row, err := ld.showCreateRow(object.objectType, sqlescape.EscapeID(dbName)+"."+sqlescape.EscapeID(name), object.createColumn+1)
+	if err != nil {
+		return err
+	}
+	stmts := []string{
+		"USE " + sqlescape.EscapeID(dbName),
+		"SET @saved_sql_mode = @@session.sql_mode",
+		"SET SESSION sql_mode = " + encodeString(row[1].ToString()),
+	}
+	restores := []string{"SET SESSION sql_mode = @saved_sql_mode"}
+	if object.base == logicalBackupEvent {
+		stmts = append(stmts,
+			"SET @saved_time_zone = @@session.time_zone",
+			"SET SESSION time_zone = "+encodeString(row[2].ToString()))
+		restores = append(restores, "SET SESSION time_zone = @saved_time_zone")
+	}
+	stmts = append(stmts, row[object.createColumn].ToString())
+	for _, stmt := range append(stmts, restores...) {
+		if err := writeStatement(w, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dumpTable writes the statements that create the table and insert
+// its rows. Generated columns are computed again by the restore.
+func (ld *logicalDump) dumpTable(w io.Writer, dbName, tableName string) error {
+	escapedTable := sqlescape.EscapeID(dbName) + "." + sqlescape.EscapeID(tableName)
+	create, err := ld.showCreate("TABLE", escapedTable)
+	if err != nil {
+		return err
+	}
+	if err := writeStatement(w, "USE "+sqlescape.EscapeID(dbName)); err != nil {
+		return err
+	}
+	if err := writeStatement(w, create); err != nil {
+		return err
+	}
+
+	rows, err := fetchAllRows(ld.conn, fmt.Sprintf("SELECT COLUMN_NAME, EXTRA FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = %v AND TABLE_NAME = %v ORDER BY ORDINAL_POSITION", encodeString(dbName), encodeString(tableName)))
+	if err != nil {
+		return fmt.Errorf("cannot list the columns of %v: %v", escapedTable, err)
+	}
+	var columns []string
+	for _, row := range rows {
+		if strings.Contains(strings.ToUpper(row[1].ToString()), "GENERATED") {
+			continue
+		}
+		columns = append(columns, sqlescape.EscapeID(row[0].ToString()))
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+	columnList := strings.Join(columns, ", ")
+	prefix := fmt.Sprintf("INSERT INTO %v (%v) VALUES ", sqlescape.EscapeID(tableName), columnList)
+
+	insert := &bytes.Buffer{}
+	flush := func() error {
+		if insert.Len() == 0 {
+			return nil
+		}
+		insert.WriteString(";\n")
+		_, err := w.Write(insert.Bytes())
+		insert.Reset()
+		return err
+	}
+	if err := ld.conn.ExecuteStreamFetch(fmt.Sprintf("SELECT %v FROM %v", columnList, escapedTable), func(qr *sqltypes.Result) error {
+		for _, row := range qr.Rows {
+			if insert.Len() == 0 {
+				insert.WriteString(prefix)
+			} else {
+				insert.WriteString(", ")
+			}
+			insert.WriteByte('(')
+			for i, v := range row {
+				if i > 0 {
+					insert.WriteString(", ")
+				}
+				v.EncodeSQL(insert)
+			}
+			insert.WriteByte(')')
+			if insert.Len() >= logicalBackupInsertSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}, logicalBackupInsertSize); err != nil {
+		return fmt.Errorf("cannot read the rows of %v: %v", escapedTable, err)
+	}
+	return flush()
+}
+
+// showCreate returns the statement that creates the object.
+func (ld *logicalDump) showCreate(objectType, escapedName string) (string, error) {
+	row, err := ld.showCreateRow(objectType, escapedName, 2)
+	if err != nil {
+		return "", err
+	}
+	return row[1].ToString(), nil
+}
+
+// showCreateRow returns the row of SHOW CREATE for the object, which
+// must have at least minColumns columns.
+func (ld *logicalDump) showCreateRow(objectType, escapedName string, minColumns int) ([]sqltypes.Value, error) {
+	qr, err := ld.conn.ExecuteFetch(fmt.Sprintf("SHOW CREATE %v %v", objectType, escapedName), 1, false)
+	if err != nil {
+		return nil, fmt.Errorf("SHOW CREATE %v %v failed: %v", objectType, escapedName, err)
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) < minColumns {
+		return nil, fmt.Errorf("SHOW CREATE %v %v returned an unexpected result: %v", objectType, escapedName, qr.Rows)
+	}
+	return qr.Rows[0], nil
+}
+
+// addFile adds the file written by dump to the backup.
+func (ld *logicalDump) addFile(base, name string, dump func(w io.Writer) error) error {
+	fe := FileEntry{
+		Base: base,
+		Name: name,
+	}
+	fileName := fmt.Sprintf("%v", len(ld.fes))
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		var err error
+		fe.Hash, fe.ContentHash, err = writeBackupFile(ld.ctx, ld.logger, ld.bh, fileName, pr, ld.ce, ld.key, ld.hookExtraEnv)
+		// Stop the dump if the file can't be written.
+		pr.CloseWithError(err)
+		done <- err
+	}()
+
+	w := bufio.NewWriterSize(pw, logicalBackupInsertSize)
+	err := dump(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	pw.CloseWithError(err)
+	if werr := <-done; err == nil {
+		err = werr
+	}
+	if err != nil {
+		return fmt.Errorf("cannot back up %v/%v: %v", base, name, err)
+	}
+	ld.fes = append(ld.fes, fe)
+	return nil
+}
+
+// writeStatement writes stmt as it is, followed by a delimiter that
+// doesn't end any of its lines.
+func writeStatement(w io.Writer, stmt string) error {
+	delimiter := ";"
+	for strings.Contains(stmt, delimiter+"\n") {
+		delimiter += ";"
+	}
+	if delimiter == ";" {
+		_, err := io.WriteString(w, stmt+";\n")
+		return err
+	}
+	_, err := io.WriteString(w, "DELIMITER "+delimiter+"\n"+stmt+delimiter+"\nDELIMITER ;\n")
+	return err
+}
+
+// fetchAllRows returns all the rows of the query. Unlike ExecuteFetch,
+// it doesn't limit their number.
+func fetchAllRows(conn *dbconnpool.DBConnection, query string) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	err := conn.ExecuteStreamFetch(query, func(qr *sqltypes.Result) error {
+		rows = append(rows, qr.Rows...)
+		return nil
+	}, logicalBackupInsertSize)
+	return rows, err
+}
+
+// encodeString returns the SQL literal of s.
+func encodeString(s string) string {
+	buf := &bytes.Buffer{}
+	sqltypes.NewVarChar(s).EncodeSQL(buf)
+	return buf.String()
+}
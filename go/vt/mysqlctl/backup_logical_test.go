@@ -0,0 +1,67 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLogicalStatements(t *testing.T) {
+	stmts := []string{
+		"USE `db`",
+		"CREATE TABLE `t` (\n  `id` int(11) NOT NULL,\n  `msg` varchar(10) DEFAULT 'a\\nb',\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+		"INSERT INTO `t` (`id`, `msg`) VALUES (1, 'a;\\n'), (2, NULL)",
+		"CREATE PROCEDURE `p`()\nBEGIN\n  -- comment\n  SELECT 1;\n  SELECT 2;;\nEND",
+		"CREATE TRIGGER `tr` BEFORE INSERT ON `t` FOR EACH ROW SET NEW.msg = 'x';",
+		"DO 1",
+	}
+	buf := &bytes.Buffer{}
+	for _, stmt := range stmts {
+		if err := writeStatement(buf, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := "USE `db`;\n" +
+		"CREATE TABLE `t` (\n  `id` int(11) NOT NULL,\n  `msg` varchar(10) DEFAULT 'a\\nb',\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB;\n" +
+		"INSERT INTO `t` (`id`, `msg`) VALUES (1, 'a;\\n'), (2, NULL);\n" +
+		"DELIMITER ;;;\nCREATE PROCEDURE `p`()\nBEGIN\n  -- comment\n  SELECT 1;\n  SELECT 2;;\nEND;;;\nDELIMITER ;\n" +
+		"CREATE TRIGGER `tr` BEFORE INSERT ON `t` FOR EACH ROW SET NEW.msg = 'x';;\n" +
+		"DO 1;\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writeStatement:\n%s\nwant:\n%s", got, want)
+	}
+
+	var got []string
+	if err := readStatements(bufio.NewReader(buf), func(stmt string) error {
+		got = append(got, stmt)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, stmts) {
+		t.Errorf("readStatements: %q, want %q", got, stmts)
+	}
+
+	err := readStatements(bufio.NewReader(strings.NewReader("DO 1;\nDO 2")), func(string) error { return nil })
+	if err == nil || err.Error() != "statement without delimiter at the end of the file" {
+		t.Errorf("readStatements(truncated): %v", err)
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+// This file handles the retention of the backups of a shard.
+
+// BackupTimestampFormat is the format of the UTC time backup names
+// start with.
+const BackupTimestampFormat = "2006-01-02.150405"
+
+var (
+	// The retention policy applied after each backup taken by the
+	// tablet. Backups are not pruned automatically if none is set.
+	backupRetentionKeepLast    = flag.Int("backup_retention_keep_last", 0, "if set, after each backup, the backups of the shard are pruned, keeping at least that many most recent backups")
+	backupRetentionKeepWithin  = flag.Duration("backup_retention_keep_within", 0, "if set, after each backup, the backups of the shard are pruned, keeping at least the backups taken within that duration")
+	backupRetentionKeepWeekly  = flag.Int("backup_retention_keep_weekly", 0, "if set, after each backup, the backups of the shard are pruned, keeping at least the most recent backup of each of that many weeks")
+	backupRetentionKeepMonthly = flag.Int("backup_retention_keep_monthly", 0, "if set, after each backup, the backups of the shard are pruned, keeping at least the most recent backup of each of that many months")
+)
+
+// RetentionPolicy describes which backups of a shard are kept when
+// they are pruned. A backup is kept if any of the rules retains it.
+// Weeks and months are counted among the ones that have backups, so
+// a shard that stopped taking backups doesn't lose its old ones.
+type RetentionPolicy struct {
+	// KeepLast is the number of most recent backups to keep.
+	KeepLast int
+
+	// KeepWithin keeps all the backups taken within that duration.
+	KeepWithin time.Duration
+
+	// KeepWeekly is the number of weeks to keep the most recent
+	// backup of. Weeks are ISO 8601 weeks, in UTC.
+	KeepWeekly int
+
+	// KeepMonthly is the number of months to keep the most recent
+	// backup of, in UTC.
+	KeepMonthly int
+}
+
+// RetentionPolicyFromFlags returns the RetentionPolicy set by the
+// -backup_retention_* flags, applied after each backup.
+func RetentionPolicyFromFlags() RetentionPolicy {
+	return RetentionPolicy{
+		KeepLast:    *backupRetentionKeepLast,
+		KeepWithin:  *backupRetentionKeepWithin,
+		KeepWeekly:  *backupRetentionKeepWeekly,
+		KeepMonthly: *backupRetentionKeepMonthly,
+	}
+}
+
+// IsZero returns true if the policy has no rule.
+func (rp RetentionPolicy) IsZero() bool {
+	return rp == RetentionPolicy{}
+}
+
+// String returns a human-readable version of the policy.
+func (rp RetentionPolicy) String() string {
+	var rules []string
+	if rp.KeepLast > 0 {
+		rules = append(rules, fmt.Sprintf("last %v", rp.KeepLast))
+	}
+	if rp.KeepWithin > 0 {
+		rules = append(rules, fmt.Sprintf("within %v", rp.KeepWithin))
+	}
+	if rp.KeepWeekly > 0 {
+		rules = append(rules, fmt.Sprintf("%v weekly", rp.KeepWeekly))
+	}
+	if rp.KeepMonthly > 0 {
+		rules = append(rules, fmt.Sprintf("%v monthly", rp.KeepMonthly))
+	}
+	return "keep " + strings.Join(rules, ", ")
+}
+
+// retentionCandidate is a backup considered for pruning.
+type retentionCandidate struct {
+	bh backupstorage.BackupHandle
+
+	// usable is true if the MANIFEST of the backup can be read,
+	// i.e. the backup is complete and can be restored.
+	usable bool
+
+	// backupTime is when the backup was taken. It is zero if it
+	// is unknown.
+	backupTime time.Time
+
+	keep bool
+}
+
+// PruneBackups removes the backups in dir (keyspace/shard) of bs that
+// policy doesn't retain, and returns their names. If dryRun is set,
+// nothing is removed, it only returns the backups that would be.
+//
+// Only complete backups are counted by the policy, and the most
+// recent one is always kept, so a shard is never left without a
+// backup to restore. Incomplete backups are removed if they are older
+// than all the retained ones: more recent ones may still be in
+// progress. For the same reason, the most recent backup is never
+// removed.
+func PruneBackups(ctx context.Context, bs backupstorage.BackupStorage, logger logutil.Logger, dir string, policy RetentionPolicy, dryRun bool) ([]string, error) {
+	return pruneBackups(ctx, bs, logger, dir, policy, dryRun, time.Now())
+}
+
+func pruneBackups(ctx context.Context, bs backupstorage.BackupStorage, logger logutil.Logger, dir string, policy RetentionPolicy, dryRun bool, now time.Time) ([]string, error) {
+	if policy.IsZero() {
+		return nil, fmt.Errorf("empty retention policy, refusing to prune the backups in %v", dir)
+	}
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, fmt.Errorf("ListBackups failed: %v", err)
+	}
+
+	candidates := make([]*retentionCandidate, 0, len(bhs))
+	for _, bh := range bhs {
+		candidates = append(candidates, readRetentionCandidate(ctx, logger, bh))
+	}
+	selectRetained(candidates, policy, now)
+
+	// The backups are sorted oldest first: incomplete backups
+	// are only removed until the first retained one, or until the
+	// most recent one if none is retained.
+	firstKept := len(candidates) - 1
+	for i, c := range candidates {
+		if c.keep {
+			firstKept = i
+			break
+		}
+	}
+	var removed []string
+	for i, c := range candidates {
+		if c.keep || (!c.usable && i >= firstKept) {
+			continue
+		}
+		if dryRun {
+			logger.Infof("PruneBackups: would remove backup %v/%v (dry run)", dir, c.bh.Name())
+		} else {
+			logger.Infof("PruneBackups: removing backup %v/%v", dir, c.bh.Name())
+			if err := bs.RemoveBackup(ctx, dir, c.bh.Name()); err != nil {
+				return removed, fmt.Errorf("RemoveBackup(%v/%v) failed: %v", dir, c.bh.Name(), err)
+			}
+		}
+		removed = append(removed, c.bh.Name())
+	}
+	return removed, nil
+}
+
+// readRetentionCandidate reads the MANIFEST of a backup, to know if it
+// is usable and when it was taken. Backups taken before the time was
+// recorded in the MANIFEST are dated by their name.
+func readRetentionCandidate(ctx context.Context, logger logutil.Logger, bh backupstorage.BackupHandle) *retentionCandidate {
+	c := &retentionCandidate{
+		bh: bh,
+	}
+	if len(bh.Name()) >= len(BackupTimestampFormat) {
+		if t, err := time.Parse(BackupTimestampFormat, bh.Name()[:len(BackupTimestampFormat)]); err == nil {
+			c.backupTime = t
+		}
+	}
+
+	rc, err := bh.ReadFile(ctx, backupManifest)
+	if err != nil {
+		logger.Infof("PruneBackups: possibly incomplete backup %v/%v: can't read MANIFEST: %v", bh.Directory(), bh.Name(), err)
+		return c
+	}
+	var bm BackupManifest
+	err = json.NewDecoder(rc).Decode(&bm)
+	rc.Close()
+	if err != nil {
+		logger.Infof("PruneBackups: possibly incomplete backup %v/%v: can't JSON decode MANIFEST: %v", bh.Directory(), bh.Name(), err)
+		return c
+	}
+	c.usable = true
+	if bm.BackupTime != "" {
+		if t, err := time.Parse(time.RFC3339, bm.BackupTime); err == nil {
+			c.backupTime = t
+		}
+	}
+	if c.backupTime.IsZero() {
+		// We don't know how old it is, so we can't prune it.
+		logger.Warningf("PruneBackups: can't find when backup %v/%v was taken, keeping it", bh.Directory(), bh.Name())
+		c.keep = true
+	}
+	return c
+}
+
+// selectRetained marks the usable candidates policy retains, and
+// always the most recent one. candidates are sorted oldest first.
+func selectRetained(candidates []*retentionCandidate, policy RetentionPolicy, now time.Time) {
+	var lastWeekYear, lastWeek, lastMonthYear int
+	var lastMonth time.Month
+	count, weeks, months := 0, 0, 0
+	for i := len(candidates) - 1; i >= 0; i-- {
+		c := candidates[i]
+		if !c.usable {
+			continue
+		}
+		count++
+		if count == 1 || count <= policy.KeepLast {
+			c.keep = true
+		}
+		if c.backupTime.IsZero() {
+			continue
+		}
+		t := c.backupTime.UTC()
+		if policy.KeepWithin > 0 && now.Sub(t) <= policy.KeepWithin {
+			c.keep = true
+		}
+		if year, week := t.ISOWeek(); year != lastWeekYear || week != lastWeek {
+			lastWeekYear, lastWeek = year, week
+			weeks++
+			if weeks <= policy.KeepWeekly {
+				c.keep = true
+			}
+		}
+		if year, month := t.Year(), t.Month(); year != lastMonthYear || month != lastMonth {
+			lastMonthYear, lastMonth = year, month
+			months++
+			if months <= policy.KeepMonthly {
+				c.keep = true
+			}
+		}
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+const retentionTestDir = "test_keyspace/0"
+
+// createTestBackups creates a backup in the file backup storage for
+// each of the times. Backups with a zero time are left incomplete.
+func createTestBackups(t *testing.T, bs backupstorage.BackupStorage, names []string, times []time.Time) {
+	ctx := context.Background()
+	for i, name := range names {
+		bh, err := bs.StartBackup(ctx, retentionTestDir, name)
+		if err != nil {
+			t.Fatalf("StartBackup(%v) failed: %v", name, err)
+		}
+		if times[i].IsZero() {
+			continue
+		}
+		wc, err := bh.AddFile(ctx, backupManifest)
+		if err != nil {
+			t.Fatalf("AddFile(%v) failed: %v", name, err)
+		}
+		bm := &BackupManifest{
+			BackupTime: times[i].UTC().Format(time.RFC3339),
+		}
+		if err := json.NewEncoder(wc).Encode(bm); err != nil {
+			t.Fatalf("cannot write MANIFEST of %v: %v", name, err)
+		}
+		wc.Close()
+		if err := bh.EndBackup(ctx); err != nil {
+			t.Fatalf("EndBackup(%v) failed: %v", name, err)
+		}
+	}
+}
+
+func listTestBackups(t *testing.T, bs backupstorage.BackupStorage) []string {
+	bhs, err := bs.ListBackups(context.Background(), retentionTestDir)
+	if err != nil {
+		t.Fatalf("ListBackups failed: %v", err)
+	}
+	var names []string
+	for _, bh := range bhs {
+		names = append(names, bh.Name())
+	}
+	return names
+}
+
+func TestPruneBackups(t *testing.T) {
+	root, err := ioutil.TempDir("", "backupretentiontest")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+	bs := &filebackupstorage.FileBackupStorage{}
+
+	// One backup a day, at noon, from Monday 2018-01-01 to
+	// Saturday 2018-03-10, except for the incomplete ones.
+	now := time.Date(2018, 3, 10, 18, 0, 0, 0, time.UTC)
+	var names []string
+	var times []time.Time
+	for ts := time.Date(2018, 1, 1, 12, 0, 0, 0, time.UTC); ts.Before(now); ts = ts.Add(24 * time.Hour) {
+		names = append(names, ts.Format(BackupTimestampFormat)+".cell1-0000000100")
+		switch ts.Format("01-02") {
+		case "01-03", "03-09":
+			// The incomplete backups of 2018-01-03 and 2018-03-09.
+			times = append(times, time.Time{})
+		default:
+			times = append(times, ts)
+		}
+	}
+
+	testcases := []struct {
+		policy RetentionPolicy
+		kept   []string
+	}{{
+		// The incomplete backup of 2018-03-09 may still be in
+		// progress, so it is kept.
+		policy: RetentionPolicy{KeepLast: 2},
+		kept: []string{
+			"2018-03-08.120000.cell1-0000000100",
+			"2018-03-09.120000.cell1-0000000100",
+			"2018-03-10.120000.cell1-0000000100",
+		},
+	}, {
+		policy: RetentionPolicy{KeepWithin: 50 * time.Hour},
+		kept: []string{
+			"2018-03-10.120000.cell1-0000000100",
+		},
+	}, {
+		// The last backups of the weeks starting on 2018-03-05
+		// and 2018-02-26.
+		policy: RetentionPolicy{KeepWeekly: 2},
+		kept: []string{
+			"2018-03-04.120000.cell1-0000000100",
+			"2018-03-09.120000.cell1-0000000100",
+			"2018-03-10.120000.cell1-0000000100",
+		},
+	}, {
+		policy: RetentionPolicy{KeepLast: 1, KeepMonthly: 3},
+		kept: []string{
+			"2018-01-31.120000.cell1-0000000100",
+			"2018-02-28.120000.cell1-0000000100",
+			"2018-03-09.120000.cell1-0000000100",
+			"2018-03-10.120000.cell1-0000000100",
+		},
+	}}
+	for _, tcase := range testcases {
+		if err := os.RemoveAll(root); err != nil {
+			t.Fatalf("os.RemoveAll failed: %v", err)
+		}
+		createTestBackups(t, bs, names, times)
+
+		// A dry run doesn't remove anything.
+		wouldRemove, err := pruneBackups(context.Background(), bs, logutil.NewMemoryLogger(), retentionTestDir, tcase.policy, true /* dryRun */, now)
+		if err != nil {
+			t.Fatalf("PruneBackups(%v, dry run) failed: %v", tcase.policy, err)
+		}
+		if got := listTestBackups(t, bs); !reflect.DeepEqual(got, names) {
+			t.Errorf("PruneBackups(%v, dry run) removed backups, left: %v", tcase.policy, got)
+		}
+
+		removed, err := pruneBackups(context.Background(), bs, logutil.NewMemoryLogger(), retentionTestDir, tcase.policy, false /* dryRun */, now)
+		if err != nil {
+			t.Fatalf("PruneBackups(%v) failed: %v", tcase.policy, err)
+		}
+		got := listTestBackups(t, bs)
+		if !reflect.DeepEqual(got, tcase.kept) {
+			t.Errorf("PruneBackups(%v) kept %v, want %v", tcase.policy, got, tcase.kept)
+		}
+		if len(removed)+len(got) != len(names) {
+			t.Errorf("PruneBackups(%v) returned %v removed backups, but %v are left out of %v", tcase.policy, len(removed), len(got), len(names))
+		}
+		if !reflect.DeepEqual(wouldRemove, removed) {
+			t.Errorf("PruneBackups(%v) removed %v, but the dry run returned %v", tcase.policy, removed, wouldRemove)
+		}
+	}
+}
+
+func TestPruneBackupsKeepsLastUsable(t *testing.T) {
+	root, err := ioutil.TempDir("", "backupretentiontest")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+	bs := &filebackupstorage.FileBackupStorage{}
+
+	// A single complete backup, a year old, followed by an
+	// incomplete one.
+	now := time.Date(2018, 3, 10, 18, 0, 0, 0, time.UTC)
+	createTestBackups(t, bs, []string{
+		"2017-03-10.120000.cell1-0000000100",
+		"2018-03-10.120000.cell1-0000000100",
+	}, []time.Time{
+		time.Date(2017, 3, 10, 12, 0, 0, 0, time.UTC),
+		{},
+	})
+	removed, err := pruneBackups(context.Background(), bs, logutil.NewMemoryLogger(), retentionTestDir, RetentionPolicy{KeepWithin: time.Hour}, false /* dryRun */, now)
+	if err != nil {
+		t.Fatalf("PruneBackups failed: %v", err)
+	}
+	if len(removed) != 0 {
+		t.Errorf("PruneBackups removed %v, want nothing", removed)
+	}
+
+	// Without any complete backup, the most recent incomplete
+	// one may still be in progress.
+	root2, err := ioutil.TempDir("", "backupretentiontest")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root2)
+	*filebackupstorage.FileBackupStorageRoot = root2
+	createTestBackups(t, bs, []string{
+		"2018-03-09.120000.cell1-0000000100",
+		"2018-03-10.120000.cell1-0000000100",
+	}, []time.Time{{}, {}})
+	removed, err = pruneBackups(context.Background(), bs, logutil.NewMemoryLogger(), retentionTestDir, RetentionPolicy{KeepLast: 1}, false /* dryRun */, now)
+	if err != nil {
+		t.Fatalf("PruneBackups failed: %v", err)
+	}
+	if want := []string{"2018-03-09.120000.cell1-0000000100"}; !reflect.DeepEqual(removed, want) {
+		t.Errorf("PruneBackups removed %v, want %v", removed, want)
+	}
+	if got, want := listTestBackups(t, bs), []string{"2018-03-10.120000.cell1-0000000100"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("backups after PruneBackups: %v, want %v", got, want)
+	}
+
+	// An empty policy is rejected.
+	if _, err := PruneBackups(context.Background(), bs, logutil.NewMemoryLogger(), retentionTestDir, RetentionPolicy{}, false /* dryRun */); err == nil {
+		t.Errorf("PruneBackups with an empty policy didn't fail")
+	}
+}
@@ -17,12 +17,18 @@ limitations under the License.
 package mysqlctl
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
 	"reflect"
 	"sort"
 	"testing"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
 )
 
 func TestFindFilesToBackup(t *testing.T) {
@@ -103,3 +109,225 @@ type forTest []FileEntry
 func (f forTest) Len() int           { return len(f) }
 func (f forTest) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
 func (f forTest) Less(i, j int) bool { return f[i].Base+f[i].Name < f[j].Base+f[j].Name }
+
+// compressionTestData returns data that compresses about as well as
+// InnoDB files: a mix of repeated rows and random bytes.
+func compressionTestData(size int) []byte {
+	data := make([]byte, 0, size)
+	seed := uint32(1)
+	for i := 0; len(data) < size; i++ {
+		data = append(data, fmt.Sprintf("row %08d name_%d ", i, i%100)...)
+		for j := 0; j < 8; j++ {
+			seed = seed*1664525 + 1013904223
+			data = append(data, byte(seed>>24))
+		}
+	}
+	return data[:size]
+}
+
+func compress(ce CompressionEngine, data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w, err := ce.NewWriter(buf)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(ce CompressionEngine, compressed []byte) ([]byte, error) {
+	r, err := ce.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+func TestCompressionEngines(t *testing.T) {
+	data := compressionTestData(3*1024*1024 + 17)
+	for name, ce := range CompressionEngines {
+		compressed, err := compress(ce, data)
+		if err != nil {
+			t.Errorf("%v: compress failed: %v", name, err)
+			continue
+		}
+		if len(compressed) >= len(data) {
+			t.Errorf("%v: compressed size %v, want less than %v", name, len(compressed), len(data))
+		}
+		got, err := decompress(ce, compressed)
+		if err != nil {
+			t.Errorf("%v: decompress failed: %v", name, err)
+			continue
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("%v: decompressed data doesn't match", name)
+		}
+	}
+
+	// pgzip writes regular gzip files.
+	compressed, err := compress(CompressionEngines[pgzipCompressionEngineName], data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decompress(CompressionEngines[gzipCompressionEngineName], compressed)
+	if err != nil || !bytes.Equal(got, data) {
+		t.Errorf("gzip can't read pgzip data: %v", err)
+	}
+
+	if _, err := getCompressionEngine("unknown"); err == nil || err.Error() != "no registered compression engine named unknown" {
+		t.Errorf("getCompressionEngine(unknown): %v", err)
+	}
+}
+
+func TestVerifyCompression(t *testing.T) {
+	testcases := []struct {
+		name  string
+		level int
+		err   string
+	}{{
+		name:  gzipCompressionEngineName,
+		level: 9,
+	}, {
+		name:  gzipCompressionEngineName,
+		level: 10,
+		err:   "invalid -backup_compression_level 10 for compression engine gzip: must be between 1 and 9",
+	}, {
+		name:  pgzipCompressionEngineName,
+		level: 0,
+		err:   "invalid -backup_compression_level 0 for compression engine pgzip: must be between 1 and 9",
+	}, {
+		name:  zstdCompressionEngineName,
+		level: 22,
+	}, {
+		name:  zstdCompressionEngineName,
+		level: 23,
+		err:   "invalid -backup_compression_level 23 for compression engine zstd: must be between 1 and 22",
+	}, {
+		// lz4 ignores the level.
+		name:  lz4CompressionEngineName,
+		level: 100,
+	}, {
+		name: "unknown",
+		err:  "no registered compression engine named unknown",
+	}}
+	for _, tc := range testcases {
+		err := verifyCompression(tc.name, tc.level)
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tc.err {
+			t.Errorf("verifyCompression(%v, %v): %v, want %v", tc.name, tc.level, got, tc.err)
+		}
+	}
+}
+
+func benchmarkCompress(b *testing.B, name string) {
+	ce := CompressionEngines[name]
+	data := compressionTestData(16 * 1024 * 1024)
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w, err := ce.NewWriter(ioutil.Discard)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := w.Write(data); err != nil {
+			b.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkDecompress(b *testing.B, name string) {
+	ce := CompressionEngines[name]
+	compressed, err := compress(ce, compressionTestData(16*1024*1024))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(16 * 1024 * 1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r, err := ce.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := io.Copy(ioutil.Discard, r); err != nil {
+			b.Fatal(err)
+		}
+		r.Close()
+	}
+}
+
+func BenchmarkCompressGzip(b *testing.B)    { benchmarkCompress(b, gzipCompressionEngineName) }
+func BenchmarkCompressPgzip(b *testing.B)   { benchmarkCompress(b, pgzipCompressionEngineName) }
+func BenchmarkCompressZstd(b *testing.B)    { benchmarkCompress(b, zstdCompressionEngineName) }
+func BenchmarkCompressLz4(b *testing.B)     { benchmarkCompress(b, lz4CompressionEngineName) }
+func BenchmarkDecompressGzip(b *testing.B)  { benchmarkDecompress(b, gzipCompressionEngineName) }
+func BenchmarkDecompressPgzip(b *testing.B) { benchmarkDecompress(b, pgzipCompressionEngineName) }
+func BenchmarkDecompressZstd(b *testing.B)  { benchmarkDecompress(b, zstdCompressionEngineName) }
+func BenchmarkDecompressLz4(b *testing.B)   { benchmarkDecompress(b, lz4CompressionEngineName) }
+
+func TestRecoveryTargetIsAfter(t *testing.T) {
+	backupPos := mysql.Position{GTIDSet: mysql.MariadbGTID{Domain: 0, Server: 1, Sequence: 10}}
+	bm := &BackupManifest{
+		Position:   backupPos,
+		BackupTime: "2018-06-01T12:00:00Z",
+	}
+	oldBM := &BackupManifest{
+		Position: backupPos,
+	}
+	backupTime := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		target RecoveryTarget
+		bm     *BackupManifest
+		want   bool
+	}{{
+		target: RecoveryTarget{},
+		bm:     bm,
+		want:   true,
+	}, {
+		target: RecoveryTarget{Position: mysql.Position{GTIDSet: mysql.MariadbGTID{Domain: 0, Server: 1, Sequence: 12}}},
+		bm:     bm,
+		want:   true,
+	}, {
+		target: RecoveryTarget{Position: backupPos},
+		bm:     bm,
+		want:   true,
+	}, {
+		target: RecoveryTarget{Position: mysql.Position{GTIDSet: mysql.MariadbGTID{Domain: 0, Server: 1, Sequence: 8}}},
+		bm:     bm,
+		want:   false,
+	}, {
+		target: RecoveryTarget{Time: backupTime.Add(time.Hour)},
+		bm:     bm,
+		want:   true,
+	}, {
+		target: RecoveryTarget{Time: backupTime},
+		bm:     bm,
+		want:   true,
+	}, {
+		target: RecoveryTarget{Time: backupTime.Add(-time.Second)},
+		bm:     bm,
+		want:   false,
+	}, {
+		// Backups without a time can't be used for a time target.
+		target: RecoveryTarget{Time: backupTime.Add(time.Hour)},
+		bm:     oldBM,
+		want:   false,
+	}}
+	for _, tcase := range testcases {
+		if got := tcase.target.isAfter(tcase.bm); got != tcase.want {
+			t.Errorf("RecoveryTarget{%v}.isAfter(%v): %v, want %v", tcase.target, tcase.bm.BackupTime, got, tcase.want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+// This file handles the verification of the backups, without
+// restoring them.
+
+// VerifyBackup reads all the files of a backup from the BackupStorage,
+// and checks they can be decrypted and uncompressed, and that their
+// hashes match the ones in the MANIFEST. The files are only streamed,
+// nothing is written and mysqld is not involved. It returns an error
+// listing all the files that failed verification.
+//
+// Backups taken before the hash of the content of the files was
+// recorded can only be checked against the hash of the stored data.
+func VerifyBackup(ctx context.Context, logger logutil.Logger, bh backupstorage.BackupHandle, verifyConcurrency int, hookExtraEnv map[string]string) error {
+	rc, err := bh.ReadFile(ctx, backupManifest)
+	if err != nil {
+		return fmt.Errorf("can't read MANIFEST of backup %v/%v, it is incomplete: %v", bh.Directory(), bh.Name(), err)
+	}
+	var bm BackupManifest
+	err = json.NewDecoder(rc).Decode(&bm)
+	rc.Close()
+	if err != nil {
+		return fmt.Errorf("can't JSON decode MANIFEST of backup %v/%v: %v", bh.Directory(), bh.Name(), err)
+	}
+	ce, key, err := bm.decoders()
+	if err != nil {
+		return err
+	}
+	logger.Infof("VerifyBackup: verifying %v files of backup %v/%v", len(bm.FileEntries), bh.Directory(), bh.Name())
+
+	// Unlike a restore, keep going after an error, to report all
+	// the broken files at once.
+	sema := sync2.NewSemaphore(verifyConcurrency, 0)
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for i := range bm.FileEntries {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sema.Acquire()
+			defer sema.Release()
+
+			fe := &bm.FileEntries[i]
+			name := fmt.Sprintf("%v", i)
+			if err := readBackupFile(ctx, bh, fe, bm.TransformHook, ce, key, name, hookExtraEnv, ioutil.Discard); err != nil {
+				logger.Errorf("VerifyBackup: file %v (%v/%v) is broken: %v", name, fe.Base, fe.Name, err)
+				rec.RecordError(fmt.Errorf("file %v (%v/%v): %v", name, fe.Base, fe.Name, err))
+			}
+		}(i)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return fmt.Errorf("backup %v/%v failed verification: %v", bh.Directory(), bh.Name(), rec.Error())
+	}
+	logger.Infof("VerifyBackup: backup %v/%v is valid", bh.Directory(), bh.Name())
+	return nil
+}
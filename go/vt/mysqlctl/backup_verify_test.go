@@ -0,0 +1,174 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+// createVerifyTestBackup backs up two files of cnf into a new backup,
+// and returns it with its MANIFEST.
+func createVerifyTestBackup(t *testing.T, bs backupstorage.BackupStorage, cnf *Mycnf) (backupstorage.BackupHandle, *BackupManifest) {
+	ctx := context.Background()
+	if err := os.MkdirAll(path.Join(cnf.DataDir, "vt_db"), os.ModePerm); err != nil {
+		t.Fatalf("failed to create data directory: %v", err)
+	}
+	fes := []FileEntry{{
+		Base: backupData,
+		Name: "vt_db/t1.ibd",
+	}, {
+		Base: backupData,
+		Name: "vt_db/t2.ibd",
+	}}
+	for i, fe := range fes {
+		content := strings.Repeat(fmt.Sprintf("contents of %v ", fe.Name), 1000*(i+1))
+		if err := ioutil.WriteFile(path.Join(cnf.DataDir, fe.Name), []byte(content), os.ModePerm); err != nil {
+			t.Fatalf("failed to write file %v: %v", fe.Name, err)
+		}
+	}
+
+	bh, err := bs.StartBackup(ctx, "test_keyspace/0", "2018-03-10.120000.cell1-0000000100")
+	if err != nil {
+		t.Fatalf("StartBackup failed: %v", err)
+	}
+	ce, err := getCompressionEngine(gzipCompressionEngineName)
+	if err != nil {
+		t.Fatalf("getCompressionEngine failed: %v", err)
+	}
+	for i := range fes {
+		if err := backupFile(ctx, cnf, logutil.NewMemoryLogger(), bh, &fes[i], fmt.Sprintf("%v", i), ce, nil, nil); err != nil {
+			t.Fatalf("backupFile(%v) failed: %v", fes[i].Name, err)
+		}
+		if fes[i].Hash == "" || fes[i].ContentHash == "" {
+			t.Errorf("backupFile(%v) didn't record the hashes: %#v", fes[i].Name, fes[i])
+		}
+	}
+	bm := &BackupManifest{
+		FileEntries:       fes,
+		CompressionEngine: gzipCompressionEngineName,
+	}
+	wc, err := bh.AddFile(ctx, backupManifest)
+	if err != nil {
+		t.Fatalf("AddFile(MANIFEST) failed: %v", err)
+	}
+	if err := json.NewEncoder(wc).Encode(bm); err != nil {
+		t.Fatalf("cannot write MANIFEST: %v", err)
+	}
+	wc.Close()
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("EndBackup failed: %v", err)
+	}
+
+	bhs, err := bs.ListBackups(ctx, "test_keyspace/0")
+	if err != nil || len(bhs) != 1 {
+		t.Fatalf("ListBackups: %v %v", bhs, err)
+	}
+	return bhs[0], bm
+}
+
+// rewriteVerifyTestManifest replaces the MANIFEST of a backup in the
+// file backup storage.
+func rewriteVerifyTestManifest(t *testing.T, bh backupstorage.BackupHandle, bm *BackupManifest) {
+	data, err := json.Marshal(bm)
+	if err != nil {
+		t.Fatalf("cannot JSON encode MANIFEST: %v", err)
+	}
+	if err := ioutil.WriteFile(path.Join(*filebackupstorage.FileBackupStorageRoot, bh.Directory(), bh.Name(), backupManifest), data, os.ModePerm); err != nil {
+		t.Fatalf("cannot write MANIFEST: %v", err)
+	}
+}
+
+func TestVerifyBackup(t *testing.T) {
+	root, err := ioutil.TempDir("", "backupverifytest")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	bs := &filebackupstorage.FileBackupStorage{}
+	cnf := &Mycnf{
+		DataDir: path.Join(root, "data"),
+	}
+	ctx := context.Background()
+
+	bh, bm := createVerifyTestBackup(t, bs, cnf)
+	if err := VerifyBackup(ctx, logutil.NewMemoryLogger(), bh, 2, nil); err != nil {
+		t.Fatalf("VerifyBackup failed: %v", err)
+	}
+
+	// A restored file has the same content.
+	restoreCnf := &Mycnf{
+		DataDir: path.Join(root, "restore"),
+	}
+	if err := restoreFile(ctx, restoreCnf, bh, &bm.FileEntries[1], "", gzipEngine{}, nil, "1", nil); err != nil {
+		t.Fatalf("restoreFile failed: %v", err)
+	}
+	want, _ := ioutil.ReadFile(path.Join(cnf.DataDir, "vt_db/t2.ibd"))
+	got, err := ioutil.ReadFile(path.Join(restoreCnf.DataDir, "vt_db/t2.ibd"))
+	if err != nil || string(got) != string(want) {
+		t.Errorf("restoreFile restored %v bytes, want %v: %v", len(got), len(want), err)
+	}
+
+	// A wrong content hash is caught, even if the stored data
+	// matches.
+	contentHash := bm.FileEntries[0].ContentHash
+	bm.FileEntries[0].ContentHash = strings.Repeat("0", len(contentHash))
+	rewriteVerifyTestManifest(t, bh, bm)
+	err = VerifyBackup(ctx, logutil.NewMemoryLogger(), bh, 2, nil)
+	if err == nil || !strings.Contains(err.Error(), "content hash mismatch for vt_db/t1.ibd") {
+		t.Errorf("VerifyBackup with a wrong content hash: %v", err)
+	}
+	bm.FileEntries[0].ContentHash = contentHash
+	rewriteVerifyTestManifest(t, bh, bm)
+
+	// Corrupt the stored data of the second file: the hash of the
+	// stored data doesn't match anymore.
+	stored := path.Join(*filebackupstorage.FileBackupStorageRoot, bh.Directory(), bh.Name(), "1")
+	data, err := ioutil.ReadFile(stored)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	data[len(data)/2] ^= 0xff
+	if err := ioutil.WriteFile(stored, data, os.ModePerm); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	err = VerifyBackup(ctx, logutil.NewMemoryLogger(), bh, 2, nil)
+	if err == nil || !strings.Contains(err.Error(), "file 1 (Data/vt_db/t2.ibd)") || strings.Contains(err.Error(), "file 0") {
+		t.Errorf("VerifyBackup with a corrupt file: %v", err)
+	}
+
+	// A missing file is reported too.
+	if err := os.Remove(path.Join(*filebackupstorage.FileBackupStorageRoot, bh.Directory(), bh.Name(), "0")); err != nil {
+		t.Fatalf("Remove failed: %v", err)
+	}
+	err = VerifyBackup(ctx, logutil.NewMemoryLogger(), bh, 2, nil)
+	if err == nil || !strings.Contains(err.Error(), "file 0 (Data/vt_db/t1.ibd)") || !strings.Contains(err.Error(), "file 1 (Data/vt_db/t2.ibd)") {
+		t.Errorf("VerifyBackup with a missing file: %v", err)
+	}
+}
@@ -86,6 +86,11 @@ type FakeMysqlDaemon struct {
 	// (as "%v:%v"). If it doesn't match, SetMaster will return an error.
 	SetMasterInput string
 
+	// StartSlaveUntilAfterPos is matched against the input of
+	// StartSlaveUntilAfter. If it doesn't match, StartSlaveUntilAfter
+	// will return an error.
+	StartSlaveUntilAfterPos mysql.Position
+
 	// DemoteMasterPosition is returned by DemoteMaster
 	DemoteMasterPosition mysql.Position
 
@@ -272,6 +277,16 @@ func (fmd *FakeMysqlDaemon) SetMaster(ctx context.Context, masterHost string, ma
 	return fmd.ExecuteSuperQueryList(ctx, cmds)
 }
 
+// StartSlaveUntilAfter is part of the MysqlDaemon interface.
+func (fmd *FakeMysqlDaemon) StartSlaveUntilAfter(ctx context.Context, targetPos mysql.Position) error {
+	if !reflect.DeepEqual(fmd.StartSlaveUntilAfterPos, targetPos) {
+		return fmt.Errorf("wrong pos for StartSlaveUntilAfter: expected %v got %v", fmd.StartSlaveUntilAfterPos, targetPos)
+	}
+	return fmd.ExecuteSuperQueryList(ctx, []string{
+		"FAKE START SLAVE UNTIL AFTER",
+	})
+}
+
 // WaitForReparentJournal is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) WaitForReparentJournal(ctx context.Context, timeCreatedNS int64) error {
 	return nil
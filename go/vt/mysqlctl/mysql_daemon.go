@@ -58,6 +58,7 @@ type MysqlDaemon interface {
 	SetReadOnly(on bool) error
 	SetSlavePosition(ctx context.Context, pos mysql.Position) error
 	SetMaster(ctx context.Context, masterHost string, masterPort int, slaveStopBefore bool, slaveStartAfter bool) error
+	StartSlaveUntilAfter(ctx context.Context, targetPos mysql.Position) error
 	WaitForReparentJournal(ctx context.Context, timeCreatedNS int64) error
 
 	// DemoteMaster waits for all current transactions to finish,
@@ -208,6 +208,19 @@ func (mysqld *Mysqld) SetSlavePosition(ctx context.Context, pos mysql.Position)
 	return mysqld.executeSuperQueryListConn(ctx, conn, cmds)
 }
 
+// StartSlaveUntilAfter starts replication, and has it stop right after
+// the transactions of targetPos are applied. It doesn't wait for it.
+func (mysqld *Mysqld) StartSlaveUntilAfter(ctx context.Context, targetPos mysql.Position) error {
+	conn, err := getPoolReconnect(ctx, mysqld.dbaPool)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	cmds := []string{conn.StartSlaveUntilAfterCommand(targetPos)}
+	return mysqld.executeSuperQueryListConn(ctx, conn, cmds)
+}
+
 // SetMaster makes the provided host / port the master. It optionally
 // stops replication before, and starts it after.
 func (mysqld *Mysqld) SetMaster(ctx context.Context, masterHost string, masterPort int, slaveStopBefore bool, slaveStartAfter bool) error {
@@ -777,6 +777,10 @@ type ExecuteOptions struct {
 	// skip_query_plan_cache specifies if the query plan shoud be cached by vitess.
 	// By default all query plans are cached.
 	SkipQueryPlanCache bool `protobuf:"varint,10,opt,name=skip_query_plan_cache,json=skipQueryPlanCache" json:"skip_query_plan_cache,omitempty"`
+	// system_variables are the MySQL session variables to set on the
+	// connection before running the query. The values are SQL
+	// expressions. Variables that are not set use their default value.
+	SystemVariables map[string]string `protobuf:"bytes,11,rep,name=system_variables,json=systemVariables" json:"system_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *ExecuteOptions) Reset()                    { *m = ExecuteOptions{} }
@@ -840,6 +844,13 @@ func (m *ExecuteOptions) GetSkipQueryPlanCache() bool {
 	return false
 }
 
+func (m *ExecuteOptions) GetSystemVariables() map[string]string {
+	if m != nil {
+		return m.SystemVariables
+	}
+	return nil
+}
+
 // Field describes a single column returned by a query
 type Field struct {
 	// name of the field as returned by mysql C API
@@ -2854,204 +2865,206 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 3183 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0x1b, 0xc7,
-	0x99, 0xd7, 0xe0, 0x41, 0x02, 0x1f, 0x08, 0xb0, 0xd9, 0x20, 0x25, 0x88, 0xf2, 0x83, 0x3b, 0xb6,
-	0x6c, 0x2e, 0xed, 0xe5, 0xca, 0x94, 0x56, 0xab, 0xb5, 0x77, 0xbd, 0x1a, 0x82, 0x43, 0x19, 0x16,
-	0x5e, 0x6a, 0x0c, 0x24, 0xcb, 0xe5, 0xaa, 0xa9, 0x21, 0xd0, 0x02, 0xa7, 0x38, 0xc0, 0x40, 0x33,
-	0x03, 0x51, 0xbc, 0x69, 0xd7, 0x71, 0xde, 0x0f, 0xe7, 0xe9, 0x38, 0xa9, 0x38, 0xa9, 0xca, 0x3d,
-	0x7f, 0x43, 0x2a, 0x7f, 0x40, 0x6e, 0x39, 0x24, 0x39, 0xe4, 0x90, 0x4a, 0xe5, 0x90, 0x2a, 0x57,
-	0x4e, 0x39, 0xe4, 0x90, 0x4a, 0xf5, 0x63, 0x06, 0x03, 0x12, 0x7a, 0x58, 0xc9, 0x85, 0xb2, 0x4f,
-	0xe8, 0xfe, 0xbe, 0xaf, 0x1f, 0xbf, 0xdf, 0xf7, 0xcd, 0xd7, 0x8d, 0xee, 0x86, 0xdc, 0xed, 0x11,
-	0xf5, 0x0e, 0xd6, 0x87, 0x9e, 0x1b, 0xb8, 0x38, 0xcd, 0x2b, 0xcb, 0x85, 0xc0, 0x1d, 0xba, 0x5d,
-	0x2b, 0xb0, 0x84, 0x78, 0x39, 0x77, 0x27, 0xf0, 0x86, 0x1d, 0x51, 0x51, 0xdf, 0x53, 0x60, 0xc6,
-	0xb0, 0xbc, 0x1e, 0x0d, 0xf0, 0x32, 0x64, 0xf6, 0xe8, 0x81, 0x3f, 0xb4, 0x3a, 0xb4, 0xa4, 0xac,
-	0x28, 0xab, 0x59, 0x12, 0xd5, 0xf1, 0x22, 0xa4, 0xfd, 0x5d, 0xcb, 0xeb, 0x96, 0x12, 0x5c, 0x21,
-	0x2a, 0xf8, 0x3f, 0x20, 0x17, 0x58, 0x3b, 0x0e, 0x0d, 0xcc, 0xe0, 0x60, 0x48, 0x4b, 0xc9, 0x15,
-	0x65, 0xb5, 0xb0, 0xb1, 0xb8, 0x1e, 0x8d, 0x67, 0x70, 0xa5, 0x71, 0x30, 0xa4, 0x04, 0x82, 0xa8,
-	0x8c, 0x31, 0xa4, 0x3a, 0xd4, 0x71, 0x4a, 0x29, 0xde, 0x17, 0x2f, 0xab, 0x5b, 0x50, 0xb8, 0x6e,
-	0x5c, 0xb1, 0x02, 0x5a, 0xb6, 0x1c, 0x87, 0x7a, 0x95, 0x2d, 0x36, 0x9d, 0x91, 0x4f, 0xbd, 0x81,
-	0xd5, 0x8f, 0xa6, 0x13, 0xd6, 0xf1, 0x49, 0x98, 0xe9, 0x79, 0xee, 0x68, 0xe8, 0x97, 0x12, 0x2b,
-	0xc9, 0xd5, 0x2c, 0x91, 0x35, 0xf5, 0x1d, 0x00, 0xfd, 0x0e, 0x1d, 0x04, 0x86, 0xbb, 0x47, 0x07,
-	0xf8, 0x29, 0xc8, 0x06, 0x76, 0x9f, 0xfa, 0x81, 0xd5, 0x1f, 0xf2, 0x2e, 0x92, 0x64, 0x2c, 0xb8,
-	0x0f, 0xa4, 0x65, 0xc8, 0x0c, 0x5d, 0xdf, 0x0e, 0x6c, 0x77, 0xc0, 0xf1, 0x64, 0x49, 0x54, 0x57,
-	0x5f, 0x87, 0xf4, 0x75, 0xcb, 0x19, 0x51, 0xfc, 0x2c, 0xa4, 0x38, 0x60, 0x85, 0x03, 0xce, 0xad,
-	0x0b, 0xd2, 0x39, 0x4e, 0xae, 0x60, 0x7d, 0xdf, 0x61, 0x96, 0xbc, 0xef, 0x39, 0x22, 0x2a, 0xea,
-	0x1e, 0xcc, 0x6d, 0xda, 0x83, 0xee, 0x75, 0xcb, 0xb3, 0x19, 0x19, 0x8f, 0xd9, 0x0d, 0x7e, 0x1e,
-	0x66, 0x78, 0xc1, 0x2f, 0x25, 0x57, 0x92, 0xab, 0xb9, 0x8d, 0x39, 0xd9, 0x90, 0xcf, 0x8d, 0x48,
-	0x9d, 0xfa, 0x0b, 0x05, 0x60, 0xd3, 0x1d, 0x0d, 0xba, 0xd7, 0x98, 0x12, 0x23, 0x48, 0xfa, 0xb7,
-	0x1d, 0x49, 0x24, 0x2b, 0xe2, 0xab, 0x50, 0xd8, 0xb1, 0x07, 0x5d, 0xf3, 0x8e, 0x9c, 0x8e, 0xe0,
-	0x32, 0xb7, 0xf1, 0xbc, 0xec, 0x6e, 0xdc, 0x78, 0x3d, 0x3e, 0x6b, 0x5f, 0x1f, 0x04, 0xde, 0x01,
-	0xc9, 0xef, 0xc4, 0x65, 0xcb, 0x6d, 0xc0, 0x47, 0x8d, 0xd8, 0xa0, 0x7b, 0xf4, 0x20, 0x1c, 0x74,
-	0x8f, 0x1e, 0xe0, 0x7f, 0x8d, 0x23, 0xca, 0x6d, 0x14, 0xc3, 0xb1, 0x62, 0x6d, 0x25, 0xcc, 0x57,
-	0x13, 0x97, 0x14, 0xf5, 0x4f, 0x69, 0x28, 0xe8, 0x77, 0x69, 0x67, 0x14, 0xd0, 0xc6, 0x90, 0xf9,
-	0xc0, 0xc7, 0xeb, 0x50, 0xb4, 0x07, 0x1d, 0x67, 0xd4, 0xa5, 0x26, 0x65, 0xae, 0x36, 0x03, 0xe6,
-	0x6b, 0xde, 0x5f, 0x86, 0x2c, 0x48, 0x55, 0x2c, 0x08, 0x34, 0x28, 0x76, 0xdc, 0xfe, 0xd0, 0xf2,
-	0x26, 0xed, 0x93, 0x7c, 0xfc, 0x05, 0x39, 0xfe, 0xd8, 0x9e, 0x2c, 0x48, 0xeb, 0x58, 0x17, 0x35,
-	0x98, 0x97, 0xfd, 0x76, 0xcd, 0x5b, 0x36, 0x75, 0xba, 0x3e, 0x0f, 0xdd, 0x42, 0x44, 0xd5, 0xe4,
-	0x14, 0xd7, 0x2b, 0xd2, 0x78, 0x9b, 0xdb, 0x92, 0x82, 0x3d, 0x51, 0xc7, 0x6b, 0xb0, 0xd0, 0x71,
-	0x6c, 0x36, 0x95, 0x5b, 0x8c, 0x62, 0xd3, 0x73, 0xf7, 0xfd, 0x52, 0x9a, 0xcf, 0x7f, 0x5e, 0x28,
-	0xb6, 0x99, 0x9c, 0xb8, 0xfb, 0x3e, 0x7e, 0x15, 0x32, 0xfb, 0xae, 0xb7, 0xe7, 0xb8, 0x56, 0xb7,
-	0x34, 0xc3, 0xc7, 0x7c, 0x66, 0xfa, 0x98, 0x37, 0xa4, 0x15, 0x89, 0xec, 0xf1, 0x2a, 0x20, 0xff,
-	0xb6, 0x63, 0xfa, 0xd4, 0xa1, 0x9d, 0xc0, 0x74, 0xec, 0xbe, 0x1d, 0x94, 0x32, 0xfc, 0x2b, 0x28,
-	0xf8, 0xb7, 0x9d, 0x16, 0x17, 0x57, 0x99, 0x14, 0x9b, 0xb0, 0x14, 0x78, 0xd6, 0xc0, 0xb7, 0x3a,
-	0xac, 0x33, 0xd3, 0xf6, 0x5d, 0xc7, 0xe2, 0x5f, 0x40, 0x96, 0x0f, 0xb9, 0x36, 0x7d, 0x48, 0x63,
-	0xdc, 0xa4, 0x12, 0xb6, 0x20, 0x8b, 0xc1, 0x14, 0x29, 0x7e, 0x05, 0x96, 0xfc, 0x3d, 0x7b, 0x68,
-	0xf2, 0x7e, 0xcc, 0xa1, 0x63, 0x0d, 0xcc, 0x8e, 0xd5, 0xd9, 0xa5, 0x25, 0xe0, 0xb0, 0x31, 0x53,
-	0xf2, 0x50, 0x6b, 0x3a, 0xd6, 0xa0, 0xcc, 0x34, 0xea, 0x6b, 0x50, 0x98, 0xe4, 0x11, 0x2f, 0x40,
-	0xde, 0xb8, 0xd9, 0xd4, 0x4d, 0xad, 0xbe, 0x65, 0xd6, 0xb5, 0x9a, 0x8e, 0x4e, 0xe0, 0x3c, 0x64,
-	0xb9, 0xa8, 0x51, 0xaf, 0xde, 0x44, 0x0a, 0x9e, 0x85, 0xa4, 0x56, 0xad, 0xa2, 0x84, 0x7a, 0x09,
-	0x32, 0x21, 0x21, 0x78, 0x1e, 0x72, 0xed, 0x7a, 0xab, 0xa9, 0x97, 0x2b, 0xdb, 0x15, 0x7d, 0x0b,
-	0x9d, 0xc0, 0x19, 0x48, 0x35, 0xaa, 0x46, 0x13, 0x29, 0xa2, 0xa4, 0x35, 0x51, 0x82, 0xb5, 0xdc,
-	0xda, 0xd4, 0x50, 0x52, 0x0d, 0x60, 0x71, 0x1a, 0x2e, 0x9c, 0x83, 0xd9, 0x2d, 0x7d, 0x5b, 0x6b,
-	0x57, 0x0d, 0x74, 0x02, 0x17, 0x61, 0x9e, 0xe8, 0x4d, 0x5d, 0x33, 0xb4, 0xcd, 0xaa, 0x6e, 0x12,
-	0x5d, 0xdb, 0x42, 0x0a, 0xc6, 0x50, 0x60, 0x25, 0xb3, 0xdc, 0xa8, 0xd5, 0x2a, 0x86, 0xa1, 0x6f,
-	0xa1, 0x04, 0x5e, 0x04, 0xc4, 0x65, 0xed, 0xfa, 0x58, 0x9a, 0xc4, 0x08, 0xe6, 0x5a, 0x3a, 0xa9,
-	0x68, 0xd5, 0xca, 0xdb, 0xac, 0x03, 0x94, 0x7a, 0x33, 0x95, 0x51, 0x50, 0x42, 0xfd, 0x20, 0x01,
-	0x69, 0x8e, 0x95, 0x65, 0xc8, 0x58, 0xde, 0xe3, 0xe5, 0x28, 0x5b, 0x24, 0x1e, 0x90, 0x2d, 0x78,
-	0x92, 0x95, 0x79, 0x4b, 0x54, 0xf0, 0x19, 0xc8, 0xba, 0x5e, 0xcf, 0x14, 0x1a, 0x91, 0x71, 0x33,
-	0xae, 0xd7, 0xe3, 0xa9, 0x99, 0x65, 0x3b, 0x96, 0xa8, 0x77, 0x2c, 0x9f, 0xf2, 0x08, 0xcc, 0x92,
-	0xa8, 0x8e, 0x4f, 0x03, 0xb3, 0x33, 0xf9, 0x3c, 0x66, 0xb8, 0x6e, 0xd6, 0xf5, 0x7a, 0x75, 0x36,
-	0x95, 0xe7, 0x20, 0xdf, 0x71, 0x9d, 0x51, 0x7f, 0x60, 0x3a, 0x74, 0xd0, 0x0b, 0x76, 0x4b, 0xb3,
-	0x2b, 0xca, 0x6a, 0x9e, 0xcc, 0x09, 0x61, 0x95, 0xcb, 0x70, 0x09, 0x66, 0x3b, 0xbb, 0x96, 0xe7,
-	0x53, 0x11, 0x75, 0x79, 0x12, 0x56, 0xf9, 0xa8, 0xb4, 0x63, 0xf7, 0x2d, 0xc7, 0xe7, 0x11, 0x96,
-	0x27, 0x51, 0x9d, 0x81, 0xb8, 0xe5, 0x58, 0x3d, 0x9f, 0x47, 0x46, 0x9e, 0x88, 0x8a, 0xfa, 0x9f,
-	0x90, 0x24, 0xee, 0x3e, 0xeb, 0x52, 0x0c, 0xe8, 0x97, 0x94, 0x95, 0xe4, 0x2a, 0x26, 0x61, 0x95,
-	0x2d, 0x08, 0x32, 0x27, 0x8a, 0x54, 0x19, 0x66, 0xc1, 0x77, 0x60, 0x8e, 0x50, 0x7f, 0xe4, 0x04,
-	0xfa, 0xdd, 0xc0, 0xb3, 0x7c, 0xbc, 0x01, 0xb9, 0x78, 0x16, 0x50, 0xee, 0x97, 0x05, 0x80, 0x8e,
-	0x3f, 0xff, 0x12, 0xcc, 0xde, 0xf2, 0xa8, 0xbf, 0x4b, 0x3d, 0x99, 0x65, 0xc2, 0x2a, 0xcb, 0xb1,
-	0x39, 0x1e, 0xb6, 0x62, 0x0c, 0x96, 0x99, 0x65, 0x7e, 0x50, 0x26, 0x32, 0x33, 0x77, 0x2a, 0x91,
-	0x3a, 0xc6, 0x1e, 0xfb, 0xe4, 0x4d, 0xeb, 0xd6, 0x2d, 0xda, 0x09, 0xa8, 0x58, 0x80, 0x52, 0x64,
-	0x8e, 0x09, 0x35, 0x29, 0x63, 0x6e, 0xb3, 0x07, 0x3e, 0xf5, 0x02, 0xd3, 0xee, 0x72, 0x87, 0xa6,
-	0x48, 0x46, 0x08, 0x2a, 0x5d, 0xfc, 0x0c, 0xa4, 0x78, 0xd2, 0x48, 0xf1, 0x51, 0x40, 0x8e, 0x42,
-	0xdc, 0x7d, 0xc2, 0xe5, 0xf8, 0x25, 0x98, 0xa1, 0x1c, 0x2f, 0x77, 0xea, 0x38, 0xcd, 0xc6, 0xa9,
-	0x20, 0xd2, 0x44, 0xfd, 0x49, 0x12, 0x72, 0xad, 0xc0, 0xa3, 0x56, 0x9f, 0xe3, 0xc7, 0xff, 0x0d,
-	0xe0, 0x07, 0x56, 0x40, 0xfb, 0x74, 0x10, 0x84, 0x40, 0x9e, 0x92, 0x1d, 0xc4, 0xec, 0xd6, 0x5b,
-	0xa1, 0x11, 0x89, 0xd9, 0x1f, 0x26, 0x38, 0xf1, 0x08, 0x04, 0x2f, 0x7f, 0x94, 0x80, 0x6c, 0xd4,
-	0x1b, 0xd6, 0x20, 0xd3, 0xb1, 0x02, 0xda, 0x73, 0xbd, 0x03, 0xb9, 0x32, 0x9e, 0x7d, 0xd0, 0xe8,
-	0xeb, 0x65, 0x69, 0x4c, 0xa2, 0x66, 0xf8, 0x69, 0x10, 0xdb, 0x0d, 0x11, 0xbc, 0x62, 0x7d, 0xcf,
-	0x72, 0x09, 0x0f, 0xdf, 0x57, 0x01, 0x0f, 0x3d, 0xbb, 0x6f, 0x79, 0x07, 0xe6, 0x1e, 0x3d, 0x08,
-	0x53, 0x7a, 0x72, 0x8a, 0xcb, 0x90, 0xb4, 0xbb, 0x4a, 0x0f, 0x64, 0x12, 0xba, 0x34, 0xd9, 0x56,
-	0x06, 0xdd, 0x51, 0x47, 0xc4, 0x5a, 0xf2, 0x75, 0xd9, 0x0f, 0x57, 0xe0, 0x34, 0x8f, 0x4f, 0x56,
-	0x54, 0x5f, 0x84, 0x4c, 0x38, 0x79, 0x9c, 0x85, 0xb4, 0xee, 0x79, 0xae, 0x87, 0x4e, 0xf0, 0x5c,
-	0x54, 0xab, 0x8a, 0x74, 0xb6, 0xb5, 0xc5, 0xd2, 0xd9, 0xcf, 0x13, 0xd1, 0x32, 0x48, 0xe8, 0xed,
-	0x11, 0xf5, 0x03, 0xfc, 0xbf, 0x50, 0xa4, 0x3c, 0x56, 0xec, 0x3b, 0xd4, 0xec, 0xf0, 0x3d, 0x13,
-	0x8b, 0x14, 0x11, 0xd0, 0xf3, 0xeb, 0x62, 0x8b, 0x17, 0xee, 0xa5, 0xc8, 0x42, 0x64, 0x2b, 0x45,
-	0x5d, 0xac, 0x43, 0xd1, 0xee, 0xf7, 0x69, 0xd7, 0xb6, 0x82, 0x78, 0x07, 0xc2, 0x61, 0x4b, 0xe1,
-	0x96, 0x62, 0x62, 0x4b, 0x46, 0x16, 0xa2, 0x16, 0x51, 0x37, 0x67, 0x61, 0x26, 0xe0, 0xdb, 0x47,
-	0xb9, 0xa2, 0xe6, 0xc3, 0xbc, 0xc4, 0x85, 0x44, 0x2a, 0xf1, 0x8b, 0x20, 0x36, 0xa3, 0x3c, 0x03,
-	0x8d, 0x03, 0x62, 0xbc, 0xc7, 0x20, 0x42, 0x8f, 0xcf, 0x42, 0x61, 0x62, 0x29, 0xea, 0x72, 0xc2,
-	0x92, 0x24, 0x1f, 0x5f, 0x57, 0xba, 0xf8, 0xdf, 0x61, 0xd6, 0x15, 0xcb, 0x10, 0xcf, 0x4d, 0xe3,
-	0x19, 0x4f, 0xae, 0x51, 0x24, 0xb4, 0x52, 0xff, 0x07, 0xe6, 0x23, 0x06, 0xfd, 0xa1, 0x3b, 0xf0,
-	0x29, 0x5e, 0x83, 0x19, 0x8f, 0x7f, 0x10, 0x92, 0x35, 0x2c, 0xbb, 0x88, 0x7d, 0xd1, 0x44, 0x5a,
-	0xa8, 0x5d, 0x98, 0x17, 0x92, 0x1b, 0x76, 0xb0, 0xcb, 0x1d, 0x85, 0xcf, 0x42, 0x9a, 0xb2, 0xc2,
-	0x21, 0xce, 0x49, 0xb3, 0xcc, 0xf5, 0x44, 0x68, 0x63, 0xa3, 0x24, 0x1e, 0x3a, 0xca, 0x9f, 0x13,
-	0x50, 0x94, 0xb3, 0xdc, 0xb4, 0x82, 0xce, 0xee, 0x31, 0x75, 0xf6, 0x4b, 0x30, 0xcb, 0xe4, 0x76,
-	0xf4, 0x61, 0x4c, 0x71, 0x77, 0x68, 0xc1, 0x1c, 0x6e, 0xf9, 0x66, 0xcc, 0xbb, 0x72, 0x2b, 0x94,
-	0xb7, 0xfc, 0xd8, 0x42, 0x3c, 0x25, 0x2e, 0x66, 0x1e, 0x12, 0x17, 0xb3, 0x8f, 0x14, 0x17, 0x5b,
-	0xb0, 0x38, 0xc9, 0xb8, 0x0c, 0x8e, 0x97, 0x61, 0x56, 0x38, 0x25, 0x4c, 0x81, 0xd3, 0xfc, 0x16,
-	0x9a, 0xa8, 0x3f, 0x4e, 0xc0, 0xa2, 0xcc, 0x4e, 0x9f, 0x8e, 0xcf, 0x34, 0xc6, 0x73, 0xfa, 0x91,
-	0x78, 0x2e, 0xc3, 0xd2, 0x21, 0x82, 0x1e, 0xe3, 0x2b, 0xfc, 0x58, 0x81, 0xb9, 0x4d, 0xda, 0xb3,
-	0x07, 0xc7, 0x94, 0xde, 0x18, 0x6b, 0xa9, 0x47, 0x62, 0xed, 0x22, 0xe4, 0x25, 0x5e, 0xc9, 0xd6,
-	0xd1, 0xcf, 0x40, 0x99, 0xf2, 0x19, 0xa8, 0x7f, 0x50, 0x20, 0x5f, 0x76, 0xfb, 0x7d, 0x3b, 0x38,
-	0xa6, 0x4c, 0x1d, 0xc5, 0x99, 0x9a, 0x86, 0x13, 0x41, 0x21, 0x84, 0x29, 0x08, 0x52, 0xff, 0xa8,
-	0xc0, 0x3c, 0x71, 0x1d, 0x67, 0xc7, 0xea, 0xec, 0x3d, 0xd9, 0xd8, 0x31, 0xa0, 0x31, 0x50, 0x89,
-	0xfe, 0xaf, 0x0a, 0x14, 0x9a, 0x1e, 0x65, 0xff, 0x5f, 0x9f, 0x68, 0xf0, 0xec, 0x0f, 0x52, 0x37,
-	0x90, 0x9b, 0x83, 0x2c, 0xe1, 0x65, 0x75, 0x01, 0xe6, 0x23, 0xec, 0x92, 0x8f, 0xdf, 0x28, 0xb0,
-	0x24, 0x02, 0x44, 0x6a, 0xba, 0xc7, 0x94, 0x96, 0x10, 0x6f, 0x2a, 0x86, 0xb7, 0x04, 0x27, 0x0f,
-	0x63, 0x93, 0xb0, 0xdf, 0x4d, 0xc0, 0xa9, 0x30, 0x36, 0x8e, 0x39, 0xf0, 0x7f, 0x20, 0x1e, 0x96,
-	0xa1, 0x74, 0x94, 0x04, 0xc9, 0xd0, 0xfb, 0x09, 0x28, 0x95, 0x3d, 0x6a, 0x05, 0x34, 0xb6, 0xc9,
-	0x78, 0x72, 0x62, 0x03, 0xbf, 0x02, 0x73, 0x43, 0xcb, 0x0b, 0xec, 0x8e, 0x3d, 0xb4, 0xd8, 0xdf,
-	0xb8, 0x34, 0xdf, 0xc3, 0x1c, 0xea, 0x60, 0xc2, 0x44, 0x3d, 0x03, 0xa7, 0xa7, 0x30, 0x22, 0xf9,
-	0xfa, 0x9b, 0x02, 0xb8, 0x15, 0x58, 0x5e, 0xf0, 0x29, 0x58, 0x55, 0xa6, 0x06, 0xd3, 0x12, 0x14,
-	0x27, 0xf0, 0xc7, 0x79, 0xa1, 0xc1, 0xa7, 0x62, 0xc5, 0xb9, 0x2f, 0x2f, 0x71, 0xfc, 0x92, 0x97,
-	0xdf, 0x29, 0xb0, 0x5c, 0x76, 0xc5, 0xf9, 0xdd, 0x13, 0xf9, 0x85, 0xa9, 0x4f, 0xc3, 0x99, 0xa9,
-	0x00, 0x25, 0x01, 0xbf, 0x55, 0xe0, 0x24, 0xa1, 0x56, 0xf7, 0xc9, 0x04, 0x7f, 0x0d, 0x4e, 0x1d,
-	0x01, 0x27, 0x77, 0xa8, 0x17, 0x21, 0xd3, 0xa7, 0x81, 0xd5, 0xb5, 0x02, 0x4b, 0x42, 0x5a, 0x0e,
-	0xfb, 0x1d, 0x5b, 0xd7, 0xa4, 0x05, 0x89, 0x6c, 0xd5, 0x8f, 0x12, 0x50, 0xe4, 0x7b, 0xdd, 0xcf,
-	0xfe, 0x41, 0x4d, 0xff, 0x2f, 0xf0, 0xbe, 0x02, 0x8b, 0x93, 0x04, 0x45, 0xff, 0x09, 0xfe, 0xd9,
-	0x07, 0x11, 0x53, 0x12, 0x42, 0x72, 0xda, 0x16, 0xf4, 0x97, 0x09, 0x28, 0xc5, 0xa7, 0xf4, 0xd9,
-	0xa1, 0xc5, 0xe4, 0xa1, 0xc5, 0x27, 0x3e, 0xa5, 0xfa, 0x40, 0x81, 0xd3, 0x53, 0x08, 0xfd, 0x64,
-	0x8e, 0x8e, 0x1d, 0x5d, 0x24, 0x1e, 0x7a, 0x74, 0xf1, 0xa8, 0xae, 0xfe, 0xb5, 0x02, 0x8b, 0x35,
-	0xea, 0xfb, 0x56, 0x8f, 0x8a, 0xff, 0xf1, 0xc7, 0x37, 0x9b, 0xf1, 0x43, 0xe1, 0xd4, 0xf8, 0x66,
-	0x45, 0x2d, 0xc3, 0xd2, 0x21, 0x68, 0x8f, 0x71, 0x36, 0xf1, 0x17, 0x05, 0x16, 0x64, 0x2f, 0xda,
-	0xb1, 0xdd, 0x08, 0x4c, 0x61, 0x07, 0x3f, 0x03, 0x49, 0xbb, 0x1b, 0xee, 0x20, 0x27, 0xef, 0x9a,
-	0x99, 0x42, 0xbd, 0x0c, 0x38, 0x8e, 0xfb, 0x31, 0xa8, 0xfb, 0x55, 0x12, 0x16, 0x5a, 0x43, 0xc7,
-	0x0e, 0xa4, 0xf2, 0xc9, 0x4e, 0xfc, 0xff, 0x02, 0x73, 0x3e, 0x03, 0x6b, 0x8a, 0xdb, 0x32, 0x4e,
-	0x6c, 0x96, 0xe4, 0xb8, 0xac, 0xcc, 0x45, 0xf8, 0x59, 0xc8, 0x85, 0x26, 0xa3, 0x41, 0x20, 0x4f,
-	0x3a, 0x41, 0x5a, 0x8c, 0x06, 0x01, 0xbe, 0x00, 0xa7, 0x06, 0xa3, 0x3e, 0xbf, 0x39, 0x36, 0x87,
-	0xd4, 0x0b, 0xef, 0x55, 0x2d, 0x2f, 0xbc, 0xe1, 0x2d, 0x0e, 0x46, 0x7d, 0xe2, 0xee, 0xfb, 0x4d,
-	0xea, 0x89, 0x7b, 0x55, 0xcb, 0x0b, 0xf0, 0x65, 0xc8, 0x5a, 0x4e, 0xcf, 0xf5, 0xec, 0x60, 0xb7,
-	0x2f, 0xaf, 0x76, 0xd5, 0xf0, 0x6a, 0xe5, 0x30, 0xfd, 0xeb, 0x5a, 0x68, 0x49, 0xc6, 0x8d, 0xd4,
-	0x97, 0x21, 0x1b, 0xc9, 0x31, 0x82, 0x39, 0xfd, 0x5a, 0x5b, 0xab, 0x9a, 0xad, 0x66, 0xb5, 0x62,
-	0xb4, 0xc4, 0x75, 0xec, 0x76, 0xbb, 0x5a, 0x35, 0x5b, 0x65, 0xad, 0x8e, 0x14, 0x95, 0x00, 0xf0,
-	0x2e, 0x79, 0xe7, 0x63, 0x82, 0x94, 0x87, 0x10, 0x74, 0x06, 0xb2, 0x9e, 0xbb, 0x2f, 0xb1, 0x27,
-	0x38, 0x9c, 0x8c, 0xe7, 0xee, 0x73, 0xe4, 0xaa, 0x06, 0x38, 0x3e, 0x57, 0x19, 0x6d, 0xb1, 0xe4,
-	0xad, 0x4c, 0x24, 0xef, 0xf1, 0xf8, 0x51, 0xf2, 0x16, 0x5b, 0x79, 0xf6, 0x9d, 0xbf, 0x41, 0x2d,
-	0x27, 0x08, 0xd7, 0x2b, 0xf5, 0xa7, 0x09, 0xc8, 0x13, 0x26, 0xb1, 0xfb, 0xb4, 0x15, 0x58, 0x81,
-	0xcf, 0x3c, 0xb5, 0xcb, 0x4d, 0xcc, 0x71, 0xda, 0xcd, 0x92, 0x9c, 0x90, 0x89, 0x4b, 0x80, 0x0d,
-	0x58, 0xf2, 0x69, 0xc7, 0x1d, 0x74, 0x7d, 0x73, 0x87, 0xee, 0xda, 0x83, 0xae, 0xd9, 0xb7, 0xfc,
-	0x40, 0xde, 0x14, 0xe6, 0x49, 0x51, 0x2a, 0x37, 0xb9, 0xae, 0xc6, 0x55, 0xf8, 0x1c, 0x2c, 0xee,
-	0xd8, 0x03, 0xc7, 0xed, 0x99, 0x43, 0xc7, 0x3a, 0xa0, 0x9e, 0x2f, 0xa1, 0xb2, 0xf0, 0x4a, 0x13,
-	0x2c, 0x74, 0x4d, 0xa1, 0x12, 0xee, 0x7e, 0x1b, 0xd6, 0xa6, 0x8e, 0x62, 0xde, 0xb2, 0x9d, 0x80,
-	0x7a, 0xb4, 0x6b, 0x7a, 0x74, 0xe8, 0xd8, 0x1d, 0x71, 0x69, 0x2f, 0xf6, 0xee, 0x2f, 0x4c, 0x19,
-	0x7a, 0x5b, 0x9a, 0x93, 0xb1, 0x35, 0x63, 0xbb, 0x33, 0x1c, 0x99, 0x23, 0xf6, 0x01, 0xf3, 0x55,
-	0x4c, 0x21, 0x99, 0xce, 0x70, 0xd4, 0x66, 0x75, 0x8c, 0x20, 0x79, 0x7b, 0x28, 0x16, 0x2f, 0x85,
-	0xb0, 0xa2, 0xfa, 0xb1, 0x02, 0x05, 0xad, 0xd7, 0xf3, 0x68, 0xcf, 0x0a, 0x24, 0x4d, 0xe7, 0x60,
-	0x51, 0x50, 0x72, 0x60, 0xca, 0xd7, 0x40, 0x02, 0x8f, 0x22, 0xf0, 0x48, 0x9d, 0x78, 0x0b, 0x14,
-	0x86, 0xef, 0xc9, 0xd1, 0x60, 0x6a, 0x9b, 0x04, 0x6f, 0xb3, 0x18, 0x69, 0xe3, 0xad, 0xfe, 0x0b,
-	0x4e, 0x4f, 0x67, 0xa1, 0x6f, 0x8b, 0xf7, 0x1c, 0x79, 0x72, 0x72, 0x0a, 0xe8, 0x9a, 0x3d, 0x78,
-	0x40, 0x53, 0xeb, 0x2e, 0xe7, 0xeb, 0x3e, 0x4d, 0xad, 0xbb, 0xea, 0xef, 0xa3, 0xa3, 0xfd, 0x30,
-	0x5c, 0xa2, 0xd5, 0x38, 0xcc, 0x0b, 0xca, 0x83, 0xf2, 0x42, 0x09, 0x66, 0x7d, 0xea, 0xdd, 0xb1,
-	0x07, 0xbd, 0xf0, 0xf6, 0x58, 0x56, 0x71, 0x0b, 0x5e, 0x90, 0xd8, 0xe9, 0xdd, 0x80, 0x7a, 0x03,
-	0xcb, 0x71, 0x0e, 0x4c, 0x71, 0x50, 0x31, 0x08, 0x68, 0xd7, 0x1c, 0xbf, 0x5d, 0x12, 0x2b, 0xf2,
-	0x73, 0xc2, 0x5a, 0x8f, 0x8c, 0x49, 0x64, 0x6b, 0x44, 0xaf, 0x9a, 0x5e, 0x83, 0x82, 0x27, 0x83,
-	0xd8, 0xf4, 0x99, 0x7b, 0x64, 0x3e, 0x5a, 0x8c, 0xae, 0x80, 0x63, 0x11, 0x4e, 0xf2, 0xde, 0x44,
-	0xc0, 0xbf, 0x0e, 0xf3, 0x56, 0xe8, 0x5b, 0xd9, 0x7a, 0x72, 0xdf, 0x32, 0xe9, 0x79, 0x52, 0xb0,
-	0x26, 0x23, 0xe1, 0x12, 0xcc, 0x49, 0x44, 0x96, 0x63, 0x5b, 0xe3, 0x8d, 0xed, 0xa1, 0x07, 0x61,
-	0x1a, 0x53, 0x12, 0xf9, 0x74, 0x8c, 0x57, 0xd8, 0xff, 0xe8, 0x62, 0x7b, 0xd8, 0xe5, 0x3d, 0x1d,
-	0xe3, 0xdd, 0x45, 0xfc, 0xf5, 0x58, 0x6a, 0xf2, 0xf5, 0xd8, 0xe4, 0x6b, 0xb4, 0xf4, 0xa1, 0xd7,
-	0x68, 0xea, 0x65, 0x58, 0x9c, 0xc4, 0x2f, 0xa3, 0x6c, 0x15, 0xd2, 0xfc, 0xa6, 0xfc, 0xd0, 0x32,
-	0x1a, 0xbb, 0x0a, 0x27, 0xc2, 0x40, 0xfd, 0x99, 0x02, 0xc5, 0x29, 0x7f, 0xb1, 0xa2, 0xff, 0x6f,
-	0x4a, 0xec, 0x78, 0xe8, 0xdf, 0x20, 0xcd, 0xef, 0xec, 0xe5, 0x63, 0x92, 0x53, 0x47, 0xff, 0xa1,
-	0xf1, 0xfb, 0x75, 0x22, 0xac, 0x58, 0x22, 0xe4, 0x01, 0xd5, 0xe1, 0xe7, 0x43, 0xe1, 0x0e, 0x31,
-	0xc7, 0x64, 0xe2, 0xc8, 0xe8, 0xe8, 0x81, 0x53, 0xea, 0xa1, 0x07, 0x4e, 0x6b, 0xdf, 0x4a, 0x42,
-	0xb6, 0x76, 0xd0, 0xba, 0xed, 0x6c, 0x3b, 0x56, 0x8f, 0x5f, 0x80, 0xd7, 0x9a, 0xc6, 0x4d, 0x74,
-	0x02, 0x2f, 0x40, 0xbe, 0xde, 0x30, 0xcc, 0x3a, 0x5b, 0x4a, 0xb6, 0xab, 0xda, 0x15, 0xa4, 0xb0,
-	0xb5, 0xa6, 0x49, 0x2a, 0xe6, 0x55, 0xfd, 0xa6, 0x90, 0x24, 0x70, 0x11, 0xe6, 0xdb, 0xf5, 0xca,
-	0xb5, 0xb6, 0x3e, 0x16, 0xa6, 0xf0, 0x12, 0x2c, 0xd4, 0xda, 0x55, 0xa3, 0xd2, 0xac, 0xc6, 0xc4,
-	0x19, 0xb6, 0x2e, 0x6d, 0x56, 0x1b, 0x9b, 0xa2, 0x8a, 0x58, 0xff, 0xed, 0x7a, 0xab, 0x72, 0xa5,
-	0xae, 0x6f, 0x09, 0xd1, 0x0a, 0x13, 0xbd, 0xad, 0x93, 0xc6, 0x76, 0x25, 0x1c, 0xf2, 0x32, 0x46,
-	0x90, 0xdb, 0xac, 0xd4, 0x35, 0x22, 0x7b, 0xb9, 0xa7, 0xe0, 0x02, 0x64, 0xf5, 0x7a, 0xbb, 0x26,
-	0xeb, 0x09, 0x5c, 0x82, 0xa2, 0xd6, 0x36, 0x1a, 0x66, 0xa5, 0x5e, 0x26, 0x7a, 0x4d, 0xaf, 0x1b,
-	0x52, 0x93, 0xc2, 0x45, 0x28, 0x18, 0x95, 0x9a, 0xde, 0x32, 0xb4, 0x5a, 0x53, 0x0a, 0xd9, 0x2c,
-	0x32, 0x2d, 0x3d, 0xb4, 0x41, 0x78, 0x19, 0x96, 0xea, 0x0d, 0x53, 0x3e, 0x2a, 0x32, 0xaf, 0x6b,
-	0xd5, 0xb6, 0x2e, 0x75, 0x2b, 0xf8, 0x14, 0xe0, 0x46, 0xdd, 0x6c, 0x37, 0xb7, 0x34, 0x43, 0x37,
-	0xeb, 0x8d, 0x1b, 0x52, 0x71, 0x19, 0x17, 0x20, 0x33, 0x9e, 0xc1, 0x3d, 0xc6, 0x42, 0xbe, 0xa9,
-	0x11, 0x63, 0x0c, 0xf6, 0xde, 0x3d, 0x46, 0x16, 0x5c, 0x21, 0x8d, 0x76, 0x73, 0x6c, 0xb6, 0x00,
-	0x39, 0x49, 0x96, 0x14, 0xa5, 0x98, 0x68, 0xb3, 0x52, 0x2f, 0x47, 0xf3, 0xbb, 0x97, 0x59, 0x4e,
-	0x20, 0x65, 0x6d, 0x0f, 0x52, 0xdc, 0x1d, 0x19, 0x48, 0xd5, 0x1b, 0x75, 0x1d, 0x9d, 0xc0, 0xf3,
-	0x00, 0x95, 0x56, 0xa5, 0x6e, 0xe8, 0x57, 0x88, 0x56, 0x65, 0xb0, 0xb9, 0x20, 0x24, 0x90, 0xa1,
-	0x9d, 0x83, 0xd9, 0x4a, 0x6b, 0xbb, 0xda, 0xd0, 0x0c, 0x09, 0xb3, 0xd2, 0xba, 0xd6, 0x6e, 0x18,
-	0x4c, 0x89, 0x70, 0x0e, 0x66, 0x2a, 0x2d, 0x43, 0x7f, 0xcb, 0x60, 0xb8, 0xb8, 0x4e, 0xb0, 0x8a,
-	0xee, 0x5d, 0x5e, 0xfb, 0x30, 0x09, 0x29, 0xfe, 0x22, 0x34, 0x0f, 0x59, 0xee, 0x6d, 0xe3, 0x66,
-	0x93, 0x0d, 0x99, 0x85, 0x54, 0xa5, 0x6e, 0x5c, 0x42, 0xff, 0x97, 0xc0, 0x00, 0xe9, 0x36, 0x2f,
-	0xff, 0xff, 0x0c, 0x2b, 0x57, 0xea, 0xc6, 0x2b, 0x17, 0xd1, 0xbb, 0x09, 0xd6, 0x6d, 0x5b, 0x54,
-	0x3e, 0x17, 0x2a, 0x36, 0x2e, 0xa0, 0xf7, 0x22, 0xc5, 0xc6, 0x05, 0xf4, 0xf9, 0x50, 0x71, 0x7e,
-	0x03, 0x7d, 0x21, 0x52, 0x9c, 0xdf, 0x40, 0x5f, 0x0c, 0x15, 0x17, 0x2f, 0xa0, 0x2f, 0x45, 0x8a,
-	0x8b, 0x17, 0xd0, 0x97, 0x67, 0x18, 0x16, 0x8e, 0xe4, 0xfc, 0x06, 0xfa, 0x4a, 0x26, 0xaa, 0x5d,
-	0xbc, 0x80, 0xbe, 0x9a, 0x61, 0xfe, 0x8f, 0xbc, 0x8a, 0xbe, 0x86, 0xd8, 0x34, 0x99, 0x83, 0xd0,
-	0xd7, 0x79, 0x91, 0xa9, 0xd0, 0x37, 0x10, 0xc3, 0xc8, 0xa4, 0xbc, 0xfa, 0x3e, 0xd7, 0xdc, 0xd4,
-	0x35, 0x82, 0xbe, 0x39, 0x23, 0xde, 0x90, 0x95, 0x2b, 0x35, 0xad, 0x8a, 0x30, 0x6f, 0xc1, 0x58,
-	0xf9, 0xf6, 0x39, 0x56, 0x64, 0xe1, 0x89, 0xbe, 0xd3, 0x64, 0x03, 0x5e, 0xd7, 0x48, 0xf9, 0x0d,
-	0x8d, 0xa0, 0xef, 0x9e, 0x63, 0x03, 0x5e, 0xd7, 0x88, 0xe4, 0xeb, 0x7b, 0x4d, 0x66, 0xc8, 0x55,
-	0x1f, 0x9c, 0x63, 0x93, 0x96, 0xf2, 0xef, 0x37, 0x71, 0x06, 0x92, 0x9b, 0x15, 0x03, 0x7d, 0xc8,
-	0x47, 0x63, 0x21, 0x8a, 0x7e, 0x80, 0x98, 0xb0, 0xa5, 0x1b, 0xe8, 0x87, 0x4c, 0x98, 0x36, 0xda,
-	0xcd, 0xaa, 0x8e, 0x9e, 0x62, 0x93, 0xbb, 0xa2, 0x37, 0x6a, 0xba, 0x41, 0x6e, 0xa2, 0x1f, 0x71,
-	0xf3, 0x37, 0x5b, 0x8d, 0x3a, 0xfa, 0x08, 0xe1, 0x02, 0x80, 0xfe, 0x56, 0x93, 0xe8, 0xad, 0x56,
-	0xa5, 0x51, 0x47, 0xcf, 0xae, 0x6d, 0x03, 0x3a, 0x9c, 0x0e, 0x18, 0x80, 0x76, 0xfd, 0x6a, 0xbd,
-	0x71, 0xa3, 0x8e, 0x4e, 0xb0, 0x4a, 0x93, 0xe8, 0x4d, 0x8d, 0xe8, 0x48, 0xc1, 0x00, 0x33, 0xe2,
-	0x85, 0x1b, 0x4a, 0xe0, 0x39, 0xc8, 0x90, 0x46, 0xb5, 0xba, 0xa9, 0x95, 0xaf, 0xa2, 0xe4, 0xe6,
-	0x02, 0xcc, 0xdb, 0xee, 0xfa, 0x1d, 0x3b, 0xa0, 0xbe, 0x2f, 0xde, 0x1c, 0xef, 0xcc, 0xf0, 0x9f,
-	0xf3, 0x7f, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xde, 0xe3, 0xd2, 0x1e, 0xad, 0x2c, 0x00, 0x00,
+	// 3210 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
+	0xb5, 0xd6, 0xe0, 0x45, 0xe0, 0x80, 0x00, 0x9b, 0x4d, 0x52, 0x82, 0x28, 0x3f, 0x78, 0xc7, 0x96,
+	0xcd, 0x4b, 0xfb, 0xf2, 0xca, 0x94, 0xae, 0xae, 0xae, 0x7d, 0xaf, 0xaf, 0x86, 0xe0, 0x50, 0x86,
+	0x85, 0x97, 0x1a, 0x03, 0xc9, 0x72, 0xb9, 0x6a, 0x6a, 0x08, 0xb4, 0xc0, 0x29, 0x0e, 0x30, 0xd0,
+	0xcc, 0x40, 0x12, 0x76, 0x4a, 0x1c, 0x3b, 0xef, 0xc4, 0x79, 0x3a, 0x4e, 0x2a, 0x4e, 0xaa, 0xb2,
+	0xcf, 0x6f, 0x48, 0xe5, 0x07, 0x64, 0x97, 0x45, 0x92, 0x45, 0x16, 0xa9, 0x54, 0x76, 0xa9, 0xac,
+	0xb2, 0xc8, 0x22, 0x95, 0xea, 0xc7, 0x0c, 0x06, 0x24, 0xf4, 0xb0, 0x92, 0x8d, 0x64, 0xaf, 0xd0,
+	0x7d, 0xce, 0xe9, 0xc7, 0xf7, 0x9d, 0x33, 0xa7, 0x1b, 0xdd, 0x0d, 0xf9, 0x9b, 0x23, 0xea, 0x8d,
+	0x37, 0x87, 0x9e, 0x1b, 0xb8, 0x38, 0xcd, 0x2b, 0xab, 0xc5, 0xc0, 0x1d, 0xba, 0x5d, 0x2b, 0xb0,
+	0x84, 0x78, 0x35, 0x7f, 0x2b, 0xf0, 0x86, 0x1d, 0x51, 0x51, 0xdf, 0x53, 0x20, 0x63, 0x58, 0x5e,
+	0x8f, 0x06, 0x78, 0x15, 0xb2, 0x07, 0x74, 0xec, 0x0f, 0xad, 0x0e, 0x2d, 0x29, 0x6b, 0xca, 0x7a,
+	0x8e, 0x44, 0x75, 0xbc, 0x0c, 0x69, 0x7f, 0xdf, 0xf2, 0xba, 0xa5, 0x04, 0x57, 0x88, 0x0a, 0xfe,
+	0x2f, 0xc8, 0x07, 0xd6, 0x9e, 0x43, 0x03, 0x33, 0x18, 0x0f, 0x69, 0x29, 0xb9, 0xa6, 0xac, 0x17,
+	0xb7, 0x96, 0x37, 0xa3, 0xf1, 0x0c, 0xae, 0x34, 0xc6, 0x43, 0x4a, 0x20, 0x88, 0xca, 0x18, 0x43,
+	0xaa, 0x43, 0x1d, 0xa7, 0x94, 0xe2, 0x7d, 0xf1, 0xb2, 0xba, 0x03, 0xc5, 0xab, 0xc6, 0x25, 0x2b,
+	0xa0, 0x65, 0xcb, 0x71, 0xa8, 0x57, 0xd9, 0x61, 0xd3, 0x19, 0xf9, 0xd4, 0x1b, 0x58, 0xfd, 0x68,
+	0x3a, 0x61, 0x1d, 0x1f, 0x87, 0x4c, 0xcf, 0x73, 0x47, 0x43, 0xbf, 0x94, 0x58, 0x4b, 0xae, 0xe7,
+	0x88, 0xac, 0xa9, 0xef, 0x00, 0xe8, 0xb7, 0xe8, 0x20, 0x30, 0xdc, 0x03, 0x3a, 0xc0, 0x4f, 0x41,
+	0x2e, 0xb0, 0xfb, 0xd4, 0x0f, 0xac, 0xfe, 0x90, 0x77, 0x91, 0x24, 0x13, 0xc1, 0x3d, 0x20, 0xad,
+	0x42, 0x76, 0xe8, 0xfa, 0x76, 0x60, 0xbb, 0x03, 0x8e, 0x27, 0x47, 0xa2, 0xba, 0xfa, 0x3a, 0xa4,
+	0xaf, 0x5a, 0xce, 0x88, 0xe2, 0x67, 0x21, 0xc5, 0x01, 0x2b, 0x1c, 0x70, 0x7e, 0x53, 0x90, 0xce,
+	0x71, 0x72, 0x05, 0xeb, 0xfb, 0x16, 0xb3, 0xe4, 0x7d, 0xcf, 0x13, 0x51, 0x51, 0x0f, 0x60, 0x7e,
+	0xdb, 0x1e, 0x74, 0xaf, 0x5a, 0x9e, 0xcd, 0xc8, 0x78, 0xc4, 0x6e, 0xf0, 0xf3, 0x90, 0xe1, 0x05,
+	0xbf, 0x94, 0x5c, 0x4b, 0xae, 0xe7, 0xb7, 0xe6, 0x65, 0x43, 0x3e, 0x37, 0x22, 0x75, 0xea, 0x2f,
+	0x15, 0x80, 0x6d, 0x77, 0x34, 0xe8, 0x5e, 0x61, 0x4a, 0x8c, 0x20, 0xe9, 0xdf, 0x74, 0x24, 0x91,
+	0xac, 0x88, 0x2f, 0x43, 0x71, 0xcf, 0x1e, 0x74, 0xcd, 0x5b, 0x72, 0x3a, 0x82, 0xcb, 0xfc, 0xd6,
+	0xf3, 0xb2, 0xbb, 0x49, 0xe3, 0xcd, 0xf8, 0xac, 0x7d, 0x7d, 0x10, 0x78, 0x63, 0x52, 0xd8, 0x8b,
+	0xcb, 0x56, 0xdb, 0x80, 0x8f, 0x1a, 0xb1, 0x41, 0x0f, 0xe8, 0x38, 0x1c, 0xf4, 0x80, 0x8e, 0xf1,
+	0xbf, 0xc7, 0x11, 0xe5, 0xb7, 0x96, 0xc2, 0xb1, 0x62, 0x6d, 0x25, 0xcc, 0x57, 0x13, 0x17, 0x14,
+	0xf5, 0xfd, 0x39, 0x28, 0xea, 0x77, 0x68, 0x67, 0x14, 0xd0, 0xc6, 0x90, 0xf9, 0xc0, 0xc7, 0x9b,
+	0xb0, 0x64, 0x0f, 0x3a, 0xce, 0xa8, 0x4b, 0x4d, 0xca, 0x5c, 0x6d, 0x06, 0xcc, 0xd7, 0xbc, 0xbf,
+	0x2c, 0x59, 0x94, 0xaa, 0x58, 0x10, 0x68, 0xb0, 0xd4, 0x71, 0xfb, 0x43, 0xcb, 0x9b, 0xb6, 0x4f,
+	0xf2, 0xf1, 0x17, 0xe5, 0xf8, 0x13, 0x7b, 0xb2, 0x28, 0xad, 0x63, 0x5d, 0xd4, 0x60, 0x41, 0xf6,
+	0xdb, 0x35, 0x6f, 0xd8, 0xd4, 0xe9, 0xfa, 0x3c, 0x74, 0x8b, 0x11, 0x55, 0xd3, 0x53, 0xdc, 0xac,
+	0x48, 0xe3, 0x5d, 0x6e, 0x4b, 0x8a, 0xf6, 0x54, 0x1d, 0x6f, 0xc0, 0x62, 0xc7, 0xb1, 0xd9, 0x54,
+	0x6e, 0x30, 0x8a, 0x4d, 0xcf, 0xbd, 0xed, 0x97, 0xd2, 0x7c, 0xfe, 0x0b, 0x42, 0xb1, 0xcb, 0xe4,
+	0xc4, 0xbd, 0xed, 0xe3, 0x57, 0x21, 0x7b, 0xdb, 0xf5, 0x0e, 0x1c, 0xd7, 0xea, 0x96, 0x32, 0x7c,
+	0xcc, 0x67, 0x66, 0x8f, 0x79, 0x4d, 0x5a, 0x91, 0xc8, 0x1e, 0xaf, 0x03, 0xf2, 0x6f, 0x3a, 0xa6,
+	0x4f, 0x1d, 0xda, 0x09, 0x4c, 0xc7, 0xee, 0xdb, 0x41, 0x29, 0xcb, 0xbf, 0x82, 0xa2, 0x7f, 0xd3,
+	0x69, 0x71, 0x71, 0x95, 0x49, 0xb1, 0x09, 0x2b, 0x81, 0x67, 0x0d, 0x7c, 0xab, 0xc3, 0x3a, 0x33,
+	0x6d, 0xdf, 0x75, 0x2c, 0x56, 0x2a, 0xe5, 0xf8, 0x90, 0x1b, 0xb3, 0x87, 0x34, 0x26, 0x4d, 0x2a,
+	0x61, 0x0b, 0xb2, 0x1c, 0xcc, 0x90, 0xe2, 0x57, 0x60, 0xc5, 0x3f, 0xb0, 0x87, 0x26, 0xef, 0xc7,
+	0x1c, 0x3a, 0xd6, 0xc0, 0xec, 0x58, 0x9d, 0x7d, 0x5a, 0x02, 0x0e, 0x1b, 0x33, 0x25, 0x0f, 0xb5,
+	0xa6, 0x63, 0x0d, 0xca, 0x4c, 0x83, 0xdb, 0x80, 0xfc, 0xb1, 0x1f, 0xd0, 0x7e, 0x2c, 0x40, 0xf3,
+	0x3c, 0x40, 0xef, 0x31, 0x9d, 0x16, 0xb7, 0x3e, 0x14, 0xa6, 0x0b, 0xfe, 0xb4, 0x74, 0x75, 0x1b,
+	0x96, 0x67, 0x19, 0xce, 0x08, 0xd5, 0xa9, 0x8f, 0x2f, 0x17, 0x8f, 0xca, 0xd7, 0xa0, 0x38, 0xed,
+	0x62, 0xbc, 0x08, 0x05, 0xe3, 0x7a, 0x53, 0x37, 0xb5, 0xfa, 0x8e, 0x59, 0xd7, 0x6a, 0x3a, 0x3a,
+	0x86, 0x0b, 0x90, 0xe3, 0xa2, 0x46, 0xbd, 0x7a, 0x1d, 0x29, 0x78, 0x0e, 0x92, 0x5a, 0xb5, 0x8a,
+	0x12, 0xea, 0x05, 0xc8, 0x86, 0xbe, 0xc2, 0x0b, 0x90, 0x6f, 0xd7, 0x5b, 0x4d, 0xbd, 0x5c, 0xd9,
+	0xad, 0xe8, 0x3b, 0xe8, 0x18, 0xce, 0x42, 0xaa, 0x51, 0x35, 0x9a, 0x48, 0x11, 0x25, 0xad, 0x89,
+	0x12, 0xac, 0xe5, 0xce, 0xb6, 0x86, 0x92, 0x6a, 0x00, 0xcb, 0xb3, 0x28, 0xc7, 0x79, 0x98, 0xdb,
+	0xd1, 0x77, 0xb5, 0x76, 0xd5, 0x40, 0xc7, 0xf0, 0x12, 0x2c, 0x10, 0xbd, 0xa9, 0x6b, 0x86, 0xb6,
+	0x5d, 0xd5, 0x4d, 0xa2, 0x6b, 0x3b, 0x48, 0xc1, 0x18, 0x8a, 0xac, 0x64, 0x96, 0x1b, 0xb5, 0x5a,
+	0xc5, 0x30, 0xf4, 0x1d, 0x94, 0xc0, 0xcb, 0x80, 0xb8, 0xac, 0x5d, 0x9f, 0x48, 0x93, 0x18, 0xc1,
+	0x7c, 0x4b, 0x27, 0x15, 0xad, 0x5a, 0x79, 0x9b, 0x75, 0x80, 0x52, 0x6f, 0xa6, 0xb2, 0x0a, 0x4a,
+	0xa8, 0x1f, 0x26, 0x20, 0xcd, 0xb1, 0xb2, 0xe4, 0x1d, 0x4b, 0xc9, 0xbc, 0x1c, 0x25, 0xb2, 0xc4,
+	0x7d, 0x12, 0x19, 0xcf, 0xff, 0x32, 0xa5, 0x8a, 0x0a, 0x3e, 0x05, 0x39, 0xd7, 0xeb, 0x99, 0x42,
+	0x23, 0x16, 0x83, 0xac, 0xeb, 0xf5, 0xf8, 0xaa, 0xc1, 0x12, 0x31, 0x5b, 0x43, 0xf6, 0x2c, 0x9f,
+	0xf2, 0x8f, 0x23, 0x47, 0xa2, 0x3a, 0x3e, 0x09, 0xcc, 0xce, 0xe4, 0xf3, 0xc8, 0x70, 0xdd, 0x9c,
+	0xeb, 0xf5, 0xea, 0x6c, 0x2a, 0xcf, 0x41, 0xa1, 0xe3, 0x3a, 0xa3, 0xfe, 0xc0, 0x74, 0xe8, 0xa0,
+	0x17, 0xec, 0x97, 0xe6, 0xd6, 0x94, 0xf5, 0x02, 0x99, 0x17, 0xc2, 0x2a, 0x97, 0xe1, 0x12, 0xcc,
+	0x75, 0xf6, 0x2d, 0xcf, 0xa7, 0xe2, 0x83, 0x28, 0x90, 0xb0, 0xca, 0x47, 0xa5, 0x1d, 0xbb, 0x6f,
+	0x39, 0x3e, 0x0f, 0xfe, 0x02, 0x89, 0xea, 0x0c, 0xc4, 0x0d, 0xc7, 0xea, 0xf9, 0x3c, 0x68, 0x0b,
+	0x44, 0x54, 0xd4, 0xff, 0x86, 0x24, 0x71, 0x6f, 0xb3, 0x2e, 0xc5, 0x80, 0x7e, 0x49, 0x59, 0x4b,
+	0xae, 0x63, 0x12, 0x56, 0xd9, 0x5a, 0x25, 0xd3, 0xb5, 0xc8, 0xe2, 0x61, 0x82, 0x7e, 0x07, 0xe6,
+	0x09, 0xf5, 0x47, 0x4e, 0xa0, 0xdf, 0x09, 0x3c, 0xcb, 0xc7, 0x5b, 0x90, 0x8f, 0x27, 0x28, 0xe5,
+	0x5e, 0x09, 0x0a, 0x68, 0x54, 0x66, 0xa3, 0xde, 0xf0, 0xa8, 0xbf, 0x4f, 0x3d, 0x99, 0x00, 0xc3,
+	0x2a, 0x4b, 0xff, 0x79, 0xfe, 0x45, 0x89, 0x31, 0xd8, 0xa2, 0x21, 0x53, 0x97, 0x32, 0xb5, 0x68,
+	0x70, 0xa7, 0x12, 0xa9, 0x63, 0xec, 0xb1, 0x6c, 0x64, 0x5a, 0x37, 0x6e, 0xd0, 0x4e, 0x40, 0xc5,
+	0xda, 0x98, 0x22, 0xf3, 0x4c, 0xa8, 0x49, 0x19, 0x73, 0x9b, 0x3d, 0xf0, 0xa9, 0x17, 0x98, 0x76,
+	0x97, 0x3b, 0x34, 0x45, 0xb2, 0x42, 0x50, 0xe9, 0xe2, 0x67, 0x20, 0xc5, 0xf3, 0x59, 0x8a, 0x8f,
+	0x02, 0x72, 0x14, 0xe2, 0xde, 0x26, 0x5c, 0x8e, 0x5f, 0x82, 0x0c, 0xe5, 0x78, 0x4b, 0xe9, 0xa9,
+	0x15, 0x20, 0x4e, 0x05, 0x91, 0x26, 0xea, 0x4f, 0x93, 0x90, 0x6f, 0x05, 0x1e, 0xb5, 0xfa, 0x1c,
+	0x3f, 0xfe, 0x5f, 0x00, 0x3f, 0xb0, 0x02, 0xda, 0xa7, 0x83, 0x20, 0x04, 0xf2, 0x94, 0xec, 0x20,
+	0x66, 0xb7, 0xd9, 0x0a, 0x8d, 0x48, 0xcc, 0xfe, 0x30, 0xc1, 0x89, 0x87, 0x20, 0x78, 0xf5, 0xe3,
+	0x04, 0xe4, 0xa2, 0xde, 0xb0, 0x06, 0xd9, 0x8e, 0x15, 0xd0, 0x9e, 0xeb, 0x8d, 0xe5, 0xa2, 0x7d,
+	0xfa, 0x7e, 0xa3, 0x6f, 0x96, 0xa5, 0x31, 0x89, 0x9a, 0xe1, 0xa7, 0x41, 0xec, 0x84, 0x44, 0xf0,
+	0x8a, 0xd4, 0x92, 0xe3, 0x12, 0x1e, 0xbe, 0xaf, 0x02, 0x1e, 0x7a, 0x76, 0xdf, 0xf2, 0xc6, 0xe6,
+	0x01, 0x1d, 0x87, 0xab, 0x4d, 0x72, 0x86, 0xcb, 0x90, 0xb4, 0xbb, 0x4c, 0xc7, 0x32, 0x09, 0x5d,
+	0x98, 0x6e, 0x2b, 0x83, 0xee, 0xa8, 0x23, 0x62, 0x2d, 0xf9, 0x96, 0xc1, 0x0f, 0x37, 0x07, 0x69,
+	0x1e, 0x9f, 0xac, 0xa8, 0xbe, 0x08, 0xd9, 0x70, 0xf2, 0x38, 0x07, 0x69, 0xdd, 0xf3, 0x5c, 0x0f,
+	0x1d, 0xe3, 0xb9, 0xa8, 0x56, 0x15, 0xe9, 0x6c, 0x67, 0x87, 0xa5, 0xb3, 0x5f, 0x24, 0xa2, 0x15,
+	0x9a, 0xd0, 0x9b, 0x23, 0xea, 0x07, 0xf8, 0xff, 0x61, 0x89, 0xf2, 0x58, 0xb1, 0x6f, 0x51, 0xb3,
+	0xc3, 0xb7, 0x73, 0x2c, 0x52, 0x44, 0x40, 0x2f, 0x6c, 0x8a, 0xdd, 0x67, 0xb8, 0xcd, 0x23, 0x8b,
+	0x91, 0xad, 0x14, 0x75, 0xb1, 0x0e, 0x4b, 0x76, 0xbf, 0x4f, 0xbb, 0xb6, 0x15, 0xc4, 0x3b, 0x10,
+	0x0e, 0x5b, 0x09, 0x77, 0x3b, 0x53, 0xbb, 0x45, 0xb2, 0x18, 0xb5, 0x88, 0xba, 0x39, 0x0d, 0x99,
+	0x80, 0xef, 0x6c, 0xe5, 0x62, 0x5f, 0x08, 0xf3, 0x12, 0x17, 0x12, 0xa9, 0xc4, 0x2f, 0x82, 0xd8,
+	0x27, 0xf3, 0x0c, 0x34, 0x09, 0x88, 0xc9, 0xf6, 0x87, 0x08, 0x3d, 0x3e, 0x0d, 0xc5, 0xa9, 0x55,
+	0xb2, 0xcb, 0x09, 0x4b, 0x92, 0x42, 0x4c, 0x5a, 0xe9, 0xe2, 0xff, 0x84, 0x39, 0x57, 0x2c, 0x49,
+	0xa5, 0xcc, 0xd4, 0x8c, 0xa7, 0xd7, 0x2b, 0x12, 0x5a, 0xa9, 0xff, 0x07, 0x0b, 0x11, 0x83, 0xfe,
+	0xd0, 0x1d, 0xf8, 0x14, 0x6f, 0x40, 0xc6, 0xe3, 0x1f, 0x84, 0x64, 0x0d, 0xcb, 0x2e, 0x62, 0x5f,
+	0x34, 0x91, 0x16, 0x6a, 0x17, 0x16, 0x84, 0xe4, 0x9a, 0x1d, 0xec, 0x73, 0x47, 0xe1, 0xd3, 0x90,
+	0xa6, 0xac, 0x70, 0x88, 0x73, 0xd2, 0x2c, 0x73, 0x3d, 0x11, 0xda, 0xd8, 0x28, 0x89, 0x07, 0x8e,
+	0xf2, 0x97, 0x04, 0x2c, 0xc9, 0x59, 0x6e, 0x5b, 0x41, 0x67, 0xff, 0x31, 0x75, 0xf6, 0x4b, 0x30,
+	0xc7, 0xe4, 0x76, 0xf4, 0x61, 0xcc, 0x70, 0x77, 0x68, 0xc1, 0x1c, 0x6e, 0xf9, 0x66, 0xcc, 0xbb,
+	0x72, 0x97, 0x56, 0xb0, 0xfc, 0xd8, 0x42, 0x3c, 0x23, 0x2e, 0x32, 0x0f, 0x88, 0x8b, 0xb9, 0x87,
+	0x8a, 0x8b, 0x1d, 0x58, 0x9e, 0x66, 0x5c, 0x06, 0xc7, 0xcb, 0x30, 0x27, 0x9c, 0x12, 0xa6, 0xc0,
+	0x59, 0x7e, 0x0b, 0x4d, 0xd4, 0x9f, 0x24, 0x60, 0x59, 0x66, 0xa7, 0x4f, 0xc7, 0x67, 0x1a, 0xe3,
+	0x39, 0xfd, 0x50, 0x3c, 0x97, 0x61, 0xe5, 0x10, 0x41, 0x8f, 0xf0, 0x15, 0xfe, 0x59, 0x81, 0xf9,
+	0x6d, 0xda, 0xb3, 0x07, 0x8f, 0x29, 0xbd, 0x31, 0xd6, 0x52, 0x0f, 0xc5, 0xda, 0x79, 0x28, 0x48,
+	0xbc, 0x92, 0xad, 0xa3, 0x9f, 0x81, 0x32, 0xe3, 0x33, 0x50, 0xff, 0xa8, 0x40, 0xa1, 0xec, 0xf6,
+	0xfb, 0x76, 0xf0, 0x98, 0x32, 0x75, 0x14, 0x67, 0x6a, 0x16, 0x4e, 0x04, 0xc5, 0x10, 0xa6, 0x20,
+	0x48, 0xfd, 0x93, 0x02, 0x0b, 0xc4, 0x75, 0x9c, 0x3d, 0xab, 0x73, 0xf0, 0x64, 0x63, 0xc7, 0x80,
+	0x26, 0x40, 0x25, 0xfa, 0xbf, 0x29, 0x50, 0x6c, 0x7a, 0x94, 0xfd, 0xb5, 0x7e, 0xa2, 0xc1, 0xb3,
+	0x3f, 0x48, 0xdd, 0x40, 0x6e, 0x0e, 0x72, 0x84, 0x97, 0xd5, 0x45, 0x58, 0x88, 0xb0, 0x4b, 0x3e,
+	0x7e, 0xab, 0xc0, 0x8a, 0x08, 0x10, 0xa9, 0xe9, 0x3e, 0xa6, 0xb4, 0x84, 0x78, 0x53, 0x31, 0xbc,
+	0x25, 0x38, 0x7e, 0x18, 0x9b, 0x84, 0xfd, 0x6e, 0x02, 0x4e, 0x84, 0xb1, 0xf1, 0x98, 0x03, 0xff,
+	0x27, 0xe2, 0x61, 0x15, 0x4a, 0x47, 0x49, 0x90, 0x0c, 0x7d, 0x90, 0x80, 0x52, 0xd9, 0xa3, 0x56,
+	0x40, 0x63, 0x9b, 0x8c, 0x27, 0x27, 0x36, 0xf0, 0x2b, 0x30, 0x3f, 0xb4, 0xbc, 0xc0, 0xee, 0xd8,
+	0x43, 0x8b, 0xfd, 0x8d, 0x4b, 0xaf, 0x25, 0x8f, 0x76, 0x30, 0x65, 0xa2, 0x9e, 0x82, 0x93, 0x33,
+	0x18, 0x91, 0x7c, 0xfd, 0x5d, 0x01, 0xdc, 0x0a, 0x2c, 0x2f, 0xf8, 0x14, 0xac, 0x2a, 0x33, 0x83,
+	0x69, 0x05, 0x96, 0xa6, 0xf0, 0xc7, 0x79, 0xa1, 0xc1, 0xa7, 0x62, 0xc5, 0xb9, 0x27, 0x2f, 0x71,
+	0xfc, 0x92, 0x97, 0xdf, 0x2b, 0xb0, 0x5a, 0x76, 0xc5, 0xf9, 0xdd, 0x13, 0xf9, 0x85, 0xa9, 0x4f,
+	0xc3, 0xa9, 0x99, 0x00, 0x25, 0x01, 0xbf, 0x53, 0xe0, 0x38, 0xa1, 0x56, 0xf7, 0xc9, 0x04, 0x7f,
+	0x05, 0x4e, 0x1c, 0x01, 0x27, 0x77, 0xa8, 0xe7, 0x21, 0xdb, 0xa7, 0x81, 0xd5, 0xb5, 0x02, 0x4b,
+	0x42, 0x5a, 0x0d, 0xfb, 0x9d, 0x58, 0xd7, 0xa4, 0x05, 0x89, 0x6c, 0xd5, 0x8f, 0x13, 0xb0, 0xc4,
+	0xf7, 0xba, 0x9f, 0xfd, 0x83, 0x9a, 0xfd, 0x5f, 0xe0, 0x03, 0x05, 0x96, 0xa7, 0x09, 0x8a, 0xfe,
+	0x13, 0xfc, 0xab, 0x0f, 0x22, 0x66, 0x24, 0x84, 0xe4, 0xac, 0x2d, 0xe8, 0xaf, 0x12, 0x50, 0x8a,
+	0x4f, 0xe9, 0xb3, 0x43, 0x8b, 0xe9, 0x43, 0x8b, 0x4f, 0x7c, 0x4a, 0xf5, 0xa1, 0x02, 0x27, 0x67,
+	0x10, 0xfa, 0xc9, 0x1c, 0x1d, 0x3b, 0xba, 0x48, 0x3c, 0xf0, 0xe8, 0xe2, 0x61, 0x5d, 0xfd, 0x1b,
+	0x05, 0x96, 0x6b, 0xd4, 0xf7, 0xad, 0x1e, 0x15, 0xff, 0xe3, 0x1f, 0xdf, 0x6c, 0xc6, 0x0f, 0x85,
+	0x53, 0x93, 0x9b, 0x15, 0x76, 0x36, 0x71, 0x08, 0xda, 0x23, 0x9c, 0x4d, 0xfc, 0x55, 0x81, 0x45,
+	0xd9, 0x8b, 0xd6, 0x39, 0x78, 0x72, 0xd8, 0xc1, 0xcf, 0x40, 0xd2, 0xee, 0x86, 0x3b, 0xc8, 0xe9,
+	0x6b, 0x70, 0xa6, 0x50, 0x2f, 0x02, 0x8e, 0xe3, 0x7e, 0x04, 0xea, 0x7e, 0x9d, 0x84, 0xc5, 0xd6,
+	0xd0, 0xb1, 0x03, 0xa9, 0x7c, 0xb2, 0x13, 0xff, 0xbf, 0xc1, 0xbc, 0xcf, 0xc0, 0x9a, 0xe2, 0xb6,
+	0x8c, 0x13, 0x9b, 0x23, 0x79, 0x2e, 0x2b, 0x73, 0x11, 0x7e, 0x16, 0xf2, 0xa1, 0xc9, 0x68, 0x10,
+	0xc8, 0x93, 0x4e, 0x90, 0x16, 0xa3, 0x41, 0x80, 0xcf, 0xc1, 0x89, 0xc1, 0xa8, 0xcf, 0x2f, 0xb5,
+	0xcd, 0x21, 0xf5, 0xc2, 0x2b, 0x5f, 0xcb, 0x0b, 0x2f, 0x9f, 0x97, 0x06, 0xa3, 0x3e, 0xbb, 0xdb,
+	0x6e, 0x52, 0x4f, 0x5c, 0xf9, 0x5a, 0x5e, 0x80, 0x2f, 0x42, 0xce, 0x72, 0x7a, 0xae, 0x67, 0x07,
+	0xfb, 0x7d, 0x79, 0xeb, 0xac, 0x86, 0x57, 0x2b, 0x87, 0xe9, 0xdf, 0xd4, 0x42, 0x4b, 0x32, 0x69,
+	0xa4, 0xbe, 0x0c, 0xb9, 0x48, 0xce, 0xae, 0x31, 0xf5, 0x2b, 0x6d, 0xad, 0x6a, 0xb6, 0x9a, 0xd5,
+	0x8a, 0xd1, 0x12, 0xd7, 0xb1, 0xbb, 0xed, 0x6a, 0xd5, 0x6c, 0x95, 0xb5, 0x3a, 0x52, 0x54, 0x02,
+	0xc0, 0xbb, 0xe4, 0x9d, 0x4f, 0x08, 0x52, 0x1e, 0x40, 0xd0, 0x29, 0xc8, 0x79, 0xee, 0x6d, 0x89,
+	0x3d, 0xc1, 0xe1, 0x64, 0x3d, 0xf7, 0x36, 0x47, 0xae, 0x6a, 0x80, 0xe3, 0x73, 0x95, 0xd1, 0x16,
+	0x4b, 0xde, 0xca, 0x54, 0xf2, 0x9e, 0x8c, 0x1f, 0x25, 0x6f, 0xb1, 0x95, 0x67, 0xdf, 0xf9, 0x1b,
+	0xd4, 0x72, 0x82, 0x70, 0xbd, 0x52, 0x7f, 0x96, 0x80, 0x02, 0x61, 0x12, 0xbb, 0x4f, 0xd9, 0xed,
+	0x92, 0xcf, 0x3c, 0xb5, 0xcf, 0x4d, 0xcc, 0x49, 0xda, 0xcd, 0x91, 0xbc, 0x90, 0x89, 0x4b, 0x80,
+	0x2d, 0x58, 0xf1, 0x69, 0xc7, 0x1d, 0x74, 0x7d, 0x73, 0x8f, 0xee, 0xb3, 0x97, 0x1e, 0x7d, 0xcb,
+	0x0f, 0xe4, 0x4d, 0x61, 0x81, 0x2c, 0x49, 0xe5, 0x36, 0xd7, 0xd5, 0xb8, 0x0a, 0x9f, 0x81, 0xe5,
+	0x3d, 0x7b, 0xe0, 0xb8, 0x3d, 0x76, 0x47, 0x3f, 0xa6, 0x9e, 0x2f, 0xa1, 0xb2, 0xf0, 0x4a, 0x13,
+	0x2c, 0x74, 0x4d, 0xa1, 0x12, 0xee, 0x7e, 0x1b, 0x36, 0x66, 0x8e, 0x62, 0xde, 0xb0, 0x9d, 0x80,
+	0x7a, 0xb4, 0x6b, 0x7a, 0x74, 0xe8, 0xd8, 0x1d, 0xf1, 0x9e, 0x40, 0xec, 0xdd, 0x5f, 0x98, 0x31,
+	0xf4, 0xae, 0x34, 0x27, 0x13, 0x6b, 0xc6, 0x76, 0x67, 0x38, 0x32, 0x47, 0xec, 0x03, 0xe6, 0xab,
+	0x98, 0x42, 0xb2, 0x9d, 0xe1, 0xa8, 0xcd, 0xea, 0xec, 0xce, 0xea, 0xe6, 0x50, 0x2c, 0x5e, 0x0a,
+	0x61, 0x45, 0x76, 0x04, 0x5b, 0xd4, 0x7a, 0x3d, 0x8f, 0xf6, 0xac, 0x40, 0xd2, 0x74, 0x06, 0x96,
+	0x05, 0x25, 0x63, 0x53, 0x3e, 0x54, 0x12, 0x78, 0x14, 0x81, 0x47, 0xea, 0xc4, 0x33, 0xa5, 0x30,
+	0x7c, 0x8f, 0x8f, 0x06, 0x33, 0xdb, 0x24, 0x78, 0x9b, 0xe5, 0xd1, 0x60, 0x46, 0xab, 0xff, 0x81,
+	0x93, 0xb3, 0x59, 0xe8, 0xdb, 0xe2, 0xa9, 0x49, 0x81, 0x1c, 0x9f, 0x01, 0xba, 0x66, 0x0f, 0xee,
+	0xd3, 0xd4, 0xba, 0x53, 0x4a, 0xdd, 0xbb, 0xa9, 0x75, 0x47, 0xfd, 0x43, 0x74, 0xb4, 0x1f, 0x86,
+	0x4b, 0xb4, 0x1a, 0x87, 0x79, 0x41, 0xb9, 0x5f, 0x5e, 0x28, 0xc1, 0x9c, 0x4f, 0xbd, 0x5b, 0xf6,
+	0xa0, 0x17, 0xde, 0x1e, 0xcb, 0x2a, 0x6e, 0xc1, 0x0b, 0x12, 0x3b, 0xbd, 0x13, 0x50, 0x6f, 0x60,
+	0x39, 0xce, 0xd8, 0x14, 0x07, 0x15, 0x83, 0x80, 0x76, 0xcd, 0xc9, 0xb3, 0x2a, 0xb1, 0x22, 0x3f,
+	0x27, 0xac, 0xf5, 0xc8, 0x98, 0x44, 0xb6, 0x46, 0x68, 0x8a, 0x5f, 0x83, 0xa2, 0x27, 0x83, 0xd8,
+	0xf4, 0x99, 0x7b, 0x64, 0x3e, 0x5a, 0x8e, 0xae, 0x80, 0x63, 0x11, 0x4e, 0x0a, 0x5e, 0xbc, 0x8a,
+	0x5f, 0x87, 0x05, 0x2b, 0xf4, 0xad, 0x6c, 0x3d, 0xbd, 0x6f, 0x99, 0xf6, 0x3c, 0x29, 0x5a, 0x53,
+	0x75, 0x7c, 0x01, 0xe6, 0x25, 0x22, 0xcb, 0xb1, 0xad, 0xc9, 0xc6, 0xf6, 0xd0, 0x5b, 0x35, 0x8d,
+	0x29, 0x49, 0x3e, 0x98, 0x54, 0xd8, 0xff, 0xe8, 0xa5, 0xf6, 0xb0, 0xcb, 0x7b, 0x7a, 0x8c, 0x77,
+	0x17, 0xf1, 0x87, 0x6d, 0xa9, 0xe9, 0x87, 0x6d, 0xd3, 0x0f, 0xe5, 0xd2, 0x87, 0x1e, 0xca, 0xa9,
+	0x17, 0x61, 0x79, 0x1a, 0xbf, 0x8c, 0xb2, 0x75, 0x48, 0xf3, 0x9b, 0xf2, 0x43, 0xcb, 0x68, 0xec,
+	0x2a, 0x9c, 0x08, 0x03, 0xf5, 0xe7, 0x0a, 0x2c, 0xcd, 0xf8, 0x8b, 0x15, 0xfd, 0x7f, 0x53, 0x62,
+	0xc7, 0x43, 0xff, 0x01, 0x69, 0xe6, 0xde, 0xf0, 0x31, 0xc9, 0x89, 0xa3, 0xff, 0xd0, 0x98, 0x43,
+	0x29, 0x11, 0x56, 0x2c, 0x11, 0xf2, 0x80, 0xea, 0xf0, 0xf3, 0xa1, 0x70, 0x87, 0x98, 0x67, 0x32,
+	0x71, 0x64, 0x74, 0xf4, 0xc0, 0x29, 0xf5, 0xc0, 0x03, 0xa7, 0x8d, 0x6f, 0x27, 0x21, 0x57, 0x1b,
+	0xb7, 0x6e, 0x3a, 0xbb, 0x8e, 0xd5, 0xe3, 0x17, 0xe0, 0xb5, 0xa6, 0x71, 0x1d, 0x1d, 0x63, 0x0f,
+	0x7d, 0xea, 0x0d, 0xc3, 0xac, 0xb3, 0xa5, 0x64, 0xb7, 0xaa, 0x5d, 0x42, 0x0a, 0x5b, 0x6b, 0x9a,
+	0xa4, 0x62, 0x5e, 0xd6, 0xaf, 0x0b, 0x49, 0x82, 0xbd, 0xc1, 0x69, 0xd7, 0x2b, 0x57, 0xda, 0xfa,
+	0x44, 0x98, 0xc2, 0x2b, 0xb0, 0x58, 0x6b, 0x57, 0x8d, 0x4a, 0xb3, 0x1a, 0x13, 0x67, 0xd9, 0xba,
+	0xb4, 0x5d, 0x6d, 0x6c, 0x8b, 0x2a, 0x62, 0xfd, 0xb7, 0xeb, 0xad, 0xca, 0xa5, 0xba, 0xbe, 0x23,
+	0x44, 0x6b, 0x4c, 0xf4, 0xb6, 0x4e, 0x1a, 0xbb, 0x95, 0x70, 0xc8, 0x8b, 0x18, 0x41, 0x7e, 0xbb,
+	0x52, 0xd7, 0x88, 0xec, 0xe5, 0xae, 0x82, 0x8b, 0x90, 0xd3, 0xeb, 0xed, 0x9a, 0xac, 0x27, 0x70,
+	0x09, 0x96, 0xb4, 0xb6, 0xd1, 0x30, 0x2b, 0xf5, 0x32, 0xd1, 0x6b, 0x7a, 0xdd, 0x90, 0x9a, 0x14,
+	0x5e, 0x82, 0xa2, 0x51, 0xa9, 0xe9, 0x2d, 0x43, 0xab, 0x35, 0xa5, 0x90, 0xcd, 0x22, 0xdb, 0xd2,
+	0x43, 0x1b, 0x84, 0x57, 0x61, 0xa5, 0xde, 0x30, 0xe5, 0xa3, 0x22, 0xf3, 0xaa, 0x56, 0x6d, 0xeb,
+	0x52, 0xb7, 0x86, 0x4f, 0x00, 0x6e, 0xd4, 0xcd, 0x76, 0x73, 0x47, 0x33, 0x74, 0xb3, 0xde, 0xb8,
+	0x26, 0x15, 0x17, 0x71, 0x11, 0xb2, 0x93, 0x19, 0xdc, 0x65, 0x2c, 0x14, 0x9a, 0x1a, 0x31, 0x26,
+	0x60, 0xef, 0xde, 0x65, 0x64, 0xc1, 0x25, 0xd2, 0x68, 0x37, 0x27, 0x66, 0x8b, 0x90, 0x97, 0x64,
+	0x49, 0x51, 0x8a, 0x89, 0xb6, 0x2b, 0xf5, 0x72, 0x34, 0xbf, 0xbb, 0xd9, 0xd5, 0x04, 0x52, 0x36,
+	0x0e, 0x20, 0xc5, 0xdd, 0x91, 0x85, 0x54, 0xbd, 0x51, 0x67, 0x6f, 0xac, 0x16, 0x00, 0x2a, 0xad,
+	0x4a, 0xdd, 0xd0, 0x2f, 0x11, 0xad, 0xca, 0x60, 0x73, 0x41, 0x48, 0x20, 0x43, 0x3b, 0x0f, 0x73,
+	0x95, 0xd6, 0x6e, 0xb5, 0xa1, 0x19, 0x12, 0x66, 0xa5, 0x75, 0xa5, 0xdd, 0x60, 0x6f, 0x9d, 0xee,
+	0x22, 0x9c, 0x87, 0x4c, 0xa5, 0x65, 0xe8, 0x6f, 0x19, 0x0c, 0x17, 0xd7, 0x09, 0x56, 0xd1, 0xdd,
+	0x8b, 0x1b, 0x1f, 0x25, 0x21, 0xc5, 0x1f, 0xab, 0x16, 0x20, 0xc7, 0xbd, 0xcd, 0x1e, 0x73, 0xa1,
+	0x63, 0x38, 0x07, 0xa9, 0x4a, 0xdd, 0xb8, 0x80, 0x3e, 0x97, 0xc0, 0x00, 0xe9, 0x36, 0x2f, 0x7f,
+	0x3e, 0xc3, 0xca, 0x95, 0xba, 0xf1, 0xca, 0x79, 0xf4, 0x6e, 0x82, 0x75, 0xdb, 0x16, 0x95, 0x2f,
+	0x84, 0x8a, 0xad, 0x73, 0xe8, 0xbd, 0x48, 0xb1, 0x75, 0x0e, 0xbd, 0x1f, 0x2a, 0xce, 0x6e, 0xa1,
+	0x2f, 0x46, 0x8a, 0xb3, 0x5b, 0xe8, 0x4b, 0xa1, 0xe2, 0xfc, 0x39, 0xf4, 0xe5, 0x48, 0x71, 0xfe,
+	0x1c, 0xfa, 0x4a, 0x86, 0x61, 0xe1, 0x48, 0xce, 0x6e, 0xa1, 0xaf, 0x66, 0xa3, 0xda, 0xf9, 0x73,
+	0xe8, 0x6b, 0x59, 0xe6, 0xff, 0xc8, 0xab, 0xe8, 0xeb, 0x88, 0x4d, 0x93, 0x39, 0x08, 0x7d, 0x83,
+	0x17, 0x99, 0x0a, 0x7d, 0x13, 0x31, 0x8c, 0x4c, 0xca, 0xab, 0x1f, 0x70, 0xcd, 0x75, 0x5d, 0x23,
+	0xe8, 0x5b, 0x19, 0xf1, 0x86, 0xac, 0x5c, 0xa9, 0x69, 0x55, 0x84, 0x79, 0x0b, 0xc6, 0xca, 0x77,
+	0xce, 0xb0, 0x22, 0x0b, 0x4f, 0xf4, 0xdd, 0x26, 0x1b, 0xf0, 0xaa, 0x46, 0xca, 0x6f, 0x68, 0x04,
+	0x7d, 0xef, 0x0c, 0x1b, 0xf0, 0xaa, 0x46, 0x24, 0x5f, 0xdf, 0x6f, 0x32, 0x43, 0xae, 0xfa, 0xf0,
+	0x0c, 0x9b, 0xb4, 0x94, 0xff, 0xa0, 0x89, 0xb3, 0x90, 0xdc, 0xae, 0x18, 0xe8, 0x23, 0x3e, 0x1a,
+	0x0b, 0x51, 0xf4, 0x43, 0xc4, 0x84, 0x2d, 0xdd, 0x40, 0x3f, 0x62, 0xc2, 0xb4, 0xd1, 0x6e, 0x56,
+	0x75, 0xf4, 0x14, 0x9b, 0xdc, 0x25, 0xbd, 0x51, 0xd3, 0x0d, 0x72, 0x1d, 0xfd, 0x98, 0x9b, 0xbf,
+	0xd9, 0x6a, 0xd4, 0xd1, 0xc7, 0x08, 0x17, 0x01, 0xf4, 0xb7, 0x9a, 0x44, 0x6f, 0xb5, 0x2a, 0x8d,
+	0x3a, 0x7a, 0x76, 0x63, 0x17, 0xd0, 0xe1, 0x74, 0xc0, 0x00, 0xb4, 0xeb, 0x97, 0xeb, 0x8d, 0x6b,
+	0x75, 0x74, 0x8c, 0x55, 0x9a, 0x44, 0x6f, 0x6a, 0x44, 0x47, 0x0a, 0x06, 0xc8, 0x88, 0x17, 0x6e,
+	0x28, 0x81, 0xe7, 0x21, 0x4b, 0x1a, 0xd5, 0xea, 0xb6, 0x56, 0xbe, 0x8c, 0x92, 0xdb, 0x8b, 0xb0,
+	0x60, 0xbb, 0x9b, 0xb7, 0xec, 0x80, 0xfa, 0xbe, 0x78, 0x0e, 0xbd, 0x97, 0xe1, 0x3f, 0x67, 0xff,
+	0x31, 0x00, 0x81, 0xa1, 0x7e, 0x4a, 0x48, 0x2d, 0x00, 0x00,
 }
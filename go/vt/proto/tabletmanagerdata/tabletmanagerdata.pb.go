@@ -1645,6 +1645,17 @@ func (m *BackupResponse) GetEvent() *logutil.Event {
 }
 
 type RestoreFromBackupRequest struct {
+	// restore_to_pos is the replication position to recover to.
+	// The latest backup before it is restored, and the binlogs are
+	// replayed up to it. If it is not set, nor restore_to_timestamp,
+	// the latest backup is restored.
+	RestoreToPos string `protobuf:"bytes,1,opt,name=restore_to_pos,json=restoreToPos" json:"restore_to_pos,omitempty"`
+	// restore_to_timestamp is the time to recover to, in seconds
+	// since the epoch. It can't be set with restore_to_pos.
+	RestoreToTimestamp int64 `protobuf:"varint,2,opt,name=restore_to_timestamp,json=restoreToTimestamp" json:"restore_to_timestamp,omitempty"`
+	// binlog_source is the host:port of the mysqld to replay the
+	// binlogs from. It defaults to the master of the shard.
+	BinlogSource string `protobuf:"bytes,3,opt,name=binlog_source,json=binlogSource" json:"binlog_source,omitempty"`
 }
 
 func (m *RestoreFromBackupRequest) Reset()                    { *m = RestoreFromBackupRequest{} }
@@ -1652,6 +1663,27 @@ func (m *RestoreFromBackupRequest) String() string            { return proto.Com
 func (*RestoreFromBackupRequest) ProtoMessage()               {}
 func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
+func (m *RestoreFromBackupRequest) GetRestoreToPos() string {
+	if m != nil {
+		return m.RestoreToPos
+	}
+	return ""
+}
+
+func (m *RestoreFromBackupRequest) GetRestoreToTimestamp() int64 {
+	if m != nil {
+		return m.RestoreToTimestamp
+	}
+	return 0
+}
+
+func (m *RestoreFromBackupRequest) GetBinlogSource() string {
+	if m != nil {
+		return m.BinlogSource
+	}
+	return ""
+}
+
 type RestoreFromBackupResponse struct {
 	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
 }
@@ -1765,134 +1797,137 @@ func init() {
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2049 bytes of a gzipped FileDescriptorProto
+	// 2099 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0xf5, 0x07, 0x45, 0x49, 0x96, 0x0e, 0x2f, 0x22, 0x97, 0xba, 0x50, 0x0a, 0xfe, 0xba, 0xac, 0x9d,
-	0x7f, 0x54, 0x17, 0x55, 0x6a, 0x25, 0x0d, 0x82, 0x04, 0x29, 0xaa, 0xab, 0xed, 0xc4, 0x89, 0x95,
-	0x95, 0x2f, 0x45, 0x5f, 0x16, 0x43, 0xee, 0x11, 0xb9, 0xd0, 0x72, 0x77, 0x3d, 0x33, 0x2b, 0x89,
-	0x40, 0xd1, 0x8f, 0xd0, 0xb7, 0xbe, 0xf5, 0xad, 0x40, 0xfb, 0xde, 0x0f, 0x93, 0xa2, 0x9f, 0xa4,
-	0x0f, 0x7d, 0x29, 0xe6, 0x46, 0xce, 0x92, 0x94, 0x4d, 0x19, 0x2e, 0xd0, 0x17, 0x81, 0xe7, 0x37,
-	0xe7, 0x3e, 0x67, 0xce, 0x39, 0x0b, 0xc1, 0x1a, 0x27, 0xad, 0x08, 0x79, 0x8f, 0xc4, 0xa4, 0x83,
-	0x34, 0x20, 0x9c, 0xec, 0xa5, 0x34, 0xe1, 0x89, 0x53, 0x1f, 0x3b, 0xd8, 0x28, 0xbd, 0xc9, 0x90,
-	0xf6, 0xd5, 0xf9, 0x46, 0x95, 0x27, 0x69, 0x32, 0xe4, 0xdf, 0x58, 0xa1, 0x98, 0x46, 0x61, 0x9b,
-	0xf0, 0x30, 0x89, 0x2d, 0xb8, 0x12, 0x25, 0x9d, 0x8c, 0x87, 0x91, 0x22, 0xdd, 0x7f, 0x16, 0x60,
-	0xe9, 0x85, 0x50, 0x7c, 0x8c, 0x17, 0x61, 0x1c, 0x0a, 0x66, 0xc7, 0x81, 0xd9, 0x98, 0xf4, 0xb0,
-	0x59, 0xd8, 0x2e, 0xec, 0x2e, 0x7a, 0xf2, 0xb7, 0xb3, 0x0a, 0xf3, 0xac, 0xdd, 0xc5, 0x1e, 0x69,
-	0xce, 0x48, 0x54, 0x53, 0x4e, 0x13, 0xee, 0xb5, 0x93, 0x28, 0xeb, 0xc5, 0xac, 0x59, 0xdc, 0x2e,
-	0xee, 0x2e, 0x7a, 0x86, 0x74, 0xf6, 0xa0, 0x91, 0xd2, 0xb0, 0x47, 0x68, 0xdf, 0xbf, 0xc4, 0xbe,
-	0x6f, 0xb8, 0x66, 0x25, 0x57, 0x5d, 0x1f, 0x7d, 0x87, 0xfd, 0x23, 0xcd, 0xef, 0xc0, 0x2c, 0xef,
-	0xa7, 0xd8, 0x9c, 0x53, 0x56, 0xc5, 0x6f, 0x67, 0x0b, 0x4a, 0xc2, 0x75, 0x3f, 0xc2, 0xb8, 0xc3,
-	0xbb, 0xcd, 0xf9, 0xed, 0xc2, 0xee, 0xac, 0x07, 0x02, 0x7a, 0x26, 0x11, 0xe7, 0x23, 0x58, 0xa4,
-	0xc9, 0xb5, 0xdf, 0x4e, 0xb2, 0x98, 0x37, 0xef, 0xc9, 0xe3, 0x05, 0x9a, 0x5c, 0x1f, 0x09, 0xda,
-	0xfd, 0x6b, 0x01, 0x6a, 0xe7, 0xd2, 0x4d, 0x2b, 0xb8, 0x4f, 0x60, 0x49, 0xc8, 0xb7, 0x08, 0x43,
-	0x5f, 0x47, 0xa4, 0xe2, 0xac, 0x1a, 0x58, 0x89, 0x38, 0xcf, 0x41, 0x65, 0xdc, 0x0f, 0x06, 0xc2,
-	0xac, 0x39, 0xb3, 0x5d, 0xdc, 0x2d, 0xed, 0xbb, 0x7b, 0xe3, 0x97, 0x34, 0x92, 0x44, 0xaf, 0xc6,
-	0xf3, 0x00, 0x13, 0xa9, 0xba, 0x42, 0xca, 0xc2, 0x24, 0x6e, 0x16, 0xa5, 0x45, 0x43, 0x0a, 0x47,
-	0x1d, 0x65, 0xf5, 0xa8, 0x4b, 0xe2, 0x0e, 0x7a, 0xc8, 0xb2, 0x88, 0x3b, 0x4f, 0xa0, 0xd2, 0xc2,
-	0x8b, 0x84, 0xe6, 0x1c, 0x2d, 0xed, 0xdf, 0x9f, 0x60, 0x7d, 0x34, 0x4c, 0xaf, 0xac, 0x24, 0x75,
-	0x2c, 0xa7, 0x50, 0x26, 0x17, 0x1c, 0xa9, 0x6f, 0xdd, 0xe1, 0x94, 0x8a, 0x4a, 0x52, 0x50, 0xc1,
-	0xee, 0xbf, 0x0a, 0x50, 0x7d, 0xc9, 0x90, 0x9e, 0x21, 0xed, 0x85, 0x8c, 0xe9, 0x62, 0xe9, 0x26,
-	0x8c, 0x9b, 0x62, 0x11, 0xbf, 0x05, 0x96, 0x31, 0xa4, 0xba, 0x54, 0xe4, 0x6f, 0xe7, 0xe7, 0x50,
-	0x4f, 0x09, 0x63, 0xd7, 0x09, 0x0d, 0xfc, 0x76, 0x17, 0xdb, 0x97, 0x2c, 0xeb, 0xc9, 0x3c, 0xcc,
-	0x7a, 0x35, 0x73, 0x70, 0xa4, 0x71, 0xe7, 0x47, 0x80, 0x94, 0x86, 0x57, 0x61, 0x84, 0x1d, 0x54,
-	0x25, 0x53, 0xda, 0x7f, 0x34, 0xc1, 0xdb, 0xbc, 0x2f, 0x7b, 0x67, 0x03, 0x99, 0x93, 0x98, 0xd3,
-	0xbe, 0x67, 0x29, 0xd9, 0xf8, 0x06, 0x96, 0x46, 0x8e, 0x9d, 0x1a, 0x14, 0x2f, 0xb1, 0xaf, 0x3d,
-	0x17, 0x3f, 0x9d, 0x65, 0x98, 0xbb, 0x22, 0x51, 0x86, 0xda, 0x73, 0x45, 0x7c, 0x35, 0xf3, 0x65,
-	0xc1, 0xfd, 0xa9, 0x00, 0xe5, 0xe3, 0xd6, 0x3b, 0xe2, 0xae, 0xc2, 0x4c, 0xd0, 0xd2, 0xb2, 0x33,
-	0x41, 0x6b, 0x90, 0x87, 0xa2, 0x95, 0x87, 0xe7, 0x13, 0x42, 0xfb, 0x74, 0x42, 0x68, 0xb6, 0xb1,
-	0xff, 0x66, 0x60, 0x7f, 0x29, 0x40, 0x69, 0x68, 0x89, 0x39, 0xcf, 0xa0, 0x26, 0xfc, 0xf4, 0xd3,
-	0x21, 0xd6, 0x2c, 0x48, 0x2f, 0x77, 0xde, 0x79, 0x01, 0xde, 0x52, 0x96, 0xa3, 0x99, 0x73, 0x0a,
-	0xd5, 0xa0, 0x95, 0xd3, 0xa5, 0x5e, 0xd0, 0xd6, 0x3b, 0x22, 0xf6, 0x2a, 0x81, 0x45, 0x31, 0xf7,
-	0x6b, 0x28, 0x1d, 0x46, 0xe9, 0x59, 0xc2, 0xd4, 0x23, 0xae, 0x41, 0x31, 0x0b, 0x03, 0x19, 0x60,
-	0xc5, 0x13, 0x3f, 0x9d, 0x0d, 0x58, 0x48, 0xf5, 0xa9, 0x8e, 0x71, 0x40, 0xbb, 0x9f, 0x40, 0xe9,
-	0x2c, 0x8c, 0x3b, 0x1e, 0xbe, 0xc9, 0x90, 0x71, 0xf1, 0x0e, 0x53, 0xd2, 0x8f, 0x12, 0x12, 0xe8,
-	0x0c, 0x19, 0xd2, 0xdd, 0x85, 0xb2, 0x62, 0x64, 0x69, 0x12, 0x33, 0x7c, 0x0b, 0xe7, 0x43, 0x28,
-	0x9f, 0x47, 0x88, 0xa9, 0xd1, 0xb9, 0x01, 0x0b, 0x41, 0x46, 0x65, 0xaf, 0x95, 0xac, 0x45, 0x6f,
-	0x40, 0xbb, 0x4b, 0x50, 0xd1, 0xbc, 0x4a, 0xad, 0xfb, 0x8f, 0x02, 0x38, 0x27, 0x37, 0xd8, 0xce,
-	0x38, 0x3e, 0x49, 0x92, 0x4b, 0xa3, 0x63, 0x52, 0xdb, 0xdd, 0x04, 0x48, 0x09, 0x25, 0x3d, 0xe4,
-	0x48, 0x55, 0xee, 0x16, 0x3d, 0x0b, 0x71, 0xce, 0x60, 0x11, 0x6f, 0x38, 0x25, 0x3e, 0xc6, 0x57,
-	0xb2, 0x01, 0x97, 0xf6, 0x3f, 0x9b, 0x90, 0xda, 0x71, 0x6b, 0x7b, 0x27, 0x42, 0xec, 0x24, 0xbe,
-	0x52, 0x05, 0xb5, 0x80, 0x9a, 0xdc, 0xf8, 0x1a, 0x2a, 0xb9, 0xa3, 0x3b, 0x15, 0xd3, 0x05, 0x34,
-	0x72, 0xa6, 0x74, 0x1e, 0xb7, 0xa0, 0x84, 0x37, 0x21, 0xf7, 0x19, 0x27, 0x3c, 0x63, 0x3a, 0x41,
-	0x20, 0xa0, 0x73, 0x89, 0xc8, 0xe9, 0xc2, 0x83, 0x24, 0xe3, 0x83, 0xe9, 0x22, 0x29, 0x8d, 0x23,
-	0x35, 0x4f, 0x48, 0x53, 0xee, 0x15, 0xd4, 0x1e, 0x23, 0x57, 0x4d, 0xc9, 0xa4, 0x6f, 0x15, 0xe6,
-	0x65, 0xe0, 0xaa, 0x5c, 0x17, 0x3d, 0x4d, 0x39, 0xf7, 0xa1, 0x12, 0xc6, 0xed, 0x28, 0x0b, 0xd0,
-	0xbf, 0x0a, 0xf1, 0x9a, 0x49, 0x13, 0x0b, 0x5e, 0x59, 0x83, 0xaf, 0x04, 0xe6, 0x7c, 0x0c, 0x55,
-	0xbc, 0x51, 0x4c, 0x5a, 0x89, 0x9a, 0x66, 0x15, 0x8d, 0xca, 0xee, 0xce, 0x5c, 0x84, 0xba, 0x65,
-	0x57, 0x47, 0x77, 0x06, 0x75, 0xd5, 0x56, 0xad, 0x49, 0x71, 0x97, 0x56, 0x5d, 0x63, 0x23, 0x88,
-	0xbb, 0x06, 0x2b, 0x8f, 0x91, 0x5b, 0xf5, 0xaf, 0x63, 0x74, 0x7f, 0x07, 0xab, 0xa3, 0x07, 0xda,
-	0x89, 0xdf, 0x40, 0x29, 0xff, 0x62, 0x85, 0xf9, 0xcd, 0x09, 0xe6, 0x6d, 0x61, 0x5b, 0xc4, 0x5d,
-	0x06, 0xe7, 0x1c, 0xb9, 0x87, 0x24, 0x78, 0x1e, 0x47, 0x7d, 0x63, 0x71, 0x05, 0x1a, 0x39, 0x54,
-	0x97, 0xf0, 0x10, 0x7e, 0x4d, 0x43, 0x8e, 0x86, 0x7b, 0x15, 0x96, 0xf3, 0xb0, 0x66, 0xff, 0x16,
-	0xea, 0x6a, 0xb2, 0xbd, 0xe8, 0xa7, 0x86, 0xd9, 0xf9, 0x15, 0x94, 0x94, 0x7b, 0xbe, 0x9c, 0xfb,
-	0xc2, 0xe5, 0xea, 0xfe, 0xf2, 0xde, 0x60, 0x8d, 0x91, 0x39, 0xe7, 0x52, 0x02, 0xf8, 0xe0, 0xb7,
-	0xf0, 0xd3, 0xd6, 0x35, 0x74, 0xc8, 0xc3, 0x0b, 0x8a, 0xac, 0x2b, 0x4a, 0xca, 0x76, 0x28, 0x0f,
-	0x6b, 0xf6, 0x35, 0x58, 0xf1, 0xb2, 0xf8, 0x09, 0x92, 0x88, 0x77, 0xe5, 0xd4, 0x31, 0x02, 0x4d,
-	0x58, 0x1d, 0x3d, 0xd0, 0x22, 0x9f, 0x43, 0xf3, 0x69, 0x27, 0x4e, 0x28, 0xaa, 0xc3, 0x13, 0x4a,
-	0x13, 0x9a, 0x6b, 0x29, 0x9c, 0x23, 0x8d, 0x87, 0x8d, 0x42, 0x92, 0xee, 0x47, 0xb0, 0x3e, 0x41,
-	0x4a, 0xab, 0xfc, 0x4a, 0x38, 0x2d, 0xfa, 0x49, 0xbe, 0x92, 0xef, 0x43, 0xe5, 0x9a, 0x84, 0xdc,
-	0x1f, 0x34, 0x34, 0xa5, 0xb3, 0x2c, 0x40, 0xd3, 0x02, 0x55, 0x64, 0xb6, 0xac, 0xd6, 0xb9, 0x0f,
-	0xab, 0x67, 0x14, 0x2f, 0xa2, 0xb0, 0xd3, 0x1d, 0x79, 0x20, 0x62, 0x55, 0x93, 0x89, 0x33, 0x2f,
-	0xc4, 0x90, 0x6e, 0x07, 0xd6, 0xc6, 0x64, 0x74, 0x5d, 0x3d, 0x83, 0xaa, 0xe2, 0xf2, 0xa9, 0x5c,
-	0x4a, 0xcc, 0x30, 0xf8, 0xf8, 0xd6, 0xca, 0xb6, 0x57, 0x18, 0xaf, 0xd2, 0xb6, 0x28, 0xe6, 0xfe,
-	0xbb, 0x00, 0xce, 0x41, 0x9a, 0x46, 0xfd, 0xbc, 0x67, 0x35, 0x28, 0xb2, 0x37, 0x91, 0x69, 0x31,
-	0xec, 0x4d, 0x24, 0x5a, 0xcc, 0x45, 0x42, 0xdb, 0xa8, 0x1f, 0xab, 0x22, 0xc4, 0x0e, 0x41, 0xa2,
-	0x28, 0xb9, 0xf6, 0xad, 0xd5, 0x56, 0x76, 0x86, 0x05, 0xaf, 0x26, 0x0f, 0xbc, 0x21, 0x3e, 0xbe,
-	0x3d, 0xcd, 0x7e, 0xa8, 0xed, 0x69, 0xee, 0x3d, 0xb7, 0xa7, 0xbf, 0x15, 0xa0, 0x91, 0x8b, 0x5e,
-	0xe7, 0xf8, 0x7f, 0x6f, 0xcf, 0xfb, 0x7b, 0x01, 0x9a, 0xba, 0x91, 0x9f, 0x22, 0x6f, 0x77, 0x0f,
-	0xd8, 0x71, 0x6b, 0x70, 0x5b, 0xcb, 0x30, 0x27, 0xbf, 0x3b, 0xa4, 0x9b, 0x65, 0x4f, 0x11, 0xce,
-	0x1a, 0xdc, 0x0b, 0x5a, 0xbe, 0x1c, 0x60, 0xba, 0x87, 0x07, 0xad, 0x1f, 0xc4, 0x08, 0x5b, 0x87,
-	0x85, 0x1e, 0xb9, 0xf1, 0x69, 0x72, 0xcd, 0xf4, 0xbe, 0x77, 0xaf, 0x47, 0x6e, 0xbc, 0xe4, 0x9a,
-	0xc9, 0x5d, 0x3c, 0x64, 0x72, 0xc9, 0x6e, 0x85, 0x71, 0x94, 0x74, 0x98, 0xbc, 0xa4, 0x05, 0xaf,
-	0xaa, 0xe1, 0x43, 0x85, 0x8a, 0x17, 0x41, 0x65, 0xb1, 0xdb, 0x57, 0xb0, 0xe0, 0x95, 0xa9, 0xf5,
-	0x02, 0xdc, 0xc7, 0xb0, 0x3e, 0xc1, 0x67, 0x9d, 0xe3, 0x87, 0x30, 0xaf, 0x0a, 0x58, 0x27, 0xd7,
-	0xd9, 0x53, 0xdf, 0x4e, 0x3f, 0x8a, 0xbf, 0xba, 0x58, 0x35, 0x87, 0xfb, 0xc7, 0x02, 0xfc, 0x5f,
-	0x5e, 0xd3, 0x41, 0x14, 0x89, 0x1d, 0x8b, 0x7d, 0xf8, 0x14, 0x8c, 0x45, 0x36, 0x3b, 0x21, 0xb2,
-	0x67, 0xb0, 0x79, 0x9b, 0x3f, 0xef, 0x11, 0xde, 0x77, 0xa3, 0x77, 0x7b, 0x90, 0xa6, 0x6f, 0x0f,
-	0xcc, 0xf6, 0x7f, 0x26, 0xe7, 0xff, 0x78, 0xd2, 0xa5, 0xb2, 0xf7, 0xf0, 0x4a, 0x8c, 0x9f, 0x88,
-	0x5c, 0xa1, 0xda, 0x08, 0x4c, 0x3b, 0x3e, 0x85, 0x46, 0x0e, 0xd5, 0x8a, 0x3f, 0x15, 0x7b, 0xc1,
-	0x60, 0x97, 0x28, 0xed, 0xaf, 0xed, 0x8d, 0x7e, 0xec, 0x6a, 0x01, 0xcd, 0x26, 0xfa, 0xfd, 0xf7,
-	0x84, 0x71, 0xa4, 0xa6, 0x7f, 0x1a, 0x03, 0x9f, 0xc3, 0xea, 0xe8, 0x81, 0xb6, 0x61, 0x6f, 0x94,
-	0x85, 0x91, 0x8d, 0xd2, 0x81, 0xda, 0x39, 0x4f, 0x52, 0xe9, 0x9a, 0xd1, 0xd4, 0x80, 0xba, 0x85,
-	0xe9, 0x6e, 0xfc, 0x5b, 0x58, 0x1b, 0x80, 0xdf, 0x87, 0x71, 0xd8, 0xcb, 0x7a, 0xd6, 0xca, 0x78,
-	0x9b, 0x7e, 0x67, 0x07, 0x64, 0xb3, 0xf7, 0x79, 0xd8, 0x43, 0xb3, 0x15, 0x15, 0xbd, 0x92, 0xc0,
-	0x5e, 0x28, 0xc8, 0xfd, 0x02, 0x9a, 0xe3, 0x9a, 0xa7, 0x70, 0x5d, 0xba, 0x49, 0x28, 0xcf, 0xf9,
-	0x2e, 0x92, 0x6f, 0x81, 0xda, 0xf9, 0x63, 0xd8, 0x51, 0x33, 0xf8, 0xe4, 0x46, 0xcc, 0x32, 0x12,
-	0x89, 0x05, 0x20, 0x25, 0x14, 0x63, 0x8e, 0x81, 0x09, 0x43, 0xee, 0x76, 0xea, 0xd8, 0x0f, 0xcd,
-	0x9e, 0x0c, 0x06, 0x7a, 0x1a, 0xb8, 0x0f, 0xc0, 0x7d, 0x9b, 0x16, 0x6d, 0x6b, 0x1b, 0x36, 0x47,
-	0xb9, 0x4e, 0x22, 0x6c, 0x0f, 0x0d, 0xb9, 0x3b, 0xb0, 0x75, 0x2b, 0x87, 0x56, 0xe2, 0xa8, 0xb5,
-	0x50, 0x04, 0x31, 0xa8, 0xa0, 0x9f, 0xa9, 0x95, 0x4d, 0x63, 0x3a, 0x41, 0xcb, 0x30, 0x47, 0x82,
-	0x80, 0x9a, 0x41, 0xa8, 0x08, 0xf7, 0x0f, 0xb0, 0xfa, 0x9a, 0x84, 0xdc, 0xfa, 0xd0, 0x30, 0x41,
-	0x1e, 0x40, 0xb9, 0x15, 0xa5, 0xf9, 0x81, 0x3c, 0x79, 0xbd, 0xb2, 0x85, 0x4b, 0x2d, 0xeb, 0x93,
-	0x65, 0x8a, 0x2b, 0x5d, 0x87, 0xb5, 0x31, 0xfb, 0x3a, 0xb2, 0x1a, 0x54, 0xc5, 0x6d, 0x1f, 0x46,
-	0xe6, 0xa5, 0xba, 0xaf, 0x60, 0x69, 0x80, 0xe8, 0xa8, 0x8e, 0xa0, 0x62, 0x7b, 0x69, 0x46, 0xf5,
-	0xbb, 0xdc, 0x2c, 0x5b, 0x6e, 0x32, 0xb7, 0x2e, 0xf4, 0x12, 0xca, 0x2d, 0x53, 0xb2, 0xda, 0x0d,
-	0xa4, 0x1d, 0xfa, 0x3d, 0x38, 0x5e, 0x16, 0x1f, 0x46, 0xe9, 0xcb, 0x98, 0x87, 0x91, 0xc9, 0xd3,
-	0x87, 0xf0, 0x60, 0x9a, 0x4c, 0x3d, 0x82, 0x46, 0xce, 0xfa, 0x14, 0x75, 0xbf, 0x0e, 0x6b, 0x1e,
-	0x32, 0xb1, 0x9c, 0x0e, 0x1a, 0x85, 0x89, 0x6f, 0x03, 0x9a, 0xe3, 0x47, 0x3a, 0xce, 0x06, 0xd4,
-	0x9f, 0xc6, 0x21, 0x57, 0x3d, 0xc2, 0x08, 0xfc, 0x12, 0x1c, 0x1b, 0x9c, 0xc2, 0xfa, 0x4f, 0x05,
-	0xd8, 0x3c, 0x4b, 0xd2, 0x2c, 0x92, 0x4b, 0xa8, 0xaa, 0xfe, 0x6f, 0x93, 0x4c, 0x94, 0xb1, 0xc9,
-	0xdd, 0xff, 0xc3, 0x92, 0x88, 0xd8, 0x6f, 0x53, 0x24, 0x1c, 0x03, 0x3f, 0x36, 0x1f, 0x4a, 0x15,
-	0x01, 0x1f, 0x29, 0xf4, 0x07, 0x26, 0x1e, 0x1c, 0x69, 0x0b, 0xa5, 0xf6, 0xa4, 0x01, 0x05, 0xc9,
-	0x69, 0xf3, 0x25, 0x94, 0x7b, 0xd2, 0x33, 0x9f, 0x44, 0x21, 0x51, 0x13, 0xa7, 0xb4, 0xbf, 0x32,
-	0xba, 0x58, 0x1f, 0x88, 0x43, 0xaf, 0xa4, 0x58, 0x25, 0xe1, 0x3c, 0x82, 0x65, 0xab, 0x8f, 0x0e,
-	0xcb, 0x7d, 0x56, 0xda, 0x68, 0x58, 0x67, 0x83, 0x35, 0x74, 0x07, 0xb6, 0x6e, 0x8d, 0x4b, 0xa7,
-	0xf0, 0xcf, 0x05, 0xa8, 0x89, 0x74, 0xd9, 0x1d, 0xc7, 0xf9, 0x05, 0xcc, 0x2b, 0x6e, 0xfd, 0x96,
-	0x6e, 0x71, 0x4f, 0x33, 0xdd, 0xea, 0xd9, 0xcc, 0xad, 0x9e, 0x4d, 0xca, 0x67, 0x71, 0x42, 0x3e,
-	0xcd, 0x0d, 0xe7, 0x5b, 0xdf, 0x0a, 0x34, 0x8e, 0xb1, 0x97, 0x70, 0xcc, 0x5f, 0xfc, 0x3e, 0x2c,
-	0xe7, 0xe1, 0x29, 0xae, 0xfe, 0x1b, 0xd8, 0x3a, 0xa3, 0x89, 0x10, 0x92, 0x26, 0x5e, 0x77, 0x31,
-	0x3e, 0x22, 0x59, 0xa7, 0xcb, 0x5f, 0xa6, 0x53, 0x8c, 0x02, 0xf7, 0xd7, 0xb0, 0x7d, 0xbb, 0xf8,
-	0x74, 0x75, 0xaf, 0x04, 0x09, 0xd3, 0x7a, 0x02, 0xab, 0xee, 0xc7, 0x8f, 0x74, 0x02, 0xfe, 0x54,
-	0x80, 0xda, 0x39, 0xe6, 0xeb, 0xfe, 0xae, 0x97, 0x36, 0xe1, 0x06, 0x66, 0x26, 0x55, 0xf4, 0x43,
-	0xa8, 0xcb, 0xfd, 0xde, 0x67, 0xa2, 0xcb, 0xf8, 0x4c, 0xf8, 0xa4, 0xd7, 0xfa, 0x25, 0x79, 0x30,
-	0x9c, 0x4d, 0x72, 0x7c, 0xe1, 0xc8, 0xcb, 0x73, 0x9f, 0x0e, 0x03, 0xf1, 0x50, 0x2a, 0x19, 0xce,
-	0xa7, 0xbb, 0xf9, 0x2c, 0xbe, 0xd7, 0x26, 0xa8, 0xd2, 0x76, 0x1e, 0x80, 0x2b, 0x7a, 0xae, 0xd5,
-	0x27, 0x0e, 0xe2, 0x40, 0x4c, 0x97, 0xdc, 0xce, 0xf2, 0x0a, 0xee, 0xbf, 0x95, 0xeb, 0x7d, 0x77,
-	0x98, 0x15, 0x68, 0xd8, 0x95, 0x60, 0xd5, 0x64, 0x1e, 0x9e, 0xa2, 0x28, 0x1e, 0x41, 0xe5, 0x90,
-	0xb4, 0x2f, 0xb3, 0x41, 0x05, 0x6e, 0x43, 0xa9, 0x9d, 0xc4, 0xed, 0x8c, 0x52, 0x8c, 0xdb, 0x7d,
-	0xdd, 0x78, 0x6c, 0xc8, 0xfd, 0x02, 0xaa, 0x46, 0x44, 0x1b, 0x78, 0x00, 0x73, 0x78, 0x35, 0x4c,
-	0x6c, 0x75, 0xcf, 0xfc, 0x67, 0xe1, 0x44, 0xa0, 0x9e, 0x3a, 0xd4, 0xcd, 0x95, 0x27, 0x14, 0x4f,
-	0x69, 0xd2, 0xcb, 0x59, 0x75, 0x0f, 0x60, 0x7d, 0xc2, 0xd9, 0x5d, 0xd4, 0xb7, 0xe6, 0xe5, 0xbf,
-	0x31, 0x3e, 0xfb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x67, 0xfd, 0x4a, 0x37, 0x19, 0x00,
-	0x00,
+	0x15, 0xc6, 0x8a, 0x92, 0x2c, 0x1d, 0x5e, 0x44, 0x2e, 0x75, 0xa1, 0x14, 0xd4, 0x92, 0xd6, 0x4e,
+	0xa3, 0xba, 0xa8, 0x12, 0x2b, 0x69, 0x10, 0x24, 0x48, 0x51, 0x5d, 0x6d, 0x27, 0x4e, 0xcc, 0xac,
+	0x64, 0xbb, 0xe8, 0xcb, 0x62, 0xc8, 0x1d, 0x91, 0x0b, 0x2d, 0x77, 0xd6, 0x33, 0xb3, 0x92, 0x08,
+	0x14, 0xfd, 0x09, 0x7d, 0x2b, 0xfa, 0xd2, 0xb7, 0x02, 0xed, 0x7b, 0x7f, 0x4c, 0x8a, 0xfe, 0x92,
+	0x3e, 0xf4, 0xa5, 0x98, 0x1b, 0x39, 0x4b, 0x52, 0x32, 0x2d, 0x18, 0x45, 0x5e, 0x0c, 0x9e, 0x6f,
+	0xce, 0x7d, 0xce, 0x9c, 0x73, 0xd6, 0x82, 0x35, 0x8e, 0x5a, 0x31, 0xe6, 0x3d, 0x94, 0xa0, 0x0e,
+	0xa6, 0x21, 0xe2, 0x68, 0x37, 0xa5, 0x84, 0x13, 0xb7, 0x36, 0x76, 0xb0, 0x51, 0x7c, 0x93, 0x61,
+	0xda, 0x57, 0xe7, 0x1b, 0x15, 0x4e, 0x52, 0x32, 0xe4, 0xdf, 0x58, 0xa1, 0x38, 0x8d, 0xa3, 0x36,
+	0xe2, 0x11, 0x49, 0x2c, 0xb8, 0x1c, 0x93, 0x4e, 0xc6, 0xa3, 0x58, 0x91, 0xde, 0xbf, 0x1d, 0x58,
+	0x3a, 0x13, 0x8a, 0x8f, 0xf0, 0x79, 0x94, 0x44, 0x82, 0xd9, 0x75, 0x61, 0x36, 0x41, 0x3d, 0xdc,
+	0x70, 0xb6, 0x9c, 0x9d, 0x45, 0x5f, 0xfe, 0x76, 0x57, 0x61, 0x9e, 0xb5, 0xbb, 0xb8, 0x87, 0x1a,
+	0x33, 0x12, 0xd5, 0x94, 0xdb, 0x80, 0x7b, 0x6d, 0x12, 0x67, 0xbd, 0x84, 0x35, 0x0a, 0x5b, 0x85,
+	0x9d, 0x45, 0xdf, 0x90, 0xee, 0x2e, 0xd4, 0x53, 0x1a, 0xf5, 0x10, 0xed, 0x07, 0x17, 0xb8, 0x1f,
+	0x18, 0xae, 0x59, 0xc9, 0x55, 0xd3, 0x47, 0xdf, 0xe2, 0xfe, 0xa1, 0xe6, 0x77, 0x61, 0x96, 0xf7,
+	0x53, 0xdc, 0x98, 0x53, 0x56, 0xc5, 0x6f, 0x77, 0x13, 0x8a, 0xc2, 0xf5, 0x20, 0xc6, 0x49, 0x87,
+	0x77, 0x1b, 0xf3, 0x5b, 0xce, 0xce, 0xac, 0x0f, 0x02, 0x7a, 0x2e, 0x11, 0xf7, 0x03, 0x58, 0xa4,
+	0xe4, 0x2a, 0x68, 0x93, 0x2c, 0xe1, 0x8d, 0x7b, 0xf2, 0x78, 0x81, 0x92, 0xab, 0x43, 0x41, 0x7b,
+	0x7f, 0x77, 0xa0, 0x7a, 0x2a, 0xdd, 0xb4, 0x82, 0xfb, 0x08, 0x96, 0x84, 0x7c, 0x0b, 0x31, 0x1c,
+	0xe8, 0x88, 0x54, 0x9c, 0x15, 0x03, 0x2b, 0x11, 0xf7, 0x05, 0xa8, 0x8c, 0x07, 0xe1, 0x40, 0x98,
+	0x35, 0x66, 0xb6, 0x0a, 0x3b, 0xc5, 0x3d, 0x6f, 0x77, 0xfc, 0x92, 0x46, 0x92, 0xe8, 0x57, 0x79,
+	0x1e, 0x60, 0x22, 0x55, 0x97, 0x98, 0xb2, 0x88, 0x24, 0x8d, 0x82, 0xb4, 0x68, 0x48, 0xe1, 0xa8,
+	0xab, 0xac, 0x1e, 0x76, 0x51, 0xd2, 0xc1, 0x3e, 0x66, 0x59, 0xcc, 0xdd, 0xa7, 0x50, 0x6e, 0xe1,
+	0x73, 0x42, 0x73, 0x8e, 0x16, 0xf7, 0x1e, 0x4c, 0xb0, 0x3e, 0x1a, 0xa6, 0x5f, 0x52, 0x92, 0x3a,
+	0x96, 0x13, 0x28, 0xa1, 0x73, 0x8e, 0x69, 0x60, 0xdd, 0xe1, 0x94, 0x8a, 0x8a, 0x52, 0x50, 0xc1,
+	0xde, 0x7f, 0x1c, 0xa8, 0xbc, 0x64, 0x98, 0x36, 0x31, 0xed, 0x45, 0x8c, 0xe9, 0x62, 0xe9, 0x12,
+	0xc6, 0x4d, 0xb1, 0x88, 0xdf, 0x02, 0xcb, 0x18, 0xa6, 0xba, 0x54, 0xe4, 0x6f, 0xf7, 0x97, 0x50,
+	0x4b, 0x11, 0x63, 0x57, 0x84, 0x86, 0x41, 0xbb, 0x8b, 0xdb, 0x17, 0x2c, 0xeb, 0xc9, 0x3c, 0xcc,
+	0xfa, 0x55, 0x73, 0x70, 0xa8, 0x71, 0xf7, 0x07, 0x80, 0x94, 0x46, 0x97, 0x51, 0x8c, 0x3b, 0x58,
+	0x95, 0x4c, 0x71, 0xef, 0xf1, 0x04, 0x6f, 0xf3, 0xbe, 0xec, 0x36, 0x07, 0x32, 0xc7, 0x09, 0xa7,
+	0x7d, 0xdf, 0x52, 0xb2, 0xf1, 0x35, 0x2c, 0x8d, 0x1c, 0xbb, 0x55, 0x28, 0x5c, 0xe0, 0xbe, 0xf6,
+	0x5c, 0xfc, 0x74, 0x97, 0x61, 0xee, 0x12, 0xc5, 0x19, 0xd6, 0x9e, 0x2b, 0xe2, 0xcb, 0x99, 0x2f,
+	0x1c, 0xef, 0x47, 0x07, 0x4a, 0x47, 0xad, 0xb7, 0xc4, 0x5d, 0x81, 0x99, 0xb0, 0xa5, 0x65, 0x67,
+	0xc2, 0xd6, 0x20, 0x0f, 0x05, 0x2b, 0x0f, 0x2f, 0x26, 0x84, 0xf6, 0xf1, 0x84, 0xd0, 0x8e, 0x5a,
+	0xff, 0x9f, 0xc0, 0xfe, 0xe6, 0x40, 0x71, 0x68, 0x89, 0xb9, 0xcf, 0xa1, 0x2a, 0xfc, 0x0c, 0xd2,
+	0x21, 0xd6, 0x70, 0xa4, 0x97, 0xdb, 0x6f, 0xbd, 0x00, 0x7f, 0x29, 0xcb, 0xd1, 0xcc, 0x3d, 0x81,
+	0x4a, 0xd8, 0xca, 0xe9, 0x52, 0x2f, 0x68, 0xf3, 0x2d, 0x11, 0xfb, 0xe5, 0xd0, 0xa2, 0x98, 0xf7,
+	0x15, 0x14, 0x0f, 0xe2, 0xb4, 0x49, 0x98, 0x7a, 0xc4, 0x55, 0x28, 0x64, 0x51, 0x28, 0x03, 0x2c,
+	0xfb, 0xe2, 0xa7, 0xbb, 0x01, 0x0b, 0xa9, 0x3e, 0xd5, 0x31, 0x0e, 0x68, 0xef, 0x23, 0x28, 0x36,
+	0xa3, 0xa4, 0xe3, 0xe3, 0x37, 0x19, 0x66, 0x5c, 0xbc, 0xc3, 0x14, 0xf5, 0x63, 0x82, 0x42, 0x9d,
+	0x21, 0x43, 0x7a, 0x3b, 0x50, 0x52, 0x8c, 0x2c, 0x25, 0x09, 0xc3, 0xb7, 0x70, 0x3e, 0x82, 0xd2,
+	0x69, 0x8c, 0x71, 0x6a, 0x74, 0x6e, 0xc0, 0x42, 0x98, 0x51, 0xd9, 0x6b, 0x25, 0x6b, 0xc1, 0x1f,
+	0xd0, 0xde, 0x12, 0x94, 0x35, 0xaf, 0x52, 0xeb, 0xfd, 0xcb, 0x01, 0xf7, 0xf8, 0x1a, 0xb7, 0x33,
+	0x8e, 0x9f, 0x12, 0x72, 0x61, 0x74, 0x4c, 0x6a, 0xbb, 0xf7, 0x01, 0x52, 0x44, 0x51, 0x0f, 0x73,
+	0x4c, 0x55, 0xee, 0x16, 0x7d, 0x0b, 0x71, 0x9b, 0xb0, 0x88, 0xaf, 0x39, 0x45, 0x01, 0x4e, 0x2e,
+	0x65, 0x03, 0x2e, 0xee, 0x7d, 0x3a, 0x21, 0xb5, 0xe3, 0xd6, 0x76, 0x8f, 0x85, 0xd8, 0x71, 0x72,
+	0xa9, 0x0a, 0x6a, 0x01, 0x6b, 0x72, 0xe3, 0x2b, 0x28, 0xe7, 0x8e, 0xde, 0xa9, 0x98, 0xce, 0xa1,
+	0x9e, 0x33, 0xa5, 0xf3, 0xb8, 0x09, 0x45, 0x7c, 0x1d, 0xf1, 0x80, 0x71, 0xc4, 0x33, 0xa6, 0x13,
+	0x04, 0x02, 0x3a, 0x95, 0x88, 0x9c, 0x2e, 0x3c, 0x24, 0x19, 0x1f, 0x4c, 0x17, 0x49, 0x69, 0x1c,
+	0x53, 0xf3, 0x84, 0x34, 0xe5, 0x5d, 0x42, 0xf5, 0x09, 0xe6, 0xaa, 0x29, 0x99, 0xf4, 0xad, 0xc2,
+	0xbc, 0x0c, 0x5c, 0x95, 0xeb, 0xa2, 0xaf, 0x29, 0xf7, 0x01, 0x94, 0xa3, 0xa4, 0x1d, 0x67, 0x21,
+	0x0e, 0x2e, 0x23, 0x7c, 0xc5, 0xa4, 0x89, 0x05, 0xbf, 0xa4, 0xc1, 0x57, 0x02, 0x73, 0x3f, 0x84,
+	0x0a, 0xbe, 0x56, 0x4c, 0x5a, 0x89, 0x9a, 0x66, 0x65, 0x8d, 0xca, 0xee, 0xce, 0x3c, 0x0c, 0x35,
+	0xcb, 0xae, 0x8e, 0xae, 0x09, 0x35, 0xd5, 0x56, 0xad, 0x49, 0xf1, 0x2e, 0xad, 0xba, 0xca, 0x46,
+	0x10, 0x6f, 0x0d, 0x56, 0x9e, 0x60, 0x6e, 0xd5, 0xbf, 0x8e, 0xd1, 0xfb, 0x3d, 0xac, 0x8e, 0x1e,
+	0x68, 0x27, 0x7e, 0x0b, 0xc5, 0xfc, 0x8b, 0x15, 0xe6, 0xef, 0x4f, 0x30, 0x6f, 0x0b, 0xdb, 0x22,
+	0xde, 0x32, 0xb8, 0xa7, 0x98, 0xfb, 0x18, 0x85, 0x2f, 0x92, 0xb8, 0x6f, 0x2c, 0xae, 0x40, 0x3d,
+	0x87, 0xea, 0x12, 0x1e, 0xc2, 0xaf, 0x69, 0xc4, 0xb1, 0xe1, 0x5e, 0x85, 0xe5, 0x3c, 0xac, 0xd9,
+	0xbf, 0x81, 0x9a, 0x9a, 0x6c, 0x67, 0xfd, 0xd4, 0x30, 0xbb, 0xbf, 0x86, 0xa2, 0x72, 0x2f, 0x90,
+	0x73, 0x5f, 0xb8, 0x5c, 0xd9, 0x5b, 0xde, 0x1d, 0xac, 0x31, 0x32, 0xe7, 0x5c, 0x4a, 0x00, 0x1f,
+	0xfc, 0x16, 0x7e, 0xda, 0xba, 0x86, 0x0e, 0xf9, 0xf8, 0x9c, 0x62, 0xd6, 0x15, 0x25, 0x65, 0x3b,
+	0x94, 0x87, 0x35, 0xfb, 0x1a, 0xac, 0xf8, 0x59, 0xf2, 0x14, 0xa3, 0x98, 0x77, 0xe5, 0xd4, 0x31,
+	0x02, 0x0d, 0x58, 0x1d, 0x3d, 0xd0, 0x22, 0x9f, 0x41, 0xe3, 0x59, 0x27, 0x21, 0x14, 0xab, 0xc3,
+	0x63, 0x4a, 0x09, 0xcd, 0xb5, 0x14, 0xce, 0x31, 0x4d, 0x86, 0x8d, 0x42, 0x92, 0xde, 0x07, 0xb0,
+	0x3e, 0x41, 0x4a, 0xab, 0xfc, 0x52, 0x38, 0x2d, 0xfa, 0x49, 0xbe, 0x92, 0x1f, 0x40, 0xf9, 0x0a,
+	0x45, 0x3c, 0x18, 0x34, 0x34, 0xa5, 0xb3, 0x24, 0x40, 0xd3, 0x02, 0x55, 0x64, 0xb6, 0xac, 0xd6,
+	0xb9, 0x07, 0xab, 0x4d, 0x8a, 0xcf, 0xe3, 0xa8, 0xd3, 0x1d, 0x79, 0x20, 0x62, 0x55, 0x93, 0x89,
+	0x33, 0x2f, 0xc4, 0x90, 0x5e, 0x07, 0xd6, 0xc6, 0x64, 0x74, 0x5d, 0x3d, 0x87, 0x8a, 0xe2, 0x0a,
+	0xa8, 0x5c, 0x4a, 0xcc, 0x30, 0xf8, 0xf0, 0xc6, 0xca, 0xb6, 0x57, 0x18, 0xbf, 0xdc, 0xb6, 0x28,
+	0xe6, 0xfd, 0xd7, 0x01, 0x77, 0x3f, 0x4d, 0xe3, 0x7e, 0xde, 0xb3, 0x2a, 0x14, 0xd8, 0x9b, 0xd8,
+	0xb4, 0x18, 0xf6, 0x26, 0x16, 0x2d, 0xe6, 0x9c, 0xd0, 0x36, 0xd6, 0x8f, 0x55, 0x11, 0x62, 0x87,
+	0x40, 0x71, 0x4c, 0xae, 0x02, 0x6b, 0xb5, 0x95, 0x9d, 0x61, 0xc1, 0xaf, 0xca, 0x03, 0x7f, 0x88,
+	0x8f, 0x6f, 0x4f, 0xb3, 0xef, 0x6b, 0x7b, 0x9a, 0xbb, 0xe3, 0xf6, 0xf4, 0x0f, 0x07, 0xea, 0xb9,
+	0xe8, 0x75, 0x8e, 0x7f, 0x7a, 0x7b, 0xde, 0x3f, 0x1d, 0x68, 0xe8, 0x46, 0x7e, 0x82, 0x79, 0xbb,
+	0xbb, 0xcf, 0x8e, 0x5a, 0x83, 0xdb, 0x5a, 0x86, 0x39, 0xf9, 0xdd, 0x21, 0xdd, 0x2c, 0xf9, 0x8a,
+	0x70, 0xd7, 0xe0, 0x5e, 0xd8, 0x0a, 0xe4, 0x00, 0xd3, 0x3d, 0x3c, 0x6c, 0x7d, 0x2f, 0x46, 0xd8,
+	0x3a, 0x2c, 0xf4, 0xd0, 0x75, 0x40, 0xc9, 0x15, 0xd3, 0xfb, 0xde, 0xbd, 0x1e, 0xba, 0xf6, 0xc9,
+	0x15, 0x93, 0xbb, 0x78, 0xc4, 0xe4, 0x92, 0xdd, 0x8a, 0x92, 0x98, 0x74, 0x98, 0xbc, 0xa4, 0x05,
+	0xbf, 0xa2, 0xe1, 0x03, 0x85, 0x8a, 0x17, 0x41, 0x65, 0xb1, 0xdb, 0x57, 0xb0, 0xe0, 0x97, 0xa8,
+	0xf5, 0x02, 0xbc, 0x27, 0xb0, 0x3e, 0xc1, 0x67, 0x9d, 0xe3, 0x47, 0x30, 0xaf, 0x0a, 0x58, 0x27,
+	0xd7, 0xdd, 0x55, 0xdf, 0x4e, 0x3f, 0x88, 0x7f, 0x75, 0xb1, 0x6a, 0x0e, 0xef, 0x4f, 0x0e, 0xfc,
+	0x2c, 0xaf, 0x69, 0x3f, 0x8e, 0xc5, 0x8e, 0xc5, 0xde, 0x7f, 0x0a, 0xc6, 0x22, 0x9b, 0x9d, 0x10,
+	0xd9, 0x73, 0xb8, 0x7f, 0x93, 0x3f, 0x77, 0x08, 0xef, 0xdb, 0xd1, 0xbb, 0xdd, 0x4f, 0xd3, 0xdb,
+	0x03, 0xb3, 0xfd, 0x9f, 0xc9, 0xf9, 0x3f, 0x9e, 0x74, 0xa9, 0xec, 0x0e, 0x5e, 0x89, 0xf1, 0x13,
+	0xa3, 0x4b, 0xac, 0x36, 0x02, 0xd3, 0x8e, 0x4f, 0xa0, 0x9e, 0x43, 0xb5, 0xe2, 0x8f, 0xc5, 0x5e,
+	0x30, 0xd8, 0x25, 0x8a, 0x7b, 0x6b, 0xbb, 0xa3, 0x1f, 0xbb, 0x5a, 0x40, 0xb3, 0x89, 0x7e, 0xff,
+	0x1d, 0x62, 0x1c, 0x53, 0xd3, 0x3f, 0x8d, 0x81, 0xcf, 0x60, 0x75, 0xf4, 0x40, 0xdb, 0xb0, 0x37,
+	0x4a, 0x67, 0x64, 0xa3, 0x74, 0xa1, 0x7a, 0xca, 0x49, 0x2a, 0x5d, 0x33, 0x9a, 0xea, 0x50, 0xb3,
+	0x30, 0xdd, 0x8d, 0x7f, 0x07, 0x6b, 0x03, 0xf0, 0xbb, 0x28, 0x89, 0x7a, 0x59, 0xcf, 0x5a, 0x19,
+	0x6f, 0xd2, 0xef, 0x6e, 0x83, 0x6c, 0xf6, 0x01, 0x8f, 0x7a, 0xd8, 0x6c, 0x45, 0x05, 0xbf, 0x28,
+	0xb0, 0x33, 0x05, 0x79, 0x9f, 0x43, 0x63, 0x5c, 0xf3, 0x14, 0xae, 0x4b, 0x37, 0x11, 0xe5, 0x39,
+	0xdf, 0x45, 0xf2, 0x2d, 0x50, 0x3b, 0x7f, 0x04, 0xdb, 0x6a, 0x06, 0x1f, 0x5f, 0x8b, 0x59, 0x86,
+	0x62, 0xb1, 0x00, 0xa4, 0x88, 0xe2, 0x84, 0xe3, 0xd0, 0x84, 0x21, 0x77, 0x3b, 0x75, 0x1c, 0x44,
+	0x66, 0x4f, 0x06, 0x03, 0x3d, 0x0b, 0xbd, 0x87, 0xe0, 0xdd, 0xa6, 0x45, 0xdb, 0xda, 0x82, 0xfb,
+	0xa3, 0x5c, 0xc7, 0x31, 0x6e, 0x0f, 0x0d, 0x79, 0xdb, 0xb0, 0x79, 0x23, 0x87, 0x56, 0xe2, 0xaa,
+	0xb5, 0x50, 0x04, 0x31, 0xa8, 0xa0, 0x5f, 0x40, 0xcd, 0xc2, 0x74, 0x82, 0x96, 0x61, 0x0e, 0x85,
+	0x21, 0x35, 0x83, 0x50, 0x11, 0xde, 0x1f, 0x61, 0xf5, 0x35, 0x8a, 0xb8, 0xf5, 0xa1, 0x61, 0x82,
+	0xdc, 0x87, 0x52, 0x2b, 0x4e, 0xf3, 0x03, 0x79, 0xf2, 0x7a, 0x65, 0x0b, 0x17, 0x5b, 0x43, 0x62,
+	0x9a, 0x2b, 0x5d, 0x87, 0xb5, 0x31, 0xfb, 0x3a, 0xb2, 0x2a, 0x54, 0xc4, 0x6d, 0x1f, 0xc4, 0xe6,
+	0xa5, 0x7a, 0xaf, 0x60, 0x69, 0x80, 0xe8, 0xa8, 0x0e, 0xa1, 0x6c, 0x7b, 0x69, 0x46, 0xf5, 0xdb,
+	0xdc, 0x2c, 0x59, 0x6e, 0x32, 0xaf, 0x26, 0xf4, 0x22, 0xca, 0x2d, 0x53, 0xb2, 0xda, 0x0d, 0xa4,
+	0x1d, 0xfa, 0x03, 0xb8, 0x7e, 0x96, 0x1c, 0xc4, 0xe9, 0xcb, 0x84, 0x47, 0xb1, 0xc9, 0xd3, 0xfb,
+	0xf0, 0x60, 0x9a, 0x4c, 0x3d, 0x86, 0x7a, 0xce, 0xfa, 0x14, 0x75, 0xbf, 0x0e, 0x6b, 0x3e, 0x66,
+	0x98, 0x5b, 0x2b, 0x82, 0x89, 0x6f, 0x03, 0x1a, 0xe3, 0x47, 0x3a, 0xce, 0x3a, 0xd4, 0x9e, 0x25,
+	0x11, 0x57, 0x3d, 0xc2, 0x08, 0x7c, 0x02, 0xae, 0x0d, 0x4e, 0x61, 0xfd, 0x47, 0x07, 0xee, 0x37,
+	0x49, 0x9a, 0xc5, 0x72, 0x09, 0x55, 0xd5, 0xff, 0x0d, 0xc9, 0x44, 0x19, 0x9b, 0xdc, 0xfd, 0x1c,
+	0x96, 0x44, 0xc4, 0x41, 0x9b, 0x62, 0xc4, 0x71, 0x18, 0x24, 0xe6, 0x43, 0xa9, 0x2c, 0xe0, 0x43,
+	0x85, 0x7e, 0xcf, 0xc4, 0x83, 0x43, 0x6d, 0xa1, 0xd4, 0x9e, 0x34, 0xa0, 0x20, 0x39, 0x6d, 0xbe,
+	0x80, 0x52, 0x4f, 0x7a, 0x16, 0xa0, 0x38, 0x42, 0x6a, 0xe2, 0x14, 0xf7, 0x56, 0x46, 0x17, 0xeb,
+	0x7d, 0x71, 0xe8, 0x17, 0x15, 0xab, 0x24, 0xdc, 0xc7, 0xb0, 0x6c, 0xf5, 0xd1, 0x61, 0xb9, 0xcf,
+	0x4a, 0x1b, 0x75, 0xeb, 0x6c, 0xb0, 0x86, 0x6e, 0xc3, 0xe6, 0x8d, 0x71, 0xe9, 0x14, 0xfe, 0xd5,
+	0x81, 0xaa, 0x48, 0x97, 0xdd, 0x71, 0xdc, 0x5f, 0xc1, 0xbc, 0xe2, 0x6e, 0x38, 0xb7, 0xb9, 0xa7,
+	0x99, 0x6e, 0xf4, 0x6c, 0xe6, 0x46, 0xcf, 0x26, 0xe5, 0xb3, 0x30, 0x21, 0x9f, 0xe6, 0x86, 0xf3,
+	0xad, 0x6f, 0x05, 0xea, 0x47, 0xb8, 0x47, 0x38, 0xce, 0x5f, 0xfc, 0x1e, 0x2c, 0xe7, 0xe1, 0x29,
+	0xae, 0xfe, 0x6b, 0xd8, 0x6c, 0x52, 0x22, 0x84, 0xa4, 0x89, 0xd7, 0x5d, 0x9c, 0x1c, 0xa2, 0xac,
+	0xd3, 0xe5, 0x2f, 0xd3, 0x29, 0x46, 0x81, 0xf7, 0x1b, 0xd8, 0xba, 0x59, 0x7c, 0xba, 0xba, 0x57,
+	0x82, 0x88, 0x69, 0x3d, 0xa1, 0x55, 0xf7, 0xe3, 0x47, 0x3a, 0x01, 0x7f, 0x16, 0xff, 0x77, 0x8a,
+	0xf3, 0x75, 0xff, 0xae, 0x97, 0x36, 0xe1, 0x06, 0x66, 0x26, 0x55, 0xf4, 0x23, 0xa8, 0xc9, 0xfd,
+	0x5e, 0xfc, 0xff, 0x00, 0xe5, 0x01, 0x13, 0x3e, 0xe9, 0xb5, 0x7e, 0x49, 0x1e, 0x0c, 0x67, 0x93,
+	0x1c, 0x5f, 0x78, 0xe4, 0xe5, 0x79, 0xcf, 0x86, 0x81, 0xf8, 0x58, 0x2a, 0xc1, 0xe1, 0xdd, 0x7c,
+	0x16, 0xdf, 0x6b, 0x13, 0x54, 0x69, 0x3b, 0x0f, 0xc1, 0x13, 0x3d, 0xd7, 0xea, 0x13, 0xfb, 0x49,
+	0x28, 0xa6, 0x4b, 0x6e, 0x67, 0x79, 0x05, 0x0f, 0x6e, 0xe5, 0xba, 0xeb, 0x0e, 0xb3, 0x02, 0x75,
+	0xbb, 0x12, 0xac, 0x9a, 0xcc, 0xc3, 0x53, 0x14, 0xc5, 0x63, 0x28, 0x1f, 0xa0, 0xf6, 0x45, 0x36,
+	0xa8, 0xc0, 0x2d, 0x28, 0xb6, 0x49, 0xd2, 0xce, 0x28, 0xc5, 0x49, 0xbb, 0xaf, 0x1b, 0x8f, 0x0d,
+	0x79, 0x9f, 0x43, 0xc5, 0x88, 0x68, 0x03, 0x0f, 0x61, 0x0e, 0x5f, 0x0e, 0x13, 0x5b, 0xd9, 0x35,
+	0x7f, 0x59, 0x38, 0x16, 0xa8, 0xaf, 0x0e, 0xbd, 0xbf, 0x38, 0xb2, 0xbb, 0x72, 0x42, 0xf1, 0x09,
+	0x25, 0xbd, 0xbc, 0xd9, 0x87, 0x50, 0xa1, 0xea, 0x2c, 0xe0, 0x44, 0xbc, 0x6a, 0xf3, 0xa9, 0xab,
+	0xd1, 0x33, 0xd2, 0x24, 0xcc, 0xfd, 0x04, 0x96, 0x35, 0x2d, 0xb8, 0x44, 0xed, 0x30, 0x8e, 0x7a,
+	0xa9, 0x2e, 0x26, 0x77, 0xc0, 0x7b, 0x66, 0x4e, 0xc4, 0x56, 0xad, 0x3e, 0x28, 0x02, 0x46, 0x32,
+	0xda, 0x56, 0xd5, 0xb4, 0xe8, 0x97, 0x14, 0x78, 0x2a, 0x31, 0x6f, 0x1f, 0xd6, 0x27, 0x38, 0xf6,
+	0x2e, 0xc1, 0xb5, 0xe6, 0xe5, 0x1f, 0x51, 0x3e, 0xfd, 0xdf, 0x00, 0x89, 0x5b, 0xb5, 0x50, 0xb5,
+	0x19, 0x00, 0x00,
 }
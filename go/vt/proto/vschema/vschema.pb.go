@@ -114,7 +114,9 @@ func (m *Vindex) GetOwner() string {
 // Table is the table info for a Keyspace.
 type Table struct {
 	// If the table is a sequence, type must be
-	// "sequence". Otherwise, it should be empty.
+	// "sequence", or "uncached_sequence" if its values
+	// are reserved in the transaction that uses them.
+	// Otherwise, it should be empty.
 	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
 	// column_vindexes associates columns to vindexes.
 	ColumnVindexes []*ColumnVindex `protobuf:"bytes,2,rep,name=column_vindexes,json=columnVindexes" json:"column_vindexes,omitempty"`
@@ -138,6 +138,15 @@ type Session struct {
 	Options *query.ExecuteOptions `protobuf:"bytes,6,opt,name=options" json:"options,omitempty"`
 	// transaction_mode specifies the current transaction mode.
 	TransactionMode TransactionMode `protobuf:"varint,7,opt,name=transaction_mode,json=transactionMode,enum=vtgate.TransactionMode" json:"transaction_mode,omitempty"`
+	// savepoints are the active savepoints of the transaction, in
+	// the order they were set. They are replayed on the shards that
+	// join the transaction later.
+	Savepoints []string `protobuf:"bytes,8,rep,name=savepoints" json:"savepoints,omitempty"`
+	// system_variables are the MySQL session variables set by the
+	// client, that vtgate doesn't handle itself. The values are SQL
+	// expressions. They are passed to the tablets in
+	// options.system_variables.
+	SystemVariables map[string]string `protobuf:"bytes,9,rep,name=system_variables,json=systemVariables" json:"system_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *Session) Reset()                    { *m = Session{} }
@@ -194,6 +203,20 @@ func (m *Session) GetTransactionMode() TransactionMode {
 	return TransactionMode_UNSPECIFIED
 }
 
+func (m *Session) GetSavepoints() []string {
+	if m != nil {
+		return m.Savepoints
+	}
+	return nil
+}
+
+func (m *Session) GetSystemVariables() map[string]string {
+	if m != nil {
+		return m.SystemVariables
+	}
+	return nil
+}
+
 type Session_ShardSession struct {
 	Target        *query.Target `protobuf:"bytes,1,opt,name=target" json:"target,omitempty"`
 	TransactionId int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId" json:"transaction_id,omitempty"`
@@ -2419,120 +2442,125 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1838 bytes of a gzipped FileDescriptorProto
+	// 1910 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5b, 0x8f, 0x23, 0x47,
-	0x15, 0xa6, 0xbb, 0x7d, 0x3d, 0xbe, 0x4e, 0xad, 0x77, 0xd7, 0x71, 0x86, 0x1d, 0xa7, 0x61, 0x14,
-	0x27, 0x59, 0x39, 0xc4, 0xe1, 0x26, 0x84, 0x04, 0x19, 0xef, 0x10, 0x59, 0xd9, 0xd9, 0x0c, 0x65,
-	0x6f, 0x02, 0x12, 0x51, 0xab, 0xc7, 0x2e, 0x79, 0x1a, 0xdb, 0xdd, 0x4e, 0x57, 0xd9, 0x61, 0x78,
-	0x40, 0xf9, 0x07, 0x11, 0x0f, 0x48, 0x68, 0x85, 0x84, 0x90, 0x90, 0x90, 0x90, 0x78, 0x45, 0x02,
-	0x5e, 0x78, 0xe3, 0x11, 0xf1, 0xc4, 0x3b, 0x7f, 0x00, 0x89, 0x5f, 0x80, 0xba, 0xaa, 0xfa, 0xe2,
-	0x9e, 0xb1, 0xc7, 0xe3, 0xb9, 0xc8, 0xfb, 0xe4, 0xae, 0x53, 0xb7, 0xaf, 0xbe, 0xf3, 0xd5, 0xa9,
-	0xd3, 0xd5, 0x86, 0xfc, 0x9c, 0x0d, 0x4d, 0x46, 0x9a, 0x53, 0xd7, 0x61, 0x0e, 0x4a, 0x89, 0x52,
-	0x2d, 0xf7, 0xe9, 0x8c, 0xb8, 0x67, 0xc2, 0x58, 0x2b, 0x32, 0x67, 0xea, 0x0c, 0x4c, 0x66, 0xca,
-	0x72, 0x6e, 0xce, 0xdc, 0x69, 0x5f, 0x14, 0xf4, 0x3f, 0x6a, 0x90, 0xee, 0x12, 0x4a, 0x2d, 0xc7,
-	0x46, 0xfb, 0x50, 0xb4, 0x6c, 0x83, 0xb9, 0xa6, 0x4d, 0xcd, 0x3e, 0xb3, 0x1c, 0xbb, 0xaa, 0xd4,
-	0x95, 0x46, 0x06, 0x17, 0x2c, 0xbb, 0x17, 0x1a, 0x51, 0x1b, 0x8a, 0xf4, 0xd4, 0x74, 0x07, 0x06,
-	0x15, 0xfd, 0x68, 0x55, 0xad, 0x6b, 0x8d, 0x5c, 0x6b, 0xb7, 0x29, 0xb1, 0xc8, 0xf1, 0x9a, 0x5d,
-	0xaf, 0x95, 0x2c, 0xe0, 0x02, 0x8d, 0x94, 0x28, 0x7a, 0x15, 0xb2, 0xd4, 0xb2, 0x87, 0x63, 0x62,
-	0x0c, 0x4e, 0xaa, 0x1a, 0x9f, 0x26, 0x23, 0x0c, 0x4f, 0x4e, 0xd0, 0x23, 0x00, 0x73, 0xc6, 0x9c,
-	0xbe, 0x33, 0x99, 0x58, 0xac, 0x9a, 0xe0, 0xb5, 0x11, 0x0b, 0xfa, 0x0a, 0x14, 0x98, 0xe9, 0x0e,
-	0x09, 0x33, 0x28, 0x73, 0x2d, 0x7b, 0x58, 0x4d, 0xd6, 0x95, 0x46, 0x16, 0xe7, 0x85, 0xb1, 0xcb,
-	0x6d, 0xe8, 0x6d, 0x48, 0x3b, 0x53, 0xc6, 0xf1, 0xa5, 0xea, 0x4a, 0x23, 0xd7, 0xba, 0xdf, 0x14,
-	0xac, 0x1c, 0xfe, 0x8c, 0xf4, 0x67, 0x8c, 0x7c, 0x28, 0x2a, 0xb1, 0xdf, 0x0a, 0x1d, 0x40, 0x39,
-	0xb2, 0x76, 0x63, 0xe2, 0x0c, 0x48, 0x35, 0x5d, 0x57, 0x1a, 0xc5, 0xd6, 0x43, 0x7f, 0x65, 0x11,
-	0x1a, 0x8e, 0x9c, 0x01, 0xc1, 0x25, 0xb6, 0x68, 0xa8, 0xfd, 0x04, 0xf2, 0xd1, 0x55, 0xa3, 0x7d,
-	0x48, 0x09, 0x50, 0x9c, 0xca, 0x5c, 0xab, 0x20, 0x31, 0xf4, 0xb8, 0x11, 0xcb, 0x4a, 0x8f, 0xf9,
-	0xe8, 0xd4, 0xd6, 0xa0, 0xaa, 0xd6, 0x95, 0x86, 0x86, 0x0b, 0x11, 0x6b, 0x67, 0xa0, 0xff, 0x53,
-	0x85, 0xa2, 0x44, 0x8f, 0xc9, 0xa7, 0x33, 0x42, 0x19, 0x7a, 0x0c, 0xd9, 0xbe, 0x39, 0x1e, 0x13,
-	0xd7, 0xeb, 0x24, 0xe6, 0x28, 0x35, 0x85, 0x83, 0xdb, 0xdc, 0xde, 0x79, 0x82, 0x33, 0xa2, 0x45,
-	0x67, 0x80, 0xde, 0x80, 0xb4, 0x74, 0x1a, 0x9f, 0x40, 0xb4, 0x8d, 0xfa, 0x0c, 0xfb, 0xf5, 0xe8,
-	0x75, 0x48, 0x72, 0xa8, 0xdc, 0x39, 0xb9, 0xd6, 0x8e, 0x04, 0x7e, 0xe0, 0xcc, 0xec, 0xc1, 0x0f,
-	0xbd, 0x47, 0x2c, 0xea, 0xd1, 0x37, 0x20, 0xc7, 0xcc, 0x93, 0x31, 0x61, 0x06, 0x3b, 0x9b, 0x12,
-	0xee, 0xad, 0x62, 0xab, 0xd2, 0x0c, 0x44, 0xd7, 0xe3, 0x95, 0xbd, 0xb3, 0x29, 0xc1, 0xc0, 0x82,
-	0x67, 0xf4, 0x18, 0x90, 0xed, 0x30, 0x23, 0x26, 0xb8, 0x24, 0xf7, 0x75, 0xd9, 0x76, 0x58, 0x67,
-	0x41, 0x73, 0xfb, 0x50, 0x1c, 0x91, 0x33, 0x3a, 0x35, 0xfb, 0xc4, 0xe0, 0x42, 0xe2, 0x3e, 0xcd,
-	0xe2, 0x82, 0x6f, 0xe5, 0xac, 0x47, 0x7d, 0x9e, 0x5e, 0xc7, 0xe7, 0xfa, 0x17, 0x0a, 0x94, 0x02,
-	0x46, 0xe9, 0xd4, 0xb1, 0x29, 0x41, 0xfb, 0x90, 0x24, 0xae, 0xeb, 0xb8, 0x31, 0x3a, 0xf1, 0x71,
-	0xfb, 0xd0, 0x33, 0x63, 0x51, 0x7b, 0x15, 0x2e, 0xdf, 0x84, 0x94, 0x4b, 0xe8, 0x6c, 0xcc, 0x24,
-	0x99, 0x48, 0xa2, 0x12, 0x3c, 0xf2, 0x1a, 0x2c, 0x5b, 0xe8, 0xff, 0x51, 0xa1, 0x22, 0x11, 0xf1,
-	0x35, 0xd1, 0xed, 0xf1, 0x74, 0x0d, 0x32, 0x3e, 0xdd, 0xdc, 0xcd, 0x59, 0x1c, 0x94, 0xd1, 0x03,
-	0x48, 0x71, 0xbf, 0xd0, 0x6a, 0xb2, 0xae, 0x35, 0xb2, 0x58, 0x96, 0xe2, 0xea, 0x48, 0x5d, 0x4b,
-	0x1d, 0xe9, 0x25, 0xea, 0x88, 0xb8, 0x3d, 0xb3, 0x96, 0xdb, 0x7f, 0xa5, 0xc0, 0xfd, 0x18, 0xc9,
-	0x5b, 0xe1, 0xfc, 0xff, 0xa9, 0xf0, 0x8a, 0xc4, 0xf5, 0x81, 0x64, 0xb6, 0xf3, 0xb2, 0x28, 0xe0,
-	0x35, 0xc8, 0x07, 0x5b, 0xd4, 0x92, 0x3a, 0xc8, 0xe3, 0xdc, 0x28, 0x5c, 0xc7, 0x96, 0x8a, 0xe1,
-	0x85, 0x02, 0xb5, 0x8b, 0x48, 0xdf, 0x0a, 0x45, 0x7c, 0xae, 0xc1, 0xc3, 0x10, 0x1c, 0x36, 0xed,
-	0x21, 0x79, 0x49, 0xf4, 0xf0, 0x0e, 0xc0, 0x88, 0x9c, 0x19, 0x2e, 0x87, 0xcc, 0xd5, 0xe0, 0xad,
-	0x34, 0xf0, 0xb5, 0xbf, 0x1a, 0x9c, 0x1d, 0xf9, 0xeb, 0xda, 0x52, 0x7d, 0xfc, 0x5a, 0x81, 0xea,
-	0x79, 0x17, 0x6c, 0x85, 0x3a, 0xfe, 0x92, 0x08, 0xd4, 0x71, 0x68, 0x33, 0x8b, 0x9d, 0xbd, 0x34,
-	0xd1, 0xe2, 0x31, 0x20, 0xc2, 0x11, 0x1b, 0x7d, 0x67, 0x3c, 0x9b, 0xd8, 0x86, 0x6d, 0x4e, 0x88,
-	0xcc, 0xe3, 0xca, 0xa2, 0xa6, 0xcd, 0x2b, 0x9e, 0x99, 0x13, 0x82, 0x7e, 0x04, 0xf7, 0x64, 0xeb,
-	0x85, 0x10, 0x93, 0xe2, 0xa2, 0x6a, 0xf8, 0x48, 0x97, 0x30, 0xd1, 0xf4, 0x0d, 0x78, 0x47, 0x0c,
-	0xf2, 0xc1, 0xf2, 0x90, 0x94, 0xbe, 0x96, 0xe4, 0x32, 0x97, 0x4b, 0x2e, 0xbb, 0x8e, 0xe4, 0x6a,
-	0x27, 0x90, 0xf1, 0x41, 0xa3, 0x3d, 0x48, 0x70, 0x68, 0x0a, 0x87, 0x96, 0xf3, 0x13, 0x48, 0x0f,
-	0x11, 0xaf, 0x40, 0x15, 0x48, 0xce, 0xcd, 0xf1, 0x8c, 0x70, 0xc7, 0xe5, 0xb1, 0x28, 0xa0, 0x3d,
-	0xc8, 0x45, 0xb8, 0xe2, 0xbe, 0xca, 0x63, 0x08, 0xa3, 0x71, 0x54, 0xd6, 0x11, 0xc6, 0xb6, 0x42,
-	0xd6, 0xff, 0x52, 0xe1, 0x9e, 0x84, 0x76, 0x60, 0xb2, 0xfe, 0xe9, 0xad, 0x4b, 0xfa, 0x2d, 0x48,
-	0x7b, 0x68, 0x2c, 0x42, 0xab, 0x1a, 0xd7, 0xd4, 0x05, 0xa2, 0xf6, 0x5b, 0x6c, 0x9a, 0xf0, 0xee,
-	0x43, 0xd1, 0xa4, 0x17, 0x24, 0xbb, 0x05, 0x93, 0xde, 0x45, 0xa6, 0xfb, 0x42, 0x09, 0xf2, 0x4a,
-	0xc9, 0xe9, 0xad, 0xb9, 0xfa, 0x6b, 0x90, 0x16, 0x8e, 0xf4, 0xd9, 0x7c, 0x20, 0xb1, 0x09, 0x37,
-	0x7f, 0x6c, 0xb1, 0x53, 0x31, 0xb4, 0xdf, 0x4c, 0xb7, 0xa1, 0xc4, 0x99, 0xe6, 0x6b, 0xe3, 0x74,
-	0x87, 0x51, 0x46, 0xb9, 0x42, 0x94, 0x51, 0x97, 0x66, 0xa5, 0x5a, 0x34, 0x2b, 0xd5, 0xff, 0x1c,
-	0xe6, 0x59, 0x9c, 0x8c, 0x3b, 0xca, 0xb4, 0xdf, 0x89, 0xcb, 0x2c, 0x78, 0xb1, 0x8c, 0xad, 0xfe,
-	0xae, 0xc4, 0x76, 0xd5, 0x77, 0x64, 0xfd, 0x37, 0x61, 0xae, 0xb4, 0x40, 0xdc, 0xad, 0x69, 0xe9,
-	0x71, 0x5c, 0x4b, 0x17, 0xc5, 0x8d, 0x40, 0x47, 0xbf, 0x80, 0x0a, 0x67, 0x32, 0x8c, 0xf0, 0x37,
-	0x28, 0xa6, 0x78, 0x82, 0xab, 0x9d, 0x4b, 0x70, 0xf5, 0xbf, 0xab, 0xf0, 0x28, 0x4a, 0xcf, 0x5d,
-	0x26, 0xf1, 0xdf, 0x8c, 0x8b, 0x6b, 0x77, 0x41, 0x5c, 0x31, 0x4a, 0xb6, 0x56, 0x61, 0xbf, 0x53,
-	0x60, 0x6f, 0x29, 0x85, 0x5b, 0x22, 0xb3, 0x3f, 0xa8, 0x50, 0xe9, 0x32, 0x97, 0x98, 0x93, 0x6b,
-	0xdd, 0xc6, 0x04, 0xaa, 0x54, 0xaf, 0x76, 0xc5, 0xa2, 0xad, 0xef, 0xa2, 0xd8, 0x51, 0x92, 0xb8,
-	0xe4, 0x28, 0x49, 0xae, 0x75, 0x51, 0x16, 0xe1, 0x35, 0xb5, 0x9a, 0x57, 0xbd, 0x0d, 0xf7, 0x63,
-	0x44, 0x49, 0x17, 0x86, 0xe9, 0x80, 0x72, 0x69, 0x3a, 0xf0, 0x85, 0x0a, 0xb5, 0x85, 0x51, 0xae,
-	0x13, 0xae, 0xd7, 0x26, 0x3d, 0x1a, 0x0a, 0xb4, 0xa5, 0xe7, 0x4a, 0x62, 0xd5, 0x6d, 0x47, 0x72,
-	0x4d, 0x47, 0x5d, 0x79, 0x93, 0x74, 0xe0, 0xd5, 0x0b, 0x09, 0xd9, 0x80, 0xdc, 0xdf, 0xaa, 0xb0,
-	0xb7, 0x30, 0xd6, 0xb5, 0x63, 0xd6, 0x8d, 0x30, 0x1c, 0x0f, 0xb6, 0x89, 0x4b, 0x6f, 0x13, 0x6e,
-	0x8d, 0xec, 0x67, 0x50, 0x5f, 0x4e, 0xd0, 0x06, 0x8c, 0xff, 0x49, 0x85, 0x2f, 0xc7, 0x07, 0xbc,
-	0xce, 0x8b, 0xfd, 0x8d, 0xf0, 0xbd, 0xf8, 0xb6, 0x9e, 0xd8, 0xe0, 0x6d, 0xfd, 0xd6, 0xf8, 0x7f,
-	0x0a, 0x8f, 0x96, 0xd1, 0xb5, 0x01, 0xfb, 0x3f, 0x86, 0xfc, 0x01, 0x19, 0x5a, 0xf6, 0x66, 0x5c,
-	0x2f, 0x7c, 0xb6, 0x50, 0x17, 0x3f, 0x5b, 0xe8, 0xdf, 0x81, 0x82, 0x1c, 0x5a, 0xe2, 0x8a, 0x04,
-	0x4a, 0xe5, 0x92, 0x40, 0xf9, 0xb9, 0x02, 0x85, 0x36, 0xff, 0xba, 0x71, 0xeb, 0x89, 0xc2, 0x03,
-	0x48, 0x99, 0xcc, 0x99, 0x58, 0x7d, 0xf9, 0xdd, 0x45, 0x96, 0xf4, 0x32, 0x14, 0x7d, 0x04, 0x02,
-	0xbf, 0xfe, 0x53, 0x28, 0x61, 0x67, 0x3c, 0x3e, 0x31, 0xfb, 0xa3, 0xdb, 0x46, 0xa5, 0x23, 0x28,
-	0x87, 0x73, 0xc9, 0xf9, 0x3f, 0x81, 0x57, 0x30, 0xa1, 0xce, 0x78, 0x4e, 0x22, 0x29, 0xc5, 0x66,
-	0x48, 0x10, 0x24, 0x06, 0x4c, 0x7e, 0x57, 0xc9, 0x62, 0xfe, 0xac, 0xff, 0x4d, 0x81, 0xca, 0x11,
-	0xa1, 0xd4, 0x1c, 0x12, 0x21, 0xb0, 0xcd, 0x86, 0x5e, 0x95, 0x33, 0x56, 0x20, 0x29, 0x4e, 0x5e,
-	0xb1, 0xdf, 0x44, 0x01, 0xbd, 0x0d, 0xd9, 0x60, 0xb3, 0xf1, 0x33, 0xf9, 0xe2, 0xbd, 0x96, 0xf1,
-	0xf7, 0x9a, 0x87, 0x3e, 0x72, 0x3f, 0xc2, 0x9f, 0xf5, 0x5f, 0x2a, 0xb0, 0x23, 0xd1, 0xbf, 0xb7,
-	0xa9, 0x7f, 0x56, 0x41, 0xf7, 0xe7, 0xd4, 0xc2, 0x39, 0xd1, 0x23, 0xd0, 0xfc, 0x60, 0x9c, 0x6b,
-	0xe5, 0xe5, 0x2e, 0xfb, 0xc8, 0x1c, 0xcf, 0x08, 0xf6, 0x2a, 0xf4, 0x23, 0xc8, 0x77, 0x22, 0x99,
-	0x26, 0xda, 0x05, 0x35, 0x80, 0xb1, 0xd8, 0x5c, 0xb5, 0x06, 0xf1, 0x2b, 0x0a, 0xf5, 0xdc, 0x15,
-	0xc5, 0x5f, 0x15, 0xd8, 0x0d, 0x97, 0x78, 0xed, 0x83, 0xe9, 0xaa, 0xab, 0xfd, 0x2e, 0x94, 0xac,
-	0x81, 0x71, 0xee, 0x18, 0xca, 0xb5, 0x2a, 0xbe, 0x8a, 0xa3, 0x8b, 0xc5, 0x05, 0x2b, 0x52, 0xa2,
-	0xfa, 0x2e, 0xd4, 0x2e, 0x12, 0xaf, 0x94, 0xf6, 0x7f, 0x55, 0xd8, 0xe9, 0x4e, 0xc7, 0x16, 0x93,
-	0x31, 0xea, 0xa6, 0xd7, 0xb3, 0xf6, 0x25, 0xdd, 0x6b, 0x90, 0xa7, 0x1e, 0x0e, 0x79, 0x0f, 0x27,
-	0x13, 0x9a, 0x1c, 0xb7, 0x89, 0x1b, 0x38, 0xcf, 0x4f, 0x7e, 0x93, 0x99, 0xcd, 0xb8, 0x08, 0x35,
-	0x0c, 0xb2, 0xc5, 0xcc, 0x66, 0xe8, 0xeb, 0xf0, 0xd0, 0x9e, 0x4d, 0x0c, 0xd7, 0xf9, 0x8c, 0x1a,
-	0x53, 0xe2, 0x1a, 0x7c, 0x64, 0x63, 0x6a, 0xba, 0x8c, 0x87, 0x78, 0x0d, 0xdf, 0xb3, 0x67, 0x13,
-	0xec, 0x7c, 0x46, 0x8f, 0x89, 0xcb, 0x27, 0x3f, 0x36, 0x5d, 0x86, 0xbe, 0x0f, 0x59, 0x73, 0x3c,
-	0x74, 0x5c, 0x8b, 0x9d, 0x4e, 0xe4, 0xc5, 0x9b, 0x2e, 0x61, 0x9e, 0x63, 0xa6, 0xf9, 0x9e, 0xdf,
-	0x12, 0x87, 0x9d, 0xd0, 0x5b, 0x80, 0x66, 0x94, 0x18, 0x02, 0x9c, 0x98, 0x74, 0xde, 0x92, 0xb7,
-	0x70, 0xa5, 0x19, 0x25, 0xe1, 0x30, 0x1f, 0xb5, 0xf4, 0x7f, 0x68, 0x80, 0xa2, 0xe3, 0xca, 0x18,
-	0xfd, 0x2d, 0x48, 0xf1, 0xfe, 0xb4, 0xaa, 0x70, 0xdf, 0xee, 0x05, 0x11, 0xea, 0x5c, 0xdb, 0xa6,
-	0x07, 0x1b, 0xcb, 0xe6, 0xb5, 0x4f, 0x20, 0xef, 0xef, 0x54, 0xbe, 0x9c, 0xa8, 0x37, 0x94, 0x95,
-	0xa7, 0xab, 0xba, 0xc6, 0xe9, 0x5a, 0xfb, 0x1e, 0x64, 0x79, 0x56, 0x77, 0xe9, 0xd8, 0x61, 0x2e,
-	0xaa, 0x46, 0x73, 0xd1, 0xda, 0xbf, 0x15, 0x48, 0xf0, 0xce, 0x6b, 0xbf, 0xfc, 0x1e, 0xf1, 0xf7,
-	0x05, 0x81, 0x52, 0x78, 0x4f, 0x04, 0xed, 0xd7, 0x57, 0x50, 0x12, 0xa5, 0x00, 0xe7, 0x47, 0x51,
-	0x42, 0xda, 0x00, 0xe2, 0x7f, 0x02, 0x7c, 0x28, 0xa1, 0xc3, 0xaf, 0xae, 0x18, 0x2a, 0x58, 0x2e,
-	0xce, 0xd2, 0x60, 0xe5, 0x08, 0x12, 0xd4, 0xfa, 0xb9, 0x88, 0x92, 0x1a, 0xe6, 0xcf, 0xfa, 0xbb,
-	0x70, 0xff, 0x7d, 0xc2, 0xba, 0xee, 0xdc, 0xdf, 0x6e, 0xfe, 0xf6, 0x59, 0x41, 0x93, 0x8e, 0xe1,
-	0x41, 0xbc, 0x93, 0x54, 0xc0, 0xb7, 0x21, 0x4f, 0xdd, 0xb9, 0xb1, 0xd0, 0xd3, 0xcb, 0x4a, 0x02,
-	0xf7, 0x44, 0x3b, 0xe5, 0x68, 0x58, 0xd0, 0x7f, 0xaf, 0xc2, 0xbd, 0xe7, 0xd3, 0x81, 0xc9, 0xb6,
-	0xfd, 0xfc, 0xd8, 0x30, 0x55, 0xdb, 0x85, 0x2c, 0xb3, 0x26, 0x84, 0x32, 0x73, 0x32, 0x95, 0x3b,
-	0x39, 0x34, 0x78, 0xba, 0x22, 0x73, 0x62, 0x33, 0x79, 0x01, 0xe9, 0xeb, 0xea, 0xd0, 0xb3, 0xf5,
-	0x9c, 0x11, 0xb1, 0xb1, 0xa8, 0xd7, 0x47, 0x50, 0x59, 0x64, 0x49, 0x12, 0xdf, 0xf0, 0x07, 0x58,
-	0xcc, 0xda, 0x64, 0xb2, 0xe7, 0xd5, 0xc8, 0x11, 0xd0, 0x1b, 0x50, 0xf6, 0xd2, 0xb7, 0x09, 0x31,
-	0x42, 0x3c, 0xe2, 0x1f, 0x12, 0x25, 0x61, 0xef, 0xf9, 0xe6, 0x37, 0x9f, 0x40, 0x29, 0xf6, 0x2f,
-	0x0d, 0x54, 0x82, 0xdc, 0xf3, 0x67, 0xdd, 0xe3, 0xc3, 0x76, 0xe7, 0x07, 0x9d, 0xc3, 0x27, 0xe5,
-	0x2f, 0x21, 0x80, 0x54, 0xb7, 0xf3, 0xec, 0xfd, 0xa7, 0x87, 0x65, 0x05, 0x65, 0x21, 0x79, 0xf4,
-	0xfc, 0x69, 0xaf, 0x53, 0x56, 0xbd, 0xc7, 0xde, 0xc7, 0x1f, 0x1e, 0xb7, 0xcb, 0xda, 0xc1, 0x0e,
-	0x94, 0x2c, 0xa7, 0x39, 0xb7, 0x18, 0xa1, 0x54, 0xfc, 0x53, 0xe6, 0x24, 0xc5, 0x7f, 0xde, 0xfd,
-	0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x09, 0x06, 0xcb, 0x72, 0x23, 0x00, 0x00,
+	0x15, 0xa6, 0xbb, 0x7d, 0xeb, 0xe3, 0xeb, 0xd4, 0x7a, 0x77, 0x1d, 0x67, 0xd8, 0x71, 0x9a, 0x8c,
+	0xe2, 0x24, 0x2b, 0x87, 0x38, 0x5c, 0xa2, 0x08, 0x09, 0x32, 0xde, 0x21, 0xb2, 0xb2, 0xb3, 0x3b,
+	0xd4, 0xcc, 0x6e, 0x40, 0x22, 0x6a, 0xf5, 0xd8, 0xa5, 0xd9, 0xc6, 0x76, 0xb7, 0xd3, 0x55, 0x76,
+	0x30, 0x0f, 0x28, 0xff, 0x20, 0xe2, 0x01, 0x09, 0xad, 0x90, 0x10, 0x12, 0x12, 0x4f, 0xbc, 0x22,
+	0x01, 0x2f, 0xbc, 0xf1, 0x88, 0x78, 0xe2, 0x11, 0x89, 0x3f, 0x80, 0xc4, 0x2f, 0x88, 0xba, 0xaa,
+	0xfa, 0x3a, 0x37, 0x8f, 0x67, 0x66, 0xe5, 0x7d, 0x72, 0xd7, 0xa9, 0xea, 0xea, 0xef, 0x7c, 0xe7,
+	0xab, 0x53, 0xa7, 0xab, 0x0d, 0xa5, 0x39, 0x3b, 0xb6, 0x18, 0xe9, 0x4c, 0x3d, 0x97, 0xb9, 0x28,
+	0x27, 0x5a, 0xcd, 0xe2, 0x67, 0x33, 0xe2, 0x2d, 0x84, 0xb1, 0x59, 0x61, 0xee, 0xd4, 0x1d, 0x5a,
+	0xcc, 0x92, 0xed, 0xe2, 0x9c, 0x79, 0xd3, 0x81, 0x68, 0x18, 0xff, 0xc9, 0x40, 0xfe, 0x80, 0x50,
+	0x6a, 0xbb, 0x0e, 0xda, 0x86, 0x8a, 0xed, 0x98, 0xcc, 0xb3, 0x1c, 0x6a, 0x0d, 0x98, 0xed, 0x3a,
+	0x0d, 0xa5, 0xa5, 0xb4, 0x0b, 0xb8, 0x6c, 0x3b, 0x87, 0x91, 0x11, 0xf5, 0xa0, 0x42, 0x9f, 0x59,
+	0xde, 0xd0, 0xa4, 0xe2, 0x3e, 0xda, 0x50, 0x5b, 0x5a, 0xbb, 0xd8, 0xdd, 0xec, 0x48, 0x2c, 0x72,
+	0xbe, 0xce, 0x81, 0x3f, 0x4a, 0x36, 0x70, 0x99, 0xc6, 0x5a, 0x14, 0xbd, 0x0a, 0x3a, 0xb5, 0x9d,
+	0xe3, 0x31, 0x31, 0x87, 0x47, 0x0d, 0x8d, 0x3f, 0xa6, 0x20, 0x0c, 0x0f, 0x8e, 0xd0, 0x3d, 0x00,
+	0x6b, 0xc6, 0xdc, 0x81, 0x3b, 0x99, 0xd8, 0xac, 0x91, 0xe1, 0xbd, 0x31, 0x0b, 0xfa, 0x06, 0x94,
+	0x99, 0xe5, 0x1d, 0x13, 0x66, 0x52, 0xe6, 0xd9, 0xce, 0x71, 0x23, 0xdb, 0x52, 0xda, 0x3a, 0x2e,
+	0x09, 0xe3, 0x01, 0xb7, 0xa1, 0x77, 0x20, 0xef, 0x4e, 0x19, 0xc7, 0x97, 0x6b, 0x29, 0xed, 0x62,
+	0xf7, 0x76, 0x47, 0xb0, 0xb2, 0xfb, 0x73, 0x32, 0x98, 0x31, 0xf2, 0x58, 0x74, 0xe2, 0x60, 0x14,
+	0xda, 0x81, 0x5a, 0xcc, 0x77, 0x73, 0xe2, 0x0e, 0x49, 0x23, 0xdf, 0x52, 0xda, 0x95, 0xee, 0xdd,
+	0xc0, 0xb3, 0x18, 0x0d, 0x7b, 0xee, 0x90, 0xe0, 0x2a, 0x4b, 0x1a, 0x7c, 0xe4, 0xd4, 0x9a, 0x93,
+	0xa9, 0x6b, 0x3b, 0x8c, 0x36, 0x0a, 0x2d, 0xad, 0xad, 0xe3, 0x98, 0x05, 0x3d, 0x86, 0x1a, 0x5d,
+	0x50, 0x46, 0x26, 0xe6, 0xdc, 0xf2, 0x6c, 0xeb, 0x68, 0x4c, 0x68, 0x43, 0xe7, 0xec, 0xbd, 0x7e,
+	0x82, 0x3d, 0x3e, 0xee, 0x69, 0x30, 0x6c, 0xd7, 0x61, 0xde, 0x02, 0x57, 0x69, 0xd2, 0xda, 0xfc,
+	0x29, 0x94, 0xe2, 0x34, 0xa3, 0x6d, 0xc8, 0x09, 0x16, 0x78, 0xec, 0x8a, 0xdd, 0xb2, 0x74, 0xfa,
+	0x90, 0x1b, 0xb1, 0xec, 0xf4, 0x43, 0x1d, 0xf7, 0xd5, 0x1e, 0x36, 0xd4, 0x96, 0xd2, 0xd6, 0x70,
+	0x39, 0x66, 0xed, 0x0f, 0x9b, 0x3b, 0x50, 0x3f, 0x0d, 0x06, 0xaa, 0x81, 0x36, 0x22, 0x0b, 0xfe,
+	0x08, 0x1d, 0xfb, 0x97, 0xa8, 0x0e, 0xd9, 0xb9, 0x35, 0x9e, 0x11, 0x3e, 0x8f, 0x8e, 0x45, 0xe3,
+	0x03, 0xf5, 0x7d, 0xc5, 0xf8, 0xa7, 0x0a, 0x15, 0x49, 0x39, 0x26, 0x9f, 0xcd, 0x08, 0x65, 0xe8,
+	0x3e, 0xe8, 0x03, 0x6b, 0x3c, 0x26, 0x9e, 0xff, 0x60, 0x81, 0xb3, 0xda, 0x11, 0xaa, 0xec, 0x71,
+	0x7b, 0xff, 0x01, 0x2e, 0x88, 0x11, 0xfd, 0x21, 0x7a, 0x13, 0xf2, 0x52, 0x69, 0x0d, 0x35, 0x1c,
+	0x1b, 0xa7, 0x0a, 0x07, 0xfd, 0xe8, 0x0d, 0xc8, 0x72, 0x77, 0xb9, 0xa2, 0x8a, 0xdd, 0x0d, 0xe9,
+	0xfc, 0x8e, 0x3b, 0x73, 0x86, 0x3f, 0xf2, 0x2f, 0xb1, 0xe8, 0x47, 0xdf, 0x86, 0x22, 0xf3, 0xfd,
+	0x61, 0x26, 0x5b, 0x4c, 0x09, 0x97, 0x58, 0xa5, 0x5b, 0xef, 0x84, 0x2b, 0xe5, 0x90, 0x77, 0x1e,
+	0x2e, 0xa6, 0x04, 0x03, 0x0b, 0xaf, 0xd1, 0x7d, 0x40, 0x8e, 0xcb, 0xcc, 0xd4, 0x2a, 0xc9, 0x72,
+	0x81, 0xd6, 0x1c, 0x97, 0xf5, 0x13, 0x0b, 0x65, 0x1b, 0x2a, 0x23, 0xb2, 0xa0, 0x53, 0x6b, 0x40,
+	0x4c, 0xae, 0x7e, 0x2e, 0x44, 0x1d, 0x97, 0x03, 0x2b, 0x8f, 0x5c, 0x5c, 0xa8, 0xf9, 0x65, 0x84,
+	0x6a, 0x7c, 0xa9, 0x40, 0x35, 0x64, 0x94, 0x4e, 0x5d, 0x87, 0x12, 0xb4, 0x0d, 0x59, 0xe2, 0x79,
+	0xae, 0x97, 0xa2, 0x13, 0xef, 0xf7, 0x76, 0x7d, 0x33, 0x16, 0xbd, 0x97, 0xe1, 0xf2, 0x2d, 0xc8,
+	0x79, 0x84, 0xce, 0xc6, 0x4c, 0x92, 0x89, 0x24, 0x2a, 0xc1, 0x23, 0xef, 0xc1, 0x72, 0x84, 0xf1,
+	0x5f, 0x15, 0xea, 0x12, 0x11, 0xf7, 0x89, 0xae, 0x4f, 0xa4, 0x9b, 0x50, 0x08, 0xe8, 0xe6, 0x61,
+	0xd6, 0x71, 0xd8, 0x46, 0x77, 0x20, 0xc7, 0xe3, 0x42, 0x1b, 0x59, 0xbe, 0x52, 0x65, 0x2b, 0xad,
+	0x8e, 0xdc, 0x95, 0xd4, 0x91, 0x3f, 0x43, 0x1d, 0xb1, 0xb0, 0x17, 0x96, 0x0a, 0xfb, 0xaf, 0x15,
+	0xb8, 0x9d, 0x22, 0x79, 0x2d, 0x82, 0xff, 0x7f, 0x15, 0x5e, 0x91, 0xb8, 0x3e, 0x96, 0xcc, 0xf6,
+	0x5f, 0x16, 0x05, 0xbc, 0x06, 0xa5, 0x70, 0x89, 0xda, 0x52, 0x07, 0x25, 0x5c, 0x1c, 0x45, 0x7e,
+	0xac, 0xa9, 0x18, 0x9e, 0x2b, 0xd0, 0x3c, 0x8d, 0xf4, 0xb5, 0x50, 0xc4, 0x17, 0x1a, 0xdc, 0x8d,
+	0xc0, 0x61, 0xcb, 0x39, 0x26, 0x2f, 0x89, 0x1e, 0xde, 0x05, 0x18, 0x91, 0x85, 0xe9, 0x71, 0xc8,
+	0x5c, 0x0d, 0xbe, 0xa7, 0x61, 0xac, 0x03, 0x6f, 0xb0, 0x3e, 0x92, 0x57, 0xeb, 0xaa, 0x8f, 0xdf,
+	0x28, 0xd0, 0x38, 0x19, 0x82, 0xb5, 0x50, 0xc7, 0x5f, 0x32, 0xa1, 0x3a, 0x76, 0x1d, 0x66, 0xb3,
+	0xc5, 0x4b, 0x93, 0x2d, 0xee, 0x03, 0x22, 0x1c, 0xb1, 0x39, 0x70, 0xc7, 0xb3, 0x89, 0x63, 0x3a,
+	0xd6, 0x84, 0xc8, 0xe2, 0xb3, 0x26, 0x7a, 0x7a, 0xbc, 0xe3, 0x91, 0x35, 0x21, 0xe8, 0xc7, 0x70,
+	0x4b, 0x8e, 0x4e, 0xa4, 0x98, 0x1c, 0x17, 0x55, 0x3b, 0x40, 0x7a, 0x06, 0x13, 0x9d, 0xc0, 0x80,
+	0x37, 0xc4, 0x24, 0x1f, 0x9f, 0x9d, 0x92, 0xf2, 0x57, 0x92, 0x5c, 0xe1, 0x62, 0xc9, 0xe9, 0xcb,
+	0x48, 0xae, 0x79, 0x04, 0x85, 0x00, 0x34, 0xda, 0x82, 0x0c, 0x87, 0xa6, 0x70, 0x68, 0xc5, 0xa0,
+	0x08, 0xf5, 0x11, 0xf1, 0x8e, 0x64, 0xbd, 0x58, 0x92, 0xf5, 0x22, 0xda, 0x82, 0x62, 0x8c, 0x2b,
+	0x1e, 0xab, 0x12, 0x86, 0x28, 0x1b, 0xc7, 0x65, 0x1d, 0x63, 0x6c, 0x2d, 0x64, 0xfd, 0x2f, 0x15,
+	0x6e, 0x49, 0x68, 0x3b, 0x16, 0x1b, 0x3c, 0xbb, 0x71, 0x49, 0xbf, 0x0d, 0x79, 0x1f, 0x8d, 0x4d,
+	0x68, 0x43, 0x6b, 0x69, 0xa7, 0x8b, 0x3a, 0x18, 0xb1, 0x6a, 0xc1, 0xbb, 0x0d, 0x15, 0x8b, 0x9e,
+	0x52, 0xec, 0x96, 0x2d, 0xfa, 0x22, 0x2a, 0xdd, 0xe7, 0x0a, 0xd4, 0x93, 0x9c, 0xde, 0x58, 0xa8,
+	0xbf, 0x09, 0x79, 0x11, 0xc8, 0x80, 0xcd, 0x3b, 0x12, 0x9b, 0x08, 0xf3, 0x27, 0x36, 0x7b, 0x26,
+	0xa6, 0x0e, 0x86, 0x19, 0x0e, 0x54, 0x39, 0xd3, 0xdc, 0x37, 0x4e, 0x77, 0x94, 0x65, 0x94, 0x4b,
+	0x64, 0x19, 0xf5, 0xcc, 0xaa, 0x54, 0x8b, 0x57, 0xa5, 0xc6, 0x9f, 0xa3, 0x3a, 0x8b, 0x93, 0xf1,
+	0x82, 0x2a, 0xed, 0x77, 0xd3, 0x32, 0x0b, 0xdf, 0x86, 0x53, 0xde, 0xbf, 0x28, 0xb1, 0x5d, 0xf6,
+	0xc5, 0xde, 0xf8, 0x6d, 0x54, 0x2b, 0x25, 0x88, 0xbb, 0x31, 0x2d, 0xdd, 0x4f, 0x6b, 0xe9, 0xb4,
+	0xbc, 0x11, 0xea, 0xe8, 0x97, 0x50, 0xe7, 0x4c, 0x46, 0x19, 0xfe, 0x1a, 0xc5, 0x94, 0x2e, 0x70,
+	0xb5, 0x13, 0x05, 0xae, 0xf1, 0x77, 0x15, 0xee, 0xc5, 0xe9, 0x79, 0x91, 0x45, 0xfc, 0x77, 0xd2,
+	0xe2, 0xda, 0x4c, 0x88, 0x2b, 0x45, 0xc9, 0xda, 0x2a, 0xec, 0xf7, 0x0a, 0x6c, 0x9d, 0x49, 0xe1,
+	0x9a, 0xc8, 0xec, 0x8f, 0x2a, 0xd4, 0x0f, 0x98, 0x47, 0xac, 0xc9, 0x95, 0x4e, 0x63, 0x42, 0x55,
+	0xaa, 0x97, 0x3b, 0x62, 0xd1, 0x96, 0x0f, 0x51, 0x6a, 0x2b, 0xc9, 0x5c, 0xb0, 0x95, 0x64, 0x97,
+	0x3a, 0xdd, 0x8b, 0xf1, 0x9a, 0x3b, 0x9f, 0x57, 0xa3, 0x07, 0xb7, 0x53, 0x44, 0xc9, 0x10, 0x46,
+	0xe5, 0x80, 0x72, 0x61, 0x39, 0xf0, 0xa5, 0x0a, 0xcd, 0xc4, 0x2c, 0x57, 0x49, 0xd7, 0x4b, 0x93,
+	0x1e, 0x4f, 0x05, 0xda, 0x99, 0xfb, 0x4a, 0xe6, 0xbc, 0xd3, 0x8e, 0xec, 0x92, 0x81, 0xba, 0xf4,
+	0x22, 0xe9, 0xc3, 0xab, 0xa7, 0x12, 0xb2, 0x02, 0xb9, 0xbf, 0x53, 0x61, 0x2b, 0x31, 0xd7, 0x95,
+	0x73, 0xd6, 0xb5, 0x30, 0x9c, 0x4e, 0xb6, 0x99, 0x0b, 0x4f, 0x13, 0x6e, 0x8c, 0xec, 0x47, 0xd0,
+	0x3a, 0x9b, 0xa0, 0x15, 0x18, 0xff, 0x93, 0x0a, 0x5f, 0x4f, 0x4f, 0x78, 0x95, 0x17, 0xfb, 0x6b,
+	0xe1, 0x3b, 0xf9, 0xb6, 0x9e, 0x59, 0xe1, 0x6d, 0xfd, 0xc6, 0xf8, 0x7f, 0x08, 0xf7, 0xce, 0xa2,
+	0x6b, 0x05, 0xf6, 0x7f, 0x02, 0xa5, 0x1d, 0x72, 0x6c, 0x3b, 0xab, 0x71, 0x9d, 0xf8, 0xd6, 0xa2,
+	0x26, 0xbf, 0xb5, 0x18, 0x1f, 0x40, 0x59, 0x4e, 0x2d, 0x71, 0xc5, 0x12, 0xa5, 0x72, 0x41, 0xa2,
+	0xfc, 0x42, 0x81, 0x72, 0x8f, 0x7f, 0x92, 0xb9, 0xf1, 0x42, 0xe1, 0x0e, 0xe4, 0x2c, 0xe6, 0x4e,
+	0xec, 0x81, 0xfc, 0x58, 0x24, 0x5b, 0x46, 0x0d, 0x2a, 0x01, 0x02, 0x81, 0xdf, 0xf8, 0x19, 0x54,
+	0xb1, 0x3b, 0x1e, 0x1f, 0x59, 0x83, 0xd1, 0x4d, 0xa3, 0x32, 0x10, 0xd4, 0xa2, 0x67, 0xc9, 0xe7,
+	0x7f, 0x0a, 0xaf, 0x60, 0x42, 0xdd, 0xf1, 0x9c, 0xc4, 0x4a, 0x8a, 0xd5, 0x90, 0x20, 0xc8, 0x0c,
+	0x99, 0xfc, 0x36, 0xa3, 0x63, 0x7e, 0x6d, 0xfc, 0x4d, 0x81, 0xfa, 0x1e, 0xa1, 0xd4, 0x3a, 0x26,
+	0x42, 0x60, 0xab, 0x4d, 0x7d, 0x5e, 0xcd, 0x58, 0x87, 0xac, 0xd8, 0x79, 0xc5, 0x7a, 0x13, 0x0d,
+	0xf4, 0x0e, 0xe8, 0xe1, 0x62, 0x6b, 0x64, 0xa4, 0x64, 0x4f, 0xae, 0xb5, 0x42, 0xb0, 0xd6, 0x7c,
+	0xf4, 0xb1, 0xf3, 0x11, 0x7e, 0x6d, 0xfc, 0x4a, 0x81, 0x0d, 0x89, 0xfe, 0xc3, 0xc1, 0xe8, 0xfa,
+	0xa1, 0x07, 0xcf, 0xd4, 0xa2, 0x67, 0xa2, 0x7b, 0xa0, 0x05, 0xc9, 0xb8, 0xd8, 0x2d, 0xc9, 0x55,
+	0xf6, 0xd4, 0x1a, 0xcf, 0x08, 0xf6, 0x3b, 0x8c, 0x3d, 0x28, 0xf5, 0x63, 0x95, 0x26, 0xda, 0x04,
+	0x35, 0x84, 0x91, 0x1c, 0xae, 0xda, 0xc3, 0xf4, 0x11, 0x85, 0x7a, 0xe2, 0x88, 0xe2, 0xaf, 0x0a,
+	0x6c, 0x46, 0x2e, 0x5e, 0x79, 0x63, 0xba, 0xac, 0xb7, 0xdf, 0x83, 0xaa, 0x3d, 0x34, 0x4f, 0x6c,
+	0x43, 0xc5, 0x6e, 0x3d, 0x50, 0x71, 0xdc, 0x59, 0x5c, 0xb6, 0x63, 0x2d, 0x6a, 0x6c, 0x42, 0xf3,
+	0x34, 0xf1, 0x4a, 0x69, 0xff, 0x4f, 0x85, 0x8d, 0x83, 0xe9, 0xd8, 0x66, 0x32, 0x47, 0x5d, 0xb7,
+	0x3f, 0x4b, 0x1f, 0xd2, 0xbd, 0x06, 0x25, 0xea, 0xe3, 0x90, 0xe7, 0x70, 0xb2, 0xa0, 0x29, 0x72,
+	0x9b, 0x38, 0x81, 0xf3, 0xe3, 0x14, 0x0c, 0x99, 0x39, 0x8c, 0x8b, 0x50, 0xc3, 0x20, 0x47, 0xcc,
+	0x1c, 0x86, 0xbe, 0x05, 0x77, 0x9d, 0xd9, 0xc4, 0xf4, 0xdc, 0xcf, 0xa9, 0x39, 0x25, 0x9e, 0xc9,
+	0x67, 0x36, 0xa7, 0x96, 0xc7, 0x78, 0x8a, 0xd7, 0xf0, 0x2d, 0x67, 0x36, 0xc1, 0xee, 0xe7, 0x74,
+	0x9f, 0x78, 0xfc, 0xe1, 0xfb, 0x96, 0xc7, 0xd0, 0x0f, 0x40, 0xb7, 0xc6, 0xc7, 0xae, 0x67, 0xb3,
+	0x67, 0x13, 0x79, 0xf0, 0x66, 0x48, 0x98, 0x27, 0x98, 0xe9, 0x7c, 0x18, 0x8c, 0xc4, 0xd1, 0x4d,
+	0xe8, 0x6d, 0x40, 0x33, 0x4a, 0x4c, 0x01, 0x4e, 0x3c, 0x74, 0xde, 0x95, 0xa7, 0x70, 0xd5, 0x19,
+	0x25, 0xd1, 0x34, 0x4f, 0xbb, 0xc6, 0x3f, 0x34, 0x40, 0xf1, 0x79, 0x65, 0x8e, 0xfe, 0x2e, 0xe4,
+	0xf8, 0xfd, 0xb4, 0xa1, 0xf0, 0xd8, 0x6e, 0x85, 0x19, 0xea, 0xc4, 0xd8, 0x8e, 0x0f, 0x1b, 0xcb,
+	0xe1, 0xcd, 0x4f, 0xa1, 0x14, 0xac, 0x54, 0xee, 0x4e, 0x3c, 0x1a, 0xca, 0xb9, 0xbb, 0xab, 0xba,
+	0xc4, 0xee, 0xda, 0xfc, 0x3e, 0xe8, 0xbc, 0xaa, 0xbb, 0x70, 0xee, 0xa8, 0x16, 0x55, 0xe3, 0xb5,
+	0x68, 0xf3, 0xdf, 0x0a, 0x64, 0xf8, 0xcd, 0x4b, 0xbf, 0xfc, 0xee, 0x41, 0x25, 0x44, 0x29, 0xa2,
+	0x27, 0x92, 0xf6, 0x1b, 0xe7, 0x50, 0x12, 0xa7, 0x00, 0x97, 0x46, 0xb1, 0x16, 0xea, 0x01, 0x88,
+	0x3f, 0x37, 0xf0, 0xa9, 0x84, 0x0e, 0x5f, 0x3f, 0x67, 0xaa, 0xd0, 0x5d, 0xac, 0xd3, 0xd0, 0x73,
+	0x04, 0x19, 0x6a, 0xff, 0x42, 0x64, 0x49, 0x0d, 0xf3, 0x6b, 0xe3, 0x3d, 0xb8, 0xfd, 0x11, 0x61,
+	0x07, 0xde, 0x3c, 0x58, 0x6e, 0xc1, 0xf2, 0x39, 0x87, 0x26, 0x03, 0xc3, 0x9d, 0xf4, 0x4d, 0x52,
+	0x01, 0xef, 0x43, 0x89, 0x7a, 0x73, 0x33, 0x71, 0xa7, 0x5f, 0x95, 0x84, 0xe1, 0x89, 0xdf, 0x54,
+	0xa4, 0x51, 0xc3, 0xf8, 0x83, 0x0a, 0xb7, 0x9e, 0x4c, 0x87, 0x16, 0x5b, 0xf7, 0xfd, 0x63, 0xc5,
+	0x52, 0x6d, 0x13, 0x74, 0x66, 0x4f, 0x08, 0x65, 0xd6, 0x64, 0x2a, 0x57, 0x72, 0x64, 0xf0, 0x75,
+	0x45, 0xe6, 0xc4, 0x61, 0x8d, 0x7c, 0x42, 0x57, 0xbb, 0xbe, 0xed, 0xd0, 0x1d, 0x11, 0x07, 0x8b,
+	0x7e, 0x63, 0x04, 0xf5, 0x24, 0x4b, 0x92, 0xf8, 0x76, 0x30, 0x41, 0xb2, 0x6a, 0x93, 0xc5, 0x9e,
+	0xdf, 0x23, 0x67, 0x40, 0x6f, 0x42, 0xcd, 0x2f, 0xdf, 0x26, 0xc4, 0x8c, 0xf0, 0x88, 0x7f, 0x59,
+	0x54, 0x85, 0xfd, 0x30, 0x30, 0xbf, 0xf5, 0x00, 0xaa, 0xa9, 0xbf, 0x96, 0xa0, 0x2a, 0x14, 0x9f,
+	0x3c, 0x3a, 0xd8, 0xdf, 0xed, 0xf5, 0x7f, 0xd8, 0xdf, 0x7d, 0x50, 0xfb, 0x1a, 0x02, 0xc8, 0x1d,
+	0xf4, 0x1f, 0x7d, 0xf4, 0x70, 0xb7, 0xa6, 0x20, 0x1d, 0xb2, 0x7b, 0x4f, 0x1e, 0x1e, 0xf6, 0x6b,
+	0xaa, 0x7f, 0x79, 0xf8, 0xc9, 0xe3, 0xfd, 0x5e, 0x4d, 0xdb, 0xd9, 0x80, 0xaa, 0xed, 0x76, 0xe6,
+	0x36, 0x23, 0x94, 0x8a, 0xbf, 0xf7, 0x1c, 0xe5, 0xf8, 0xcf, 0x7b, 0x5f, 0x0d, 0x00, 0xc6, 0x75,
+	0xbe, 0x04, 0x27, 0x24, 0x00, 0x00,
 }
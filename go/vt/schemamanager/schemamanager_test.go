@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -163,6 +164,54 @@ func TestSchemaManagerRun(t *testing.T) {
 	}
 }
 
+func TestSchemaManagerRunOnlineDDL(t *testing.T) {
+	sql := "alter table test_table add column c int"
+	controller := newFakeController(
+		[]string{sql}, false, false, false)
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaChange(sql, &tabletmanagerdatapb.SchemaChangeResult{
+		BeforeSchema: &tabletmanagerdatapb.SchemaDefinition{},
+		AfterSchema: &tabletmanagerdatapb.SchemaDefinition{
+			DatabaseSchema: "CREATE DATABASE `{{.DatabaseName}}` /*!40100 DEFAULT CHARACTER SET utf8 */",
+			TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+				{
+					Name:   "test_table",
+					Schema: "create table test_table (pk int, c int)",
+					Type:   tmutils.TableBaseTable,
+				},
+			},
+		},
+	})
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{})
+
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	executor := NewTabletExecutor(wr, testWaitSlaveTimeout)
+	executor.EnableOnlineDDL()
+
+	ctx := context.Background()
+	if err := Run(ctx, controller, executor); err != nil {
+		t.Fatalf("schema change should success but get error: %v", err)
+	}
+
+	// The ALTER is not applied, but queued on the 3 masters,
+	// with the same uuid.
+	uuids := make(map[string]bool)
+	for _, query := range fakeTmc.queries {
+		if query == sql {
+			t.Fatalf("the ALTER should not be applied directly")
+		}
+		if strings.HasPrefix(query, "INSERT INTO _vt.schema_migrations") {
+			uuids[strings.Split(query, "'")[1]] = true
+		}
+	}
+	if len(uuids) != 1 {
+		t.Fatalf("the migration should be queued with a single uuid, got: %v", fakeTmc.queries)
+	}
+	if got := len(fakeTmc.queries); got != 9 {
+		t.Fatalf("got %v queries, want 3 per master: %v", got, fakeTmc.queries)
+	}
+}
+
 func TestSchemaManagerExecutorFail(t *testing.T) {
 	sql := "create table test_table (pk int)"
 	controller := newFakeController([]string{sql}, false, false, false)
@@ -245,6 +294,9 @@ type fakeTabletManagerClient struct {
 	EnableExecuteFetchAsDbaError bool
 	preflightSchemas             map[string]*tabletmanagerdatapb.SchemaChangeResult
 	schemaDefinitions            map[string]*tabletmanagerdatapb.SchemaDefinition
+
+	mu      sync.Mutex
+	queries []string
 }
 
 func (client *fakeTabletManagerClient) AddSchemaChange(sql string, schemaResult *tabletmanagerdatapb.SchemaChangeResult) {
@@ -281,6 +333,9 @@ func (client *fakeTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, ta
 	if client.EnableExecuteFetchAsDbaError {
 		return nil, fmt.Errorf("ExecuteFetchAsDba occur an unknown error")
 	}
+	client.mu.Lock()
+	client.queries = append(client.queries, string(query))
+	client.mu.Unlock()
 	return client.TabletManagerClient.ExecuteFetchAsDba(ctx, tablet, usePool, query, maxRows, disableBinlogs, reloadSchema)
 }
 
@@ -21,13 +21,16 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pborman/uuid"
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
@@ -39,6 +42,7 @@ type TabletExecutor struct {
 	schemaDiffs          []*tabletmanagerdatapb.SchemaChangeResult
 	isClosed             bool
 	allowBigSchemaChange bool
+	onlineDDL            bool
 	keyspace             string
 	waitSlaveTimeout     time.Duration
 }
@@ -65,6 +69,18 @@ func (exec *TabletExecutor) DisallowBigSchemaChange() {
 	exec.allowBigSchemaChange = false
 }
 
+// EnableOnlineDDL changes TabletExecutor such that ALTER TABLE statements
+// are not applied directly, but submitted as online schema migrations
+// to the masters. They are not subject to the big schema change check.
+func (exec *TabletExecutor) EnableOnlineDDL() {
+	exec.onlineDDL = true
+}
+
+// DisableOnlineDDL makes TabletExecutor apply all the statements directly.
+func (exec *TabletExecutor) DisableOnlineDDL() {
+	exec.onlineDDL = false
+}
+
 // Open opens a connection to the master for every shard.
 func (exec *TabletExecutor) Open(ctx context.Context, keyspace string) error {
 	if !exec.isClosed {
@@ -155,6 +171,11 @@ func (exec *TabletExecutor) detectBigSchemaChanges(ctx context.Context, parsedDD
 		switch ddl.Action {
 		case sqlparser.DropStr, sqlparser.CreateStr, sqlparser.TruncateStr:
 			continue
+		case sqlparser.AlterStr:
+			if exec.onlineDDL {
+				// Online schema migrations don't lock the table.
+				continue
+			}
 		}
 		tableName := ddl.Table.Name.String()
 		if rowCount, ok := tableWithCount[tableName]; ok {
@@ -234,9 +255,26 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 		return &execResult
 	}
 
+	parsedDDLs, err := parseDDLs(sqls)
+	if err != nil {
+		execResult.ExecutorErr = err.Error()
+		return &execResult
+	}
+
 	for index, sql := range sqls {
 		execResult.CurSQLIndex = index
-		exec.executeOnAllTablets(ctx, &execResult, sql)
+		queries := []string{sql}
+		if exec.onlineDDL && parsedDDLs[index].Action == sqlparser.AlterStr {
+			// The migration has the same uuid on all the shards.
+			migrationUUID := uuid.NewUUID().String()
+			queries, err = onlineddl.SubmitQueries(migrationUUID, parsedDDLs[index].Table.Name.String(), sql)
+			if err != nil {
+				execResult.ExecutorErr = err.Error()
+				return &execResult
+			}
+			exec.wr.Logger().Printf("Submitting online schema migration %v: %v\n", migrationUUID, sql)
+		}
+		exec.executeOnAllTablets(ctx, &execResult, queries)
 		if len(execResult.FailedShards) > 0 {
 			break
 		}
@@ -244,7 +282,7 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 	return &execResult
 }
 
-func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult *ExecuteResult, sql string) {
+func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult *ExecuteResult, queries []string) {
 	var wg sync.WaitGroup
 	numOfMasterTablets := len(exec.tablets)
 	wg.Add(numOfMasterTablets)
@@ -253,7 +291,7 @@ func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult
 	for _, tablet := range exec.tablets {
 		go func(tablet *topodatapb.Tablet) {
 			defer wg.Done()
-			exec.executeOneTablet(ctx, tablet, sql, errChan, successChan)
+			exec.executeOneTablet(ctx, tablet, queries, errChan, successChan)
 		}(tablet)
 	}
 	wg.Wait()
@@ -291,13 +329,17 @@ func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult
 func (exec *TabletExecutor) executeOneTablet(
 	ctx context.Context,
 	tablet *topodatapb.Tablet,
-	sql string,
+	queries []string,
 	errChan chan ShardWithError,
 	successChan chan ShardResult) {
-	result, err := exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(sql), 10, false, true)
-	if err != nil {
-		errChan <- ShardWithError{Shard: tablet.Shard, Err: err.Error()}
-		return
+	var result *querypb.QueryResult
+	for _, query := range queries {
+		var err error
+		result, err = exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte(query), 10, false, true)
+		if err != nil {
+			errChan <- ShardWithError{Shard: tablet.Shard, Err: err.Error()}
+			return
+		}
 	}
 	// Get a replication position that's guaranteed to be after the schema change
 	// was applied on the master.
@@ -168,6 +168,19 @@ func TestTabletExecutorValidate(t *testing.T) {
 	}); err == nil {
 		t.Fatalf("executor.Validate should fail, alter a table more than 100,000 rows")
 	}
+
+	executor.EnableOnlineDDL()
+	// online schema migrations don't lock the table
+	if err := executor.Validate(ctx, []string{
+		"ALTER TABLE test_table_04 ADD COLUMN new_id bigint(20)",
+	}); err != nil {
+		t.Fatalf("executor.Validate should succeed, online schema migrations are not big schema changes: %v", err)
+	}
+	if err := executor.Validate(ctx, []string{
+		"RENAME TABLE test_table_04 TO test_table_05",
+	}); err == nil {
+		t.Fatalf("executor.Validate should fail, only ALTER TABLE runs as an online schema migration")
+	}
 }
 
 func TestTabletExecutorExecute(t *testing.T) {
@@ -42,6 +42,9 @@ const (
 	StmtBegin
 	StmtCommit
 	StmtRollback
+	StmtSavepoint
+	StmtSRollback
+	StmtRelease
 	StmtSet
 	StmtShow
 	StmtUse
@@ -63,7 +66,7 @@ func Preview(sql string) int {
 	// Comparison is done in order of priority.
 	loweredFirstWord := strings.ToLower(firstWord)
 	switch loweredFirstWord {
-	case "select":
+	case "select", "with":
 		return StmtSelect
 	case "stream":
 		return StmtStream
@@ -93,6 +96,12 @@ func Preview(sql string) int {
 	switch loweredFirstWord {
 	case "create", "alter", "rename", "drop", "truncate":
 		return StmtDDL
+	case "rollback":
+		return StmtSRollback
+	case "savepoint":
+		return StmtSavepoint
+	case "release":
+		return StmtRelease
 	case "set":
 		return StmtSet
 	case "show":
@@ -131,6 +140,12 @@ func StmtType(stmtType int) string {
 		return "COMMIT"
 	case StmtRollback:
 		return "ROLLBACK"
+	case StmtSavepoint:
+		return "SAVEPOINT"
+	case StmtSRollback:
+		return "SAVEPOINT_ROLLBACK"
+	case StmtRelease:
+		return "RELEASE"
 	case StmtSet:
 		return "SET"
 	case StmtShow:
@@ -306,3 +321,28 @@ func ExtractSetValues(sql string) (keyValues map[string]interface{}, scope strin
 	}
 	return result, strings.ToLower(setStmt.Scope), nil
 }
+
+// passthroughSystemVariables are the session variables vtgate passes
+// through to the tablets. They only change how the queries of the
+// session run. The variables that would change the replication, like
+// sql_log_bin or gtid_next, or that vttablet depends on, like
+// autocommit or sql_auto_is_null, are not in the list.
+var passthroughSystemVariables = map[string]bool{
+	"div_precision_increment":  true,
+	"group_concat_max_len":     true,
+	"innodb_lock_wait_timeout": true,
+	"lc_time_names":            true,
+	"max_execution_time":       true,
+	"max_sort_length":          true,
+	"sql_mode":                 true,
+	"sql_quote_show_create":    true,
+	"sql_safe_updates":         true,
+	"time_zone":                true,
+}
+
+// IsPassthroughSystemVariable returns true if the session variable
+// can be set by the sessions of vtgate on the tablet connections.
+// name must be lower case.
+func IsPassthroughSystemVariable(name string) bool {
+	return passthroughSystemVariables[name]
+}
@@ -31,6 +31,7 @@ func TestPreview(t *testing.T) {
 	}{
 		{"select ...", StmtSelect},
 		{"    select ...", StmtSelect},
+		{"with t as (select 1) select ...", StmtSelect},
 		{"insert ...", StmtInsert},
 		{"replace ....", StmtReplace},
 		{"   update ...", StmtUpdate},
@@ -52,6 +53,10 @@ func TestPreview(t *testing.T) {
 		{"commit /*...*/", StmtCommit},
 		{"rollback", StmtRollback},
 		{"rollback /*...*/", StmtRollback},
+		{"rollback to a", StmtSRollback},
+		{"rollback to savepoint a /*...*/", StmtSRollback},
+		{"savepoint a", StmtSavepoint},
+		{"release savepoint a", StmtRelease},
 		{"create", StmtDDL},
 		{"alter", StmtDDL},
 		{"rename", StmtDDL},
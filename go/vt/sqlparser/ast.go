@@ -54,10 +54,12 @@ func Parse(sql string) (Statement, error) {
 		if tokenizer.partialDDL != nil {
 			log.Warningf("ignoring error parsing DDL '%s': %v", sql, tokenizer.LastError)
 			tokenizer.ParseTree = tokenizer.partialDDL
+			addUnparsedAlter(tokenizer, sql)
 			return tokenizer.ParseTree, nil
 		}
 		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, tokenizer.LastError.Error())
 	}
+	addUnparsedAlter(tokenizer, sql)
 	return tokenizer.ParseTree, nil
 }
 
@@ -68,6 +70,7 @@ func ParseStrictDDL(sql string) (Statement, error) {
 	if yyParse(tokenizer) != 0 {
 		return nil, tokenizer.LastError
 	}
+	addUnparsedAlter(tokenizer, sql)
 	return tokenizer.ParseTree, nil
 }
 
@@ -87,16 +90,83 @@ func ParseNext(tokenizer *Tokenizer) (Statement, error) {
 
 	tokenizer.reset()
 	tokenizer.multi = true
+	// The text of the statement is recorded, for the
+	// alterations of an ALTER TABLE that can't be parsed.
+	tokenizer.startRecording()
 	if yyParse(tokenizer) != 0 {
 		if tokenizer.partialDDL != nil {
+			// Skip the rest of the DDL, which the parser didn't read.
+			tokenizer.skipStatement()
 			tokenizer.ParseTree = tokenizer.partialDDL
+			addUnparsedAlter(tokenizer, tokenizer.stopRecording())
 			return tokenizer.ParseTree, nil
 		}
+		tokenizer.stopRecording()
 		return nil, tokenizer.LastError
 	}
+	addUnparsedAlter(tokenizer, tokenizer.stopRecording())
 	return tokenizer.ParseTree, nil
 }
 
+// addUnparsedAlter keeps the text of the alterations of an ALTER TABLE
+// statement that the grammar could not parse: the parse tree is then
+// the partial DDL, which only has the table name.
+func addUnparsedAlter(tokenizer *Tokenizer, sql string) {
+	ddl, ok := tokenizer.ParseTree.(*DDL)
+	if !ok || ddl != tokenizer.partialDDL || ddl.Action != AlterStr {
+		return
+	}
+	if text := alterText(sql); text != "" {
+		ddl.AlterSpecs = []*AlterSpec{{Action: UnparsedAlterStr, Text: text}}
+	}
+}
+
+// alterText returns the text that follows the table name of the
+// ALTER TABLE statement at the start of sql, up to the end of the
+// statement.
+func alterText(sql string) string {
+	tokenizer := NewStringTokenizer(sql)
+	scan := func() int {
+		typ, _ := tokenizer.Scan()
+		for typ == COMMENT {
+			typ, _ = tokenizer.Scan()
+		}
+		return typ
+	}
+	if scan() != ALTER {
+		return ""
+	}
+	typ := scan()
+	if typ == IGNORE {
+		typ = scan()
+	}
+	if typ != TABLE {
+		return ""
+	}
+	// The table name is an identifier, optionally qualified
+	// by the keyspace. Position is past the end of the last
+	// token read.
+	scan()
+	start := tokenizer.Position - 1
+	typ = scan()
+	if typ == '.' {
+		scan()
+		start = tokenizer.Position - 1
+		typ = scan()
+	}
+	end := start
+	for typ != 0 && typ != ';' {
+		if typ == LEX_ERROR {
+			// The rest of the statement can't be tokenized.
+			end = len(sql)
+			break
+		}
+		end = tokenizer.Position - 1
+		typ = scan()
+	}
+	return strings.TrimSpace(sql[start:end])
+}
+
 // SplitStatement returns the first sql statement up to either a ; or EOF
 // and the remainder from the given buffer
 func SplitStatement(blob string) (string, string, error) {
@@ -117,6 +187,36 @@ func SplitStatement(blob string) (string, string, error) {
 	return blob, "", nil
 }
 
+// SplitStatementToPieces splits the given buffer into its statements,
+// separated by ;. The ; inside comments and quoted strings are not
+// separators. The pieces are trimmed, and the empty ones are skipped.
+func SplitStatementToPieces(blob string) ([]string, error) {
+	var pieces []string
+	tokenizer := NewStringTokenizer(blob)
+	start := 0
+	for {
+		tkn, _ := tokenizer.Scan()
+		if tkn != 0 && tkn != ';' && tkn != eofChar {
+			continue
+		}
+		end := len(blob)
+		if tkn == ';' {
+			end = tokenizer.Position - 2
+		}
+		if piece := strings.TrimSpace(blob[start:end]); piece != "" {
+			pieces = append(pieces, piece)
+		}
+		if tkn != ';' {
+			break
+		}
+		start = tokenizer.Position - 1
+	}
+	if tokenizer.LastError != nil {
+		return nil, tokenizer.LastError
+	}
+	return pieces, nil
+}
+
 // SQLNode defines the interface for all nodes
 // generated by the parser.
 type SQLNode interface {
@@ -192,6 +292,9 @@ func (*Use) iStatement()        {}
 func (*Begin) iStatement()      {}
 func (*Commit) iStatement()     {}
 func (*Rollback) iStatement()   {}
+func (*Savepoint) iStatement()  {}
+func (*SRollback) iStatement()  {}
+func (*Release) iStatement()    {}
 func (*OtherRead) iStatement()  {}
 func (*OtherAdmin) iStatement() {}
 
@@ -216,6 +319,7 @@ func (*ParenSelect) iSelectStatement() {}
 
 // Select represents a SELECT statement.
 type Select struct {
+	With        *With
 	Cache       string
 	Comments    Comments
 	Distinct    string
@@ -260,8 +364,8 @@ func (node *Select) SetLimit(limit *Limit) {
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
-		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
+	buf.Myprintf("%vselect %v%s%s%s%v from %v%v%v%v%v%v%s",
+		node.With, node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
 		node.From, node.Where,
 		node.GroupBy, node.Having, node.OrderBy,
 		node.Limit, node.Lock)
@@ -274,6 +378,7 @@ func (node *Select) WalkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.SelectExprs,
 		node.From,
@@ -364,6 +469,7 @@ func (node *ParenSelect) WalkSubtree(visit Visit) error {
 
 // Union represents a UNION statement.
 type Union struct {
+	With        *With
 	Type        string
 	Left, Right SelectStatement
 	OrderBy     OrderBy
@@ -390,7 +496,7 @@ func (node *Union) SetLimit(limit *Limit) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v%v%v%s", node.Left, node.Type, node.Right,
+	buf.Myprintf("%v%v %s %v%v%v%s", node.With, node.Left, node.Type, node.Right,
 		node.OrderBy, node.Limit, node.Lock)
 }
 
@@ -401,11 +507,73 @@ func (node *Union) WalkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Left,
 		node.Right,
 	)
 }
 
+// With represents the WITH clause of a SELECT or UNION.
+type With struct {
+	Recursive bool
+	CTEs      []*CommonTableExpr
+}
+
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.Myprintf("with ")
+	if node.Recursive {
+		buf.Myprintf("recursive ")
+	}
+	var prefix string
+	for _, cte := range node.CTEs {
+		buf.Myprintf("%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.Myprintf(" ")
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *With) WalkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, cte := range node.CTEs {
+		if err := Walk(visit, cte); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CommonTableExpr represents a named subquery of a WITH clause.
+type CommonTableExpr struct {
+	Name     TableIdent
+	Columns  Columns
+	Subquery *Subquery
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *CommonTableExpr) WalkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.Columns,
+		node.Subquery,
+	)
+}
+
 // Stream represents a SELECT statement.
 type Stream struct {
 	Comments   Comments
@@ -601,6 +769,8 @@ func (node *Set) WalkSubtree(visit Visit) error {
 // NewName is set for AlterStr, CreateStr, RenameStr.
 // VindexSpec is set for CreateVindexStr, DropVindexStr, AddColVindexStr, DropColVindexStr
 // VindexCols is set for AddColVindexStr
+// AlterSpecs is set for AlterStr. If the alterations could not be parsed,
+// it has a single UnparsedAlterStr AlterSpec with their text.
 type DDL struct {
 	Action        string
 	Table         TableName
@@ -608,6 +778,7 @@ type DDL struct {
 	IfExists      bool
 	TableSpec     *TableSpec
 	PartitionSpec *PartitionSpec
+	AlterSpecs    []*AlterSpec
 	VindexSpec    *VindexSpec
 	VindexCols    []ColIdent
 }
@@ -647,6 +818,13 @@ func (node *DDL) Format(buf *TrackedBuffer) {
 	case AlterStr:
 		if node.PartitionSpec != nil {
 			buf.Myprintf("%s table %v %v", node.Action, node.Table, node.PartitionSpec)
+		} else if len(node.AlterSpecs) != 0 {
+			buf.Myprintf("%s table %v", node.Action, node.Table)
+			prefix := " "
+			for _, spec := range node.AlterSpecs {
+				buf.Myprintf("%s%v", prefix, spec)
+				prefix = ", "
+			}
 		} else {
 			buf.Myprintf("%s table %v", node.Action, node.Table)
 		}
@@ -756,6 +934,147 @@ func (node *PartitionDefinition) WalkSubtree(visit Visit) error {
 	)
 }
 
+// Alter strings
+const (
+	AddColumnStr      = "add column"
+	DropColumnStr     = "drop column"
+	ModifyColumnStr   = "modify column"
+	ChangeColumnStr   = "change column"
+	AddIndexStr       = "add index"
+	DropIndexStr      = "drop index"
+	DropPrimaryKeyStr = "drop primary key"
+	RenameIndexStr    = "rename index"
+	RenameTableStr    = "rename table"
+	TableOptionStr    = "table option"
+	UnparsedAlterStr  = "unparsed"
+)
+
+// AlterSpec describes one of the alterations of an ALTER TABLE statement.
+// Column is set for AddColumnStr, ModifyColumnStr, ChangeColumnStr
+// ColumnName is set for DropColumnStr, ChangeColumnStr
+// Position is optional for AddColumnStr, ModifyColumnStr, ChangeColumnStr
+// Index is set for AddIndexStr
+// IndexName is set for DropIndexStr, RenameIndexStr
+// NewIndexName is set for RenameIndexStr
+// NewName is set for RenameTableStr
+// Option is set for TableOptionStr
+// Text is set for UnparsedAlterStr
+type AlterSpec struct {
+	Action       string
+	Column       *ColumnDefinition
+	ColumnName   ColIdent
+	Position     *ColumnPosition
+	Index        *IndexDefinition
+	IndexName    ColIdent
+	NewIndexName ColIdent
+	NewName      TableName
+	Option       string
+	Text         string
+}
+
+// Format formats the node.
+func (node *AlterSpec) Format(buf *TrackedBuffer) {
+	switch node.Action {
+	case AddColumnStr, ModifyColumnStr:
+		buf.Myprintf("%s %v%v", node.Action, node.Column, node.Position)
+	case ChangeColumnStr:
+		buf.Myprintf("%s %v %v%v", node.Action, node.ColumnName, node.Column, node.Position)
+	case DropColumnStr:
+		buf.Myprintf("%s %v", node.Action, node.ColumnName)
+	case AddIndexStr:
+		buf.Myprintf("add %v", node.Index)
+	case DropIndexStr:
+		buf.Myprintf("%s %v", node.Action, node.IndexName)
+	case DropPrimaryKeyStr:
+		buf.Myprintf("%s", node.Action)
+	case RenameIndexStr:
+		buf.Myprintf("%s %v to %v", node.Action, node.IndexName, node.NewIndexName)
+	case RenameTableStr:
+		buf.Myprintf("rename to %v", node.NewName)
+	case TableOptionStr:
+		buf.Myprintf("%s", node.Option)
+	case UnparsedAlterStr:
+		buf.Myprintf("%s", node.Text)
+	default:
+		panic("unimplemented")
+	}
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *AlterSpec) WalkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Column,
+		node.ColumnName,
+		node.Position,
+		node.Index,
+		node.IndexName,
+		node.NewIndexName,
+		node.NewName,
+	)
+}
+
+// alterTableOptions are the identifiers that can start a table option
+// of an ALTER TABLE statement. The ones that are keywords are listed
+// in the grammar.
+var alterTableOptions = map[string]bool{
+	"algorithm":          true,
+	"avg_row_length":     true,
+	"checksum":           true,
+	"compression":        true,
+	"connection":         true,
+	"data":               true,
+	"delay_key_write":    true,
+	"disable":            true,
+	"discard":            true,
+	"enable":             true,
+	"encryption":         true,
+	"engine":             true,
+	"import":             true,
+	"insert_method":      true,
+	"key_block_size":     true,
+	"max_rows":           true,
+	"min_rows":           true,
+	"pack_keys":          true,
+	"password":           true,
+	"row_format":         true,
+	"stats_auto_recalc":  true,
+	"stats_persistent":   true,
+	"stats_sample_pages": true,
+	"tablespace":         true,
+	"without":            true,
+}
+
+// ColumnPosition is the FIRST or AFTER clause of a column
+// that is added or moved by an ALTER TABLE statement.
+type ColumnPosition struct {
+	First bool
+	After ColIdent
+}
+
+// Format formats the node.
+func (node *ColumnPosition) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.First {
+		buf.Myprintf(" first")
+	} else {
+		buf.Myprintf(" after %v", node.After)
+	}
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *ColumnPosition) WalkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.After)
+}
+
 // TableSpec describes the structure of a table from a CREATE TABLE statement
 type TableSpec struct {
 	Columns []*ColumnDefinition
@@ -1085,7 +1404,7 @@ type IndexInfo struct {
 
 // Format formats the node.
 func (ii *IndexInfo) Format(buf *TrackedBuffer) {
-	if ii.Primary {
+	if ii.Primary || ii.Name.IsEmpty() {
 		buf.Myprintf("%s", ii.Type)
 	} else {
 		buf.Myprintf("%s %v", ii.Type, ii.Name)
@@ -1287,6 +1606,51 @@ func (node *Rollback) WalkSubtree(visit Visit) error {
 	return nil
 }
 
+// Savepoint represents a SAVEPOINT statement.
+type Savepoint struct {
+	Name ColIdent
+}
+
+// Format formats the node.
+func (node *Savepoint) Format(buf *TrackedBuffer) {
+	buf.Myprintf("savepoint %v", node.Name)
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *Savepoint) WalkSubtree(visit Visit) error {
+	return Walk(visit, node.Name)
+}
+
+// SRollback represents a ROLLBACK TO SAVEPOINT statement.
+type SRollback struct {
+	Name ColIdent
+}
+
+// Format formats the node.
+func (node *SRollback) Format(buf *TrackedBuffer) {
+	buf.Myprintf("rollback to %v", node.Name)
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *SRollback) WalkSubtree(visit Visit) error {
+	return Walk(visit, node.Name)
+}
+
+// Release represents a RELEASE SAVEPOINT statement.
+type Release struct {
+	Name ColIdent
+}
+
+// Format formats the node.
+func (node *Release) Format(buf *TrackedBuffer) {
+	buf.Myprintf("release savepoint %v", node.Name)
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *Release) WalkSubtree(visit Visit) error {
+	return Walk(visit, node.Name)
+}
+
 // OtherRead represents a DESCRIBE, or EXPLAIN statement.
 // It should be used only as an indicator. It does not contain
 // the full AST for the statement.
@@ -2440,6 +2804,7 @@ type FuncExpr struct {
 	Name      ColIdent
 	Distinct  bool
 	Exprs     SelectExprs
+	Over      *OverClause
 }
 
 // Format formats the node.
@@ -2454,7 +2819,7 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// Function names should not be back-quoted even
 	// if they match a reserved word. So, print the
 	// name as is.
-	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
+	buf.Myprintf("%s(%s%v)%v", node.Name.String(), distinct, node.Exprs, node.Over)
 }
 
 // WalkSubtree walks the nodes of the subtree.
@@ -2467,6 +2832,7 @@ func (node *FuncExpr) WalkSubtree(visit Visit) error {
 		node.Qualifier,
 		node.Name,
 		node.Exprs,
+		node.Over,
 	)
 }
 
@@ -2491,8 +2857,130 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// An aggregate function with an OVER clause is a window function,
+// and is not an aggregate.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// OverClause represents the OVER clause of a window function.
+type OverClause struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.Myprintf(" over (")
+	var sep string
+	if len(node.PartitionBy) != 0 {
+		buf.Myprintf("partition by %v", node.PartitionBy)
+		sep = " "
+	}
+	if len(node.OrderBy) != 0 {
+		prefix := sep + "order by "
+		for _, order := range node.OrderBy {
+			buf.Myprintf("%s%v", prefix, order)
+			prefix = ", "
+		}
+		sep = " "
+	}
+	if node.Frame != nil {
+		buf.Myprintf("%s%v", sep, node.Frame)
+	}
+	buf.Myprintf(")")
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *OverClause) WalkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.PartitionBy,
+		node.OrderBy,
+		node.Frame,
+	)
+}
+
+// FrameClause represents the frame of a window.
+// End is nil if the frame has no BETWEEN.
+type FrameClause struct {
+	Unit  string
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameClause.Unit
+const (
+	RowsStr  = "rows"
+	RangeStr = "range"
+)
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.End == nil {
+		buf.Myprintf("%s %v", node.Unit, node.Start)
+	} else {
+		buf.Myprintf("%s between %v and %v", node.Unit, node.Start, node.End)
+	}
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *FrameClause) WalkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Start,
+		node.End,
+	)
+}
+
+// FramePoint represents a bound of a window frame.
+// Expr is set for PrecedingStr and FollowingStr.
+type FramePoint struct {
+	Type string
+	Expr Expr
+}
+
+// FramePoint.Type
+const (
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	CurrentRowStr         = "current row"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+)
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	switch node.Type {
+	case PrecedingStr, FollowingStr:
+		buf.Myprintf("%v %s", node.Expr, node.Type)
+	default:
+		buf.Myprintf("%s", node.Type)
+	}
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *FramePoint) WalkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Expr)
 }
 
 // GroupConcatExpr represents a call to GROUP_CONCAT
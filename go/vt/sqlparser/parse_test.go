@@ -19,6 +19,7 @@ package sqlparser
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -93,6 +94,23 @@ var (
 		input: "select /* union */ 1 from t union select 1 from t",
 	}, {
 		input: "select /* double union */ 1 from t union select 1 from t union select 1 from t",
+	}, {
+		input: "with a as (select 1 from t) select /* cte */ * from a",
+	}, {
+		input:  "WITH a AS (select 1 from t), b (c, d) AS (select 1, 2 from a) select /* ctes */ * from b",
+		output: "with a as (select 1 from t), b(c, d) as (select 1, 2 from a) select /* ctes */ * from b",
+	}, {
+		input: "with recursive a(n) as (select 1 from dual union all select n + 1 from a where n < 10) select /* recursive cte */ n from a",
+	}, {
+		input: "with a as (select 1 from t) select /* cte union */ * from a union select 1 from b",
+	}, {
+		input: "with a as (select 1 from t) select /* cte order */ * from a order by 1 asc limit 1",
+	}, {
+		input: "select /* cte in subquery */ * from (with a as (select 1 from t) select * from a) as b",
+	}, {
+		input: "select /* cte in expression */ 1 from t where a in (with b as (select 1 from t) select * from b)",
+	}, {
+		input: "with a as (with b as (select 1 from t) select * from b) select /* nested cte */ * from a",
 	}, {
 		input: "select /* union all */ 1 from t union all select 1 from t",
 	}, {
@@ -415,6 +433,33 @@ var (
 		input: "select /* function with many params */ 1 from t where a = b(c, d)",
 	}, {
 		input: "select /* function with distinct */ count(distinct a) from t",
+	}, {
+		input: "select /* window function */ row_number() over () from t",
+	}, {
+		input: "select /* window partition */ a, rank() over (partition by b, c order by d desc) from t",
+	}, {
+		input:  "select /* window order */ sum(a) over (order by b, c) from t",
+		output: "select /* window order */ sum(a) over (order by b asc, c asc) from t",
+	}, {
+		input:  "select /* window frame */ sum(a) OVER (ORDER BY b ROWS UNBOUNDED PRECEDING) from t",
+		output: "select /* window frame */ sum(a) over (order by b asc rows unbounded preceding) from t",
+	}, {
+		input:  "select /* window between */ avg(a) over (partition by b order by c rows between 1 preceding and current row) from t",
+		output: "select /* window between */ avg(a) over (partition by b order by c asc rows between 1 preceding and current row) from t",
+	}, {
+		input: "select /* window range */ count(*) over (partition by b range between current row and unbounded following) from t",
+	}, {
+		input: "select /* window frame only */ max(a) over (rows between :a preceding and 2 following) from t",
+	}, {
+		input: "select /* window qualified */ a.b(c) over (partition by d) from t",
+	}, {
+		input: "select /* window distinct */ count(distinct a) over (partition by b) from t",
+	}, {
+		input:  "select /* window in order by */ a from t order by rank() over (order by b)",
+		output: "select /* window in order by */ a from t order by rank() over (order by b asc) asc",
+	}, {
+		input:  "select /* rows as column */ rows, `range`, current from t",
+		output: "select /* rows as column */ `rows`, `range`, `current` from t",
 	}, {
 		input: "select /* if as func */ 1 from t where a = if(b)",
 	}, {
@@ -679,43 +724,32 @@ var (
 		input: "set /* mixed list */ a = 3, names 'utf8', charset 'ascii', b = 4",
 	}, {
 		input:  "alter ignore table a add foo",
-		output: "alter table a",
+		output: "alter table a add foo",
 	}, {
-		input:  "alter table a add foo",
-		output: "alter table a",
+		input: "alter table a add foo",
 	}, {
-		input:  "alter table `By` add foo",
-		output: "alter table `By`",
+		input: "alter table `By` add foo",
 	}, {
-		input:  "alter table a alter foo",
-		output: "alter table a",
+		input: "alter table a alter foo",
 	}, {
-		input:  "alter table a change foo",
-		output: "alter table a",
+		input: "alter table a change foo",
 	}, {
-		input:  "alter table a modify foo",
-		output: "alter table a",
+		input: "alter table a modify foo",
 	}, {
 		input:  "alter table a drop foo",
-		output: "alter table a",
+		output: "alter table a drop column foo",
 	}, {
-		input:  "alter table a disable foo",
-		output: "alter table a",
+		input: "alter table a disable foo",
 	}, {
-		input:  "alter table a enable foo",
-		output: "alter table a",
+		input: "alter table a enable foo",
 	}, {
-		input:  "alter table a order foo",
-		output: "alter table a",
+		input: "alter table a order foo",
 	}, {
-		input:  "alter table a default foo",
-		output: "alter table a",
+		input: "alter table a default foo",
 	}, {
-		input:  "alter table a discard foo",
-		output: "alter table a",
+		input: "alter table a discard foo",
 	}, {
-		input:  "alter table a import foo",
-		output: "alter table a",
+		input: "alter table a import foo",
 	}, {
 		input:  "alter table a rename b",
 		output: "rename table a to b",
@@ -729,80 +763,134 @@ var (
 		input:  "alter table a rename as b",
 		output: "rename table a to b",
 	}, {
-		input:  "alter table a rename index foo to bar",
-		output: "alter table a",
+		input: "alter table a rename index foo to bar",
 	}, {
 		input:  "alter table a rename key foo to bar",
-		output: "alter table a",
+		output: "alter table a rename index foo to bar",
 	}, {
 		input:  "alter table e auto_increment = 20",
-		output: "alter table e",
+		output: "alter table e auto_increment=20",
 	}, {
 		input:  "alter table e character set = 'ascii'",
-		output: "alter table e",
+		output: "alter table e character set='ascii'",
 	}, {
 		input:  "alter table e default character set = 'ascii'",
-		output: "alter table e",
+		output: "alter table e default character set='ascii'",
 	}, {
 		input:  "alter table e comment = 'hello'",
-		output: "alter table e",
+		output: "alter table e comment='hello'",
 	}, {
 		input:  "alter table a reorganize partition b into (partition c values less than (?), partition d values less than (maxvalue))",
 		output: "alter table a reorganize partition b into (partition c values less than (:v1), partition d values less than (maxvalue))",
 	}, {
-		input:  "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
-		output: "alter table a",
+		input: "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
 	}, {
-		input:  "alter table a add column id int",
-		output: "alter table a",
+		input: "alter table a add column id int",
 	}, {
-		input:  "alter table a add index idx (id)",
-		output: "alter table a",
+		input: "alter table a add index idx (id)",
 	}, {
-		input:  "alter table a add fulltext index idx (id)",
-		output: "alter table a",
+		input: "alter table a add fulltext index idx (id)",
 	}, {
-		input:  "alter table a add spatial index idx (id)",
-		output: "alter table a",
+		input: "alter table a add spatial index idx (id)",
 	}, {
-		input:  "alter table a add foreign key",
-		output: "alter table a",
+		input: "alter table a add foreign key",
 	}, {
-		input:  "alter table a add primary key",
-		output: "alter table a",
+		input: "alter table a add primary key",
 	}, {
-		input:  "alter table a add constraint",
-		output: "alter table a",
+		input: "alter table a add constraint",
 	}, {
-		input:  "alter table a add id",
-		output: "alter table a",
+		input: "alter table a add id",
 	}, {
-		input:  "alter table a drop column id int",
-		output: "alter table a",
+		input: "alter table a drop column id int",
 	}, {
-		input:  "alter table a drop partition p2712",
-		output: "alter table a",
+		input: "alter table a drop partition p2712",
 	}, {
-		input:  "alter table a drop index idx (id)",
-		output: "alter table a",
+		input: "alter table a drop index idx (id)",
 	}, {
-		input:  "alter table a drop fulltext index idx (id)",
-		output: "alter table a",
+		input: "alter table a drop fulltext index idx (id)",
 	}, {
-		input:  "alter table a drop spatial index idx (id)",
-		output: "alter table a",
+		input: "alter table a drop spatial index idx (id)",
 	}, {
-		input:  "alter table a drop foreign key",
-		output: "alter table a",
+		input: "alter table a drop foreign key",
 	}, {
-		input:  "alter table a drop primary key",
-		output: "alter table a",
+		input: "alter table a drop primary key",
 	}, {
-		input:  "alter table a drop constraint",
-		output: "alter table a",
+		input: "alter table a drop constraint",
 	}, {
 		input:  "alter table a drop id",
-		output: "alter table a",
+		output: "alter table a drop column id",
+	}, {
+		input: "alter table a add column id int not null default 0 comment 'id' first",
+	}, {
+		input:  "alter table a add id bigint unsigned after b",
+		output: "alter table a add column id bigint unsigned after b",
+	}, {
+		input:  "alter table a add column id int FIRST",
+		output: "alter table a add column id int first",
+	}, {
+		input: "alter table a add column id int last",
+	}, {
+		input: "alter table a add column (id int, b int)",
+	}, {
+		input: "alter table a modify column id varchar(255) character set utf8mb4 not null",
+	}, {
+		input:  "alter table a modify id int after b",
+		output: "alter table a modify column id int after b",
+	}, {
+		input: "alter table a change column id id2 bigint first",
+	}, {
+		input:  "alter table a change `select` b int",
+		output: "alter table a change column `select` b int",
+	}, {
+		input: "alter table a add primary key (id)",
+	}, {
+		input: "alter table a add unique key idx (a, b(10))",
+	}, {
+		input: "alter table a add unique (a)",
+	}, {
+		input: "alter table a add index (a)",
+	}, {
+		input:  "alter table a drop key idx",
+		output: "alter table a drop index idx",
+	}, {
+		input: "alter table a engine=InnoDB",
+	}, {
+		input:  "alter table a engine = InnoDB, algorithm = inplace, lock = none",
+		output: "alter table a engine=InnoDB, algorithm=inplace, lock=none",
+	}, {
+		input: "alter table a force",
+	}, {
+		input: "alter table a add column b int after a, drop column c, add index b (b), rename to c",
+	}, {
+		input: "alter table a add column b int, order by b",
+	}, {
+		input: "alter table a rename column b to c",
+	}, {
+		input: "alter table a add fulltext idx (b)",
+	}, {
+		input:  "alter table a add index idx (b) using btree",
+		output: "alter table a add index idx (b) USING btree",
+	}, {
+		input: "alter table a add index idx using btree (b)",
+	}, {
+		input: "alter table a alter column b set default 1",
+	}, {
+		input: "alter table a convert to character set utf8mb4",
+	}, {
+		input: "alter table a add constraint fk foreign key (b) references c (id)",
+	}, {
+		input: "alter table a drop column b, add foreign key (c) references d (id)",
+	}, {
+		input:  "alter table a  add column (b int,  c int) ;",
+		output: "alter table a add column (b int,  c int)",
+	}, {
+		input:  "alter table ks.a /* x */ add fulltext idx (b)",
+		output: "alter table ks.a /* x */ add fulltext idx (b)",
+	}, {
+		input: "alter table a foo",
+	}, {
+		input:  "select modify from t",
+		output: "select `modify` from t",
 	}, {
 		input: "alter table a add vindex hash (id)",
 	}, {
@@ -1194,6 +1282,20 @@ var (
 		input: "commit",
 	}, {
 		input: "rollback",
+	}, {
+		input: "savepoint a",
+	}, {
+		input: "savepoint `savepoint`",
+	}, {
+		input: "rollback to a",
+	}, {
+		input:  "rollback to savepoint a",
+		output: "rollback to a",
+	}, {
+		input:  "ROLLBACK TO SAVEPOINT savepoint",
+		output: "rollback to `savepoint`",
+	}, {
+		input: "release savepoint a",
 	}}
 )
 
@@ -1232,11 +1334,9 @@ func TestCaseSensitivity(t *testing.T) {
 		input:  "create index b on A",
 		output: "alter table A",
 	}, {
-		input:  "alter table A foo",
-		output: "alter table A",
+		input: "alter table A foo",
 	}, {
-		input:  "alter table A convert",
-		output: "alter table A",
+		input: "alter table A convert",
 	}, {
 		// View names get lower-cased.
 		input:  "alter view A foo",
@@ -1718,6 +1818,71 @@ func TestCreateTableEscaped(t *testing.T) {
 	}
 }
 
+func TestAlterTable(t *testing.T) {
+	sql := "alter table a add column b int after c, drop index d, rename key e to f, engine=InnoDB"
+	tree, err := ParseStrictDDL(sql)
+	if err != nil {
+		t.Fatalf("input: %s, err: %v", sql, err)
+	}
+	want := []*AlterSpec{{
+		Action: AddColumnStr,
+		Column: &ColumnDefinition{
+			Name: NewColIdent("b"),
+			Type: ColumnType{Type: "int"},
+		},
+		Position: &ColumnPosition{After: NewColIdent("c")},
+	}, {
+		Action:    DropIndexStr,
+		IndexName: NewColIdent("d"),
+	}, {
+		Action:       RenameIndexStr,
+		IndexName:    NewColIdent("e"),
+		NewIndexName: NewColIdent("f"),
+	}, {
+		Action: TableOptionStr,
+		Option: "engine=InnoDB",
+	}}
+	if got := tree.(*DDL).AlterSpecs; !reflect.DeepEqual(got, want) {
+		t.Errorf("AlterSpecs: %+v, want %+v", got, want)
+	}
+
+	// Alterations that can't be parsed fall back to their text.
+	for _, sql := range []string{
+		"alter table a add column b int, add foo",
+		"alter table a foo",
+	} {
+		tree, err = Parse(sql)
+		if err != nil {
+			t.Fatalf("input: %s, err: %v", sql, err)
+		}
+		want := []*AlterSpec{{
+			Action: UnparsedAlterStr,
+			Text:   strings.TrimPrefix(sql, "alter table a "),
+		}}
+		if got := tree.(*DDL); got.Action != AlterStr || !reflect.DeepEqual(got.AlterSpecs, want) {
+			t.Errorf("Parse(%s): %+v, want an unparsed alter", sql, got)
+		}
+		tree, err = ParseStrictDDL(sql)
+		if tree != nil || err == nil {
+			t.Errorf("ParseStrictDDL unexpectedly accepted input %s", sql)
+		}
+	}
+
+	// So do the ones that are not parsed yet, without an error.
+	sql = "alter table a convert to character set utf8"
+	tree, err = ParseStrictDDL(sql)
+	if err != nil {
+		t.Fatalf("input: %s, err: %v", sql, err)
+	}
+	want = []*AlterSpec{{
+		Action: UnparsedAlterStr,
+		Text:   "convert to character set utf8",
+	}}
+	if got := tree.(*DDL).AlterSpecs; !reflect.DeepEqual(got, want) {
+		t.Errorf("AlterSpecs: %+v, want %+v", got, want)
+	}
+}
+
 var (
 	invalidSQL = []struct {
 		input        string
@@ -1726,6 +1891,9 @@ var (
 	}{{
 		input:  "select $ from t",
 		output: "syntax error at position 9 near '$'",
+	}, {
+		input:  "select change from t",
+		output: "syntax error at position 14 near 'change'",
 	}, {
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
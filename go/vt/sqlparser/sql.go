@@ -92,6 +92,15 @@ type yySymType struct {
 	partDefs          []*PartitionDefinition
 	partDef           *PartitionDefinition
 	partSpec          *PartitionSpec
+	alterSpec         *AlterSpec
+	alterSpecs        []*AlterSpec
+	colPosition       *ColumnPosition
+	with              *With
+	cte               *CommonTableExpr
+	ctes              []*CommonTableExpr
+	overClause        *OverClause
+	frameClause       *FrameClause
+	framePoint        *FramePoint
 	vindexParam       VindexParam
 	vindexParams      []VindexParam
 }
@@ -230,77 +239,90 @@ const START = 57476
 const TRANSACTION = 57477
 const COMMIT = 57478
 const ROLLBACK = 57479
-const BIT = 57480
-const TINYINT = 57481
-const SMALLINT = 57482
-const MEDIUMINT = 57483
-const INT = 57484
-const INTEGER = 57485
-const BIGINT = 57486
-const INTNUM = 57487
-const REAL = 57488
-const DOUBLE = 57489
-const FLOAT_TYPE = 57490
-const DECIMAL = 57491
-const NUMERIC = 57492
-const TIME = 57493
-const TIMESTAMP = 57494
-const DATETIME = 57495
-const YEAR = 57496
-const CHAR = 57497
-const VARCHAR = 57498
-const BOOL = 57499
-const CHARACTER = 57500
-const VARBINARY = 57501
-const NCHAR = 57502
-const TEXT = 57503
-const TINYTEXT = 57504
-const MEDIUMTEXT = 57505
-const LONGTEXT = 57506
-const BLOB = 57507
-const TINYBLOB = 57508
-const MEDIUMBLOB = 57509
-const LONGBLOB = 57510
-const JSON = 57511
-const ENUM = 57512
-const NULLX = 57513
-const AUTO_INCREMENT = 57514
-const APPROXNUM = 57515
-const SIGNED = 57516
-const UNSIGNED = 57517
-const ZEROFILL = 57518
-const DATABASES = 57519
-const TABLES = 57520
-const VITESS_KEYSPACES = 57521
-const VITESS_SHARDS = 57522
-const VITESS_TABLETS = 57523
-const VSCHEMA_TABLES = 57524
-const NAMES = 57525
-const CHARSET = 57526
-const GLOBAL = 57527
-const SESSION = 57528
-const CURRENT_TIMESTAMP = 57529
-const DATABASE = 57530
-const CURRENT_DATE = 57531
-const CURRENT_TIME = 57532
-const LOCALTIME = 57533
-const LOCALTIMESTAMP = 57534
-const UTC_DATE = 57535
-const UTC_TIME = 57536
-const UTC_TIMESTAMP = 57537
-const REPLACE = 57538
-const CONVERT = 57539
-const CAST = 57540
-const GROUP_CONCAT = 57541
-const SEPARATOR = 57542
-const MATCH = 57543
-const AGAINST = 57544
-const BOOLEAN = 57545
-const LANGUAGE = 57546
-const WITH = 57547
-const QUERY = 57548
-const EXPANSION = 57549
-const UNUSED = 57550
+const SAVEPOINT = 57480
+const RELEASE = 57481
+const BIT = 57482
+const TINYINT = 57483
+const SMALLINT = 57484
+const MEDIUMINT = 57485
+const INT = 57486
+const INTEGER = 57487
+const BIGINT = 57488
+const INTNUM = 57489
+const REAL = 57490
+const DOUBLE = 57491
+const FLOAT_TYPE = 57492
+const DECIMAL = 57493
+const NUMERIC = 57494
+const TIME = 57495
+const TIMESTAMP = 57496
+const DATETIME = 57497
+const YEAR = 57498
+const CHAR = 57499
+const VARCHAR = 57500
+const BOOL = 57501
+const CHARACTER = 57502
+const VARBINARY = 57503
+const NCHAR = 57504
+const TEXT = 57505
+const TINYTEXT = 57506
+const MEDIUMTEXT = 57507
+const LONGTEXT = 57508
+const BLOB = 57509
+const TINYBLOB = 57510
+const MEDIUMBLOB = 57511
+const LONGBLOB = 57512
+const JSON = 57513
+const ENUM = 57514
+const NULLX = 57515
+const AUTO_INCREMENT = 57516
+const APPROXNUM = 57517
+const SIGNED = 57518
+const UNSIGNED = 57519
+const ZEROFILL = 57520
+const DATABASES = 57521
+const TABLES = 57522
+const VITESS_KEYSPACES = 57523
+const VITESS_SHARDS = 57524
+const VITESS_TABLETS = 57525
+const VSCHEMA_TABLES = 57526
+const NAMES = 57527
+const CHARSET = 57528
+const GLOBAL = 57529
+const SESSION = 57530
+const CURRENT_TIMESTAMP = 57531
+const DATABASE = 57532
+const CURRENT_DATE = 57533
+const CURRENT_TIME = 57534
+const LOCALTIME = 57535
+const LOCALTIMESTAMP = 57536
+const UTC_DATE = 57537
+const UTC_TIME = 57538
+const UTC_TIMESTAMP = 57539
+const REPLACE = 57540
+const CONVERT = 57541
+const CAST = 57542
+const GROUP_CONCAT = 57543
+const SEPARATOR = 57544
+const MATCH = 57545
+const AGAINST = 57546
+const BOOLEAN = 57547
+const LANGUAGE = 57548
+const WITH = 57549
+const QUERY = 57550
+const EXPANSION = 57551
+const RECURSIVE = 57552
+const OVER = 57553
+const ROWS = 57554
+const RANGE = 57555
+const UNBOUNDED = 57556
+const PRECEDING = 57557
+const FOLLOWING = 57558
+const CURRENT = 57559
+const ROW = 57560
+const MODIFY = 57561
+const CHANGE = 57562
+const UNUSED = 57563
 
 var yyToknames = [...]string{
 	"$end",
@@ -457,6 +479,8 @@ var yyToknames = [...]string{
 	"TRANSACTION",
 	"COMMIT",
 	"ROLLBACK",
+	"SAVEPOINT",
+	"RELEASE",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -527,6 +551,17 @@ var yyToknames = [...]string{
 	"WITH",
 	"QUERY",
 	"EXPANSION",
+	"RECURSIVE",
+	"OVER",
+	"ROWS",
+	"RANGE",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
+	"CURRENT",
+	"ROW",
+	"MODIFY",
+	"CHANGE",
 	"UNUSED",
 	"';'",
 }
@@ -542,965 +577,1182 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 3,
-	5, 27,
+	5, 37,
 	-2, 4,
-	-1, 215,
-	109, 531,
-	-2, 527,
-	-1, 216,
-	109, 532,
-	-2, 528,
-	-1, 282,
-	80, 672,
-	-2, 46,
-	-1, 283,
-	80, 645,
-	-2, 47,
-	-1, 288,
-	80, 632,
-	-2, 493,
-	-1, 290,
-	80, 659,
-	-2, 495,
-	-1, 649,
-	109, 534,
-	-2, 530,
-	-1, 837,
-	5, 28,
-	-2, 346,
-	-1, 857,
-	5, 27,
-	-2, 469,
-	-1, 1049,
-	5, 28,
-	-2, 470,
-	-1, 1088,
-	5, 27,
-	-2, 472,
-	-1, 1136,
-	5, 28,
-	-2, 473,
+	-1, 67,
+	5, 37,
+	-2, 28,
+	-1, 264,
+	109, 585,
+	-2, 581,
+	-1, 265,
+	109, 586,
+	-2, 582,
+	-1, 331,
+	80, 738,
+	-2, 56,
+	-1, 332,
+	80, 705,
+	-2, 57,
+	-1, 337,
+	80, 690,
+	-2, 559,
+	-1, 339,
+	80, 720,
+	-2, 561,
+	-1, 785,
+	109, 588,
+	-2, 584,
+	-1, 966,
+	5, 38,
+	-2, 397,
+	-1, 986,
+	5, 37,
+	-2, 535,
+	-1, 1160,
+	5, 38,
+	-2, 536,
+	-1, 1200,
+	5, 37,
+	-2, 538,
+	-1, 1249,
+	5, 38,
+	-2, 539,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 8808
+const yyLast = 10887
 
 var yyAct = [...]int{
 
-	216, 707, 1127, 488, 971, 213, 784, 594, 1055, 996,
-	533, 972, 738, 968, 220, 781, 911, 727, 860, 741,
-	194, 877, 759, 829, 914, 708, 531, 742, 751, 778,
-	950, 74, 188, 287, 866, 164, 245, 674, 164, 53,
-	421, 684, 774, 427, 487, 3, 281, 696, 765, 381,
-	535, 651, 520, 441, 279, 203, 704, 681, 500, 52,
-	270, 218, 164, 164, 74, 1155, 1145, 1153, 164, 1134,
-	74, 1151, 433, 785, 1144, 963, 189, 190, 191, 192,
-	683, 1133, 811, 799, 1043, 385, 1104, 894, 268, 269,
-	758, 1067, 193, 1001, 1002, 1003, 1081, 798, 766, 1038,
-	57, 207, 1004, 402, 1036, 392, 187, 413, 414, 1152,
-	1150, 1128, 934, 705, 393, 23, 24, 48, 26, 27,
-	1102, 728, 730, 388, 803, 59, 60, 61, 62, 63,
-	154, 155, 155, 797, 42, 157, 158, 159, 593, 28,
-	600, 1114, 454, 453, 463, 464, 456, 457, 458, 459,
-	460, 461, 462, 455, 389, 164, 465, 164, 37, 753,
-	753, 164, 50, 876, 419, 404, 167, 406, 164, 875,
-	1009, 874, 74, 74, 74, 74, 383, 74, 74, 156,
-	1119, 794, 791, 792, 74, 790, 1052, 382, 888, 937,
-	931, 403, 405, 864, 729, 823, 933, 456, 457, 458,
-	459, 460, 461, 462, 455, 477, 478, 465, 801, 804,
-	74, 739, 623, 445, 397, 766, 1103, 1101, 222, 620,
-	1010, 30, 31, 33, 32, 35, 465, 440, 1018, 479,
-	480, 481, 482, 483, 484, 485, 965, 429, 438, 1005,
-	36, 43, 44, 796, 430, 45, 46, 34, 697, 863,
-	752, 752, 401, 1132, 440, 750, 749, 795, 455, 38,
-	39, 465, 40, 41, 842, 395, 951, 546, 164, 922,
-	597, 439, 438, 1115, 435, 164, 164, 164, 967, 755,
-	273, 74, 800, 697, 756, 847, 74, 932, 440, 930,
-	641, 643, 644, 802, 953, 642, 892, 920, 1122, 246,
-	47, 431, 463, 464, 456, 457, 458, 459, 460, 461,
-	462, 455, 439, 438, 465, 502, 503, 504, 505, 506,
-	507, 508, 49, 820, 821, 822, 955, 1072, 959, 440,
-	954, 1071, 952, 439, 438, 545, 153, 957, 906, 173,
-	458, 459, 460, 461, 462, 455, 956, 47, 465, 905,
-	440, 958, 960, 895, 675, 199, 676, 841, 658, 840,
-	921, 274, 1138, 183, 626, 627, 923, 916, 917, 924,
-	919, 918, 656, 657, 655, 439, 438, 1084, 1070, 50,
-	904, 925, 1141, 420, 420, 74, 622, 927, 164, 654,
-	164, 74, 440, 164, 998, 889, 164, 267, 164, 881,
-	74, 74, 74, 74, 74, 74, 74, 74, 1092, 1125,
-	439, 438, 787, 168, 74, 74, 1092, 420, 164, 170,
-	1092, 1093, 621, 1064, 1063, 176, 172, 440, 991, 420,
-	1107, 607, 209, 74, 1051, 420, 475, 164, 439, 438,
-	1015, 1014, 21, 74, 1012, 1011, 835, 420, 517, 420,
-	628, 174, 677, 650, 178, 440, 659, 660, 661, 662,
-	663, 664, 665, 666, 667, 668, 669, 670, 671, 672,
-	673, 408, 408, 408, 408, 591, 408, 408, 399, 649,
-	605, 394, 169, 408, 542, 652, 74, 630, 382, 23,
-	686, 420, 1106, 273, 553, 552, 645, 1006, 198, 47,
-	74, 171, 177, 179, 180, 181, 182, 861, 969, 185,
-	184, 861, 686, 855, 474, 164, 856, 476, 164, 164,
-	164, 164, 164, 1047, 517, 543, 1017, 541, 647, 1013,
-	164, 709, 54, 164, 882, 688, 50, 164, 835, 678,
-	679, 164, 164, 544, 486, 74, 490, 491, 492, 493,
-	494, 495, 496, 74, 499, 501, 501, 501, 501, 501,
-	501, 501, 501, 509, 510, 511, 512, 734, 701, 694,
-	23, 835, 689, 690, 532, 23, 693, 688, 940, 713,
-	714, 724, 716, 761, 762, 763, 764, 732, 516, 624,
-	700, 736, 702, 703, 164, 629, 733, 1087, 771, 772,
-	773, 164, 862, 746, 164, 74, 862, 712, 200, 50,
-	715, 595, 517, 1076, 767, 768, 769, 50, 760, 779,
-	780, 835, 50, 985, 885, 244, 867, 868, 424, 428,
-	776, 777, 775, 782, 409, 770, 65, 235, 234, 237,
-	238, 239, 240, 1000, 517, 446, 236, 241, 861, 969,
-	907, 870, 685, 687, 603, 50, 72, 649, 417, 636,
-	721, 873, 826, 827, 828, 722, 699, 653, 812, 813,
-	522, 525, 526, 527, 523, 872, 524, 528, 719, 489,
-	867, 868, 718, 720, 408, 723, 498, 526, 527, 286,
-	408, 717, 652, 1149, 922, 386, 726, 1143, 284, 408,
-	408, 408, 408, 408, 408, 408, 408, 825, 204, 205,
-	936, 808, 434, 408, 408, 1148, 819, 522, 525, 526,
-	527, 523, 920, 524, 528, 818, 432, 817, 899, 422,
-	551, 400, 74, 891, 1124, 1123, 273, 273, 273, 273,
-	273, 423, 74, 846, 1085, 886, 1045, 1077, 788, 857,
-	871, 273, 879, 880, 602, 530, 201, 202, 434, 273,
-	816, 195, 1112, 834, 196, 54, 1111, 1079, 815, 883,
-	862, 436, 1116, 1068, 619, 844, 47, 56, 898, 887,
-	900, 901, 902, 74, 74, 921, 74, 58, 540, 51,
-	490, 923, 916, 917, 924, 919, 918, 286, 286, 286,
-	286, 1, 286, 286, 786, 910, 925, 74, 793, 286,
-	896, 897, 915, 1126, 995, 164, 926, 274, 274, 274,
-	274, 274, 913, 748, 74, 740, 380, 64, 747, 903,
-	1100, 1066, 532, 754, 731, 443, 946, 947, 893, 757,
-	274, 999, 832, 1121, 890, 556, 833, 557, 555, 559,
-	944, 558, 554, 837, 838, 839, 175, 970, 843, 74,
-	74, 280, 529, 849, 547, 850, 851, 852, 853, 437,
-	709, 638, 639, 962, 653, 66, 709, 949, 929, 961,
-	928, 978, 943, 74, 964, 74, 74, 980, 789, 387,
-	473, 814, 285, 973, 976, 625, 426, 1110, 649, 1078,
-	979, 845, 993, 975, 408, 497, 286, 695, 164, 1007,
-	1008, 548, 994, 221, 640, 284, 74, 233, 230, 992,
-	232, 231, 631, 489, 854, 447, 691, 692, 74, 164,
-	219, 211, 272, 513, 521, 74, 519, 518, 74, 277,
-	869, 164, 865, 271, 939, 1042, 1113, 1019, 824, 635,
-	25, 1029, 55, 206, 19, 18, 17, 20, 1026, 1021,
-	16, 15, 1024, 14, 29, 13, 12, 1027, 11, 10,
-	9, 8, 7, 6, 1031, 1032, 5, 1033, 4, 737,
-	1035, 197, 1037, 22, 1046, 1034, 74, 2, 74, 74,
-	74, 164, 74, 0, 74, 0, 1057, 1058, 1059, 948,
-	1054, 1060, 0, 0, 858, 859, 0, 0, 0, 0,
-	286, 0, 0, 1062, 0, 0, 286, 74, 74, 74,
-	1065, 883, 0, 0, 0, 286, 286, 286, 286, 286,
-	286, 286, 286, 0, 1074, 0, 0, 425, 0, 286,
-	286, 0, 990, 0, 0, 0, 1075, 1082, 0, 0,
-	0, 0, 74, 74, 809, 810, 0, 428, 632, 1086,
-	1069, 0, 0, 0, 0, 74, 0, 0, 443, 0,
-	0, 286, 162, 0, 1099, 186, 0, 74, 1097, 1098,
-	648, 1080, 1105, 408, 973, 1108, 0, 0, 0, 0,
-	0, 407, 1117, 74, 0, 1088, 0, 210, 0, 162,
-	162, 0, 0, 0, 0, 162, 408, 0, 1028, 0,
-	0, 680, 0, 0, 0, 1030, 1130, 0, 1135, 836,
-	74, 0, 0, 0, 973, 698, 1039, 1040, 0, 74,
-	848, 709, 0, 1139, 1118, 0, 0, 0, 0, 1048,
-	1049, 1050, 710, 1053, 1147, 0, 1146, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 974, 0, 47, 273,
-	1156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	286, 0, 0, 0, 987, 988, 989, 0, 286, 284,
-	0, 0, 161, 0, 0, 0, 0, 743, 0, 0,
-	0, 0, 162, 0, 162, 0, 0, 0, 162, 0,
-	0, 0, 0, 0, 0, 162, 0, 1083, 0, 0,
-	278, 0, 0, 0, 0, 384, 0, 0, 0, 0,
-	0, 0, 1094, 1095, 1096, 0, 0, 0, 0, 0,
-	286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	274, 0, 0, 0, 0, 0, 0, 0, 0, 286,
-	0, 0, 0, 0, 0, 0, 0, 0, 648, 0,
-	0, 0, 0, 1041, 410, 411, 412, 0, 415, 416,
-	0, 0, 0, 0, 0, 418, 0, 0, 1131, 0,
-	966, 0, 0, 1136, 0, 0, 0, 0, 0, 0,
-	0, 1140, 0, 0, 0, 981, 982, 0, 0, 0,
-	984, 0, 390, 986, 391, 162, 0, 0, 396, 0,
-	0, 0, 162, 537, 162, 398, 408, 0, 0, 0,
-	0, 1158, 1159, 0, 454, 453, 463, 464, 456, 457,
-	458, 459, 460, 461, 462, 455, 0, 0, 465, 0,
-	0, 0, 0, 0, 0, 0, 0, 974, 0, 0,
-	1089, 0, 0, 0, 0, 0, 0, 878, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 286, 830, 0,
-	0, 0, 0, 0, 0, 0, 743, 1109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 974, 0, 47,
+	83, 844, 267, 589, 1254, 1084, 912, 587, 3, 1132,
+	864, 269, 1085, 61, 893, 695, 1035, 1059, 294, 1081,
+	243, 906, 989, 634, 878, 336, 922, 1006, 632, 909,
+	845, 879, 958, 1063, 820, 67, 810, 995, 753, 833,
+	206, 704, 787, 206, 887, 527, 521, 902, 447, 841,
+	636, 330, 533, 319, 875, 233, 206, 621, 940, 445,
+	817, 241, 238, 541, 175, 328, 454, 239, 252, 265,
+	171, 206, 206, 60, 1266, 1267, 1268, 206, 601, 318,
+	1060, 65, 1264, 1265, 1241, 1242, 1278, 284, 283, 286,
+	287, 288, 289, 1261, 26, 1277, 285, 290, 1247, 317,
+	1273, 913, 1260, 256, 262, 1076, 234, 235, 236, 237,
+	68, 69, 70, 71, 72, 284, 283, 286, 287, 288,
+	289, 1154, 438, 1246, 285, 290, 177, 1213, 485, 1018,
+	495, 886, 1255, 177, 434, 1174, 1110, 1111, 1112, 894,
+	192, 58, 194, 1149, 1147, 1113, 1193, 192, 232, 194,
+	229, 506, 507, 1252, 258, 1236, 1133, 1191, 842, 472,
+	435, 193, 486, 865, 867, 441, 471, 186, 193, 195,
+	197, 197, 458, 927, 186, 1211, 191, 199, 200, 201,
+	483, 731, 230, 191, 694, 1005, 1004, 1003, 436, 458,
+	209, 198, 497, 1231, 499, 1163, 206, 577, 578, 206,
+	1048, 993, 952, 206, 759, 545, 490, 819, 555, 458,
+	206, 565, 876, 565, 756, 540, 190, 477, 496, 498,
+	971, 1127, 458, 190, 176, 173, 181, 443, 172, 992,
+	474, 176, 702, 181, 647, 701, 866, 711, 1078, 794,
+	462, 206, 834, 473, 1118, 265, 265, 834, 468, 976,
+	435, 179, 182, 792, 793, 791, 530, 698, 179, 215,
+	529, 1256, 265, 457, 1257, 470, 1272, 894, 539, 538,
+	469, 1212, 1210, 265, 265, 265, 265, 265, 265, 265,
+	457, 494, 500, 225, 1114, 540, 1016, 1233, 188, 1256,
+	762, 763, 1257, 535, 1119, 188, 265, 1245, 539, 538,
+	457, 883, 187, 265, 538, 1080, 884, 1179, 518, 187,
+	458, 24, 55, 457, 189, 540, 1178, 206, 453, 452,
+	540, 189, 456, 455, 206, 206, 206, 178, 196, 1251,
+	488, 531, 1030, 210, 178, 1029, 539, 538, 435, 212,
+	539, 538, 949, 950, 951, 218, 214, 183, 184, 180,
+	811, 1019, 812, 540, 183, 184, 180, 540, 1226, 554,
+	553, 563, 564, 556, 557, 558, 559, 560, 561, 562,
+	555, 216, 1196, 565, 220, 247, 1177, 579, 580, 581,
+	582, 583, 584, 585, 603, 604, 605, 606, 607, 608,
+	609, 1028, 1064, 58, 58, 646, 990, 435, 316, 524,
+	528, 457, 823, 790, 211, 705, 453, 452, 446, 448,
+	456, 455, 449, 777, 779, 780, 546, 1010, 778, 435,
+	1066, 926, 450, 213, 219, 221, 222, 223, 224, 936,
+	516, 227, 226, 556, 557, 558, 559, 560, 561, 562,
+	555, 206, 925, 565, 558, 559, 560, 561, 562, 555,
+	590, 924, 565, 970, 1068, 969, 1072, 599, 1067, 915,
+	1065, 936, 1204, 1185, 516, 1070, 1104, 516, 206, 1162,
+	516, 539, 538, 813, 1069, 936, 1128, 1124, 1123, 1071,
+	1073, 1121, 1120, 516, 206, 712, 206, 1216, 540, 206,
+	692, 206, 1227, 964, 516, 1039, 1038, 503, 504, 505,
+	492, 508, 509, 936, 935, 1215, 706, 700, 511, 487,
+	728, 206, 1115, 699, 1082, 724, 738, 990, 206, 1158,
+	710, 26, 713, 714, 563, 564, 556, 557, 558, 559,
+	560, 561, 562, 555, 725, 726, 565, 206, 758, 265,
+	265, 643, 26, 618, 516, 1051, 265, 62, 265, 736,
+	764, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+	265, 265, 265, 265, 265, 265, 984, 788, 58, 985,
+	618, 785, 823, 516, 757, 654, 653, 617, 1126, 751,
+	814, 815, 644, 26, 642, 991, 964, 265, 964, 58,
+	539, 538, 265, 265, 265, 265, 265, 265, 991, 825,
+	766, 618, 1122, 515, 783, 1011, 781, 540, 964, 645,
+	1199, 265, 265, 265, 265, 760, 206, 517, 265, 206,
+	206, 206, 206, 206, 442, 295, 54, 618, 58, 846,
+	58, 206, 249, 888, 206, 907, 1098, 918, 206, 696,
+	990, 825, 206, 206, 519, 996, 997, 910, 265, 903,
+	831, 898, 786, 54, 708, 795, 796, 797, 798, 799,
+	800, 801, 802, 803, 804, 805, 806, 807, 808, 809,
+	871, 74, 838, 850, 851, 520, 853, 1002, 1109, 58,
+	54, 895, 896, 897, 1082, 1031, 999, 861, 869, 248,
+	870, 734, 510, 774, 775, 206, 323, 873, 849, 858,
+	856, 852, 772, 881, 859, 857, 889, 890, 891, 892,
+	502, 1001, 855, 908, 880, 860, 854, 627, 628, 1269,
+	693, 899, 900, 901, 284, 283, 286, 287, 288, 289,
+	253, 254, 206, 285, 290, 206, 904, 905, 623, 626,
+	627, 628, 624, 1259, 625, 629, 590, 1047, 920, 828,
+	829, 937, 534, 1219, 947, 917, 946, 1023, 265, 265,
+	265, 265, 522, 652, 493, 729, 532, 929, 930, 462,
+	1015, 1156, 265, 1235, 523, 739, 740, 741, 742, 743,
+	744, 745, 746, 333, 1234, 785, 1197, 931, 719, 747,
+	748, 718, 709, 265, 265, 265, 928, 916, 733, 1045,
+	826, 827, 874, 631, 830, 534, 941, 250, 251, 945,
+	788, 942, 244, 1224, 1188, 1222, 245, 944, 837, 265,
+	839, 840, 62, 1221, 265, 991, 536, 293, 1228, 1175,
+	755, 64, 265, 66, 954, 265, 588, 4, 641, 501,
+	501, 501, 501, 59, 501, 501, 1, 185, 170, 986,
+	914, 501, 1034, 174, 1131, 921, 451, 1056, 877, 433,
+	73, 1027, 81, 1209, 1173, 882, 1017, 885, 1108, 1232,
+	1014, 657, 975, 658, 54, 231, 656, 554, 553, 563,
+	564, 556, 557, 558, 559, 560, 561, 562, 555, 574,
+	1000, 565, 576, 1008, 1009, 660, 479, 955, 956, 957,
+	335, 659, 655, 217, 1020, 1021, 439, 329, 630, 648,
+	1012, 537, 938, 939, 75, 528, 467, 440, 573, 586,
+	943, 591, 592, 593, 594, 595, 596, 597, 334, 600,
+	602, 602, 602, 602, 602, 602, 602, 602, 610, 611,
+	612, 613, 1089, 761, 206, 526, 1220, 1187, 1022, 633,
+	1024, 1025, 1026, 974, 265, 598, 832, 1037, 270, 776,
+	265, 265, 282, 279, 281, 265, 280, 767, 1040, 983,
+	547, 268, 260, 1043, 321, 614, 622, 265, 965, 948,
+	620, 619, 326, 271, 998, 265, 1083, 1077, 1055, 977,
+	994, 265, 265, 265, 265, 1088, 846, 265, 320, 1062,
+	265, 460, 846, 1092, 1086, 1075, 1050, 1074, 1153, 1225,
+	771, 29, 475, 335, 1054, 63, 1091, 255, 934, 785,
+	22, 1093, 1105, 21, 20, 19, 963, 623, 626, 627,
+	628, 624, 206, 625, 629, 18, 1106, 996, 997, 973,
+	333, 335, 335, 335, 335, 1107, 335, 335, 23, 17,
+	16, 15, 206, 335, 322, 516, 35, 265, 512, 33,
+	514, 14, 13, 501, 1057, 1058, 1130, 12, 1129, 11,
+	10, 9, 707, 1135, 8, 1116, 1117, 7, 6, 1140,
+	1136, 265, 5, 1240, 1239, 1190, 543, 27, 265, 246,
+	1145, 554, 553, 563, 564, 556, 557, 558, 559, 560,
+	561, 562, 555, 1157, 206, 565, 25, 2, 501, 1142,
+	1143, 0, 1144, 1165, 0, 1146, 0, 1148, 501, 501,
+	501, 501, 501, 501, 501, 501, 1172, 1170, 0, 0,
+	0, 1079, 501, 501, 765, 1176, 0, 1166, 1012, 1167,
+	1168, 1169, 0, 1181, 1183, 576, 1094, 1095, 0, 0,
+	0, 1097, 0, 265, 1099, 1189, 0, 335, 1192, 1182,
+	0, 1138, 649, 0, 0, 0, 0, 0, 0, 1200,
+	0, 1198, 0, 0, 0, 0, 0, 1086, 0, 0,
+	0, 1208, 0, 458, 0, 0, 0, 0, 0, 479,
+	1217, 821, 822, 824, 1033, 1218, 0, 0, 0, 0,
+	0, 0, 54, 0, 1223, 1229, 836, 0, 0, 1230,
+	0, 435, 0, 0, 0, 0, 1044, 591, 1086, 0,
+	0, 0, 265, 265, 0, 265, 1238, 0, 1243, 265,
+	1248, 1214, 0, 0, 0, 1155, 863, 0, 0, 0,
+	846, 0, 590, 1258, 323, 323, 323, 323, 323, 0,
+	575, 0, 0, 0, 0, 0, 784, 1194, 1258, 633,
+	1263, 868, 0, 265, 0, 335, 0, 323, 0, 0,
+	0, 0, 703, 0, 457, 1258, 0, 1276, 0, 453,
+	452, 446, 448, 456, 455, 449, 0, 715, 0, 716,
+	717, 0, 0, 0, 720, 450, 722, 0, 0, 0,
+	723, 444, 0, 0, 727, 0, 335, 322, 0, 0,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	335, 335, 335, 335, 335, 335, 335, 335, 0, 0,
+	0, 0, 0, 0, 335, 335, 0, 0, 0, 0,
+	0, 750, 0, 0, 0, 0, 0, 0, 754, 0,
+	0, 0, 0, 0, 0, 0, 333, 0, 0, 0,
+	768, 501, 0, 0, 479, 0, 0, 1270, 0, 0,
+	543, 0, 0, 335, 0, 960, 1237, 590, 0, 590,
+	554, 553, 563, 564, 556, 557, 558, 559, 560, 561,
+	562, 555, 0, 0, 565, 554, 553, 563, 564, 556,
+	557, 558, 559, 560, 561, 562, 555, 0, 1180, 565,
+	953, 0, 0, 816, 0, 0, 0, 961, 0, 0,
+	0, 962, 0, 0, 959, 0, 0, 0, 835, 966,
+	967, 968, 0, 0, 972, 0, 0, 0, 0, 978,
+	0, 979, 980, 981, 982, 847, 0, 0, 0, 0,
+	0, 324, 554, 553, 563, 564, 556, 557, 558, 559,
+	560, 561, 562, 555, 0, 0, 565, 987, 988, 0,
+	784, 0, 0, 335, 0, 0, 0, 0, 0, 0,
+	0, 335, 0, 0, 525, 0, 0, 0, 549, 0,
+	552, 203, 0, 0, 0, 0, 566, 567, 568, 569,
+	570, 571, 572, 752, 550, 551, 548, 554, 553, 563,
+	564, 556, 557, 558, 559, 560, 561, 562, 555, 0,
+	0, 565, 0, 327, 204, 0, 0, 228, 437, 0,
+	460, 0, 789, 919, 0, 0, 923, 501, 0, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 259, 1042, 204, 204, 0, 0, 501,
+	0, 204, 0, 335, 0, 0, 0, 1061, 553, 563,
+	564, 556, 557, 558, 559, 560, 561, 562, 555, 0,
+	0, 565, 0, 0, 0, 0, 0, 335, 0, 479,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1044, 420, 0, 0, 0, 0, 0, 489, 908, 286,
-	0, 286, 0, 0, 0, 515, 0, 0, 0, 0,
-	912, 0, 0, 0, 539, 162, 0, 162, 0, 0,
-	162, 0, 286, 162, 0, 606, 0, 454, 453, 463,
-	464, 456, 457, 458, 459, 460, 461, 462, 455, 286,
-	0, 465, 1154, 0, 0, 162, 0, 0, 942, 454,
-	453, 463, 464, 456, 457, 458, 459, 460, 461, 462,
-	455, 0, 0, 465, 162, 0, 592, 0, 0, 0,
-	0, 710, 599, 606, 977, 878, 0, 710, 0, 0,
-	286, 608, 609, 610, 611, 612, 613, 614, 615, 983,
-	0, 0, 0, 0, 0, 616, 617, 0, 286, 0,
-	286, 997, 0, 0, 0, 0, 0, 743, 0, 743,
-	0, 0, 0, 0, 210, 0, 0, 0, 0, 210,
-	210, 0, 0, 210, 0, 596, 0, 598, 0, 0,
-	601, 1020, 0, 604, 1129, 489, 0, 210, 210, 210,
-	210, 0, 162, 1022, 711, 162, 162, 162, 162, 162,
-	1025, 0, 0, 286, 0, 618, 945, 725, 0, 0,
-	162, 0, 942, 0, 537, 0, 0, 0, 162, 162,
-	0, 0, 0, 0, 637, 0, 454, 453, 463, 464,
-	456, 457, 458, 459, 460, 461, 462, 455, 0, 0,
-	465, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1056, 0, 1056, 1056, 1056, 0, 1061, 0, 286,
-	0, 0, 0, 0, 0, 0, 0, 0, 743, 0,
-	0, 162, 0, 0, 0, 0, 0, 0, 162, 0,
-	0, 162, 286, 286, 286, 0, 0, 0, 0, 0,
-	0, 0, 912, 743, 0, 0, 0, 0, 0, 0,
-	113, 606, 706, 0, 442, 0, 0, 0, 0, 90,
-	0, 0, 0, 210, 100, 0, 102, 1090, 1091, 124,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 735,
-	997, 0, 0, 0, 0, 0, 807, 0, 73, 0,
-	444, 0, 1056, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 439, 438, 0, 0, 0, 0, 1120, 0,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 440,
-	0, 0, 210, 0, 0, 0, 0, 0, 0, 0,
-	0, 783, 710, 0, 0, 1137, 0, 0, 805, 0,
-	0, 806, 0, 0, 1142, 0, 0, 0, 0, 0,
-	0, 0, 165, 0, 0, 0, 0, 116, 0, 0,
-	127, 95, 94, 0, 0, 0, 86, 0, 121, 114,
-	139, 0, 115, 120, 103, 131, 117, 138, 166, 145,
-	129, 144, 76, 128, 137, 85, 122, 78, 135, 126,
-	107, 97, 98, 77, 0, 119, 89, 93, 88, 112,
-	132, 133, 87, 151, 81, 143, 80, 82, 142, 111,
-	130, 136, 108, 105, 79, 134, 106, 104, 99, 91,
-	0, 0, 0, 125, 140, 152, 0, 0, 146, 147,
-	148, 149, 110, 83, 96, 123, 0, 0, 0, 0,
-	0, 0, 162, 0, 562, 0, 0, 0, 0, 0,
-	0, 75, 210, 101, 150, 118, 92, 141, 0, 0,
-	0, 0, 0, 210, 0, 909, 0, 0, 0, 0,
-	0, 0, 574, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 711, 0, 0, 0, 0, 935, 711,
-	0, 0, 606, 579, 580, 581, 582, 583, 584, 585,
-	0, 586, 587, 588, 589, 590, 575, 576, 577, 578,
-	560, 561, 0, 0, 563, 0, 564, 565, 566, 567,
-	568, 569, 570, 571, 572, 573, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 453, 463, 464, 456,
-	457, 458, 459, 460, 461, 462, 455, 0, 0, 465,
-	0, 0, 938, 449, 0, 452, 162, 0, 0, 0,
-	0, 466, 467, 468, 469, 470, 471, 472, 162, 450,
-	451, 448, 454, 453, 463, 464, 456, 457, 458, 459,
-	460, 461, 462, 455, 0, 0, 465, 369, 359, 0,
-	332, 371, 310, 324, 379, 325, 326, 353, 298, 340,
-	113, 322, 0, 313, 293, 319, 294, 311, 334, 90,
-	337, 309, 361, 343, 100, 377, 102, 348, 537, 124,
-	109, 0, 0, 336, 363, 338, 358, 331, 354, 303,
-	347, 372, 323, 351, 373, 0, 0, 0, 73, 0,
-	744, 745, 0, 0, 0, 1016, 0, 84, 0, 350,
-	368, 321, 352, 292, 349, 0, 296, 299, 378, 366,
-	316, 317, 884, 0, 0, 0, 1023, 0, 0, 335,
-	339, 355, 329, 0, 0, 0, 0, 0, 0, 0,
-	0, 314, 0, 346, 0, 0, 0, 300, 297, 0,
-	333, 0, 0, 0, 302, 0, 315, 356, 1073, 291,
-	364, 330, 165, 367, 328, 327, 370, 116, 0, 0,
-	127, 95, 94, 362, 312, 320, 86, 318, 121, 114,
-	139, 345, 115, 120, 103, 131, 117, 138, 166, 145,
-	129, 144, 76, 128, 137, 85, 122, 78, 135, 126,
-	107, 97, 98, 77, 711, 119, 89, 93, 88, 112,
-	132, 133, 87, 151, 81, 143, 80, 82, 142, 111,
-	130, 136, 108, 105, 79, 134, 106, 104, 99, 91,
-	0, 295, 0, 125, 140, 152, 308, 365, 146, 147,
-	148, 149, 110, 83, 96, 123, 306, 307, 304, 305,
-	341, 342, 374, 375, 376, 357, 301, 0, 0, 360,
-	344, 75, 0, 101, 150, 118, 92, 141, 369, 359,
-	0, 332, 371, 310, 324, 379, 325, 326, 353, 298,
-	340, 113, 322, 0, 313, 293, 319, 294, 311, 334,
-	90, 337, 309, 361, 343, 100, 377, 102, 348, 0,
-	124, 109, 0, 0, 336, 363, 338, 358, 331, 354,
-	303, 347, 372, 323, 351, 373, 0, 0, 0, 73,
-	0, 744, 745, 0, 0, 0, 0, 0, 84, 0,
-	350, 368, 321, 352, 292, 349, 0, 296, 299, 378,
-	366, 316, 317, 0, 0, 0, 0, 0, 0, 0,
-	335, 339, 355, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 314, 0, 346, 0, 0, 0, 300, 297,
-	0, 333, 0, 0, 0, 302, 0, 315, 356, 0,
-	291, 364, 330, 165, 367, 328, 327, 370, 116, 0,
-	0, 127, 95, 94, 362, 312, 320, 86, 318, 121,
-	114, 139, 345, 115, 120, 103, 131, 117, 138, 166,
-	145, 129, 144, 76, 128, 137, 85, 122, 78, 135,
-	126, 107, 97, 98, 77, 0, 119, 89, 93, 88,
-	112, 132, 133, 87, 151, 81, 143, 80, 82, 142,
-	111, 130, 136, 108, 105, 79, 134, 106, 104, 99,
-	91, 0, 295, 0, 125, 140, 152, 308, 365, 146,
-	147, 148, 149, 110, 83, 96, 123, 306, 307, 304,
-	305, 341, 342, 374, 375, 376, 357, 301, 0, 0,
-	360, 344, 75, 0, 101, 150, 118, 92, 141, 369,
-	359, 0, 332, 371, 310, 324, 379, 325, 326, 353,
-	298, 340, 113, 322, 0, 313, 293, 319, 294, 311,
-	334, 90, 337, 309, 361, 343, 100, 377, 102, 348,
-	0, 124, 109, 0, 0, 336, 363, 338, 358, 331,
-	354, 303, 347, 372, 323, 351, 373, 50, 0, 0,
-	73, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	0, 350, 368, 321, 352, 292, 349, 0, 296, 299,
-	378, 366, 316, 317, 0, 0, 0, 0, 0, 0,
-	0, 335, 339, 355, 329, 0, 0, 0, 0, 0,
-	0, 0, 0, 314, 0, 346, 0, 0, 0, 300,
-	297, 0, 333, 0, 0, 0, 302, 0, 315, 356,
-	0, 291, 364, 330, 165, 367, 328, 327, 370, 116,
-	0, 0, 127, 95, 94, 362, 312, 320, 86, 318,
-	121, 114, 139, 345, 115, 120, 103, 131, 117, 138,
-	166, 145, 129, 144, 76, 128, 137, 85, 122, 78,
-	135, 126, 107, 97, 98, 77, 0, 119, 89, 93,
-	88, 112, 132, 133, 87, 151, 81, 143, 80, 82,
-	142, 111, 130, 136, 108, 105, 79, 134, 106, 104,
-	99, 91, 0, 295, 0, 125, 140, 152, 308, 365,
-	146, 147, 148, 149, 110, 83, 96, 123, 306, 307,
-	304, 305, 341, 342, 374, 375, 376, 357, 301, 0,
-	0, 360, 344, 75, 0, 101, 150, 118, 92, 141,
-	369, 359, 0, 332, 371, 310, 324, 379, 325, 326,
-	353, 298, 340, 113, 322, 0, 313, 293, 319, 294,
-	311, 334, 90, 337, 309, 361, 343, 100, 377, 102,
-	348, 0, 124, 109, 0, 0, 336, 363, 338, 358,
-	331, 354, 303, 347, 372, 323, 351, 373, 0, 0,
-	0, 73, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 0, 350, 368, 321, 352, 292, 349, 0, 296,
-	299, 378, 366, 316, 317, 0, 0, 0, 0, 0,
-	0, 0, 335, 339, 355, 329, 0, 0, 0, 0,
-	0, 0, 941, 0, 314, 0, 346, 0, 0, 0,
-	300, 297, 0, 333, 0, 0, 0, 302, 0, 315,
-	356, 0, 291, 364, 330, 165, 367, 328, 327, 370,
-	116, 0, 0, 127, 95, 94, 362, 312, 320, 86,
-	318, 121, 114, 139, 345, 115, 120, 103, 131, 117,
-	138, 166, 145, 129, 144, 76, 128, 137, 85, 122,
-	78, 135, 126, 107, 97, 98, 77, 0, 119, 89,
-	93, 88, 112, 132, 133, 87, 151, 81, 143, 80,
-	82, 142, 111, 130, 136, 108, 105, 79, 134, 106,
-	104, 99, 91, 0, 295, 0, 125, 140, 152, 308,
-	365, 146, 147, 148, 149, 110, 83, 96, 123, 306,
-	307, 304, 305, 341, 342, 374, 375, 376, 357, 301,
-	0, 0, 360, 344, 75, 0, 101, 150, 118, 92,
-	141, 369, 359, 0, 332, 371, 310, 324, 379, 325,
-	326, 353, 298, 340, 113, 322, 0, 313, 293, 319,
-	294, 311, 334, 90, 337, 309, 361, 343, 100, 377,
-	102, 348, 0, 124, 109, 0, 0, 336, 363, 338,
-	358, 331, 354, 303, 347, 372, 323, 351, 373, 0,
-	0, 0, 215, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 0, 350, 368, 321, 352, 292, 349, 0,
-	296, 299, 378, 366, 316, 317, 0, 0, 0, 0,
-	0, 0, 0, 335, 339, 355, 329, 0, 0, 0,
-	0, 0, 0, 646, 0, 314, 0, 346, 0, 0,
-	0, 300, 297, 0, 333, 0, 0, 0, 302, 0,
-	315, 356, 0, 291, 364, 330, 165, 367, 328, 327,
-	370, 116, 0, 0, 127, 95, 94, 362, 312, 320,
-	86, 318, 121, 114, 139, 345, 115, 120, 103, 131,
-	117, 138, 166, 145, 129, 144, 76, 128, 137, 85,
-	122, 78, 135, 126, 107, 97, 98, 77, 0, 119,
-	89, 93, 88, 112, 132, 133, 87, 151, 81, 143,
-	80, 82, 142, 111, 130, 136, 108, 105, 79, 134,
-	106, 104, 99, 91, 0, 295, 0, 125, 140, 152,
-	308, 365, 146, 147, 148, 149, 110, 83, 96, 123,
-	306, 307, 304, 305, 341, 342, 374, 375, 376, 357,
-	301, 0, 0, 360, 344, 75, 0, 101, 150, 118,
-	92, 141, 369, 359, 0, 332, 371, 310, 324, 379,
-	325, 326, 353, 298, 340, 113, 322, 0, 313, 293,
-	319, 294, 311, 334, 90, 337, 309, 361, 343, 100,
-	377, 102, 348, 0, 124, 109, 0, 0, 336, 363,
-	338, 358, 331, 354, 303, 347, 372, 323, 351, 373,
-	0, 0, 0, 73, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 0, 350, 368, 321, 352, 292, 349,
-	0, 296, 299, 378, 366, 316, 317, 0, 0, 0,
-	0, 0, 0, 0, 335, 339, 355, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 314, 0, 346, 0,
-	0, 0, 300, 297, 0, 333, 0, 0, 0, 302,
-	0, 315, 356, 0, 291, 364, 330, 165, 367, 328,
-	327, 370, 116, 0, 0, 127, 95, 94, 362, 312,
-	320, 86, 318, 121, 114, 139, 345, 115, 120, 103,
-	131, 117, 138, 166, 145, 129, 144, 76, 128, 137,
-	85, 122, 78, 135, 126, 107, 97, 98, 77, 0,
-	119, 89, 93, 88, 112, 132, 133, 87, 151, 81,
-	143, 80, 82, 142, 111, 130, 136, 108, 105, 79,
-	134, 106, 104, 99, 91, 0, 295, 0, 125, 140,
-	152, 308, 365, 146, 147, 148, 149, 110, 83, 96,
-	123, 306, 307, 304, 305, 341, 342, 374, 375, 376,
-	357, 301, 0, 0, 360, 344, 75, 0, 101, 150,
-	118, 92, 141, 369, 359, 0, 332, 371, 310, 324,
-	379, 325, 326, 353, 298, 340, 113, 322, 0, 313,
-	293, 319, 294, 311, 334, 90, 337, 309, 361, 343,
-	100, 377, 102, 348, 0, 124, 109, 0, 0, 336,
-	363, 338, 358, 331, 354, 303, 347, 372, 323, 351,
-	373, 0, 0, 0, 215, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 0, 350, 368, 321, 352, 292,
-	349, 0, 296, 299, 378, 366, 316, 317, 0, 0,
-	0, 0, 0, 0, 0, 335, 339, 355, 329, 0,
-	0, 0, 0, 0, 0, 0, 0, 314, 0, 346,
-	0, 0, 0, 300, 297, 0, 333, 0, 0, 0,
-	302, 0, 315, 356, 0, 291, 364, 330, 165, 367,
-	328, 327, 370, 116, 0, 0, 127, 95, 94, 362,
-	312, 320, 86, 318, 121, 114, 139, 345, 115, 120,
-	103, 131, 117, 138, 166, 145, 129, 144, 76, 128,
-	137, 85, 122, 78, 135, 126, 107, 97, 98, 77,
-	0, 119, 89, 93, 88, 112, 132, 133, 87, 151,
-	81, 143, 80, 82, 142, 111, 130, 136, 108, 105,
-	79, 134, 106, 104, 99, 91, 0, 295, 0, 125,
-	140, 152, 308, 365, 146, 147, 148, 149, 110, 83,
-	96, 123, 306, 307, 304, 305, 341, 342, 374, 375,
-	376, 357, 301, 0, 0, 360, 344, 75, 0, 101,
-	150, 118, 92, 141, 369, 359, 0, 332, 371, 310,
-	324, 379, 325, 326, 353, 298, 340, 113, 322, 0,
-	313, 293, 319, 294, 311, 334, 90, 337, 309, 361,
-	343, 100, 377, 102, 348, 0, 124, 109, 0, 0,
-	336, 363, 338, 358, 331, 354, 303, 347, 372, 323,
-	351, 373, 0, 0, 0, 73, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 0, 350, 368, 321, 352,
-	292, 349, 0, 296, 299, 378, 366, 316, 317, 0,
-	0, 0, 0, 0, 0, 0, 335, 339, 355, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 314, 0,
-	346, 0, 0, 0, 300, 297, 0, 333, 0, 0,
-	0, 302, 0, 315, 356, 0, 291, 364, 330, 165,
-	367, 328, 327, 370, 116, 0, 0, 127, 95, 94,
-	362, 312, 320, 86, 318, 121, 114, 139, 345, 115,
-	120, 103, 131, 117, 138, 166, 145, 129, 144, 76,
-	128, 137, 85, 122, 78, 135, 126, 107, 97, 98,
-	77, 0, 119, 89, 93, 88, 112, 132, 133, 87,
-	151, 81, 143, 80, 289, 142, 111, 130, 136, 108,
-	105, 79, 134, 106, 104, 99, 91, 0, 295, 0,
-	125, 140, 152, 308, 365, 146, 147, 148, 149, 290,
-	288, 96, 123, 306, 307, 304, 305, 341, 342, 374,
-	375, 376, 357, 301, 0, 0, 360, 344, 75, 0,
-	101, 150, 118, 92, 141, 369, 359, 0, 332, 371,
-	310, 324, 379, 325, 326, 353, 298, 340, 113, 322,
-	0, 313, 293, 319, 294, 311, 334, 90, 337, 309,
-	361, 343, 100, 377, 102, 348, 0, 124, 109, 0,
-	0, 336, 363, 338, 358, 331, 354, 303, 347, 372,
-	323, 351, 373, 0, 0, 0, 163, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 0, 350, 368, 321,
-	352, 292, 349, 0, 296, 299, 378, 366, 316, 317,
-	0, 0, 0, 0, 0, 0, 0, 335, 339, 355,
-	329, 0, 0, 0, 0, 0, 0, 0, 0, 314,
-	0, 346, 0, 0, 0, 300, 297, 0, 333, 0,
-	0, 0, 302, 0, 315, 356, 0, 291, 364, 330,
-	165, 367, 328, 327, 370, 116, 0, 0, 127, 95,
-	94, 362, 312, 320, 86, 318, 121, 114, 139, 345,
-	115, 120, 103, 131, 117, 138, 166, 145, 129, 144,
-	76, 128, 137, 85, 122, 78, 135, 126, 107, 97,
-	98, 77, 0, 119, 89, 93, 88, 112, 132, 133,
-	87, 151, 81, 143, 80, 82, 142, 111, 130, 136,
-	108, 105, 79, 134, 106, 104, 99, 91, 0, 295,
-	0, 125, 140, 152, 308, 365, 146, 147, 148, 149,
-	110, 83, 96, 123, 306, 307, 304, 305, 341, 342,
-	374, 375, 376, 357, 301, 0, 0, 360, 344, 75,
-	0, 101, 150, 118, 92, 141, 369, 359, 0, 332,
-	371, 310, 324, 379, 325, 326, 353, 298, 340, 113,
-	322, 0, 313, 293, 319, 294, 311, 334, 90, 337,
-	309, 361, 343, 100, 377, 102, 348, 0, 124, 109,
-	0, 0, 336, 363, 338, 358, 331, 354, 303, 347,
-	372, 323, 351, 373, 0, 0, 0, 73, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 0, 350, 368,
-	321, 352, 292, 349, 0, 296, 299, 378, 366, 316,
-	317, 0, 0, 0, 0, 0, 0, 0, 335, 339,
-	355, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	314, 0, 346, 0, 0, 0, 300, 297, 0, 333,
-	0, 0, 0, 302, 0, 315, 356, 0, 291, 364,
-	330, 165, 367, 328, 327, 370, 116, 0, 0, 127,
-	95, 94, 362, 312, 320, 86, 318, 121, 114, 139,
-	345, 115, 120, 103, 131, 117, 138, 166, 145, 129,
-	144, 76, 128, 137, 85, 122, 78, 135, 126, 107,
-	97, 98, 77, 0, 119, 89, 93, 88, 112, 132,
-	133, 87, 151, 81, 143, 80, 289, 142, 111, 130,
-	136, 108, 105, 79, 134, 106, 104, 99, 91, 0,
-	295, 0, 125, 140, 152, 308, 365, 146, 147, 148,
-	149, 290, 288, 283, 282, 306, 307, 304, 305, 341,
-	342, 374, 375, 376, 357, 301, 0, 0, 360, 344,
-	75, 0, 101, 150, 118, 92, 141, 113, 0, 0,
-	682, 0, 217, 0, 0, 0, 90, 0, 214, 0,
-	0, 100, 254, 102, 0, 0, 124, 109, 0, 0,
-	0, 0, 247, 248, 0, 0, 0, 0, 0, 0,
-	0, 0, 50, 0, 0, 215, 235, 234, 237, 238,
-	239, 240, 0, 0, 84, 236, 241, 242, 243, 0,
-	0, 212, 228, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 322, 322, 322, 322, 322, 0, 0, 0,
+	1103, 1087, 0, 54, 0, 0, 0, 322, 0, 0,
+	0, 0, 0, 0, 1036, 322, 0, 0, 0, 1100,
+	1101, 1102, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 482, 0, 0,
+	484, 0, 0, 0, 489, 0, 0, 0, 1137, 0,
+	0, 491, 0, 1053, 0, 0, 0, 1141, 0, 0,
+	0, 576, 0, 0, 0, 0, 0, 323, 1150, 1151,
+	204, 0, 0, 204, 0, 0, 1139, 204, 0, 0,
+	0, 1159, 1160, 1161, 204, 1164, 1007, 0, 0, 0,
+	0, 0, 1152, 0, 1096, 0, 335, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 225, 226, 208, 0, 0, 0,
-	265, 0, 227, 0, 0, 223, 224, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 165,
-	0, 0, 263, 0, 116, 0, 0, 127, 95, 94,
-	0, 0, 0, 86, 0, 121, 114, 139, 0, 115,
-	120, 103, 131, 117, 138, 166, 145, 129, 144, 76,
-	128, 137, 85, 122, 78, 135, 126, 107, 97, 98,
-	77, 0, 119, 89, 93, 88, 112, 132, 133, 87,
-	151, 81, 143, 80, 82, 142, 111, 130, 136, 108,
-	105, 79, 134, 106, 104, 99, 91, 0, 0, 0,
-	125, 140, 152, 0, 0, 146, 147, 148, 149, 110,
-	83, 96, 123, 255, 264, 261, 262, 259, 260, 258,
-	257, 256, 266, 249, 250, 252, 0, 251, 75, 0,
-	101, 150, 118, 92, 141, 113, 0, 0, 0, 0,
-	217, 0, 0, 0, 90, 0, 214, 0, 0, 100,
-	254, 102, 0, 0, 124, 109, 0, 0, 0, 0,
-	247, 248, 0, 0, 0, 0, 0, 0, 0, 0,
-	50, 0, 420, 215, 235, 234, 237, 238, 239, 240,
-	831, 0, 84, 236, 241, 242, 243, 0, 0, 212,
-	228, 0, 253, 0, 0, 0, 0, 0, 0, 0,
-	454, 453, 463, 464, 456, 457, 458, 459, 460, 461,
-	462, 455, 225, 226, 465, 0, 0, 0, 265, 0,
-	227, 0, 0, 223, 224, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 165, 0, 0,
-	263, 0, 116, 0, 0, 127, 95, 94, 0, 0,
-	0, 86, 0, 121, 114, 139, 0, 115, 120, 103,
-	131, 117, 138, 166, 145, 129, 144, 76, 128, 137,
-	85, 122, 78, 135, 126, 107, 97, 98, 77, 0,
-	119, 89, 93, 88, 112, 132, 133, 87, 151, 81,
-	143, 80, 82, 142, 111, 130, 136, 108, 105, 79,
-	134, 106, 104, 99, 91, 0, 0, 0, 125, 140,
-	152, 0, 0, 146, 147, 148, 149, 110, 83, 96,
-	123, 255, 264, 261, 262, 259, 260, 258, 257, 256,
-	266, 249, 250, 252, 0, 251, 75, 0, 101, 150,
-	118, 92, 141, 113, 0, 0, 0, 0, 217, 0,
-	0, 0, 90, 0, 214, 0, 0, 100, 254, 102,
-	0, 0, 124, 109, 0, 0, 0, 0, 247, 248,
-	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
-	0, 215, 235, 234, 237, 238, 239, 240, 0, 0,
-	84, 236, 241, 242, 243, 0, 0, 212, 228, 0,
-	253, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 479, 0, 479, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1184, 0, 0, 1032, 335,
+	0, 335, 0, 0, 0, 0, 754, 0, 0, 0,
+	0, 501, 0, 0, 0, 1041, 0, 1195, 0, 1053,
+	0, 335, 0, 0, 1046, 0, 0, 0, 616, 0,
+	0, 1205, 1206, 1207, 0, 789, 0, 640, 0, 0,
+	335, 0, 0, 0, 1087, 0, 0, 1201, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	225, 226, 208, 0, 0, 0, 265, 0, 227, 0,
-	0, 223, 224, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 165, 0, 0, 263, 0,
-	116, 0, 0, 127, 95, 94, 0, 0, 0, 86,
-	0, 121, 114, 139, 0, 115, 120, 103, 131, 117,
-	138, 166, 145, 129, 144, 76, 128, 137, 85, 122,
-	78, 135, 126, 107, 97, 98, 77, 0, 119, 89,
-	93, 88, 112, 132, 133, 87, 151, 81, 143, 80,
-	82, 142, 111, 130, 136, 108, 105, 79, 134, 106,
-	104, 99, 91, 0, 0, 0, 125, 140, 152, 0,
-	0, 146, 147, 148, 149, 110, 83, 96, 123, 255,
-	264, 261, 262, 259, 260, 258, 257, 256, 266, 249,
-	250, 252, 23, 251, 75, 0, 101, 150, 118, 92,
-	141, 0, 0, 0, 113, 0, 0, 0, 0, 217,
-	0, 0, 0, 90, 0, 214, 0, 0, 100, 254,
-	102, 0, 0, 124, 109, 0, 0, 0, 0, 247,
-	248, 0, 0, 0, 0, 0, 0, 0, 0, 50,
-	0, 0, 215, 235, 234, 237, 238, 239, 240, 0,
-	0, 84, 236, 241, 242, 243, 0, 0, 212, 228,
-	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 204, 0, 0, 0, 0, 0, 0, 204, 638,
+	204, 0, 847, 0, 0, 1090, 1007, 479, 847, 0,
+	0, 335, 0, 0, 0, 1087, 0, 54, 0, 1244,
+	0, 0, 0, 0, 1249, 0, 0, 1036, 479, 335,
+	0, 335, 0, 1253, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 225, 226, 0, 0, 0, 0, 265, 0, 227,
-	0, 0, 223, 224, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 165, 0, 0, 263,
-	0, 116, 0, 0, 127, 95, 94, 0, 0, 0,
-	86, 0, 121, 114, 139, 0, 115, 120, 103, 131,
-	117, 138, 166, 145, 129, 144, 76, 128, 137, 85,
-	122, 78, 135, 126, 107, 97, 98, 77, 0, 119,
-	89, 93, 88, 112, 132, 133, 87, 151, 81, 143,
-	80, 82, 142, 111, 130, 136, 108, 105, 79, 134,
-	106, 104, 99, 91, 0, 0, 0, 125, 140, 152,
-	0, 0, 146, 147, 148, 149, 110, 83, 96, 123,
-	255, 264, 261, 262, 259, 260, 258, 257, 256, 266,
-	249, 250, 252, 0, 251, 75, 0, 101, 150, 118,
-	92, 141, 113, 0, 0, 0, 0, 217, 0, 0,
-	0, 90, 0, 214, 0, 0, 100, 254, 102, 0,
-	0, 124, 109, 0, 0, 0, 0, 247, 248, 0,
-	0, 0, 0, 0, 0, 0, 0, 50, 0, 0,
-	215, 235, 234, 237, 238, 239, 240, 0, 0, 84,
-	236, 241, 242, 243, 0, 0, 212, 228, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 923, 0, 0,
+	0, 0, 0, 0, 1274, 1275, 335, 0, 1262, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 225,
-	226, 0, 0, 0, 0, 265, 0, 227, 0, 0,
-	223, 224, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 165, 0, 0, 263, 0, 116,
-	0, 0, 127, 95, 94, 0, 0, 0, 86, 0,
-	121, 114, 139, 0, 115, 120, 103, 131, 117, 138,
-	166, 145, 129, 144, 76, 128, 137, 85, 122, 78,
-	135, 126, 107, 97, 98, 77, 0, 119, 89, 93,
-	88, 112, 132, 133, 87, 151, 81, 143, 80, 82,
-	142, 111, 130, 136, 108, 105, 79, 134, 106, 104,
-	99, 91, 0, 0, 0, 125, 140, 152, 0, 0,
-	146, 147, 148, 149, 110, 83, 96, 123, 255, 264,
-	261, 262, 259, 260, 258, 257, 256, 266, 249, 250,
-	252, 113, 251, 75, 0, 101, 150, 118, 92, 141,
-	90, 0, 0, 0, 0, 100, 254, 102, 0, 0,
-	124, 109, 0, 0, 0, 0, 247, 248, 0, 0,
-	0, 0, 0, 0, 0, 0, 50, 0, 0, 215,
-	235, 234, 237, 238, 239, 240, 0, 0, 84, 236,
-	241, 242, 243, 0, 0, 0, 228, 0, 253, 0,
+	0, 0, 697, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 225, 226,
-	0, 0, 0, 0, 265, 0, 227, 0, 0, 223,
-	224, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 165, 0, 0, 263, 0, 116, 0,
-	0, 127, 95, 94, 0, 0, 0, 86, 0, 121,
-	114, 139, 1157, 115, 120, 103, 131, 117, 138, 166,
-	145, 129, 144, 76, 128, 137, 85, 122, 78, 135,
-	126, 107, 97, 98, 77, 0, 119, 89, 93, 88,
-	112, 132, 133, 87, 151, 81, 143, 80, 82, 142,
-	111, 130, 136, 108, 105, 79, 134, 106, 104, 99,
-	91, 0, 0, 0, 125, 140, 152, 0, 0, 146,
-	147, 148, 149, 110, 83, 96, 123, 255, 264, 261,
-	262, 259, 260, 258, 257, 256, 266, 249, 250, 252,
-	113, 251, 75, 0, 101, 150, 118, 92, 141, 90,
-	0, 0, 0, 0, 100, 254, 102, 0, 0, 124,
-	109, 0, 0, 0, 0, 247, 248, 0, 0, 0,
-	0, 0, 0, 0, 0, 50, 0, 0, 215, 235,
-	234, 237, 238, 239, 240, 0, 0, 84, 236, 241,
-	242, 243, 0, 0, 0, 228, 0, 253, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 721,
+	0, 0, 0, 0, 0, 204, 754, 0, 754, 754,
+	754, 0, 1171, 0, 335, 730, 0, 732, 0, 0,
+	735, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 204, 335, 335, 335, 0, 0, 0, 0,
+	0, 1186, 749, 0, 0, 0, 0, 0, 204, 0,
+	204, 0, 0, 204, 0, 737, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 773, 0,
+	1202, 1203, 0, 0, 0, 204, 0, 0, 0, 0,
+	0, 0, 242, 26, 28, 56, 30, 31, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 225, 226, 0,
-	0, 0, 0, 265, 0, 227, 0, 0, 223, 224,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 165, 0, 0, 263, 0, 116, 0, 0,
-	127, 95, 94, 0, 0, 0, 86, 0, 121, 114,
-	139, 0, 115, 120, 103, 131, 117, 138, 166, 145,
-	129, 144, 76, 128, 137, 85, 122, 78, 135, 126,
-	107, 97, 98, 77, 0, 119, 89, 93, 88, 112,
-	132, 133, 87, 151, 81, 143, 80, 82, 142, 111,
-	130, 136, 108, 105, 79, 134, 106, 104, 99, 91,
-	0, 0, 0, 125, 140, 152, 0, 0, 146, 147,
-	148, 149, 110, 83, 96, 123, 255, 264, 261, 262,
-	259, 260, 258, 257, 256, 266, 249, 250, 252, 113,
-	251, 75, 0, 101, 150, 118, 92, 141, 90, 0,
-	0, 0, 0, 100, 0, 102, 0, 0, 124, 109,
+	0, 204, 49, 0, 0, 0, 0, 32, 0, 1134,
+	737, 0, 0, 0, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 42, 0, 0, 0,
+	58, 0, 0, 0, 0, 0, 847, 0, 0, 1250,
+	0, 0, 0, 0, 0, 0, 0, 843, 0, 0,
+	0, 259, 0, 0, 0, 0, 0, 259, 259, 0,
+	0, 259, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 872, 259, 259, 259, 259, 0,
+	204, 0, 848, 204, 204, 204, 204, 204, 0, 34,
+	36, 38, 37, 40, 0, 862, 0, 0, 204, 0,
+	0, 0, 638, 0, 0, 0, 204, 204, 41, 50,
+	51, 0, 0, 52, 53, 39, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 911, 43, 44, 0,
+	45, 46, 47, 48, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 73, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 204,
+	0, 0, 0, 932, 0, 0, 933, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 0, 0, 0,
+	0, 0, 0, 0, 100, 0, 0, 0, 0, 111,
+	0, 113, 57, 0, 141, 120, 204, 0, 0, 204,
+	0, 55, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 82, 0, 0, 0, 0, 0, 0,
+	0, 0, 93, 0, 737, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 554, 553,
+	563, 564, 556, 557, 558, 559, 560, 561, 562, 555,
+	0, 0, 565, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 0,
+	0, 0, 129, 259, 0, 144, 106, 105, 0, 0,
+	0, 96, 0, 135, 126, 156, 259, 127, 134, 114,
+	148, 130, 155, 208, 163, 146, 162, 85, 145, 154,
+	94, 136, 139, 0, 87, 152, 143, 118, 108, 109,
+	86, 0, 133, 99, 103, 98, 124, 149, 150, 97,
+	168, 90, 161, 89, 91, 160, 123, 147, 153, 119,
+	116, 88, 151, 117, 115, 110, 101, 0, 0, 0,
+	142, 158, 169, 0, 0, 164, 165, 166, 167, 122,
+	92, 107, 140, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1049, 0, 0, 84, 0,
+	112, 0, 131, 102, 0, 0, 138, 132, 157, 128,
+	104, 95, 137, 121, 0, 159, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 204, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 848,
+	0, 0, 0, 0, 0, 848, 0, 0, 737, 0,
+	0, 0, 0, 1125, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 454, 453, 463, 464, 456, 457, 458, 459,
-	460, 461, 462, 455, 0, 0, 465, 0, 0, 0,
+	0, 421, 411, 0, 381, 423, 359, 373, 431, 374,
+	375, 402, 347, 389, 125, 371, 204, 362, 342, 368,
+	343, 360, 383, 100, 386, 358, 413, 392, 111, 429,
+	113, 397, 0, 141, 120, 0, 204, 385, 415, 387,
+	410, 380, 403, 352, 396, 424, 372, 400, 425, 0,
+	0, 0, 82, 0, 480, 481, 0, 0, 0, 0,
+	0, 93, 0, 399, 420, 370, 401, 341, 398, 0,
+	345, 348, 430, 418, 365, 366, 1013, 0, 0, 0,
+	0, 0, 0, 384, 388, 406, 378, 0, 638, 0,
+	0, 0, 0, 0, 0, 363, 0, 395, 0, 0,
+	0, 349, 346, 0, 382, 0, 0, 0, 351, 0,
+	364, 408, 0, 340, 416, 379, 207, 419, 377, 376,
+	422, 129, 0, 0, 144, 106, 105, 414, 361, 369,
+	96, 367, 135, 126, 156, 394, 127, 134, 114, 148,
+	130, 155, 208, 163, 146, 162, 85, 145, 154, 94,
+	136, 139, 407, 87, 152, 143, 118, 108, 109, 86,
+	0, 133, 99, 103, 98, 124, 149, 150, 97, 168,
+	90, 161, 89, 91, 160, 123, 147, 153, 119, 116,
+	88, 151, 117, 115, 110, 101, 0, 344, 0, 142,
+	158, 169, 357, 417, 164, 165, 166, 167, 122, 92,
+	107, 140, 355, 356, 353, 354, 390, 391, 426, 427,
+	428, 409, 350, 848, 0, 412, 393, 84, 0, 112,
+	432, 131, 102, 405, 404, 138, 132, 157, 128, 104,
+	95, 137, 121, 0, 159, 421, 411, 0, 381, 423,
+	359, 373, 431, 374, 375, 402, 347, 389, 125, 371,
+	0, 362, 342, 368, 343, 360, 383, 100, 386, 358,
+	413, 392, 111, 429, 113, 397, 0, 141, 120, 0,
+	0, 385, 415, 387, 410, 380, 403, 352, 396, 424,
+	372, 400, 425, 0, 0, 0, 82, 0, 480, 481,
+	0, 0, 0, 0, 0, 93, 0, 399, 420, 370,
+	401, 341, 398, 0, 345, 348, 430, 418, 365, 366,
+	478, 0, 0, 0, 0, 0, 0, 384, 388, 406,
+	378, 0, 0, 0, 0, 0, 0, 0, 0, 363,
+	0, 395, 0, 0, 0, 349, 346, 0, 382, 0,
+	0, 0, 351, 0, 364, 408, 0, 340, 416, 379,
+	207, 419, 377, 376, 422, 129, 0, 0, 144, 106,
+	105, 414, 361, 369, 96, 367, 135, 126, 156, 394,
+	127, 134, 114, 148, 130, 155, 208, 163, 146, 162,
+	85, 145, 154, 94, 136, 139, 407, 87, 152, 143,
+	118, 108, 109, 86, 0, 133, 99, 103, 98, 124,
+	149, 150, 97, 168, 90, 161, 89, 91, 160, 123,
+	147, 153, 119, 116, 88, 151, 117, 115, 110, 101,
+	0, 344, 0, 142, 158, 169, 357, 417, 164, 165,
+	166, 167, 122, 92, 107, 140, 355, 356, 353, 354,
+	390, 391, 426, 427, 428, 409, 350, 0, 0, 412,
+	393, 84, 0, 112, 432, 131, 102, 405, 404, 138,
+	132, 157, 128, 104, 95, 137, 121, 0, 159, 421,
+	411, 0, 381, 423, 359, 373, 431, 374, 375, 402,
+	347, 389, 125, 371, 0, 362, 342, 368, 343, 360,
+	383, 100, 386, 358, 413, 392, 111, 429, 113, 397,
+	0, 141, 120, 0, 0, 385, 415, 387, 410, 380,
+	403, 352, 396, 424, 372, 400, 425, 0, 0, 0,
+	82, 0, 480, 481, 0, 0, 0, 0, 0, 93,
+	0, 399, 420, 370, 401, 341, 398, 0, 345, 348,
+	430, 418, 365, 366, 0, 0, 0, 0, 0, 0,
+	0, 384, 388, 406, 378, 0, 0, 0, 0, 0,
+	0, 0, 0, 363, 0, 395, 0, 0, 0, 349,
+	346, 0, 382, 0, 0, 0, 351, 0, 364, 408,
+	0, 340, 416, 379, 207, 419, 377, 376, 422, 129,
+	0, 0, 144, 106, 105, 414, 361, 369, 96, 367,
+	135, 126, 156, 394, 127, 134, 114, 148, 130, 155,
+	208, 163, 146, 162, 85, 145, 154, 94, 136, 139,
+	407, 87, 152, 143, 118, 108, 109, 86, 0, 133,
+	99, 103, 98, 124, 149, 150, 97, 168, 90, 161,
+	89, 91, 160, 123, 147, 153, 119, 116, 88, 151,
+	117, 115, 110, 101, 0, 344, 0, 142, 158, 169,
+	357, 417, 164, 165, 166, 167, 122, 92, 107, 140,
+	355, 356, 353, 354, 390, 391, 426, 427, 428, 409,
+	350, 0, 0, 412, 393, 84, 0, 112, 432, 131,
+	102, 405, 404, 138, 132, 157, 128, 104, 95, 137,
+	121, 0, 159, 421, 411, 0, 381, 423, 359, 373,
+	431, 374, 375, 402, 347, 389, 125, 371, 0, 362,
+	342, 368, 343, 360, 383, 100, 386, 358, 413, 392,
+	111, 429, 113, 397, 0, 141, 120, 0, 0, 385,
+	415, 387, 410, 380, 403, 352, 396, 424, 372, 400,
+	425, 58, 0, 0, 82, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 0, 399, 420, 370, 401, 341,
+	398, 0, 345, 348, 430, 418, 365, 366, 0, 0,
+	0, 0, 0, 0, 0, 384, 388, 406, 378, 0,
+	0, 0, 0, 0, 0, 0, 0, 363, 0, 395,
+	0, 0, 0, 349, 346, 0, 382, 0, 0, 0,
+	351, 0, 364, 408, 0, 340, 416, 379, 207, 419,
+	377, 376, 422, 129, 0, 0, 144, 106, 105, 414,
+	361, 369, 96, 367, 135, 126, 156, 394, 127, 134,
+	114, 148, 130, 155, 208, 163, 146, 162, 85, 145,
+	154, 94, 136, 139, 407, 87, 152, 143, 118, 108,
+	109, 86, 0, 133, 99, 103, 98, 124, 149, 150,
+	97, 168, 90, 161, 89, 91, 160, 123, 147, 153,
+	119, 116, 88, 151, 117, 115, 110, 101, 0, 344,
+	0, 142, 158, 169, 357, 417, 164, 165, 166, 167,
+	122, 92, 107, 140, 355, 356, 353, 354, 390, 391,
+	426, 427, 428, 409, 350, 0, 0, 412, 393, 84,
+	0, 112, 432, 131, 102, 405, 404, 138, 132, 157,
+	128, 104, 95, 137, 121, 0, 159, 421, 411, 0,
+	381, 423, 359, 373, 431, 374, 375, 402, 347, 389,
+	125, 371, 0, 362, 342, 368, 343, 360, 383, 100,
+	386, 358, 413, 392, 111, 429, 113, 397, 0, 141,
+	120, 0, 0, 385, 415, 387, 410, 380, 403, 352,
+	396, 424, 372, 400, 425, 0, 0, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 399,
+	420, 370, 401, 341, 398, 0, 345, 348, 430, 418,
+	365, 366, 0, 0, 0, 0, 0, 0, 0, 384,
+	388, 406, 378, 0, 0, 0, 0, 0, 0, 1052,
+	0, 363, 0, 395, 0, 0, 0, 349, 346, 0,
+	382, 0, 0, 0, 351, 0, 364, 408, 0, 340,
+	416, 379, 207, 419, 377, 376, 422, 129, 0, 0,
+	144, 106, 105, 414, 361, 369, 96, 367, 135, 126,
+	156, 394, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 407, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 344, 0, 142, 158, 169, 357, 417,
+	164, 165, 166, 167, 122, 92, 107, 140, 355, 356,
+	353, 354, 390, 391, 426, 427, 428, 409, 350, 0,
+	0, 412, 393, 84, 0, 112, 432, 131, 102, 405,
+	404, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 421, 411, 0, 381, 423, 359, 373, 431, 374,
+	375, 402, 347, 389, 125, 371, 0, 362, 342, 368,
+	343, 360, 383, 100, 386, 358, 413, 392, 111, 429,
+	113, 397, 0, 141, 120, 0, 0, 385, 415, 387,
+	410, 380, 403, 352, 396, 424, 372, 400, 425, 0,
+	0, 0, 264, 0, 0, 0, 0, 0, 0, 0,
+	0, 93, 0, 399, 420, 370, 401, 341, 398, 0,
+	345, 348, 430, 418, 365, 366, 0, 0, 0, 0,
+	0, 0, 0, 384, 388, 406, 378, 0, 0, 0,
+	0, 0, 0, 782, 0, 363, 0, 395, 0, 0,
+	0, 349, 346, 0, 382, 0, 0, 0, 351, 0,
+	364, 408, 0, 340, 416, 379, 207, 419, 377, 376,
+	422, 129, 0, 0, 144, 106, 105, 414, 361, 369,
+	96, 367, 135, 126, 156, 394, 127, 134, 114, 148,
+	130, 155, 208, 163, 146, 162, 85, 145, 154, 94,
+	136, 139, 407, 87, 152, 143, 118, 108, 109, 86,
+	0, 133, 99, 103, 98, 124, 149, 150, 97, 168,
+	90, 161, 89, 91, 160, 123, 147, 153, 119, 116,
+	88, 151, 117, 115, 110, 101, 0, 344, 0, 142,
+	158, 169, 357, 417, 164, 165, 166, 167, 122, 92,
+	107, 140, 355, 356, 353, 354, 390, 391, 426, 427,
+	428, 409, 350, 0, 0, 412, 393, 84, 0, 112,
+	432, 131, 102, 405, 404, 138, 132, 157, 128, 104,
+	95, 137, 121, 0, 159, 421, 411, 0, 381, 423,
+	359, 373, 431, 374, 375, 402, 347, 389, 125, 371,
+	0, 362, 342, 368, 343, 360, 383, 100, 386, 358,
+	413, 392, 111, 429, 113, 397, 0, 141, 120, 0,
+	0, 385, 415, 387, 410, 380, 403, 352, 396, 424,
+	372, 400, 425, 0, 0, 0, 82, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 0, 399, 420, 370,
+	401, 341, 398, 0, 345, 348, 430, 418, 365, 366,
+	0, 0, 0, 0, 0, 0, 0, 384, 388, 406,
+	378, 0, 0, 0, 0, 0, 0, 0, 0, 363,
+	0, 395, 0, 0, 0, 349, 346, 0, 382, 0,
+	0, 0, 351, 0, 364, 408, 0, 340, 416, 379,
+	207, 419, 377, 376, 422, 129, 0, 0, 144, 106,
+	105, 414, 361, 369, 96, 367, 135, 126, 156, 394,
+	127, 134, 114, 148, 130, 155, 208, 163, 146, 162,
+	85, 145, 154, 94, 136, 139, 407, 87, 152, 143,
+	118, 108, 109, 86, 0, 133, 99, 103, 98, 124,
+	149, 150, 97, 168, 90, 161, 89, 91, 160, 123,
+	147, 153, 119, 116, 88, 151, 117, 115, 110, 101,
+	0, 344, 0, 142, 158, 169, 357, 417, 164, 165,
+	166, 167, 122, 92, 107, 140, 355, 356, 353, 354,
+	390, 391, 426, 427, 428, 409, 350, 0, 0, 412,
+	393, 84, 0, 112, 432, 131, 102, 405, 404, 138,
+	132, 157, 128, 104, 95, 137, 121, 0, 159, 421,
+	411, 0, 381, 423, 359, 373, 431, 374, 375, 402,
+	347, 389, 125, 371, 0, 362, 342, 368, 343, 360,
+	383, 100, 386, 358, 413, 392, 111, 429, 113, 397,
+	0, 141, 120, 0, 0, 385, 415, 387, 410, 380,
+	403, 352, 396, 424, 372, 400, 425, 0, 0, 0,
+	264, 0, 0, 0, 0, 0, 0, 0, 0, 93,
+	0, 399, 420, 370, 401, 341, 398, 0, 345, 348,
+	430, 418, 365, 366, 0, 0, 0, 0, 0, 0,
+	0, 384, 388, 406, 378, 0, 0, 0, 0, 0,
+	0, 0, 0, 363, 0, 395, 0, 0, 0, 349,
+	346, 0, 382, 0, 0, 0, 351, 0, 364, 408,
+	0, 340, 416, 379, 207, 419, 377, 376, 422, 129,
+	0, 0, 144, 106, 105, 414, 361, 369, 96, 367,
+	135, 126, 156, 394, 127, 134, 114, 148, 130, 155,
+	208, 163, 146, 162, 85, 145, 154, 94, 136, 139,
+	407, 87, 152, 143, 118, 108, 109, 86, 0, 133,
+	99, 103, 98, 124, 149, 150, 97, 168, 90, 161,
+	89, 91, 160, 123, 147, 153, 119, 116, 88, 151,
+	117, 115, 110, 101, 0, 344, 0, 142, 158, 169,
+	357, 417, 164, 165, 166, 167, 122, 92, 107, 140,
+	355, 356, 353, 354, 390, 391, 426, 427, 428, 409,
+	350, 0, 0, 412, 393, 84, 0, 112, 432, 131,
+	102, 405, 404, 138, 132, 157, 128, 104, 95, 137,
+	121, 0, 159, 421, 411, 0, 381, 423, 359, 373,
+	431, 374, 375, 402, 347, 389, 125, 371, 0, 362,
+	342, 368, 343, 360, 383, 100, 386, 358, 413, 392,
+	111, 429, 113, 397, 0, 141, 120, 0, 0, 385,
+	415, 387, 410, 380, 403, 352, 396, 424, 372, 400,
+	425, 0, 0, 0, 82, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 0, 399, 420, 370, 401, 341,
+	398, 0, 345, 348, 430, 418, 365, 366, 0, 0,
+	0, 0, 0, 0, 0, 384, 388, 406, 378, 0,
+	0, 0, 0, 0, 0, 0, 0, 363, 0, 395,
+	0, 0, 0, 349, 346, 0, 382, 0, 0, 0,
+	351, 0, 364, 408, 0, 340, 416, 379, 207, 419,
+	377, 376, 422, 129, 0, 0, 144, 106, 105, 414,
+	361, 369, 96, 367, 135, 126, 156, 394, 127, 134,
+	114, 148, 130, 155, 208, 163, 146, 162, 85, 145,
+	154, 94, 136, 139, 407, 87, 152, 143, 118, 108,
+	109, 86, 0, 133, 99, 103, 98, 124, 149, 150,
+	97, 168, 90, 161, 89, 338, 160, 123, 147, 153,
+	119, 116, 88, 151, 117, 115, 110, 101, 0, 344,
+	0, 142, 158, 169, 357, 417, 164, 165, 166, 167,
+	339, 337, 107, 140, 355, 356, 353, 354, 390, 391,
+	426, 427, 428, 409, 350, 0, 0, 412, 393, 84,
+	0, 112, 432, 131, 102, 405, 404, 138, 132, 157,
+	128, 104, 95, 137, 121, 0, 159, 421, 411, 0,
+	381, 423, 359, 373, 431, 374, 375, 402, 347, 389,
+	125, 371, 0, 362, 342, 368, 343, 360, 383, 100,
+	386, 358, 413, 392, 111, 429, 113, 397, 0, 141,
+	120, 0, 0, 385, 415, 387, 410, 380, 403, 352,
+	396, 424, 372, 400, 425, 0, 0, 0, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 399,
+	420, 370, 401, 341, 398, 0, 345, 348, 430, 418,
+	365, 366, 0, 0, 0, 0, 0, 0, 0, 384,
+	388, 406, 378, 0, 0, 0, 0, 0, 0, 0,
+	0, 363, 0, 395, 0, 0, 0, 349, 346, 0,
+	382, 0, 0, 0, 351, 0, 364, 408, 0, 340,
+	416, 379, 207, 419, 377, 376, 422, 129, 0, 0,
+	144, 106, 105, 414, 361, 369, 96, 367, 135, 126,
+	156, 394, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 407, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 344, 0, 142, 158, 169, 357, 417,
+	164, 165, 166, 167, 122, 92, 107, 140, 355, 356,
+	353, 354, 390, 391, 426, 427, 428, 409, 350, 0,
+	0, 412, 393, 84, 0, 112, 432, 131, 102, 405,
+	404, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 421, 411, 0, 381, 423, 359, 373, 431, 374,
+	375, 402, 347, 389, 125, 371, 0, 362, 342, 368,
+	343, 360, 383, 100, 386, 358, 413, 392, 111, 429,
+	113, 397, 0, 141, 120, 0, 0, 385, 415, 387,
+	410, 380, 403, 352, 396, 424, 372, 400, 425, 0,
+	0, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 93, 0, 399, 420, 370, 401, 341, 398, 0,
+	345, 348, 430, 418, 365, 366, 0, 0, 0, 0,
+	0, 0, 0, 384, 388, 406, 378, 0, 0, 0,
+	0, 0, 0, 0, 0, 363, 0, 395, 0, 0,
+	0, 349, 346, 0, 382, 0, 0, 0, 351, 0,
+	364, 408, 0, 340, 416, 379, 207, 419, 377, 376,
+	422, 129, 0, 0, 144, 106, 105, 414, 361, 369,
+	96, 367, 135, 126, 156, 394, 127, 134, 114, 148,
+	130, 155, 208, 163, 146, 162, 85, 145, 154, 94,
+	136, 139, 407, 87, 152, 143, 118, 108, 109, 86,
+	0, 133, 99, 103, 98, 124, 149, 150, 97, 168,
+	90, 161, 89, 338, 160, 123, 147, 153, 119, 116,
+	88, 151, 117, 115, 110, 101, 0, 344, 0, 142,
+	158, 169, 357, 417, 164, 165, 166, 167, 339, 337,
+	332, 331, 355, 356, 353, 354, 390, 391, 426, 427,
+	428, 409, 350, 0, 0, 412, 393, 84, 0, 112,
+	432, 131, 102, 405, 404, 138, 132, 157, 128, 104,
+	95, 137, 121, 26, 159, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 0, 0, 0,
+	266, 0, 0, 0, 100, 0, 263, 0, 0, 111,
+	303, 113, 0, 0, 141, 120, 0, 0, 0, 0,
+	296, 297, 0, 0, 0, 0, 0, 0, 0, 0,
+	58, 0, 0, 264, 284, 283, 286, 287, 288, 289,
+	0, 0, 93, 285, 290, 291, 292, 0, 0, 261,
+	277, 0, 302, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 165, 0, 0, 0, 0, 116, 0, 0, 127,
-	95, 94, 0, 0, 0, 86, 0, 121, 114, 139,
-	0, 115, 120, 103, 131, 117, 138, 166, 145, 129,
-	144, 76, 128, 137, 85, 122, 78, 135, 126, 107,
-	97, 98, 77, 0, 119, 89, 93, 88, 112, 132,
-	133, 87, 151, 81, 143, 80, 82, 142, 111, 130,
-	136, 108, 105, 79, 134, 106, 104, 99, 91, 0,
-	0, 0, 125, 140, 152, 0, 113, 146, 147, 148,
-	149, 110, 83, 96, 123, 90, 0, 0, 0, 0,
-	100, 0, 102, 0, 0, 124, 109, 0, 0, 0,
-	75, 0, 101, 150, 118, 92, 141, 0, 0, 0,
-	0, 0, 0, 0, 73, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 68, 0,
+	0, 0, 274, 275, 0, 0, 0, 0, 314, 0,
+	276, 0, 0, 272, 273, 278, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 207, 0, 0,
+	312, 0, 129, 0, 0, 144, 106, 105, 0, 0,
+	0, 96, 0, 135, 126, 156, 0, 127, 134, 114,
+	148, 130, 155, 208, 163, 146, 162, 85, 145, 154,
+	94, 136, 139, 0, 87, 152, 143, 118, 108, 109,
+	86, 0, 133, 99, 103, 98, 124, 149, 150, 97,
+	168, 90, 161, 89, 91, 160, 123, 147, 153, 119,
+	116, 88, 151, 117, 115, 110, 101, 0, 0, 0,
+	142, 158, 169, 0, 0, 164, 165, 166, 167, 122,
+	92, 107, 140, 304, 313, 310, 311, 308, 309, 307,
+	306, 305, 315, 298, 299, 301, 0, 300, 84, 0,
+	112, 55, 131, 102, 0, 0, 138, 132, 157, 128,
+	104, 95, 137, 121, 125, 159, 0, 818, 0, 266,
+	0, 0, 0, 100, 0, 263, 0, 0, 111, 303,
+	113, 0, 0, 141, 120, 0, 0, 0, 0, 296,
+	297, 0, 0, 0, 0, 0, 0, 0, 0, 58,
+	0, 0, 264, 284, 283, 286, 287, 288, 289, 0,
+	0, 93, 285, 290, 291, 292, 0, 0, 261, 277,
+	0, 302, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 274, 275, 257, 0, 0, 0, 314, 0, 276,
+	0, 0, 272, 273, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 207, 0, 0, 312,
+	0, 129, 0, 0, 144, 106, 105, 0, 0, 0,
+	96, 0, 135, 126, 156, 0, 127, 134, 114, 148,
+	130, 155, 208, 163, 146, 162, 85, 145, 154, 94,
+	136, 139, 0, 87, 152, 143, 118, 108, 109, 86,
+	0, 133, 99, 103, 98, 124, 149, 150, 97, 168,
+	90, 161, 89, 91, 160, 123, 147, 153, 119, 116,
+	88, 151, 117, 115, 110, 101, 0, 0, 0, 142,
+	158, 169, 0, 0, 164, 165, 166, 167, 122, 92,
+	107, 140, 304, 313, 310, 311, 308, 309, 307, 306,
+	305, 315, 298, 299, 301, 0, 300, 84, 0, 112,
+	0, 131, 102, 0, 0, 138, 132, 157, 128, 104,
+	95, 137, 121, 125, 159, 0, 0, 0, 266, 0,
+	0, 0, 100, 0, 263, 0, 0, 111, 303, 113,
+	0, 0, 141, 120, 0, 0, 0, 0, 296, 297,
+	0, 0, 0, 0, 0, 0, 0, 0, 58, 0,
+	516, 264, 284, 283, 286, 287, 288, 289, 0, 0,
+	93, 285, 290, 291, 292, 0, 0, 261, 277, 0,
+	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	274, 275, 0, 0, 0, 0, 314, 0, 276, 0,
+	0, 272, 273, 278, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 207, 0, 0, 312, 0,
+	129, 0, 0, 144, 106, 105, 0, 0, 0, 96,
+	0, 135, 126, 156, 0, 127, 134, 114, 148, 130,
+	155, 208, 163, 146, 162, 85, 145, 154, 94, 136,
+	139, 0, 87, 152, 143, 118, 108, 109, 86, 0,
+	133, 99, 103, 98, 124, 149, 150, 97, 168, 90,
+	161, 89, 91, 160, 123, 147, 153, 119, 116, 88,
+	151, 117, 115, 110, 101, 0, 0, 0, 142, 158,
+	169, 0, 0, 164, 165, 166, 167, 122, 92, 107,
+	140, 304, 313, 310, 311, 308, 309, 307, 306, 305,
+	315, 298, 299, 301, 0, 300, 84, 0, 112, 0,
+	131, 102, 0, 0, 138, 132, 157, 128, 104, 95,
+	137, 121, 125, 159, 0, 0, 0, 266, 0, 0,
+	0, 100, 0, 263, 0, 0, 111, 303, 113, 0,
+	0, 141, 120, 0, 0, 0, 0, 296, 297, 0,
+	0, 0, 0, 0, 0, 0, 0, 58, 0, 0,
+	264, 284, 283, 286, 287, 288, 289, 0, 0, 93,
+	285, 290, 291, 292, 0, 0, 261, 277, 0, 302,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 274,
+	275, 257, 0, 0, 0, 314, 0, 276, 0, 0,
+	272, 273, 278, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 207, 0, 0, 312, 0, 129,
+	0, 0, 144, 106, 105, 0, 0, 0, 96, 0,
+	135, 126, 156, 0, 127, 134, 114, 148, 130, 155,
+	208, 163, 146, 162, 85, 145, 154, 94, 136, 139,
+	0, 87, 152, 143, 118, 108, 109, 86, 0, 133,
+	99, 103, 98, 124, 149, 150, 97, 168, 90, 161,
+	89, 91, 160, 123, 147, 153, 119, 116, 88, 151,
+	117, 115, 110, 101, 0, 0, 0, 142, 158, 169,
+	0, 0, 164, 165, 166, 167, 122, 92, 107, 140,
+	304, 313, 310, 311, 308, 309, 307, 306, 305, 315,
+	298, 299, 301, 0, 300, 84, 0, 112, 0, 131,
+	102, 0, 0, 138, 132, 157, 128, 104, 95, 137,
+	121, 125, 159, 0, 0, 0, 266, 0, 0, 0,
+	100, 0, 263, 0, 0, 111, 303, 113, 0, 0,
+	141, 120, 0, 0, 0, 0, 296, 297, 0, 0,
+	0, 0, 0, 0, 0, 0, 58, 0, 0, 264,
+	284, 283, 286, 287, 288, 289, 0, 0, 93, 285,
+	290, 291, 292, 0, 0, 261, 277, 0, 302, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 70, 0, 67, 0,
-	0, 0, 71, 116, 0, 0, 127, 95, 94, 0,
-	0, 0, 86, 0, 121, 114, 139, 0, 115, 120,
-	103, 131, 117, 138, 69, 145, 129, 144, 76, 128,
-	137, 85, 122, 78, 135, 126, 107, 97, 98, 77,
-	0, 119, 89, 93, 88, 112, 132, 133, 87, 151,
-	81, 143, 80, 82, 142, 111, 130, 136, 108, 105,
-	79, 134, 106, 104, 99, 91, 0, 0, 0, 125,
-	140, 152, 0, 0, 146, 147, 148, 149, 110, 83,
-	96, 123, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 75, 0, 101,
-	150, 118, 92, 141, 113, 0, 0, 0, 536, 0,
-	0, 0, 0, 90, 0, 0, 0, 0, 100, 0,
-	102, 0, 0, 124, 109, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 274, 275,
+	0, 0, 0, 0, 314, 0, 276, 0, 0, 272,
+	273, 278, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 0, 312, 0, 129, 0,
+	0, 144, 106, 105, 0, 0, 0, 96, 0, 135,
+	126, 156, 0, 127, 134, 114, 148, 130, 155, 208,
+	163, 146, 162, 85, 145, 154, 94, 136, 139, 0,
+	87, 152, 143, 118, 108, 109, 86, 0, 133, 99,
+	103, 98, 124, 149, 150, 97, 168, 90, 161, 89,
+	91, 160, 123, 147, 153, 119, 116, 88, 151, 117,
+	115, 110, 101, 0, 0, 0, 142, 158, 169, 0,
+	0, 164, 165, 166, 167, 122, 92, 107, 140, 304,
+	313, 310, 311, 308, 309, 307, 306, 305, 315, 298,
+	299, 301, 0, 300, 84, 0, 112, 0, 131, 102,
+	0, 125, 138, 132, 157, 128, 104, 95, 137, 121,
+	100, 159, 0, 0, 0, 111, 303, 113, 0, 0,
+	141, 120, 0, 0, 0, 0, 296, 297, 0, 0,
+	0, 0, 0, 0, 0, 0, 58, 0, 0, 264,
+	284, 283, 286, 287, 288, 289, 0, 0, 93, 285,
+	290, 291, 292, 0, 0, 0, 277, 0, 302, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 163, 0, 538, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 274, 275,
+	0, 0, 0, 0, 314, 0, 276, 0, 0, 272,
+	273, 278, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 0, 312, 0, 129, 0,
+	0, 144, 106, 105, 0, 0, 0, 96, 0, 135,
+	126, 156, 1271, 127, 134, 114, 148, 130, 155, 208,
+	163, 146, 162, 85, 145, 154, 94, 136, 139, 0,
+	87, 152, 143, 118, 108, 109, 86, 0, 133, 99,
+	103, 98, 124, 149, 150, 97, 168, 90, 161, 89,
+	91, 160, 123, 147, 153, 119, 116, 88, 151, 117,
+	115, 110, 101, 0, 0, 0, 142, 158, 169, 0,
+	0, 164, 165, 166, 167, 122, 92, 107, 140, 304,
+	313, 310, 311, 308, 309, 307, 306, 305, 315, 298,
+	299, 301, 0, 300, 84, 0, 112, 0, 131, 102,
+	0, 125, 138, 132, 157, 128, 104, 95, 137, 121,
+	100, 159, 0, 0, 0, 111, 303, 113, 0, 0,
+	141, 120, 0, 0, 0, 0, 296, 297, 0, 0,
+	0, 0, 0, 0, 0, 0, 58, 0, 0, 264,
+	284, 283, 286, 287, 288, 289, 0, 0, 93, 285,
+	290, 291, 292, 0, 0, 0, 277, 0, 302, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 274, 275,
+	0, 0, 0, 0, 314, 0, 276, 0, 0, 272,
+	273, 278, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 207, 0, 0, 312, 0, 129, 0,
+	0, 144, 106, 105, 0, 0, 0, 96, 0, 135,
+	126, 156, 0, 127, 134, 114, 148, 130, 155, 208,
+	163, 146, 162, 85, 145, 154, 94, 136, 139, 0,
+	87, 152, 143, 118, 108, 109, 86, 0, 133, 99,
+	103, 98, 124, 149, 150, 97, 168, 90, 161, 89,
+	91, 160, 123, 147, 153, 119, 116, 88, 151, 117,
+	115, 110, 101, 0, 0, 0, 142, 158, 169, 0,
+	0, 164, 165, 166, 167, 122, 92, 107, 140, 304,
+	313, 310, 311, 308, 309, 307, 306, 305, 315, 298,
+	299, 301, 0, 300, 84, 0, 112, 0, 131, 102,
+	0, 0, 138, 132, 157, 128, 104, 95, 137, 121,
+	125, 159, 0, 0, 542, 0, 0, 0, 0, 100,
+	0, 663, 0, 0, 111, 0, 113, 0, 0, 141,
+	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 82, 675,
+	544, 0, 0, 0, 0, 0, 0, 93, 0, 0,
+	0, 0, 539, 538, 0, 0, 0, 0, 0, 0,
+	0, 0, 680, 681, 682, 683, 684, 685, 686, 540,
+	687, 688, 689, 690, 691, 676, 677, 678, 679, 661,
+	662, 0, 0, 664, 0, 665, 666, 667, 668, 669,
+	670, 671, 672, 673, 674, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	458, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 165, 0, 0, 0,
-	0, 116, 0, 0, 127, 95, 94, 0, 0, 0,
-	86, 0, 121, 114, 139, 0, 115, 120, 103, 131,
-	117, 138, 166, 145, 129, 144, 76, 128, 137, 85,
-	122, 78, 135, 126, 107, 97, 98, 77, 0, 119,
-	89, 93, 88, 112, 132, 133, 87, 151, 81, 143,
-	80, 82, 142, 111, 130, 136, 108, 105, 79, 134,
-	106, 104, 99, 91, 0, 0, 0, 125, 140, 152,
-	0, 0, 146, 147, 148, 149, 110, 83, 96, 123,
-	0, 23, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 113, 0, 75, 0, 101, 150, 118,
-	92, 141, 90, 0, 0, 0, 0, 100, 0, 102,
-	0, 0, 124, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
-	0, 73, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 457, 207, 0, 0, 0, 0, 463, 461, 464,
+	144, 106, 465, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 466, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	458, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 165, 0, 0, 0, 0,
-	116, 0, 0, 127, 95, 94, 0, 0, 0, 86,
-	0, 121, 114, 139, 0, 115, 120, 103, 131, 117,
-	138, 166, 145, 129, 144, 76, 128, 137, 85, 122,
-	78, 135, 126, 107, 97, 98, 77, 0, 119, 89,
-	93, 88, 112, 132, 133, 87, 151, 81, 143, 80,
-	82, 142, 111, 130, 136, 108, 105, 79, 134, 106,
-	104, 99, 91, 0, 0, 0, 125, 140, 152, 0,
-	0, 146, 147, 148, 149, 110, 83, 96, 123, 0,
-	23, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 113, 0, 75, 0, 101, 150, 118, 92,
-	141, 90, 0, 0, 0, 0, 100, 0, 102, 0,
-	0, 124, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 50, 0, 0,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 84,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 457, 207, 0, 0, 0, 0, 463, 461, 464,
+	144, 106, 465, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 466, 134, 114, 148, 130, 155, 459, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 26, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 58, 0, 0, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 165, 0, 0, 0, 0, 116,
-	0, 0, 127, 95, 94, 0, 0, 0, 86, 0,
-	121, 114, 139, 0, 115, 120, 103, 131, 117, 138,
-	166, 145, 129, 144, 76, 128, 137, 85, 122, 78,
-	135, 126, 107, 97, 98, 77, 0, 119, 89, 93,
-	88, 112, 132, 133, 87, 151, 81, 143, 80, 82,
-	142, 111, 130, 136, 108, 105, 79, 134, 106, 104,
-	99, 91, 0, 0, 0, 125, 140, 152, 0, 113,
-	146, 147, 148, 149, 110, 83, 96, 123, 90, 0,
-	0, 0, 0, 100, 0, 102, 0, 0, 124, 109,
-	0, 0, 0, 75, 0, 101, 150, 118, 92, 141,
-	0, 0, 0, 0, 0, 0, 0, 73, 0, 0,
-	633, 0, 0, 634, 0, 0, 84, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 84, 0, 112, 55, 131, 102, 0,
+	125, 138, 132, 157, 128, 104, 95, 137, 121, 100,
+	159, 0, 0, 0, 111, 0, 113, 0, 0, 141,
+	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 0,
+	0, 0, 77, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 165, 0, 0, 0, 0, 116, 0, 0, 127,
-	95, 94, 0, 0, 0, 86, 0, 121, 114, 139,
-	0, 115, 120, 103, 131, 117, 138, 166, 145, 129,
-	144, 76, 128, 137, 85, 122, 78, 135, 126, 107,
-	97, 98, 77, 0, 119, 89, 93, 88, 112, 132,
-	133, 87, 151, 81, 143, 80, 82, 142, 111, 130,
-	136, 108, 105, 79, 134, 106, 104, 99, 91, 0,
-	0, 0, 125, 140, 152, 0, 113, 146, 147, 148,
-	149, 110, 83, 96, 123, 90, 0, 550, 0, 0,
-	100, 0, 102, 0, 0, 124, 109, 0, 0, 0,
-	75, 0, 101, 150, 118, 92, 141, 0, 0, 0,
-	0, 0, 0, 0, 73, 0, 549, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	79, 0, 76, 0, 0, 0, 80, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 78, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 637, 0, 0, 0, 0, 100,
+	0, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 205, 0,
+	639, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 165, 0,
-	0, 0, 0, 116, 0, 0, 127, 95, 94, 0,
-	0, 0, 86, 0, 121, 114, 139, 0, 115, 120,
-	103, 131, 117, 138, 166, 145, 129, 144, 76, 128,
-	137, 85, 122, 78, 135, 126, 107, 97, 98, 77,
-	0, 119, 89, 93, 88, 112, 132, 133, 87, 151,
-	81, 143, 80, 82, 142, 111, 130, 136, 108, 105,
-	79, 134, 106, 104, 99, 91, 0, 0, 0, 125,
-	140, 152, 0, 0, 146, 147, 148, 149, 110, 83,
-	96, 123, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 75, 0, 101,
-	150, 118, 92, 141, 113, 0, 0, 0, 536, 0,
-	0, 0, 0, 90, 0, 0, 0, 0, 100, 0,
-	102, 0, 0, 124, 109, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 163, 0, 538, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 26, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 58, 0, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 165, 0, 0, 0,
-	0, 116, 0, 0, 127, 95, 94, 0, 0, 0,
-	86, 0, 121, 114, 139, 0, 534, 120, 103, 131,
-	117, 138, 166, 145, 129, 144, 76, 128, 137, 85,
-	122, 78, 135, 126, 107, 97, 98, 77, 0, 119,
-	89, 93, 88, 112, 132, 133, 87, 151, 81, 143,
-	80, 82, 142, 111, 130, 136, 108, 105, 79, 134,
-	106, 104, 99, 91, 0, 0, 0, 125, 140, 152,
-	0, 113, 146, 147, 148, 149, 110, 83, 96, 123,
-	90, 0, 0, 0, 0, 100, 0, 102, 0, 0,
-	124, 109, 0, 0, 0, 75, 0, 101, 150, 118,
-	92, 141, 0, 0, 0, 0, 50, 0, 0, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	0, 769, 0, 0, 770, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 165, 0, 0, 0, 0, 116, 0,
-	0, 127, 95, 94, 0, 0, 0, 86, 0, 121,
-	114, 139, 0, 115, 120, 103, 131, 117, 138, 166,
-	145, 129, 144, 76, 128, 137, 85, 122, 78, 135,
-	126, 107, 97, 98, 77, 0, 119, 89, 93, 88,
-	112, 132, 133, 87, 151, 81, 143, 80, 82, 142,
-	111, 130, 136, 108, 105, 79, 134, 106, 104, 99,
-	91, 0, 0, 0, 125, 140, 152, 0, 113, 146,
-	147, 148, 149, 110, 83, 96, 123, 90, 0, 0,
-	0, 0, 100, 0, 102, 0, 0, 124, 109, 0,
-	0, 0, 75, 0, 101, 150, 118, 92, 141, 0,
-	0, 0, 0, 0, 0, 0, 163, 0, 538, 0,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 651, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	650, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	165, 0, 0, 0, 0, 116, 0, 0, 127, 95,
-	94, 0, 0, 0, 86, 0, 121, 114, 139, 0,
-	115, 120, 103, 131, 117, 138, 166, 145, 129, 144,
-	76, 128, 137, 85, 122, 78, 135, 126, 107, 97,
-	98, 77, 0, 119, 89, 93, 88, 112, 132, 133,
-	87, 151, 81, 143, 80, 82, 142, 111, 130, 136,
-	108, 105, 79, 134, 106, 104, 99, 91, 0, 0,
-	0, 125, 140, 152, 0, 113, 146, 147, 148, 149,
-	110, 83, 96, 123, 90, 0, 0, 0, 0, 100,
-	0, 102, 0, 0, 124, 109, 0, 0, 0, 75,
-	0, 101, 150, 118, 92, 141, 0, 0, 0, 0,
-	0, 0, 0, 73, 0, 444, 0, 0, 0, 0,
-	0, 0, 84, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 165, 0, 0,
-	0, 0, 116, 0, 0, 127, 95, 94, 0, 0,
-	0, 86, 0, 121, 114, 139, 0, 115, 120, 103,
-	131, 117, 138, 166, 145, 129, 144, 76, 128, 137,
-	85, 122, 78, 135, 126, 107, 97, 98, 77, 0,
-	119, 89, 93, 88, 112, 132, 133, 87, 151, 81,
-	143, 80, 82, 142, 111, 130, 136, 108, 105, 79,
-	134, 106, 104, 99, 91, 0, 0, 0, 125, 140,
-	152, 0, 0, 146, 147, 148, 149, 110, 83, 96,
-	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 113, 75, 0, 101, 150,
-	118, 92, 141, 514, 90, 0, 0, 0, 0, 100,
-	0, 102, 0, 0, 124, 109, 0, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 637, 0, 0, 0, 0, 100,
+	0, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 205, 0,
+	639, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 163, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 165, 0, 0,
-	0, 0, 116, 0, 0, 127, 95, 94, 0, 0,
-	0, 86, 0, 121, 114, 139, 0, 115, 120, 103,
-	131, 117, 138, 166, 145, 129, 144, 76, 128, 137,
-	85, 122, 78, 135, 126, 107, 97, 98, 77, 0,
-	119, 89, 93, 88, 112, 132, 133, 87, 151, 81,
-	143, 80, 82, 142, 111, 130, 136, 108, 105, 79,
-	134, 106, 104, 99, 91, 276, 0, 0, 125, 140,
-	152, 0, 113, 146, 147, 148, 149, 110, 83, 96,
-	123, 90, 0, 0, 0, 0, 100, 0, 102, 0,
-	0, 124, 109, 0, 0, 0, 75, 0, 101, 150,
-	118, 92, 141, 0, 0, 0, 0, 0, 0, 0,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 84,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 635, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 58, 0, 0, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 165, 0, 0, 0, 0, 116,
-	0, 0, 127, 95, 94, 0, 0, 0, 86, 0,
-	121, 114, 139, 0, 115, 120, 103, 131, 117, 138,
-	166, 145, 129, 144, 76, 128, 137, 85, 122, 78,
-	135, 126, 107, 97, 98, 77, 0, 119, 89, 93,
-	88, 112, 132, 133, 87, 151, 81, 143, 80, 82,
-	142, 111, 130, 136, 108, 105, 79, 134, 106, 104,
-	99, 91, 0, 0, 0, 125, 140, 152, 0, 113,
-	146, 147, 148, 149, 110, 83, 96, 123, 90, 0,
-	0, 0, 0, 100, 0, 102, 0, 0, 124, 109,
-	0, 0, 0, 75, 0, 101, 150, 118, 92, 141,
-	0, 0, 0, 0, 0, 0, 0, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 205, 0,
+	639, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
-	0, 165, 0, 0, 0, 0, 116, 0, 0, 127,
-	95, 94, 0, 0, 0, 86, 0, 121, 114, 139,
-	0, 115, 120, 103, 131, 117, 138, 166, 145, 129,
-	144, 76, 128, 137, 85, 122, 78, 135, 126, 107,
-	97, 98, 77, 0, 119, 89, 93, 88, 112, 132,
-	133, 87, 151, 81, 143, 80, 82, 142, 111, 130,
-	136, 108, 105, 79, 134, 106, 104, 99, 91, 0,
-	0, 0, 125, 140, 152, 0, 113, 146, 147, 148,
-	149, 110, 83, 96, 123, 90, 0, 0, 0, 0,
-	100, 0, 102, 0, 0, 124, 109, 0, 0, 0,
-	75, 0, 101, 150, 118, 92, 141, 0, 0, 0,
-	0, 0, 0, 0, 73, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	544, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 165, 0,
-	0, 0, 0, 116, 0, 0, 127, 95, 94, 0,
-	0, 0, 86, 0, 121, 114, 139, 0, 115, 120,
-	103, 131, 117, 138, 166, 145, 129, 144, 76, 128,
-	137, 85, 122, 78, 135, 126, 107, 97, 98, 77,
-	0, 119, 89, 93, 88, 112, 132, 133, 87, 151,
-	81, 143, 80, 82, 142, 111, 130, 136, 108, 105,
-	79, 134, 106, 104, 99, 91, 0, 0, 0, 125,
-	140, 152, 0, 113, 146, 147, 148, 149, 110, 83,
-	96, 123, 90, 0, 0, 0, 0, 100, 0, 102,
-	0, 0, 124, 109, 0, 0, 0, 75, 0, 101,
-	150, 118, 92, 141, 0, 0, 0, 0, 0, 0,
-	0, 215, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 615, 100,
+	0, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 165, 0, 0, 0, 0,
-	116, 0, 0, 127, 95, 94, 0, 0, 0, 86,
-	0, 121, 114, 139, 0, 115, 120, 103, 131, 117,
-	138, 166, 145, 129, 144, 76, 128, 137, 85, 122,
-	78, 135, 126, 107, 97, 98, 77, 0, 119, 89,
-	93, 88, 112, 132, 133, 87, 151, 81, 143, 80,
-	82, 142, 111, 130, 136, 108, 105, 79, 134, 106,
-	104, 99, 91, 0, 0, 0, 125, 140, 152, 0,
-	113, 146, 147, 148, 149, 110, 83, 96, 123, 90,
-	0, 0, 0, 0, 100, 0, 102, 0, 0, 124,
-	109, 0, 0, 0, 75, 0, 101, 150, 118, 92,
-	141, 0, 0, 0, 0, 0, 0, 0, 163, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 0, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 0, 0, 84, 111, 112, 113, 131, 102, 141,
+	120, 138, 132, 157, 128, 104, 95, 137, 121, 0,
+	159, 0, 0, 0, 0, 0, 0, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 165, 0, 0, 0, 0, 116, 0, 0,
-	127, 95, 94, 0, 0, 0, 86, 0, 121, 114,
-	139, 0, 115, 120, 103, 131, 117, 138, 166, 145,
-	129, 144, 76, 128, 137, 85, 122, 78, 135, 126,
-	107, 97, 98, 77, 0, 119, 89, 93, 88, 112,
-	132, 133, 87, 151, 81, 143, 80, 82, 142, 111,
-	130, 136, 108, 105, 79, 134, 106, 104, 99, 91,
-	0, 0, 0, 125, 140, 152, 0, 0, 146, 147,
-	148, 149, 110, 83, 96, 123, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 75, 0, 101, 150, 118, 92, 141,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 207, 0, 0, 0, 0, 129, 476, 0,
+	144, 106, 105, 0, 0, 0, 96, 0, 135, 126,
+	156, 0, 127, 134, 114, 148, 130, 155, 208, 163,
+	146, 162, 85, 145, 154, 94, 136, 139, 0, 87,
+	152, 143, 118, 108, 109, 86, 0, 133, 99, 103,
+	98, 124, 149, 150, 97, 168, 90, 161, 89, 91,
+	160, 123, 147, 153, 119, 116, 88, 151, 117, 115,
+	110, 101, 0, 0, 0, 142, 158, 169, 0, 0,
+	164, 165, 166, 167, 122, 92, 107, 140, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 84, 0, 112, 0, 131, 102, 0,
+	0, 138, 132, 157, 128, 104, 95, 137, 121, 325,
+	159, 0, 0, 0, 0, 0, 125, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 0, 0, 0, 0,
+	111, 0, 113, 0, 0, 141, 120, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
+	0, 0, 0, 129, 0, 0, 144, 106, 105, 0,
+	0, 0, 96, 0, 135, 126, 156, 0, 127, 134,
+	114, 148, 130, 155, 208, 163, 146, 162, 85, 145,
+	154, 94, 136, 139, 0, 87, 152, 143, 118, 108,
+	109, 86, 0, 133, 99, 103, 98, 124, 149, 150,
+	97, 168, 90, 161, 89, 91, 160, 123, 147, 153,
+	119, 116, 88, 151, 117, 115, 110, 101, 0, 0,
+	0, 142, 158, 169, 0, 0, 164, 165, 166, 167,
+	122, 92, 107, 140, 0, 0, 125, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 0, 0, 0, 84,
+	111, 112, 113, 131, 102, 141, 120, 138, 132, 157,
+	128, 104, 95, 137, 121, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
+	0, 0, 0, 129, 0, 0, 144, 106, 105, 0,
+	0, 0, 96, 0, 135, 126, 156, 0, 127, 134,
+	114, 148, 130, 155, 208, 163, 146, 162, 85, 145,
+	154, 94, 136, 139, 0, 87, 152, 143, 118, 108,
+	109, 86, 0, 133, 99, 103, 98, 124, 149, 150,
+	97, 168, 90, 161, 89, 91, 160, 123, 147, 153,
+	119, 116, 88, 151, 117, 115, 110, 101, 0, 0,
+	0, 142, 158, 169, 0, 0, 164, 165, 166, 167,
+	122, 92, 107, 140, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
+	0, 112, 0, 131, 102, 240, 125, 138, 132, 157,
+	128, 104, 95, 137, 121, 100, 159, 0, 0, 0,
+	111, 0, 113, 0, 0, 141, 120, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 202, 0, 207, 0,
+	0, 0, 0, 129, 0, 0, 144, 106, 105, 0,
+	0, 0, 96, 0, 135, 126, 156, 0, 127, 134,
+	114, 148, 130, 155, 208, 163, 146, 162, 85, 145,
+	154, 94, 136, 139, 0, 87, 152, 143, 118, 108,
+	109, 86, 0, 133, 99, 103, 98, 124, 149, 150,
+	97, 168, 90, 161, 89, 91, 160, 123, 147, 153,
+	119, 116, 88, 151, 117, 115, 110, 101, 0, 0,
+	0, 142, 158, 169, 0, 0, 164, 165, 166, 167,
+	122, 92, 107, 140, 0, 0, 125, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 0, 0, 0, 84,
+	111, 112, 113, 131, 102, 141, 120, 138, 132, 157,
+	128, 104, 95, 137, 121, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
+	0, 0, 0, 129, 0, 0, 144, 106, 105, 0,
+	0, 0, 96, 0, 135, 126, 156, 0, 127, 134,
+	114, 148, 130, 155, 208, 163, 146, 162, 85, 145,
+	154, 94, 136, 139, 0, 87, 152, 143, 118, 108,
+	109, 86, 0, 133, 99, 103, 98, 124, 149, 150,
+	97, 168, 90, 161, 89, 91, 160, 123, 147, 153,
+	119, 116, 88, 151, 117, 115, 110, 101, 0, 0,
+	0, 142, 158, 169, 0, 0, 164, 165, 166, 167,
+	122, 92, 107, 140, 0, 0, 125, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 0, 0, 0, 84,
+	111, 112, 113, 131, 102, 141, 120, 138, 132, 157,
+	128, 104, 95, 137, 121, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 264, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
+	0, 0, 0, 129, 0, 0, 144, 106, 105, 0,
+	0, 0, 96, 0, 135, 126, 156, 0, 127, 134,
+	114, 148, 130, 155, 208, 163, 146, 162, 85, 145,
+	154, 94, 136, 139, 0, 87, 152, 143, 118, 108,
+	109, 86, 0, 133, 99, 103, 98, 124, 149, 150,
+	97, 168, 90, 161, 89, 91, 160, 123, 147, 153,
+	119, 116, 88, 151, 117, 115, 110, 101, 0, 0,
+	0, 142, 158, 169, 0, 0, 164, 165, 166, 167,
+	122, 92, 107, 140, 0, 0, 125, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 0, 0, 0, 84,
+	111, 112, 113, 131, 102, 141, 120, 138, 132, 157,
+	128, 104, 95, 137, 121, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
+	0, 0, 0, 129, 0, 0, 144, 106, 105, 0,
+	0, 0, 96, 0, 135, 126, 156, 0, 127, 134,
+	114, 148, 130, 155, 208, 163, 146, 162, 85, 145,
+	154, 94, 136, 139, 0, 87, 152, 143, 118, 108,
+	109, 86, 0, 133, 99, 103, 98, 124, 149, 150,
+	97, 168, 90, 161, 89, 91, 160, 123, 147, 153,
+	119, 116, 88, 151, 117, 115, 110, 101, 0, 0,
+	0, 142, 158, 169, 0, 0, 164, 165, 166, 167,
+	122, 92, 107, 140, 0, 0, 125, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 0, 0, 0, 84,
+	111, 112, 113, 131, 102, 141, 120, 138, 132, 157,
+	128, 104, 95, 137, 121, 0, 159, 0, 0, 0,
+	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
+	0, 0, 0, 93, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 207, 0,
+	0, 0, 0, 129, 0, 0, 144, 106, 105, 0,
+	0, 0, 96, 0, 135, 126, 156, 0, 127, 134,
+	114, 148, 130, 155, 208, 163, 146, 162, 85, 145,
+	154, 94, 136, 513, 0, 87, 152, 143, 118, 108,
+	109, 86, 0, 133, 99, 103, 98, 124, 149, 150,
+	97, 168, 90, 161, 89, 91, 160, 123, 147, 153,
+	119, 116, 88, 151, 117, 115, 110, 101, 0, 0,
+	0, 142, 158, 169, 0, 0, 164, 165, 166, 167,
+	122, 92, 107, 140, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
+	0, 112, 0, 131, 102, 0, 0, 138, 132, 157,
+	128, 104, 95, 137, 121, 0, 159,
 }
 var yyPact = [...]int{
 
-	109, -1000, -167, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1997, -1000, -166, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 807, 826, -1000, 515, -1000, -1000,
+	-1000, -1000, -1000, 618, 7362, 111, 49, 73, 59, 9888,
+	72, 227, 10458, -1000, -2, -1000, 61, 10078, -7, -1000,
+	-1000, -1000, -1000, -1000, 515, 9678, -1000, -1000, -1000, -1000,
+	-1000, 795, 800, 626, 787, 691, -1000, -1000, 5724, 48,
+	8502, 9488, 4836, -1000, 363, 69, 10458, -93, 10078, 42,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 750, 772, -1000, -1000, -1000, -1000, -1000, -1000, 583,
-	5798, 10, 61, 17, 8051, 48, 307, 8582, -1000, -46,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 569, -1000, -1000,
-	-1000, -1000, -1000, 744, 748, 602, 736, 669, -1000, 4605,
-	9, 7143, 7874, 3981, -1000, 432, 57, 8582, -128, 8228,
-	0, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	570, -1000, 1155, 6962, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 144, 19, 104, 9262, 2730, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 10458, 62, -1000, 10458, 39,
+	453, 39, 10458, -1000, 97, -1000, -1000, -1000, -1000, 10458,
+	444, 734, 74, 3198, 3198, 3198, 3198, 3, 3198, 3198,
+	641, -1000, -1000, -1000, -1000, 3198, -1000, -1000, -1000, -1000,
+	10648, -1000, 10078, -1000, -1000, -1000, -1000, -1000, 428, 563,
+	10458, -1000, 622, 743, 5943, 5943, 807, -1000, 515, -1000,
+	-1000, -1000, 731, -1000, -1000, 229, 815, -1000, 6582, 96,
+	-1000, 5943, 1416, 575, -1000, -1000, 575, -1000, -1000, 87,
+	-1000, -1000, 6363, 6363, 6363, 6363, 6363, 6363, 6363, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 36, 8582, -1000, 8582, -9, 425, -9,
-	8582, -1000, 105, -1000, -1000, -1000, -1000, 8582, 422, 701,
-	47, 2434, 2434, 2434, 2434, -41, 2434, 2434, 607, -1000,
-	-1000, -1000, -1000, 2434, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 329, 710, 5024, 5024, 750, -1000, 569,
-	-1000, -1000, -1000, 691, -1000, -1000, 210, 760, -1000, 1642,
-	104, -1000, 5024, 1891, 556, -1000, -1000, 556, -1000, -1000,
-	95, -1000, -1000, 5422, 5422, 5422, 5422, 5422, 5422, 5422,
+	-1000, -1000, -1000, 575, -1000, 5067, 575, 575, 575, 575,
+	575, 575, 5943, 575, 575, 575, 575, 575, 575, 575,
+	575, 575, 575, 575, 575, 575, 9072, 547, 697, -1000,
+	-1000, -1000, 781, 7152, 8312, 10458, 530, -1000, 555, 4368,
+	-1000, -1000, -1000, 154, 8122, -1000, -1000, -1000, 733, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 556, -1000, 4816, 556, 556, 556,
-	556, 556, 556, 5024, 556, 556, 556, 556, 556, 556,
-	556, 556, 556, 556, 556, 556, 556, 7697, 558, 676,
-	-1000, -1000, -1000, 733, 6404, 6966, 8582, 473, -1000, 489,
-	3539, -1000, -1000, -1000, 187, 6758, -1000, -1000, -1000, 700,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -1509,186 +1761,201 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 521, -1000, 6505, 434, 3198, 64, 587,
+	10458, 185, 118, -1000, 10078, 349, 341, -1000, -1000, -1000,
+	-1000, 601, 764, 181, 429, 161, 161, -1000, -1000, 10078,
+	-1000, 10078, 10078, 763, -1000, 760, 10078, 10458, 10078, -1000,
+	-1000, -1000, 10078, 349, 363, 363, 10078, -1000, 2964, -1000,
+	-1000, -1000, 3198, 10458, 60, 10458, 775, 640, 10458, -1000,
+	4602, -1000, 3198, 3198, 3198, 3198, 3198, 3198, 3198, 3198,
+	-1000, -1000, -1000, -1000, -1000, -1000, 3198, 3198, -1000, -1000,
+	10458, -1000, -1000, 10078, -1000, -1000, -1000, 10458, 563, 575,
+	10078, -1000, 821, 124, 520, 95, 561, -1000, 266, 795,
+	428, 691, 7932, 660, -1000, -1000, 10458, -1000, 5943, 5943,
+	346, -1000, 8882, -1000, -1000, 3666, 128, 6363, 340, 165,
+	6363, 6363, 6363, 6363, 6363, 6363, 6363, 6363, 6363, 6363,
+	6363, 6363, 6363, 6363, 6363, 294, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 417, -1000, 88, 667, 667, 108,
+	108, 108, 108, 108, 108, 2177, 5286, 428, 428, 518,
+	270, 5067, 5724, 5724, 5943, 5943, 5724, 784, 166, 270,
+	10078, -1000, 428, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	5724, 5724, 5724, 5724, 18, 10458, -1000, 10268, 8502, 8502,
+	8502, 8502, 8502, -1000, 675, 671, -1000, 659, 658, 674,
+	10458, -1000, 489, 7152, 114, 575, -1000, 8692, -1000, -1000,
+	18, 8502, 10458, -1000, -1000, 4368, 555, 5943, 107, -1000,
+	-1000, -1000, -1000, 2964, 194, 234, -63, -1000, -1000, 580,
+	-1000, 580, 580, 580, 580, -38, -38, -38, -38, -1000,
+	-1000, -1000, -1000, -1000, 598, -1000, 580, 580, 580, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 596, 596, 596,
+	582, 582, 595, -1000, 10458, -123, 403, -1000, 774, -1000,
+	-1000, 282, 6772, 584, -1000, 10078, 349, -1000, 10078, -1000,
+	395, -1000, -1000, 386, 365, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 52, 770, -1000, 349, 349, 363, -1000, -1000,
+	-1000, 10458, -1000, -1000, 10458, 3198, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	440, -1000, 1748, 419, 2434, 18, 559, 8582, 198, 8582,
-	2434, 19, 8582, 731, 603, 8582, -1000, 3760, -1000, 2434,
-	2434, 2434, 2434, 2434, 2434, 2434, 2434, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2434, 2434, -1000, -1000, 8582, -1000, -1000,
-	-1000, -1000, 765, 129, 368, 103, 535, -1000, 340, 744,
-	329, 669, 6581, 617, -1000, -1000, 8582, -1000, 5024, 5024,
-	223, -1000, 7497, -1000, -1000, 2876, 140, 5422, 326, 284,
-	5422, 5422, 5422, 5422, 5422, 5422, 5422, 5422, 5422, 5422,
-	5422, 5422, 5422, 5422, 5422, 298, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 396, -1000, 569, 580, 580, 121,
-	121, 121, 121, 121, 121, 5621, 4189, 329, 436, 263,
-	4816, 4605, 4605, 5024, 5024, 4605, 737, 172, 263, 8228,
-	-1000, 329, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 4605,
-	4605, 4605, 4605, -27, 8582, -1000, 8405, 7143, 7143, 7143,
-	7143, 7143, -1000, 650, 641, -1000, 637, 619, 644, 8582,
-	-1000, 394, 6404, 72, 556, -1000, 7320, -1000, -1000, -27,
-	7143, 8582, -1000, -1000, 3539, 489, 5024, 106, -1000, -1000,
-	-1000, -1000, 2213, 131, 212, -102, -1000, -1000, 565, -1000,
-	565, 565, 565, 565, -77, -77, -77, -77, -1000, -1000,
-	-1000, -1000, -1000, 582, -1000, 565, 565, 565, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 579, 579, 579, 566,
-	566, 581, -1000, 8582, -149, 356, -1000, 725, 68, -1000,
-	8582, -1000, -1000, 8582, 2434, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 449, -1000, -1000, 714, 5943, 5943, 4134,
+	5943, -1000, -1000, -1000, 743, -1000, 784, 798, -1000, 723,
+	721, 5724, -1000, -1000, 128, 233, -1000, -1000, 275, -1000,
+	-1000, -1000, -1000, 93, 575, -1000, 1361, -1000, -1000, -1000,
+	-1000, 340, 6363, 6363, 6363, 1289, 1361, 1304, 431, 1476,
+	108, 347, 347, 106, 106, 106, 106, 106, 338, 338,
+	-1000, -1000, -1000, 428, -1000, -1000, -1000, 428, 5724, 554,
+	-1000, -1000, -1000, 5943, -1000, 428, 439, 439, 401, 198,
+	439, 5724, 171, -1000, 5943, 428, -1000, 439, 428, 439,
+	439, 536, 575, -1000, 586, -1000, 149, -1000, 92, 697,
+	594, 635, 986, -1000, -1000, -1000, -1000, 670, -1000, 636,
+	-1000, -1000, -1000, -1000, -1000, 68, 67, 66, 10078, -1000,
+	813, 573, -1000, -1000, 270, -1000, 361, 551, 2496, -1000,
+	-1000, -1000, 741, -1000, 219, -66, -1000, -1000, 292, -38,
+	-38, -1000, -1000, 107, 727, 107, 107, 107, 333, -1000,
+	-1000, -1000, -1000, 276, -1000, -1000, -1000, 273, -1000, 634,
+	10078, 3198, -1000, 3900, -1000, -1000, -1000, -1000, 10078, -1000,
+	-1000, 441, -1000, 580, -1000, -1000, -1000, 10078, 575, -1000,
+	-1000, 349, -1000, 3198, -1000, 777, 10078, 709, 270, 270,
+	91, -1000, -1000, 10458, -1000, -1000, -1000, -1000, 534, -1000,
+	-1000, -1000, 3432, 5724, -1000, 1289, 1361, 786, -1000, 6363,
+	6363, -1000, -148, 439, 5724, 270, -1000, -1000, -1000, 286,
+	294, 286, -116, 532, 159, -1000, 5943, 228, -1000, -1000,
+	-1000, -1000, -1000, 633, 10268, 575, -1000, 7742, 10078, 807,
+	10268, 5943, 5943, 4134, -1000, -1000, 5943, 583, -1000, 5943,
+	-1000, -1000, -1000, 575, 575, 575, 412, -1000, 807, -1000,
+	-1000, 2964, -1000, 2964, 627, 78, -1000, -1000, -1000, 457,
+	107, 107, -1000, 188, -1000, -1000, -1000, 427, -1000, 548,
+	423, 10458, -1000, -1000, 524, -1000, 141, 421, 595, 10078,
+	-1000, -1000, 16, -1000, -1000, 575, -1000, -1000, 3900, -1000,
+	813, 8502, -1000, -1000, 428, -1000, 6363, 1361, 1361, -1000,
+	575, -148, -1000, 428, 580, 580, -1000, 580, 582, -1000,
+	580, -18, 580, -19, 428, 428, 575, -98, -1000, 270,
+	5943, -1000, 744, 463, 465, -1000, -1000, 5505, 428, 415,
+	86, 412, 795, -1000, 270, 270, -1000, 270, 10078, 270,
+	10078, 10078, 10078, 7552, 10078, 795, 2496, -1000, -56, 820,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	674, 5024, 5024, 3318, 5024, -1000, -1000, -1000, 710, -1000,
-	737, 749, -1000, 694, 692, 4605, -1000, -1000, 140, 167,
-	-1000, -1000, 256, -1000, -1000, -1000, -1000, 86, 556, -1000,
-	1368, -1000, -1000, -1000, -1000, 326, 5422, 5422, 5422, 1233,
-	1368, 4389, 209, 1854, 121, 243, 243, 156, 156, 156,
-	156, 156, 102, 102, -1000, -1000, -1000, 329, -1000, -1000,
-	-1000, 329, 4605, 484, -1000, -1000, 5024, -1000, 329, 392,
-	392, 305, 242, 392, 4605, 207, -1000, 5024, 329, -1000,
-	392, 329, 392, 392, 483, 556, -1000, 594, -1000, 169,
-	-1000, 84, 676, 575, 600, 629, -1000, -1000, -1000, -1000,
-	634, -1000, 620, -1000, -1000, -1000, -1000, -1000, 52, 50,
-	44, 8228, -1000, 758, 590, -1000, -1000, 263, -1000, 343,
-	480, 1992, -1000, -1000, -1000, -1000, -1000, -1000, 571, 717,
-	132, 339, -1000, -1000, 704, -1000, 229, -106, -1000, -1000,
-	294, -77, -77, -1000, -1000, 106, 698, 106, 106, 106,
-	322, -1000, -1000, -1000, -1000, 290, -1000, -1000, -1000, 279,
-	-1000, 599, 8228, 2434, -1000, 3097, -1000, -1000, -1000, -1000,
-	666, 241, 168, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -28, -1000, 2434, -1000, 672, 263,
-	263, 80, -1000, -1000, 8582, -1000, -1000, -1000, -1000, 567,
-	-1000, -1000, -1000, 2655, 4605, -1000, 1233, 1368, 1495, -1000,
-	5422, 5422, -1000, -1000, 392, 4605, 263, -1000, -1000, -1000,
-	160, 298, 160, -144, 517, 157, -1000, 5024, 201, -1000,
-	-1000, -1000, -1000, -1000, 598, 8405, 556, -1000, 6205, 8228,
-	750, 8405, 5024, 5024, 3318, -1000, -1000, 5024, 570, -1000,
-	5024, -1000, -1000, -1000, 556, 556, 556, 374, -1000, 750,
-	-1000, -1000, 2213, -1000, 2213, 8228, -1000, 338, -1000, -1000,
-	592, 35, -1000, -1000, -1000, 442, 106, 106, -1000, 114,
-	-1000, -1000, -1000, 390, -1000, 475, 386, 8582, -1000, -1000,
-	472, -1000, 148, -1000, -1000, 8228, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 8228, 8582, -1000,
-	-1000, -1000, -1000, -1000, 8228, -1000, -1000, 3097, -1000, 758,
-	7143, -1000, -1000, 329, -1000, 5422, 1368, 1368, -1000, -1000,
-	329, 565, 565, -1000, 565, 566, -1000, 565, -56, 565,
-	-61, 329, 329, 556, -133, -1000, 263, 5024, -1000, 719,
-	457, 469, -1000, -1000, 4397, 329, 380, 77, 374, 744,
-	-1000, 263, 263, -1000, 263, 8228, 263, 8228, 8228, 8228,
-	6006, 8228, 744, 1992, -1000, 369, -1000, 565, -1000, -98,
-	764, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -77, 320, 272, -1000, 268, 2434, 3097, 2213, -1000,
-	560, -1000, -1000, -1000, -1000, 721, 754, 470, -1000, 1368,
-	-1000, -1000, 40, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 5422, 329, 319, 263, 716, -1000, 556, -1000, -1000,
-	564, 8228, 8228, -1000, -1000, 366, -1000, 362, 362, 362,
-	72, -1000, -1000, 581, 8228, -1000, 92, -1000, -118, 106,
-	-1000, 437, 375, -1000, -1000, -1000, 8228, 556, 752, 746,
-	-1000, -1000, 51, -1000, -1000, 763, -1000, 556, -1000, 569,
-	71, -1000, 8228, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	233, 707, -1000, 706, -1000, -1000, -1000, -1000, 354, -29,
-	-1000, 5024, 5024, 329, 33, -154, 8405, 469, 329, 8228,
-	-1000, -1000, 304, -1000, -1000, 559, 328, -1000, 8228, 263,
-	458, -1000, 659, -147, -158, 453, -1000, -1000, -1000, -149,
-	-1000, -29, 682, -1000, 655, -1000, -1000, -1000, -32, -151,
-	-34, -156, 556, -159, 5223, -1000, 1346, 329, -1000, -1000,
+	-38, 318, 257, -1000, 248, 3198, 3900, 2964, 587, -1000,
+	-1000, 409, -1000, 10078, -1000, 801, 516, -148, 1361, 17,
+	-1000, -1000, -1000, 90, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 6363, 428, 314, 270, 758, -1000, 575, -1000,
+	-1000, 577, 10078, 10078, -1000, -1000, 407, 375, 375, 375,
+	114, -1000, -1000, 147, -1000, -79, 107, -1000, 450, 432,
+	-1000, -1000, -1000, -123, -1000, 16, 720, 809, 799, -1000,
+	807, 797, -1000, -1000, 268, -1000, -1000, 819, -1000, 575,
+	-1000, 515, 84, -1000, -1000, -1000, -1000, -1000, -1000, 222,
+	756, -1000, 745, -1000, -1000, -1000, -1000, -1000, -1000, 13,
+	-1000, 5943, 5943, -145, 5943, 428, 75, -127, 10268, 465,
+	428, 10078, -1000, 271, -1000, -1000, 10, 270, 348, 428,
+	58, -1000, -1000, 348, -1000, 705, -121, -133, 342, -1000,
+	-1000, -1000, 575, -1000, -1000, 30, -150, -161, -157, -1000,
+	681, -1000, 6153, 195, -1000, -1000, -1000, -1000, -1000, -124,
+	1000, 428, 30, -130, -1000, -1000, -1000, -140, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 987, 44, 442, 983, 981, 978, 976, 973, 972,
-	971, 970, 969, 968, 966, 965, 964, 963, 961, 960,
-	957, 956, 955, 954, 100, 953, 952, 950, 72, 949,
-	55, 946, 945, 23, 80, 57, 41, 432, 944, 26,
-	89, 60, 943, 34, 942, 940, 939, 937, 52, 936,
-	934, 1147, 933, 932, 17, 18, 931, 930, 925, 924,
-	61, 5, 922, 921, 920, 918, 917, 914, 51, 3,
-	4, 36, 11, 913, 218, 14, 907, 47, 905, 901,
-	899, 897, 39, 896, 43, 895, 20, 40, 894, 8,
-	56, 21, 13, 1, 54, 892, 25, 46, 891, 336,
-	890, 105, 889, 888, 880, 878, 875, 33, 0, 625,
-	634, 53, 869, 15, 864, 1037, 82, 50, 10, 862,
-	32, 1091, 37, 861, 856, 30, 852, 851, 849, 848,
-	847, 845, 22, 844, 843, 841, 48, 12, 839, 838,
-	42, 29, 833, 831, 830, 829, 49, 828, 28, 827,
-	826, 825, 19, 27, 823, 9, 814, 813, 2, 808,
-	16, 805, 6, 804, 7, 24, 801, 789, 299, 164,
-	788, 787, 58,
+	0, 1107, 7, 311, 1106, 1089, 836, 1087, 67, 61,
+	17, 1085, 1084, 1083, 4, 1082, 1078, 1077, 1074, 1071,
+	1070, 1069, 1067, 1062, 1061, 1059, 1056, 1051, 1050, 1049,
+	1048, 1035, 1025, 1024, 1023, 1020, 81, 1017, 1015, 1011,
+	52, 1010, 68, 1009, 1008, 32, 207, 60, 34, 154,
+	1006, 28, 79, 53, 998, 37, 990, 984, 982, 981,
+	57, 980, 976, 1451, 975, 974, 10, 22, 972, 971,
+	970, 969, 2, 104, 967, 966, 964, 963, 962, 959,
+	42, 3, 5, 18, 12, 958, 983, 11, 956, 39,
+	955, 953, 947, 946, 13, 945, 45, 943, 20, 46,
+	942, 38, 49, 27, 19, 1, 65, 928, 30, 51,
+	920, 328, 918, 128, 917, 70, 916, 914, 25, 0,
+	827, 710, 63, 911, 29, 909, 1484, 58, 50, 23,
+	908, 55, 282, 36, 907, 903, 33, 902, 901, 895,
+	876, 873, 871, 44, 870, 869, 868, 14, 54, 867,
+	866, 47, 21, 865, 864, 863, 861, 59, 48, 66,
+	860, 859, 858, 24, 31, 856, 26, 855, 854, 9,
+	853, 16, 852, 6, 850, 15, 64, 848, 847, 41,
+	846, 843, 625, 603, 838, 833, 78,
 }
 var yyR1 = [...]int{
 
-	0, 166, 167, 167, 1, 1, 1, 1, 1, 1,
+	0, 180, 181, 181, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 6, 3, 4, 4, 5,
-	5, 7, 7, 27, 27, 8, 9, 9, 170, 170,
-	46, 46, 90, 90, 10, 10, 123, 123, 11, 11,
-	11, 11, 11, 164, 164, 163, 162, 162, 161, 161,
-	160, 16, 149, 150, 150, 150, 146, 126, 126, 126,
-	129, 129, 127, 127, 127, 127, 127, 127, 127, 128,
-	128, 128, 128, 128, 130, 130, 130, 130, 130, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 145, 145, 132, 132, 140, 140, 141,
-	141, 141, 138, 138, 139, 139, 142, 142, 142, 133,
-	133, 133, 133, 133, 133, 135, 135, 143, 143, 136,
-	136, 136, 137, 137, 144, 144, 144, 144, 144, 134,
-	134, 147, 154, 154, 154, 154, 148, 148, 156, 156,
-	155, 151, 151, 151, 152, 152, 152, 153, 153, 153,
-	12, 12, 12, 12, 12, 12, 12, 12, 12, 165,
-	165, 165, 165, 165, 165, 165, 165, 165, 165, 159,
-	157, 157, 158, 158, 13, 14, 14, 14, 15, 15,
-	17, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-	18, 18, 18, 18, 124, 124, 124, 19, 19, 21,
-	21, 22, 23, 20, 20, 20, 20, 20, 171, 24,
-	25, 25, 26, 26, 26, 30, 30, 30, 28, 28,
-	29, 29, 35, 35, 34, 34, 36, 36, 36, 36,
-	112, 112, 112, 111, 111, 38, 38, 39, 39, 40,
-	40, 41, 41, 41, 53, 53, 89, 89, 91, 91,
-	42, 42, 42, 42, 43, 43, 44, 44, 45, 45,
-	119, 119, 118, 118, 118, 117, 117, 47, 47, 47,
-	49, 48, 48, 48, 48, 50, 50, 52, 52, 51,
-	51, 54, 54, 54, 54, 55, 55, 37, 37, 37,
-	37, 37, 37, 37, 100, 100, 57, 57, 56, 56,
-	56, 56, 56, 56, 56, 56, 56, 56, 67, 67,
-	67, 67, 67, 67, 58, 58, 58, 58, 58, 58,
-	58, 33, 33, 68, 68, 68, 74, 69, 69, 61,
-	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
-	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
-	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
-	65, 65, 65, 63, 63, 63, 63, 63, 63, 63,
-	63, 63, 64, 64, 64, 64, 64, 64, 64, 64,
-	172, 172, 66, 66, 66, 66, 31, 31, 31, 31,
-	31, 122, 122, 125, 125, 125, 125, 125, 125, 125,
-	125, 125, 125, 125, 125, 125, 78, 78, 32, 32,
-	76, 76, 77, 79, 79, 75, 75, 75, 60, 60,
-	60, 60, 60, 60, 60, 60, 62, 62, 62, 80,
-	80, 81, 81, 82, 82, 83, 83, 84, 85, 85,
-	85, 86, 86, 86, 86, 87, 87, 87, 59, 59,
-	59, 59, 59, 59, 88, 88, 88, 88, 92, 92,
-	70, 70, 72, 72, 71, 73, 93, 93, 96, 94,
-	94, 97, 97, 95, 95, 95, 114, 114, 114, 98,
-	98, 101, 101, 102, 102, 99, 99, 103, 103, 103,
-	103, 103, 103, 103, 103, 103, 103, 104, 104, 104,
-	105, 105, 106, 106, 106, 113, 113, 109, 109, 110,
-	110, 115, 115, 116, 116, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 168, 169, 120, 121, 121, 121,
+	1, 1, 1, 1, 1, 2, 2, 2, 6, 7,
+	7, 8, 8, 9, 9, 15, 3, 4, 4, 5,
+	5, 16, 16, 39, 39, 17, 18, 18, 184, 184,
+	58, 58, 102, 102, 19, 19, 134, 134, 20, 20,
+	20, 20, 20, 175, 175, 174, 173, 173, 172, 172,
+	171, 25, 160, 161, 161, 161, 157, 137, 137, 137,
+	140, 140, 138, 138, 138, 138, 138, 138, 138, 139,
+	139, 139, 139, 139, 141, 141, 141, 141, 141, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 156, 156, 143, 143, 151, 151, 152,
+	152, 152, 149, 149, 150, 150, 153, 153, 153, 144,
+	144, 144, 144, 144, 144, 146, 146, 154, 154, 147,
+	147, 147, 148, 148, 155, 155, 155, 155, 155, 145,
+	145, 158, 165, 165, 165, 165, 165, 165, 165, 165,
+	165, 159, 159, 167, 167, 166, 162, 162, 162, 163,
+	163, 163, 164, 164, 164, 21, 21, 21, 21, 21,
+	21, 21, 26, 177, 177, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 176, 176, 176, 179,
+	179, 179, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 115, 115, 115, 115, 170, 168, 168, 169,
+	169, 22, 23, 23, 23, 24, 24, 27, 28, 28,
+	28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
+	28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
+	28, 135, 135, 135, 29, 29, 31, 31, 32, 33,
+	33, 33, 34, 35, 30, 30, 30, 30, 30, 185,
+	36, 37, 37, 38, 38, 38, 42, 42, 42, 40,
+	40, 41, 41, 47, 47, 46, 46, 48, 48, 48,
+	48, 123, 123, 123, 122, 122, 50, 50, 51, 51,
+	52, 52, 53, 53, 53, 65, 65, 101, 101, 103,
+	103, 54, 54, 54, 54, 55, 55, 56, 56, 57,
+	57, 130, 130, 129, 129, 129, 128, 128, 59, 59,
+	59, 61, 60, 60, 60, 60, 62, 62, 64, 64,
+	63, 63, 66, 66, 66, 66, 67, 67, 49, 49,
+	49, 49, 49, 49, 49, 112, 112, 69, 69, 68,
+	68, 68, 68, 68, 68, 68, 68, 68, 68, 79,
+	79, 79, 79, 79, 79, 70, 70, 70, 70, 70,
+	70, 70, 45, 45, 80, 80, 80, 86, 86, 81,
+	81, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 73, 73, 73, 73, 73, 73, 73, 73,
+	73, 73, 77, 77, 77, 10, 10, 11, 11, 12,
+	12, 12, 13, 13, 14, 14, 14, 14, 14, 75,
+	75, 75, 75, 75, 75, 75, 75, 75, 76, 76,
+	76, 76, 76, 76, 76, 76, 186, 186, 78, 78,
+	78, 78, 43, 43, 43, 43, 43, 133, 133, 136,
+	136, 136, 136, 136, 136, 136, 136, 136, 136, 136,
+	136, 136, 90, 90, 44, 44, 88, 88, 89, 91,
+	91, 87, 87, 87, 72, 72, 72, 72, 72, 72,
+	72, 72, 74, 74, 74, 92, 92, 93, 93, 94,
+	94, 95, 95, 96, 97, 97, 97, 98, 98, 98,
+	98, 99, 99, 99, 71, 71, 71, 71, 71, 71,
+	100, 100, 100, 100, 104, 104, 82, 82, 84, 84,
+	83, 85, 105, 105, 108, 106, 106, 109, 109, 107,
+	107, 107, 125, 125, 125, 110, 110, 113, 113, 114,
+	114, 111, 111, 116, 116, 116, 117, 117, 117, 124,
+	124, 120, 120, 121, 121, 126, 126, 127, 127, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	182, 183, 131, 132, 132, 132,
 }
 var yyR2 = [...]int{
 
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 6, 7, 5, 10, 1, 3, 1,
+	1, 1, 1, 1, 1, 4, 6, 7, 2, 2,
+	3, 1, 3, 3, 6, 5, 10, 1, 3, 1,
 	3, 7, 8, 1, 1, 8, 8, 6, 1, 1,
 	1, 3, 0, 4, 3, 4, 1, 1, 2, 8,
 	4, 6, 5, 0, 2, 1, 0, 2, 1, 3,
@@ -1700,45 +1967,51 @@ var yyR2 = [...]int{
 	3, 5, 0, 1, 0, 1, 0, 1, 2, 0,
 	2, 2, 2, 2, 2, 0, 3, 0, 1, 0,
 	3, 3, 0, 2, 0, 2, 1, 2, 1, 0,
-	2, 5, 2, 3, 2, 2, 1, 1, 1, 3,
-	2, 0, 1, 3, 1, 2, 3, 1, 1, 1,
-	6, 7, 7, 12, 7, 7, 7, 4, 5, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 7,
-	1, 3, 8, 8, 5, 4, 6, 5, 3, 2,
-	3, 4, 4, 4, 4, 4, 4, 4, 4, 3,
-	3, 3, 3, 4, 3, 3, 4, 2, 4, 2,
-	2, 2, 2, 3, 0, 1, 1, 2, 1, 1,
-	2, 1, 1, 2, 2, 2, 2, 2, 0, 2,
-	0, 2, 1, 2, 2, 0, 1, 1, 0, 1,
-	0, 1, 0, 1, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 1, 0, 2, 1, 3, 1,
-	1, 1, 3, 3, 3, 7, 1, 3, 1, 3,
-	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
-	0, 1, 0, 1, 2, 1, 1, 1, 2, 2,
-	1, 2, 3, 2, 3, 2, 2, 2, 1, 1,
-	3, 0, 5, 5, 5, 0, 2, 1, 3, 3,
-	2, 3, 1, 2, 0, 3, 1, 1, 3, 3,
-	4, 4, 5, 3, 4, 5, 6, 2, 1, 2,
-	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 0, 2, 1, 1, 1, 3, 1, 3, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
-	4, 5, 6, 4, 4, 6, 6, 6, 9, 7,
-	5, 4, 2, 2, 2, 2, 2, 2, 2, 2,
-	0, 2, 4, 4, 4, 4, 0, 3, 4, 7,
-	3, 1, 1, 2, 3, 3, 1, 2, 2, 1,
-	2, 1, 2, 2, 1, 2, 0, 1, 0, 2,
-	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
-	3, 0, 2, 0, 3, 1, 3, 2, 0, 1,
-	1, 0, 2, 4, 4, 0, 2, 4, 2, 1,
-	3, 5, 4, 6, 1, 3, 3, 5, 0, 5,
-	1, 3, 1, 2, 3, 1, 1, 3, 3, 1,
-	3, 3, 3, 1, 2, 1, 1, 1, 1, 1,
-	1, 0, 2, 0, 3, 0, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
-	1, 1, 0, 1, 1, 0, 2, 1, 1, 1,
+	2, 5, 2, 3, 2, 2, 1, 2, 1, 3,
+	3, 1, 1, 1, 3, 2, 0, 1, 3, 1,
+	2, 3, 1, 1, 1, 2, 3, 5, 9, 4,
+	4, 2, 4, 1, 3, 3, 4, 2, 2, 3,
+	3, 3, 3, 4, 4, 5, 3, 5, 1, 0,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 3, 1, 1, 1, 1, 1, 2, 2,
+	2, 3, 2, 3, 3, 2, 7, 1, 3, 8,
+	8, 5, 4, 6, 5, 3, 2, 3, 4, 4,
+	4, 4, 4, 4, 4, 4, 3, 3, 3, 3,
+	4, 3, 3, 4, 2, 4, 2, 2, 2, 2,
+	3, 0, 1, 1, 2, 1, 1, 2, 1, 1,
+	3, 4, 2, 3, 2, 2, 2, 2, 2, 0,
+	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
+	1, 0, 1, 0, 1, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 1, 0, 2, 1, 3,
+	1, 1, 1, 3, 3, 3, 7, 1, 3, 1,
+	3, 4, 4, 4, 3, 2, 4, 0, 1, 0,
+	2, 0, 1, 0, 1, 2, 1, 1, 1, 2,
+	2, 1, 2, 3, 2, 3, 2, 2, 2, 1,
+	1, 3, 0, 5, 5, 5, 0, 2, 1, 3,
+	3, 2, 3, 1, 2, 0, 3, 1, 1, 3,
+	3, 4, 4, 5, 3, 4, 5, 6, 2, 1,
+	2, 1, 2, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 0, 2, 1, 1, 1, 3, 3, 1,
+	3, 1, 1, 1, 1, 1, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 2, 2, 2, 2, 2, 2, 3, 1, 1,
+	1, 1, 5, 6, 7, 0, 6, 0, 3, 0,
+	2, 5, 1, 1, 2, 2, 2, 2, 2, 4,
+	4, 6, 6, 6, 9, 7, 5, 4, 2, 2,
+	2, 2, 2, 2, 2, 2, 0, 2, 4, 4,
+	4, 4, 0, 3, 4, 7, 3, 1, 1, 2,
+	3, 3, 1, 2, 2, 1, 2, 1, 2, 2,
+	1, 2, 0, 1, 0, 2, 1, 2, 4, 0,
+	2, 1, 3, 5, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 0, 3, 0, 2, 0,
+	3, 1, 3, 2, 0, 1, 1, 0, 2, 4,
+	4, 0, 2, 4, 2, 1, 3, 5, 4, 6,
+	1, 3, 3, 5, 0, 5, 1, 3, 1, 2,
+	3, 1, 1, 3, 3, 1, 3, 3, 3, 1,
+	2, 1, 1, 1, 1, 1, 1, 0, 2, 0,
+	3, 0, 1, 0, 1, 1, 0, 1, 1, 0,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -1756,245 +2029,269 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
+	1, 1, 0, 0, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -166, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -17, -18, -19, -21, -22, -23,
-	-20, -3, -4, 6, 7, -27, 9, 10, 30, -16,
-	112, 113, 115, 114, 138, 116, 131, 49, 150, 151,
-	153, 154, 25, 132, 133, 136, 137, -168, 8, 213,
-	53, -167, 226, -82, 15, -26, 5, -24, -171, -24,
-	-24, -24, -24, -24, -149, 53, -106, 120, 70, 146,
-	118, 124, -109, 56, -108, 219, 150, 161, 155, 182,
-	174, 172, 175, 201, 65, 153, 134, 170, 166, 164,
-	27, 187, 224, 165, 130, 129, 202, 159, 160, 186,
-	32, 221, 34, 142, 185, 181, 184, 158, 180, 38,
-	200, 177, 167, 18, 137, 140, 125, 144, 223, 163,
-	141, 136, 154, 203, 37, 191, 157, 128, 151, 148,
-	178, 143, 168, 169, 183, 156, 179, 152, 145, 138,
-	192, 225, 176, 173, 149, 147, 196, 197, 198, 199,
-	222, 171, 193, -99, 120, 122, 118, 118, 119, 120,
-	118, -51, -115, 56, -108, 120, 146, 118, 106, 175,
-	112, 194, 119, 32, 144, -124, 118, 195, 147, 196,
-	197, 198, 199, 56, 203, 202, -115, 152, -120, -120,
-	-120, -120, -120, -2, -86, 17, 16, -5, -3, -168,
-	6, 20, 21, -30, 39, 40, -25, -36, 97, -37,
-	-115, -56, 72, -61, 29, 56, -108, 23, -60, -57,
-	-75, -73, -74, 106, 107, 95, 96, 103, 73, 108,
-	-65, -63, -64, -66, 58, 57, 66, 59, 60, 61,
-	62, 67, 68, 69, -109, -71, -168, 43, 44, 214,
-	215, 218, 216, 75, 33, 204, 212, 211, 210, 208,
-	209, 206, 207, 123, 205, 101, 213, -99, -39, -40,
-	-41, -42, -53, -74, -168, -51, 11, -46, -51, -94,
-	-123, -97, 203, 202, -110, -95, -109, -107, 201, 175,
-	200, 117, 71, 22, 24, 189, 74, 106, 16, 75,
-	105, 214, 112, 47, 206, 207, 204, 205, 194, 29,
-	10, 25, 132, 21, 99, 114, 78, 79, 135, 23,
-	133, 69, 19, 50, 11, 13, 14, 123, 122, 90,
-	119, 45, 8, 108, 26, 87, 41, 28, 43, 88,
-	17, 208, 209, 31, 218, 139, 101, 48, 35, 72,
-	67, 51, 70, 15, 46, 89, 115, 213, 44, 6,
-	217, 30, 131, 42, 118, 195, 77, 121, 68, 5,
-	124, 9, 49, 52, 210, 211, 212, 33, 76, 12,
-	-150, -146, 56, 119, -51, 213, -109, -102, 123, 118,
-	-51, -51, -101, 123, 56, -101, -51, 109, -51, 56,
-	30, 205, 56, 144, 118, 145, 120, -121, -168, -110,
-	-121, -121, -121, 148, 149, -121, -121, 51, -121, -169,
-	55, -87, 19, 31, -37, -115, -83, -84, -37, -82,
-	-2, -24, 35, -28, 21, 64, 11, -112, 71, 70,
-	87, -111, 22, -109, 58, 109, -37, -58, 90, 72,
+	-1000, -180, -1, -2, -6, -15, -16, -17, -18, -19,
+	-20, -21, -22, -23, -24, -27, -28, -29, -31, -32,
+	-33, -34, -35, -30, -3, -4, 6, -7, 7, -39,
+	9, 10, 30, -25, 112, -26, 113, 115, 114, 138,
+	116, 131, 49, 150, 151, 153, 154, 155, 156, 25,
+	132, 133, 136, 137, -182, 224, 8, 215, 53, -181,
+	239, -94, 15, -38, 5, -36, -185, -2, -36, -36,
+	-36, -36, -36, -160, 53, -117, 120, 70, 146, 118,
+	124, -120, 56, -119, 221, 150, 163, 157, 184, 176,
+	174, 177, 203, 65, 153, 234, 134, 172, 168, 166,
+	27, 189, 226, 167, 233, 130, 129, 204, 161, 162,
+	188, 32, 223, 34, 142, 187, 183, 186, 160, 182,
+	38, 236, 202, 179, 169, 18, 137, 140, 232, 125,
+	144, 225, 230, 165, 141, 136, 154, 235, 229, 155,
+	205, 37, 193, 159, 128, 151, 148, 180, 143, 170,
+	171, 185, 158, 181, 152, 145, 138, 231, 194, 238,
+	178, 175, 149, 147, 198, 199, 200, 201, 173, 195,
+	-177, -115, 117, 114, -170, -176, 113, 15, 216, 140,
+	238, 115, 141, 236, 237, -178, 56, 191, 177, 203,
+	105, 65, 29, 50, 31, 120, -111, 122, 118, 118,
+	119, 120, 118, -63, -126, 56, -119, 120, 146, 118,
+	106, 177, 112, 196, 119, 32, 144, -135, 118, 197,
+	147, 198, 199, 200, 201, 56, 205, 204, -126, 152,
+	121, -120, 155, -131, -131, -131, -131, -131, -2, -8,
+	227, -9, -126, -98, 17, 16, -5, -3, -182, 6,
+	20, 21, -42, 39, 40, -37, -48, 97, -49, -126,
+	-68, 72, -73, 29, 56, -119, 23, -72, -69, -87,
+	-85, -86, 106, 107, 95, 96, 103, 73, 108, -77,
+	-75, -76, -78, 58, 57, 66, 59, 60, 61, 62,
+	67, 68, 69, -120, -83, -182, 43, 44, 216, 217,
+	220, 218, 75, 33, 206, 214, 213, 212, 210, 211,
+	208, 209, 123, 207, 101, 215, -111, -51, -52, -53,
+	-54, -65, -86, -182, -63, 11, -58, -63, -106, -134,
+	-109, 205, 204, -121, -107, -120, -118, 203, 177, 202,
+	117, 71, 22, 24, 191, 74, 106, 16, 75, 105,
+	216, 112, 47, 208, 209, 206, 207, 196, 29, 10,
+	25, 132, 21, 99, 114, 78, 79, 135, 23, 133,
+	69, 19, 50, 11, 13, 14, 123, 122, 90, 119,
+	45, 8, 108, 26, 87, 41, 28, 43, 88, 17,
+	210, 211, 31, 220, 139, 101, 48, 35, 72, 67,
+	51, 70, 15, 46, 228, 227, 89, 156, 115, 215,
+	44, 6, 219, 30, 131, 42, 118, 197, 77, 121,
+	68, 5, 124, 9, 49, 52, 212, 213, 214, 33,
+	76, 12, 224, -161, -157, 56, 119, -63, 215, -120,
+	-114, 123, 54, -131, 146, -157, 126, -158, 127, 130,
+	140, -165, 125, 124, -159, 129, 128, 119, 28, 146,
+	-120, 126, -159, 125, 127, 130, 140, -116, -159, 126,
+	121, 22, 140, -157, 126, -120, 126, -164, 80, -121,
+	58, 59, -63, 118, -63, -113, 123, 56, -113, -63,
+	109, -63, 56, 30, 207, 56, 144, 118, 145, 120,
+	-132, -182, -121, -132, -132, -132, 148, 149, -132, -132,
+	51, -132, -120, 155, -120, -183, 55, 54, -8, 22,
+	53, -99, 19, 31, -49, -126, -95, -96, -49, -94,
+	-2, -36, 35, -40, 21, 64, 11, -123, 71, 70,
+	87, -122, 22, -120, 58, 109, -49, -70, 90, 72,
 	88, 89, 74, 92, 91, 102, 95, 96, 97, 98,
 	99, 100, 101, 93, 94, 105, 80, 81, 82, 83,
-	84, 85, 86, -100, -168, -74, -168, 110, 111, -61,
-	-61, -61, -61, -61, -61, -61, -168, -2, -69, -37,
-	-168, -168, -168, -168, -168, -168, -168, -78, -37, -168,
-	-172, -168, -172, -172, -172, -172, -172, -172, -172, -168,
-	-168, -168, -168, -52, 26, -51, 30, 54, -47, -49,
-	-48, -50, 41, 45, 47, 42, 43, 44, 48, -119,
-	22, -39, -168, -118, 140, -117, 22, -115, 58, -51,
-	-170, 54, 11, 52, 54, -94, 80, -114, -109, 58,
-	29, 30, 55, 54, -126, -129, -131, -130, -127, -128,
-	172, 173, 106, 176, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 134, 168, 169, 170, 171, 155,
-	156, 157, 158, 159, 160, 161, 163, 164, 165, 166,
-	167, 56, -121, 120, -164, 52, -51, 72, -51, -121,
-	121, -51, 23, 51, -51, -116, -115, -107, -121, -121,
-	-121, -121, -121, -121, -121, -121, -121, -121, -51, 9,
-	90, 54, 18, 109, 54, -85, 24, 25, -86, -169,
-	-30, -62, -109, 59, 62, -29, 42, -51, -37, -37,
-	-67, 67, 72, 68, 69, -111, 97, -116, -110, -107,
-	-61, -68, -71, -74, 63, 90, 88, 89, 74, -61,
-	-61, -61, -61, -61, -61, -61, -61, -61, -61, -61,
-	-61, -61, -61, -61, -122, 56, 58, 56, -60, -60,
-	-109, -35, 21, -34, -36, -169, 54, -169, -2, -34,
-	-34, -37, -37, -34, -28, -76, -77, 76, -109, -169,
-	-34, -35, -34, -34, -90, 140, -51, -93, -96, -75,
-	-109, -115, -40, -41, -41, -40, -41, 41, 41, 41,
-	46, 41, 46, 41, -48, -115, -169, -54, 49, 122,
-	50, -168, -117, -90, -39, -51, -97, -37, -137, 105,
-	-151, -152, -153, -110, 58, 59, -146, -147, -154, 125,
-	124, -148, 119, 28, -142, 67, 72, -138, 192, -132,
-	53, -132, -132, -132, -132, -136, 175, -136, -136, -136,
-	53, -132, -132, -132, -140, 53, -140, -140, -141, 53,
-	-141, -113, 52, -51, -162, 222, -163, 56, 23, -103,
-	117, 114, 115, -159, 113, 189, 175, 65, 29, 15,
-	214, 140, 225, 56, 141, -51, -51, -121, 37, -37,
-	-37, -116, -84, -87, -98, 19, 11, 33, 33, -34,
-	67, 68, 69, 109, -168, -68, -61, -61, -61, -33,
-	135, 71, -169, -169, -34, 54, -37, -169, -169, -169,
-	54, 52, 22, -169, -34, -79, -77, 78, -37, -169,
-	-169, -169, -169, -169, -59, 30, 33, -2, -168, -168,
-	-55, 54, 12, 80, 109, -44, -43, 51, 52, -45,
-	51, -43, 41, 41, 119, 119, 119, -91, -109, -55,
-	-55, 56, 54, -153, 80, 53, 28, -148, 56, 56,
-	-133, 29, 67, -139, 193, 59, -136, -136, -137, 30,
-	-137, -137, -137, -145, 58, 59, 59, 51, -109, -121,
-	-161, -160, -110, -120, -165, 146, 126, 127, 130, 129,
-	56, 119, 28, 125, 128, 140, -165, 146, -104, -105,
-	121, 22, 119, 28, 140, -121, 38, 109, -51, -38,
-	11, 97, -110, -35, -33, 71, -61, -61, -169, -36,
-	-125, 106, 172, 134, 170, 166, 186, 177, 191, 168,
-	192, -122, -125, 219, -82, 79, -37, 77, -92, 51,
-	-93, -70, -72, -71, -168, -2, -88, -109, -91, -82,
-	-96, -37, -37, -110, -37, 53, -37, -168, -168, -168,
-	-169, 54, -82, -152, -153, -156, -155, -109, 56, -135,
-	51, 58, 59, 60, 67, 204, 55, -137, -137, 56,
-	106, 55, 54, 54, 55, 54, -51, 54, 80, -120,
-	-109, -120, -109, -51, -120, -109, -55, -39, -169, -61,
-	-169, -132, -132, -132, -141, -132, 160, -132, 160, -169,
-	-169, -168, -32, 217, -37, 27, -92, 54, -169, -169,
-	-169, 54, 109, -169, -86, -89, -109, -89, -89, -89,
-	-118, -109, -86, 55, 54, -132, -143, 189, 9, -136,
-	58, 59, 59, -121, -160, -153, 53, 26, -80, 13,
-	-136, 56, -61, -169, 58, 28, -72, 33, -2, -168,
-	-109, -109, 54, 55, -169, -169, -169, -54, -113, -155,
-	-144, 125, 28, 124, 204, -137, 55, 55, -89, -168,
-	-81, 14, 16, -31, 90, 222, 9, -70, -2, 109,
-	-109, -134, 65, 28, 28, 55, -157, -158, 140, -37,
-	-69, -169, 220, 48, 223, -93, -169, -109, 58, -164,
-	-169, 54, -109, 38, 221, 224, -162, -158, 33, 38,
-	142, 222, 143, 223, -168, 224, -61, 139, -169, -169,
+	84, 85, 86, -112, -182, -86, -182, 110, 111, -73,
+	-73, -73, -73, -73, -73, -73, -182, -2, -6, -81,
+	-49, -182, -182, -182, -182, -182, -182, -182, -90, -49,
+	-182, -186, -182, -186, -186, -186, -186, -186, -186, -186,
+	-182, -182, -182, -182, -64, 26, -63, 30, 54, -59,
+	-61, -60, -62, 41, 45, 47, 42, 43, 44, 48,
+	-130, 22, -51, -182, -129, 140, -128, 22, -126, 58,
+	-63, -184, 54, 11, 52, 54, -106, 80, -125, -120,
+	58, 29, 30, 55, 54, -137, -140, -142, -141, -138,
+	-139, 174, 175, 106, 178, 180, 181, 182, 183, 184,
+	185, 186, 187, 188, 189, 134, 170, 171, 172, 173,
+	157, 158, 159, 160, 161, 162, 163, 165, 166, 167,
+	168, 169, 56, -132, 120, -175, 52, -63, 72, -115,
+	-176, 117, 114, -120, -179, 56, -157, -182, 53, 28,
+	-159, 56, 56, -159, -159, -120, -120, -120, 28, 28,
+	-120, -63, -120, -120, -179, -157, -157, -120, -164, -132,
+	-63, 121, -63, 23, 51, -63, -127, -126, -118, -132,
+	-132, -132, -132, -132, -132, -132, -132, -132, -132, -63,
+	-120, -9, -86, -101, -120, 9, 90, 54, 18, 109,
+	54, -97, 24, 25, -98, -183, -42, -74, -120, 59,
+	62, -41, 42, -63, -49, -49, -79, 67, 72, 68,
+	69, -122, 97, -127, -121, -118, -73, -80, -83, -86,
+	63, 90, 88, 89, 74, -73, -73, -73, -73, -73,
+	-73, -73, -73, -73, -73, -73, -73, -73, -73, -73,
+	-133, 56, 58, 56, -72, -72, -120, -47, 21, -46,
+	-48, -183, -183, 54, -183, -2, -46, -46, -49, -49,
+	-46, -40, -88, -89, 76, -120, -183, -46, -47, -46,
+	-46, -102, 140, -63, -105, -108, -87, -120, -126, -52,
+	-53, -53, -52, -53, 41, 41, 41, 46, 41, 46,
+	41, -60, -126, -183, -66, 49, 122, 50, -182, -128,
+	-102, -51, -63, -109, -49, -148, 105, -162, -163, -164,
+	-157, -158, -153, 67, 72, -149, 194, -143, 53, -143,
+	-143, -143, -143, -147, 177, -147, -147, -147, 53, -143,
+	-143, -143, -151, 53, -151, -151, -152, 53, -152, -124,
+	52, -63, -173, 224, -174, 56, 23, -131, 53, -120,
+	-179, -167, -166, -120, 56, 56, 56, 121, 26, -179,
+	-179, -157, -63, -63, -132, 55, 54, 37, -49, -49,
+	-127, -96, -99, -110, 19, 11, 33, 33, -46, 67,
+	68, 69, 109, -182, -80, -73, -73, -73, -45, 135,
+	71, -183, -183, -46, 54, -49, -183, -183, -183, 54,
+	52, 22, -183, -46, -91, -89, 78, -49, -183, -183,
+	-183, -183, -183, -71, 30, 33, -2, -182, -182, -67,
+	54, 12, 80, 109, -56, -55, 51, 52, -57, 51,
+	-55, 41, 41, 119, 119, 119, -103, -120, -67, -67,
+	56, 54, -164, 80, -144, 29, 67, -150, 195, 59,
+	-147, -147, -148, 30, -148, -148, -148, -156, 58, 59,
+	59, 51, -120, -132, -172, -171, -121, -101, 55, 54,
+	-143, -120, -182, -179, -132, 22, -120, 38, 109, -63,
+	-50, 11, 97, -121, -47, -45, 71, -73, -73, -10,
+	228, -183, -48, -136, 106, 174, 134, 172, 168, 188,
+	179, 193, 170, 194, -133, -136, 221, -94, 79, -49,
+	77, -104, 51, -105, -82, -84, -83, -182, -2, -100,
+	-120, -103, -94, -108, -49, -49, -121, -49, 53, -49,
+	-182, -182, -182, -183, 54, -94, -163, -164, -146, 51,
+	58, 59, 60, 67, 206, 55, -148, -148, 56, 106,
+	55, 54, 54, 55, 54, -63, 54, 80, 55, -124,
+	-166, -168, -169, 140, -86, -67, -51, -183, -73, -182,
+	-10, -183, -143, -143, -143, -152, -143, 162, -143, 162,
+	-183, -183, -182, -44, 219, -49, 27, -104, 54, -183,
+	-183, -183, 54, 109, -183, -98, -101, -101, -101, -101,
+	-129, -120, -98, -154, 191, 9, -147, 58, 59, 59,
+	-132, -171, -164, -175, -183, 54, -120, -92, 13, -10,
+	-11, 140, -147, 56, -73, -183, 58, 28, -84, 33,
+	-2, -182, -120, -120, 55, -183, -183, -183, -66, -155,
+	125, 28, 124, 206, -148, 55, 55, -173, -169, 33,
+	-93, 14, 16, -94, 16, -43, 90, 224, 9, -82,
+	-2, 109, -145, 65, 28, 28, 142, -49, -81, -12,
+	-13, 229, 230, -81, -183, 222, 48, 225, -105, -183,
+	-120, 58, 143, -183, -14, 74, 231, 234, -72, 38,
+	223, 226, -182, -14, 232, 233, 235, 232, 233, 38,
+	-73, 139, 71, 224, -183, -183, -14, 225, 226,
 }
 var yyDef = [...]int{
 
 	0, -2, 2, -2, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 453, 0, 228, 228, 228, 228, 228, 228, 0,
-	522, 505, 0, 0, 0, 0, 214, 218, 219, 0,
-	221, 222, 706, 706, 706, 706, 706, 0, 33, 34,
-	704, 1, 3, 461, 0, 0, 232, 235, 230, 0,
-	505, 0, 0, 0, 48, 0, 0, 694, 0, 695,
-	503, 523, 524, 527, 528, 624, 625, 626, 627, 628,
+	21, 22, 23, 24, 519, 0, 279, 0, 279, 279,
+	279, 279, 279, 0, 576, 0, 571, 0, 0, 0,
+	0, 261, 265, 266, 0, 268, 269, 0, 0, 772,
+	772, 772, 772, 772, 0, 0, 43, 44, 770, 1,
+	3, 527, 0, 0, 283, 286, 281, -2, 0, 571,
+	0, 0, 0, 58, 0, 0, 761, 0, 762, 569,
+	577, 578, 581, 582, 682, 683, 684, 685, 686, 687,
+	688, 689, 690, 691, 692, 693, 694, 695, 696, 697,
+	698, 699, 700, 701, 702, 703, 704, 705, 706, 707,
+	708, 709, 710, 711, 712, 713, 714, 715, 716, 717,
+	718, 719, 720, 721, 722, 723, 724, 725, 726, 727,
+	728, 729, 730, 731, 732, 733, 734, 735, 736, 737,
+	738, 739, 740, 741, 742, 743, 744, 745, 746, 747,
+	748, 749, 750, 751, 752, 753, 754, 755, 756, 757,
+	758, 759, 760, 763, 764, 765, 766, 767, 768, 769,
+	175, 772, 0, 0, 181, 183, 213, 214, 215, 216,
+	217, 573, 0, 0, 0, 198, 202, 203, 204, 205,
+	206, 207, 208, 209, 210, 0, 0, 572, 0, 567,
+	0, 567, 0, 236, 350, 585, 586, 761, 762, 0,
+	0, 0, 0, 773, 773, 773, 773, 0, 773, 773,
+	254, 256, 257, 258, 259, 773, 262, 263, 264, 267,
+	0, 272, 0, 274, 275, 276, 277, 278, 37, 29,
+	0, 31, 0, 531, 0, 0, 519, 39, 0, 279,
+	284, 285, 289, 287, 288, 280, 0, 297, 301, 0,
+	358, 0, 363, 365, -2, -2, 0, 401, 402, 403,
+	404, 405, 0, 0, 0, 0, 0, 0, 0, 428,
+	429, 430, 431, 504, 505, 506, 507, 508, 509, 510,
+	511, 367, 368, 501, 551, 0, 0, 0, 0, 0,
+	0, 0, 492, 0, 466, 466, 466, 466, 466, 466,
+	466, 466, 0, 0, 0, 0, 0, 0, 308, 310,
+	311, 312, 331, 0, 333, 0, 0, 50, 54, 0,
+	555, -2, -2, 0, 0, 583, 584, -2, 689, -2,
+	589, 590, 591, 592, 593, 594, 595, 596, 597, 598,
+	599, 600, 601, 602, 603, 604, 605, 606, 607, 608,
+	609, 610, 611, 612, 613, 614, 615, 616, 617, 618,
+	619, 620, 621, 622, 623, 624, 625, 626, 627, 628,
 	629, 630, 631, 632, 633, 634, 635, 636, 637, 638,
 	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
 	649, 650, 651, 652, 653, 654, 655, 656, 657, 658,
 	659, 660, 661, 662, 663, 664, 665, 666, 667, 668,
 	669, 670, 671, 672, 673, 674, 675, 676, 677, 678,
-	679, 680, 681, 682, 683, 684, 685, 686, 687, 688,
-	689, 690, 691, 692, 693, 696, 697, 698, 699, 700,
-	701, 702, 703, 0, 0, 506, 0, 501, 0, 501,
-	0, 189, 299, 531, 532, 694, 695, 0, 0, 0,
-	0, 707, 707, 707, 707, 0, 707, 707, 207, 209,
-	210, 211, 212, 707, 215, 216, 217, 220, 223, 224,
-	225, 226, 227, 27, 465, 0, 0, 453, 29, 0,
-	228, 233, 234, 238, 236, 237, 229, 0, 246, 250,
-	0, 307, 0, 312, 314, -2, -2, 0, 349, 350,
-	351, 352, 353, 0, 0, 0, 0, 0, 0, 0,
-	376, 377, 378, 379, 438, 439, 440, 441, 442, 443,
-	444, 445, 316, 317, 435, 485, 0, 0, 0, 0,
-	0, 0, 0, 426, 0, 400, 400, 400, 400, 400,
-	400, 400, 400, 0, 0, 0, 0, 0, 0, 257,
-	259, 260, 261, 280, 0, 282, 0, 0, 40, 44,
-	0, 489, -2, -2, 0, 0, 529, 530, -2, 631,
-	-2, 535, 536, 537, 538, 539, 540, 541, 542, 543,
-	544, 545, 546, 547, 548, 549, 550, 551, 552, 553,
-	554, 555, 556, 557, 558, 559, 560, 561, 562, 563,
-	564, 565, 566, 567, 568, 569, 570, 571, 572, 573,
-	574, 575, 576, 577, 578, 579, 580, 581, 582, 583,
-	584, 585, 586, 587, 588, 589, 590, 591, 592, 593,
-	594, 595, 596, 597, 598, 599, 600, 601, 602, 603,
-	604, 605, 606, 607, 608, 609, 610, 611, 612, 613,
-	614, 615, 616, 617, 618, 619, 620, 621, 622, 623,
-	0, 63, 0, 0, 707, 0, 53, 0, 0, 0,
-	707, 0, 0, 0, 0, 0, 188, 0, 190, 707,
-	707, 707, 707, 707, 707, 707, 707, 199, 708, 709,
-	200, 201, 202, 707, 707, 204, 205, 0, 213, 28,
-	705, 22, 0, 0, 462, 0, 454, 455, 458, 461,
-	27, 235, 0, 240, 239, 231, 0, 247, 0, 0,
-	0, 251, 0, 253, 254, 0, 310, 0, 0, 0,
+	679, 680, 681, 0, 73, 0, 0, 773, 0, 63,
+	0, 0, 0, 176, 0, 199, 0, 187, 218, 219,
+	220, 0, 0, 156, 158, 0, 0, 161, 162, 762,
+	188, 0, 0, 727, 222, 703, 725, 0, 0, 225,
+	574, 575, 0, 199, 0, 0, 0, 211, 0, 172,
+	173, 174, 773, 0, 0, 0, 0, 0, 0, 235,
+	0, 237, 773, 773, 773, 773, 773, 773, 773, 773,
+	246, 774, 775, 247, 248, 249, 773, 773, 251, 252,
+	0, 260, 270, 737, 273, 38, 771, 0, 30, 0,
+	0, 25, 0, 0, 528, 0, 520, 521, 524, 527,
+	37, 286, 0, 291, 290, 282, 0, 298, 0, 0,
+	0, 302, 0, 304, 305, 0, 361, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 334, 335, 336, 337,
-	338, 339, 340, 313, 0, 327, 0, 0, 0, 369,
-	370, 371, 372, 373, 374, 0, 242, 27, 0, 347,
-	0, 0, 0, 0, 0, 0, 238, 0, 427, 0,
-	392, 0, 393, 394, 395, 396, 397, 398, 399, 0,
-	242, 0, 0, 42, 0, 298, 0, 0, 0, 0,
-	0, 0, 287, 0, 0, 290, 0, 0, 0, 0,
-	281, 0, 0, 301, 664, 283, 0, 285, 286, 42,
-	0, 0, 38, 39, 0, 45, 0, 132, 496, 497,
-	498, 494, 151, 0, 116, 112, 68, 69, 105, 71,
-	105, 105, 105, 105, 129, 129, 129, 129, 97, 98,
-	99, 100, 101, 0, 84, 105, 105, 105, 88, 72,
-	73, 74, 75, 76, 77, 78, 107, 107, 107, 109,
-	109, 525, 50, 0, 56, 0, 61, 0, 0, 167,
-	0, 185, 502, 0, 707, 300, 533, 534, 191, 192,
-	193, 194, 195, 196, 197, 198, 203, 206, 208, 466,
-	0, 0, 0, 0, 0, 457, 459, 460, 465, 30,
-	238, 0, 446, 0, 0, 0, 241, 25, 308, 309,
-	311, 328, 0, 330, 332, 252, 248, 0, 436, -2,
-	318, 319, 343, 344, 345, 0, 0, 0, 0, 341,
-	323, 0, 354, 355, 356, 357, 358, 359, 360, 361,
-	362, 363, 364, 365, 368, 411, 412, 0, 366, 367,
-	375, 0, 0, 243, 244, 346, 0, 484, 27, 0,
-	0, 0, 0, 0, 0, 433, 430, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 297, 305, 486, 0,
-	435, 0, 258, 276, 278, 0, 273, 288, 289, 291,
-	0, 293, 0, 295, 296, 262, 263, 264, 0, 0,
-	0, 0, 284, 305, 305, 41, 490, 491, 492, 0,
-	62, 152, 154, 157, 158, 159, 64, 65, 0, 0,
-	0, 0, 146, 147, 119, 117, 0, 114, 113, 70,
-	0, 129, 129, 91, 92, 132, 0, 132, 132, 132,
-	0, 85, 86, 87, 79, 0, 80, 81, 82, 0,
-	83, 0, 0, 707, 52, 0, 54, 55, 504, 706,
-	0, 0, 517, 168, 507, 508, 509, 510, 511, 512,
-	513, 514, 515, 516, 0, 184, 707, 187, 0, 463,
-	464, 0, 456, 23, 0, 499, 500, 447, 448, 255,
-	329, 331, 333, 0, 242, 320, 341, 324, 0, 321,
-	0, 0, 315, 380, 0, 0, 348, -2, 383, 384,
-	0, 0, 0, 0, 453, 0, 431, 0, 0, 391,
-	402, 403, 404, 405, 478, 0, 0, -2, 0, 0,
-	453, 0, 0, 0, 0, 270, 277, 0, 0, 271,
-	0, 272, 292, 294, 0, 0, 0, 0, 268, 453,
-	37, 133, 0, 155, 0, 0, 142, 0, 144, 145,
-	125, 0, 118, 67, 115, 0, 132, 132, 93, 0,
-	94, 95, 96, 0, 103, 0, 0, 0, 526, 51,
-	57, 58, 0, 160, 706, 0, 169, 170, 171, 172,
-	173, 174, 175, 176, 177, 178, 706, 0, 0, 706,
-	518, 519, 520, 521, 0, 186, 467, 0, 24, 305,
-	0, 249, 437, 0, 322, 0, 342, 325, 381, 245,
-	0, 105, 105, 416, 105, 109, 419, 105, 421, 105,
-	424, 0, 0, 0, 428, 390, 434, 0, 31, 0,
-	478, 468, 480, 482, 0, 27, 0, 474, 0, 461,
-	487, 306, 488, 436, 274, 0, 279, 0, 0, 0,
-	282, 0, 461, 153, 156, 0, 148, 105, 143, 127,
-	0, 120, 121, 122, 123, 124, 106, 89, 90, 130,
-	131, 129, 0, 0, 110, 0, 707, 0, 0, 161,
-	0, 162, 164, 165, 166, 0, 449, 256, 382, 326,
-	385, 413, 129, 417, 418, 420, 422, 423, 425, 387,
-	386, 0, 0, 0, 432, 0, 32, 0, 483, -2,
-	0, 0, 0, 43, 35, 0, 266, 0, 0, 0,
-	301, 269, 36, 525, 0, 150, 134, 128, 0, 132,
-	104, 0, 0, 49, 59, 60, 0, 0, 451, 0,
-	414, 415, 406, 389, 429, 0, 481, 0, -2, 0,
-	476, 475, 0, 275, 302, 303, 304, 265, 141, 149,
-	139, 0, 136, 138, 126, 102, 108, 111, 0, 0,
-	26, 0, 0, 0, 0, 0, 0, 471, 27, 0,
-	267, 66, 0, 135, 137, 53, 0, 180, 0, 452,
-	450, 388, 0, 0, 0, 479, -2, 477, 140, 56,
-	179, 0, 0, 407, 0, 410, 163, 181, 0, 408,
-	0, 0, 0, 0, 0, 409, 0, 0, 182, 183,
+	0, 0, 0, 0, 0, 0, 385, 386, 387, 388,
+	389, 390, 391, 364, 0, 378, 0, 0, 0, 421,
+	422, 423, 424, 425, 426, 0, 293, 37, 0, 0,
+	399, 0, 0, 0, 0, 0, 0, 289, 0, 493,
+	0, 458, 0, 459, 460, 461, 462, 463, 464, 465,
+	0, 293, 0, 0, 52, 0, 349, 0, 0, 0,
+	0, 0, 0, 338, 0, 0, 341, 0, 0, 0,
+	0, 332, 0, 0, 352, 725, 334, 0, 336, 337,
+	52, 0, 0, 48, 49, 0, 55, 0, 142, 562,
+	563, 564, 560, 166, 0, 126, 122, 78, 79, 115,
+	81, 115, 115, 115, 115, 139, 139, 139, 139, 107,
+	108, 109, 110, 111, 0, 94, 115, 115, 115, 98,
+	82, 83, 84, 85, 86, 87, 88, 117, 117, 117,
+	119, 119, 579, 60, 0, 66, 0, 71, 0, 772,
+	184, 0, 0, 0, 185, 200, 199, 221, 0, 152,
+	155, 154, 157, 0, 0, 179, 189, 190, 191, 223,
+	224, 196, 0, 0, 192, 199, 199, 0, 212, 180,
+	182, 0, 232, 568, 0, 773, 351, 587, 588, 238,
+	239, 240, 241, 242, 243, 244, 245, 250, 253, 255,
+	271, 32, 33, 0, 317, 532, 0, 0, 0, 0,
+	0, 523, 525, 526, 531, 40, 289, 0, 512, 0,
+	0, 0, 292, 35, 359, 360, 362, 379, 0, 381,
+	383, 303, 299, 0, 502, -2, 369, 370, 394, 395,
+	396, 0, 0, 0, 0, 392, 374, 0, 406, 407,
+	408, 409, 410, 411, 412, 413, 414, 415, 416, 417,
+	420, 477, 478, 0, 418, 419, 427, 0, 0, 294,
+	295, 397, 398, 0, 550, 37, 0, 0, 0, 0,
+	0, 0, 499, 496, 0, 0, 467, 0, 0, 0,
+	0, 0, 0, 348, 356, 552, 0, 501, 0, 309,
+	327, 329, 0, 324, 339, 340, 342, 0, 344, 0,
+	346, 347, 313, 314, 315, 0, 0, 0, 0, 335,
+	356, 356, 51, 556, 557, 558, 0, 72, 167, 169,
+	74, 75, 129, 127, 0, 124, 123, 80, 0, 139,
+	139, 101, 102, 142, 0, 142, 142, 142, 0, 95,
+	96, 97, 89, 0, 90, 91, 92, 0, 93, 0,
+	0, 773, 62, 0, 64, 65, 570, 177, 0, 201,
+	186, 0, 163, 115, 153, 159, 160, 0, 0, 193,
+	194, 199, 231, 773, 234, 0, 0, 0, 529, 530,
+	0, 522, 26, 0, 565, 566, 513, 514, 306, 380,
+	382, 384, 0, 293, 371, 392, 375, 0, 372, 0,
+	0, 366, 435, 0, 0, 400, -2, 449, 450, 0,
+	0, 0, 0, 519, 0, 497, 0, 0, 457, 468,
+	469, 470, 471, 544, 0, 0, -2, 0, 0, 519,
+	0, 0, 0, 0, 321, 328, 0, 0, 322, 0,
+	323, 343, 345, 0, 0, 0, 0, 319, 519, 47,
+	143, 0, 170, 0, 135, 0, 128, 77, 125, 0,
+	142, 142, 103, 0, 104, 105, 106, 0, 113, 0,
+	0, 0, 580, 61, 67, 68, 0, 0, 579, 0,
+	165, 197, 0, 195, 233, 0, 318, 533, 0, 27,
+	356, 0, 300, 503, 0, 373, 0, 393, 376, 432,
+	0, 435, 296, 0, 115, 115, 482, 115, 119, 485,
+	115, 487, 115, 490, 0, 0, 0, 494, 456, 500,
+	0, 41, 0, 544, 534, 546, 548, 0, 37, 0,
+	540, 0, 527, 553, 357, 554, 502, 325, 0, 330,
+	0, 0, 0, 333, 0, 527, 168, 171, 137, 0,
+	130, 131, 132, 133, 134, 116, 99, 100, 140, 141,
+	139, 0, 0, 120, 0, 773, 0, 0, 63, 151,
+	164, 0, 227, 0, 34, 515, 307, 435, 377, 437,
+	433, 451, 479, 139, 483, 484, 486, 488, 489, 491,
+	453, 452, 0, 0, 0, 498, 0, 42, 0, 549,
+	-2, 0, 0, 0, 53, 45, 0, 0, 0, 0,
+	352, 320, 46, 144, 138, 0, 142, 114, 0, 0,
+	59, 69, 70, 66, 226, 0, 0, 517, 0, 434,
+	519, 0, 480, 481, 472, 455, 495, 0, 547, 0,
+	-2, 0, 542, 541, 326, 353, 354, 355, 316, 149,
+	0, 146, 148, 136, 112, 118, 121, 178, 228, 0,
+	36, 0, 0, 439, 0, 0, 0, 0, 0, 537,
+	37, 0, 76, 0, 145, 147, 0, 518, 516, 0,
+	0, 442, 443, 438, 454, 0, 0, 0, 545, -2,
+	543, 150, 0, 436, 440, 0, 0, 0, 0, 473,
+	0, 476, 0, 0, 444, 445, 446, 447, 448, 474,
+	0, 0, 0, 0, 229, 230, 441, 0, 475,
 }
 var yyTok1 = [...]int{
 
@@ -2003,7 +2300,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 73, 3, 3, 3, 100, 92, 3,
 	53, 55, 97, 95, 54, 96, 109, 98, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 226,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 239,
 	81, 80, 82, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -2034,7 +2331,8 @@ var yyTok2 = [...]int{
 	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
 	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
 	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 221, 222, 223, 224, 225,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
 }
 var yyTok3 = [...]int{
 	0,
@@ -2379,29 +2677,35 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:292
+		//line sql.y:316
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:297
+		//line sql.y:321
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:298
+		//line sql.y:322
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:302
+		//line sql.y:326
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 5:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:330
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 22:
+	case 25:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:325
+		//line sql.y:355
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -2409,57 +2713,105 @@ yydefault:
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
 		}
-	case 23:
+	case 26:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:333
+		//line sql.y:363
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 24:
+	case 27:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:337
+		//line sql.y:367
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 25:
+	case 28:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:374
+		{
+			switch sel := yyDollar[2].selStmt.(type) {
+			case *Select:
+				sel.With = yyDollar[1].with
+			case *Union:
+				sel.With = yyDollar[1].with
+			}
+			yyVAL.selStmt = yyDollar[2].selStmt
+		}
+	case 29:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:386
+		{
+			yyVAL.with = &With{CTEs: yyDollar[2].ctes}
+		}
+	case 30:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:390
+		{
+			yyVAL.with = &With{Recursive: true, CTEs: yyDollar[3].ctes}
+		}
+	case 31:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:396
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 32:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:400
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 33:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:406
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Subquery: yyDollar[3].subquery}
+		}
+	case 34:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line sql.y:410
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[3].columns, Subquery: yyDollar[6].subquery}
+		}
+	case 35:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:343
+		//line sql.y:416
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 26:
+	case 36:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line sql.y:350
+		//line sql.y:423
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
 		}
-	case 27:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:356
+		//line sql.y:429
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 28:
+	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:360
+		//line sql.y:433
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 29:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:366
+		//line sql.y:439
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 30:
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:370
+		//line sql.y:443
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 31:
+	case 41:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:377
+		//line sql.y:450
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -2471,9 +2823,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 32:
+	case 42:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:389
+		//line sql.y:462
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -2483,123 +2835,123 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 33:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:401
+		//line sql.y:474
 		{
 			yyVAL.str = InsertStr
 		}
-	case 34:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:405
+		//line sql.y:478
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 35:
+	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:411
+		//line sql.y:484
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 36:
+	case 46:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:417
+		//line sql.y:490
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 37:
+	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:421
+		//line sql.y:494
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 38:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:426
+		//line sql.y:499
 		{
 		}
-	case 39:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:427
+		//line sql.y:500
 		{
 		}
-	case 40:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:431
+		//line sql.y:504
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 41:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:435
+		//line sql.y:508
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 42:
+	case 52:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:440
+		//line sql.y:513
 		{
 			yyVAL.partitions = nil
 		}
-	case 43:
+	case 53:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:444
+		//line sql.y:517
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 44:
+	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:450
+		//line sql.y:523
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 45:
+	case 55:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:454
+		//line sql.y:527
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 46:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:460
+		//line sql.y:533
 		{
 			yyVAL.str = SessionStr
 		}
-	case 47:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:464
+		//line sql.y:537
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 48:
+	case 58:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:470
+		//line sql.y:543
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 49:
+	case 59:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:475
+		//line sql.y:548
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, NewName: yyDollar[7].tableName}
 		}
-	case 50:
+	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:480
+		//line sql.y:553
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName()}
 		}
-	case 51:
+	case 61:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:484
+		//line sql.y:557
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName()}
 		}
-	case 52:
+	case 62:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:488
+		//line sql.y:561
 		{
 			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
 				Name:   yyDollar[3].colIdent,
@@ -2607,92 +2959,92 @@ yydefault:
 				Params: yyDollar[5].vindexParams,
 			}}
 		}
-	case 53:
+	case 63:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:497
+		//line sql.y:570
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 54:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:501
+		//line sql.y:574
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 55:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:507
+		//line sql.y:580
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 56:
+	case 66:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:512
+		//line sql.y:585
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 57:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:517
+		//line sql.y:590
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 58:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:523
+		//line sql.y:596
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 59:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:528
+		//line sql.y:601
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 60:
+	case 70:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:534
+		//line sql.y:607
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 61:
+	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:540
+		//line sql.y:613
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 62:
+	case 72:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:547
+		//line sql.y:620
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 63:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:554
+		//line sql.y:627
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 64:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:559
+		//line sql.y:632
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 65:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:563
+		//line sql.y:636
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 66:
+	case 76:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:569
+		//line sql.y:642
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -2702,684 +3054,983 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].optVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 67:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:580
+		//line sql.y:653
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 70:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:590
+		//line sql.y:663
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].optVal
 		}
-	case 71:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:595
+		//line sql.y:668
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 72:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:601
+		//line sql.y:674
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 73:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:605
+		//line sql.y:678
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 74:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:609
+		//line sql.y:682
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 75:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:613
+		//line sql.y:686
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 76:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:617
+		//line sql.y:690
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 77:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:621
+		//line sql.y:694
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 78:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:625
+		//line sql.y:698
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 79:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:631
+		//line sql.y:704
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 80:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:637
+		//line sql.y:710
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 81:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:643
+		//line sql.y:716
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 82:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:649
+		//line sql.y:722
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 83:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:655
+		//line sql.y:728
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 84:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:663
+		//line sql.y:736
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 85:
+	case 95:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:667
+		//line sql.y:740
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 86:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:671
+		//line sql.y:744
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 87:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:675
+		//line sql.y:748
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 88:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:679
+		//line sql.y:752
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 89:
+	case 99:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:685
+		//line sql.y:758
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 90:
+	case 100:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:689
+		//line sql.y:762
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 91:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:693
+		//line sql.y:766
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 92:
+	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:697
+		//line sql.y:770
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 93:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:701
+		//line sql.y:774
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 94:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:705
+		//line sql.y:778
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 95:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:709
+		//line sql.y:782
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 96:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:713
+		//line sql.y:786
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 97:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:717
+		//line sql.y:790
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 98:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:721
+		//line sql.y:794
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 99:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:725
+		//line sql.y:798
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 100:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:729
+		//line sql.y:802
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:733
+		//line sql.y:806
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 112:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:737
+		//line sql.y:810
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 103:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:743
+		//line sql.y:816
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 104:
+	case 114:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:748
+		//line sql.y:821
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 105:
+	case 115:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:753
+		//line sql.y:826
 		{
 			yyVAL.optVal = nil
 		}
-	case 106:
+	case 116:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:757
+		//line sql.y:830
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 107:
+	case 117:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:762
+		//line sql.y:835
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 108:
+	case 118:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:766
+		//line sql.y:839
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 109:
+	case 119:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:774
+		//line sql.y:847
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 110:
+	case 120:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:778
+		//line sql.y:851
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 111:
+	case 121:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:784
+		//line sql.y:857
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 112:
+	case 122:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:792
+		//line sql.y:865
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 113:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:796
+		//line sql.y:869
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 114:
+	case 124:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:801
+		//line sql.y:874
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 115:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:805
+		//line sql.y:878
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 116:
+	case 126:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:811
+		//line sql.y:884
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 117:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:815
+		//line sql.y:888
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 118:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:819
+		//line sql.y:892
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 119:
+	case 129:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:824
+		//line sql.y:897
 		{
 			yyVAL.optVal = nil
 		}
-	case 120:
+	case 130:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:828
+		//line sql.y:901
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 121:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:832
+		//line sql.y:905
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 122:
+	case 132:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:836
+		//line sql.y:909
 		{
 			yyVAL.optVal = NewFloatVal(yyDollar[2].bytes)
 		}
-	case 123:
+	case 133:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:840
+		//line sql.y:913
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 124:
+	case 134:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:844
+		//line sql.y:917
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 125:
+	case 135:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:849
+		//line sql.y:922
 		{
 			yyVAL.optVal = nil
 		}
-	case 126:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:853
+		//line sql.y:926
 		{
 			yyVAL.optVal = NewValArg(yyDollar[3].bytes)
 		}
-	case 127:
+	case 137:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:858
+		//line sql.y:931
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 128:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:862
+		//line sql.y:935
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 129:
+	case 139:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:867
+		//line sql.y:940
 		{
 			yyVAL.str = ""
 		}
-	case 130:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:871
+		//line sql.y:944
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 131:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:875
+		//line sql.y:948
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 132:
+	case 142:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:880
+		//line sql.y:953
 		{
 			yyVAL.str = ""
 		}
-	case 133:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:884
+		//line sql.y:957
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 134:
+	case 144:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:889
+		//line sql.y:962
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 135:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:893
+		//line sql.y:966
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 136:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:897
+		//line sql.y:970
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 137:
+	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:901
+		//line sql.y:974
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 138:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:905
+		//line sql.y:978
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 139:
+	case 149:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:910
+		//line sql.y:983
 		{
 			yyVAL.optVal = nil
 		}
-	case 140:
+	case 150:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:914
+		//line sql.y:987
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 141:
+	case 151:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:920
+		//line sql.y:993
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Using: yyDollar[5].colIdent}
 		}
-	case 142:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:926
+		//line sql.y:999
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 143:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:930
+		//line sql.y:1003
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
 		}
-	case 144:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:934
+		//line sql.y:1007
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
 		}
-	case 145:
+	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:938
+		//line sql.y:1011
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Unique: true}
+		}
+	case 156:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1015
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Unique: true}
+		}
+	case 157:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1019
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false}
 		}
-	case 146:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:944
+		//line sql.y:1023
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Unique: false}
+		}
+	case 159:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1027
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: false}
+		}
+	case 160:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1031
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: false}
+		}
+	case 161:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1037
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 147:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:948
+		//line sql.y:1041
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 148:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:954
+		//line sql.y:1047
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 149:
+	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:958
+		//line sql.y:1051
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 150:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:964
+		//line sql.y:1057
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal}
 		}
-	case 151:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:969
+		//line sql.y:1062
 		{
 			yyVAL.str = ""
 		}
-	case 152:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:973
+		//line sql.y:1066
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 153:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:977
+		//line sql.y:1070
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 154:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:985
+		//line sql.y:1078
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 155:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:989
+		//line sql.y:1082
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 156:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:993
+		//line sql.y:1086
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 157:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:999
+		//line sql.y:1092
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 158:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1003
+		//line sql.y:1096
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 159:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1007
+		//line sql.y:1100
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 160:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1013
+	case 175:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1106
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			if len(yyDollar[2].alterSpecs) == 1 && yyDollar[2].alterSpecs[0].Action == RenameTableStr {
+				// Change this to a rename statement
+				yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[1].ddl.Table, NewName: yyDollar[2].alterSpecs[0].NewName}
+			} else {
+				// Don't modify $1: it's the partial DDL used if the rest fails to parse.
+				yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[1].ddl.Table, NewName: yyDollar[1].ddl.NewName, AlterSpecs: yyDollar[2].alterSpecs}
+			}
 		}
-	case 161:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1017
+	case 176:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1116
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 162:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1021
+	case 177:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:1120
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 163:
-		yyDollar = yyS[yypt-12 : yypt+1]
-		//line sql.y:1025
+	case 178:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		//line sql.y:1124
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
-				Table:  yyDollar[4].tableName,
+				Table:  yyDollar[1].ddl.Table,
 				VindexSpec: &VindexSpec{
-					Name:   yyDollar[7].colIdent,
-					Type:   yyDollar[11].colIdent,
-					Params: yyDollar[12].vindexParams,
+					Name:   yyDollar[4].colIdent,
+					Type:   yyDollar[8].colIdent,
+					Params: yyDollar[9].vindexParams,
 				},
-				VindexCols: yyDollar[9].columns,
+				VindexCols: yyDollar[6].columns,
 			}
 		}
-	case 164:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1038
+	case 179:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:1137
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
-				Table:  yyDollar[4].tableName,
+				Table:  yyDollar[1].ddl.Table,
 				VindexSpec: &VindexSpec{
-					Name: yyDollar[7].colIdent,
+					Name: yyDollar[4].colIdent,
 				},
 			}
 		}
-	case 165:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1048
+	case 180:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:1147
 		{
-			// Change this to a rename statement
-			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableName, NewName: yyDollar[7].tableName}
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
 		}
-	case 166:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1053
+	case 181:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1151
 		{
-			// Rename an index can just be an alter
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[1].ddl.Table, PartitionSpec: yyDollar[2].partSpec}
 		}
-	case 167:
+	case 182:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1058
+		//line sql.y:1159
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
+			yyVAL.ddl = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+			setDDL(yylex, yyVAL.ddl)
 		}
-	case 168:
+	case 183:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1166
+		{
+			yyVAL.alterSpecs = []*AlterSpec{yyDollar[1].alterSpec}
+		}
+	case 184:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1170
+		{
+			yyVAL.alterSpecs = append(yyDollar[1].alterSpecs, yyDollar[3].alterSpec)
+		}
+	case 185:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1176
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: AddColumnStr, Column: yyDollar[2].columnDefinition, Position: yyDollar[3].colPosition}
+		}
+	case 186:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:1180
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: AddColumnStr, Column: yyDollar[3].columnDefinition, Position: yyDollar[4].colPosition}
+		}
+	case 187:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1184
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: AddIndexStr, Index: yyDollar[2].indexDefinition}
+		}
+	case 188:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1188
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropColumnStr, ColumnName: yyDollar[2].colIdent}
+		}
+	case 189:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1192
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropColumnStr, ColumnName: yyDollar[3].colIdent}
+		}
+	case 190:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1196
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropIndexStr, IndexName: yyDollar[3].colIdent}
+		}
+	case 191:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1200
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: DropPrimaryKeyStr}
+		}
+	case 192:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1204
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: ModifyColumnStr, Column: yyDollar[2].columnDefinition, Position: yyDollar[3].colPosition}
+		}
+	case 193:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:1208
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: ModifyColumnStr, Column: yyDollar[3].columnDefinition, Position: yyDollar[4].colPosition}
+		}
+	case 194:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:1212
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: ChangeColumnStr, ColumnName: yyDollar[2].colIdent, Column: yyDollar[3].columnDefinition, Position: yyDollar[4].colPosition}
+		}
+	case 195:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1062
+		//line sql.y:1216
 		{
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
+			yyVAL.alterSpec = &AlterSpec{Action: ChangeColumnStr, ColumnName: yyDollar[3].colIdent, Column: yyDollar[4].columnDefinition, Position: yyDollar[5].colPosition}
 		}
-	case 179:
+	case 196:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1220
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: RenameTableStr, NewName: yyDollar[3].tableName}
+		}
+	case 197:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:1224
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: RenameIndexStr, IndexName: yyDollar[3].colIdent, NewIndexName: yyDollar[5].colIdent}
+		}
+	case 198:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1228
+		{
+			yyVAL.alterSpec = &AlterSpec{Action: TableOptionStr, Option: yyDollar[1].str}
+		}
+	case 199:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:1233
+		{
+			yyVAL.colPosition = nil
+		}
+	case 200:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1237
+		{
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "first" {
+				yylex.Error("expecting first or after")
+				return 1
+			}
+			yyVAL.colPosition = &ColumnPosition{First: true}
+		}
+	case 201:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1245
+		{
+			if NewColIdent(string(yyDollar[1].bytes)).Lowered() != "after" {
+				yylex.Error("expecting first or after")
+				return 1
+			}
+			yyVAL.colPosition = &ColumnPosition{After: yyDollar[2].colIdent}
+		}
+	case 202:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1256
+		{
+			if !alterTableOptions[NewColIdent(string(yyDollar[1].bytes)).Lowered()] {
+				yylex.Error("unknown table option")
+				return 1
+			}
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 203:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1264
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 204:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1268
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 205:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1272
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 206:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1276
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 207:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1280
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 208:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1284
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 209:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1288
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 210:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1292
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 211:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1296
+		{
+			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
+		}
+	case 212:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1300
+		{
+			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
+		}
+	case 213:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1308
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 214:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1310
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 215:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1312
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 216:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1314
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 217:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1316
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 218:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1318
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 219:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1320
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 220:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1322
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 221:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1324
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 222:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1326
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 223:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1328
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 224:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1330
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 225:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1332
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 226:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1080
+		//line sql.y:1336
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 180:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1086
+		//line sql.y:1342
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 181:
+	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1090
+		//line sql.y:1346
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 182:
+	case 229:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:1096
+		//line sql.y:1352
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 183:
+	case 230:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:1100
+		//line sql.y:1356
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 184:
+	case 231:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1106
+		//line sql.y:1362
 		{
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 185:
+	case 232:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1112
+		//line sql.y:1368
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -3387,16 +4038,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
 		}
-	case 186:
+	case 233:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1120
+		//line sql.y:1376
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 187:
+	case 234:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1125
+		//line sql.y:1381
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -3404,632 +4055,656 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
 		}
-	case 188:
+	case 235:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1135
+		//line sql.y:1391
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 189:
+	case 236:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1139
+		//line sql.y:1395
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 190:
+	case 237:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1144
+		//line sql.y:1400
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName, NewName: yyDollar[3].tableName}
 		}
-	case 191:
+	case 238:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1150
+		//line sql.y:1406
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 192:
+	case 239:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1154
+		//line sql.y:1410
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 193:
+	case 240:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1158
+		//line sql.y:1414
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 194:
+	case 241:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1163
+		//line sql.y:1419
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 195:
+	case 242:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1167
+		//line sql.y:1423
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 196:
+	case 243:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1171
+		//line sql.y:1427
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 197:
+	case 244:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1175
+		//line sql.y:1431
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 198:
+	case 245:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1179
+		//line sql.y:1435
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 199:
+	case 246:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1183
+		//line sql.y:1439
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 200:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1187
+		//line sql.y:1443
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 201:
+	case 248:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1191
+		//line sql.y:1447
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 202:
+	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1195
+		//line sql.y:1451
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 203:
+	case 250:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1199
+		//line sql.y:1455
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 204:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1203
+		//line sql.y:1459
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 205:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1207
+		//line sql.y:1463
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 206:
+	case 253:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1211
+		//line sql.y:1467
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 207:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1215
+		//line sql.y:1471
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 208:
+	case 255:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1219
+		//line sql.y:1475
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), OnTable: yyDollar[4].tableName}
 		}
-	case 209:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1223
+		//line sql.y:1479
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 210:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1227
+		//line sql.y:1483
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 211:
+	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1231
+		//line sql.y:1487
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 212:
+	case 259:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1235
+		//line sql.y:1491
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 213:
+	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1245
+		//line sql.y:1501
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 214:
+	case 261:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1251
+		//line sql.y:1507
 		{
 			yyVAL.str = ""
 		}
-	case 215:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1255
+		//line sql.y:1511
 		{
 			yyVAL.str = SessionStr
 		}
-	case 216:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1259
+		//line sql.y:1515
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 217:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1265
+		//line sql.y:1521
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 218:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1269
+		//line sql.y:1525
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 219:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1275
+		//line sql.y:1531
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 220:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1279
+		//line sql.y:1535
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 221:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1285
+		//line sql.y:1541
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 222:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1291
+		//line sql.y:1547
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 223:
+	case 270:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1551
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[3].colIdent}
+		}
+	case 271:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:1555
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[4].colIdent}
+		}
+	case 272:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:1561
+		{
+			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
+		}
+	case 273:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:1567
+		{
+			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
+		}
+	case 274:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1297
+		//line sql.y:1573
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 224:
+	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1301
+		//line sql.y:1577
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 225:
+	case 276:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1305
+		//line sql.y:1581
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 226:
+	case 277:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1309
+		//line sql.y:1585
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 227:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1313
+		//line sql.y:1589
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 228:
+	case 279:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1318
+		//line sql.y:1594
 		{
 			setAllowComments(yylex, true)
 		}
-	case 229:
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1322
+		//line sql.y:1598
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 230:
+	case 281:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1328
+		//line sql.y:1604
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 231:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1332
+		//line sql.y:1608
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 232:
+	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1338
+		//line sql.y:1614
 		{
 			yyVAL.str = UnionStr
 		}
-	case 233:
+	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1342
+		//line sql.y:1618
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 234:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1346
+		//line sql.y:1622
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 235:
+	case 286:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1351
+		//line sql.y:1627
 		{
 			yyVAL.str = ""
 		}
-	case 236:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1355
+		//line sql.y:1631
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 237:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1359
+		//line sql.y:1635
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 238:
+	case 289:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1364
+		//line sql.y:1640
 		{
 			yyVAL.str = ""
 		}
-	case 239:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1368
+		//line sql.y:1644
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 240:
+	case 291:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1373
+		//line sql.y:1649
 		{
 			yyVAL.str = ""
 		}
-	case 241:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1377
+		//line sql.y:1653
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 242:
+	case 293:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1382
+		//line sql.y:1658
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 243:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1386
+		//line sql.y:1662
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 244:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1392
+		//line sql.y:1668
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 245:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1396
+		//line sql.y:1672
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 246:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1402
+		//line sql.y:1678
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 247:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1406
+		//line sql.y:1682
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 248:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1410
+		//line sql.y:1686
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 249:
+	case 300:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1414
+		//line sql.y:1690
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 250:
+	case 301:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1419
+		//line sql.y:1695
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 251:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1423
+		//line sql.y:1699
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 252:
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1427
+		//line sql.y:1703
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 254:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1434
+		//line sql.y:1710
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 255:
+	case 306:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1439
+		//line sql.y:1715
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 256:
+	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1443
+		//line sql.y:1719
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 257:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1449
+		//line sql.y:1725
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 258:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1453
+		//line sql.y:1729
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 261:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1463
+		//line sql.y:1739
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 262:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1467
+		//line sql.y:1743
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 263:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1471
+		//line sql.y:1747
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 264:
+	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1477
+		//line sql.y:1753
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 265:
+	case 316:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1481
+		//line sql.y:1757
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 266:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1487
+		//line sql.y:1763
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 267:
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1491
+		//line sql.y:1767
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 268:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1497
+		//line sql.y:1773
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 269:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1501
+		//line sql.y:1777
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 270:
+	case 321:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1514
+		//line sql.y:1790
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 271:
+	case 322:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1518
+		//line sql.y:1794
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 272:
+	case 323:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1522
+		//line sql.y:1798
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 273:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1526
+		//line sql.y:1802
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 274:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1532
+		//line sql.y:1808
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 275:
+	case 326:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1534
+		//line sql.y:1810
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 276:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1538
+		//line sql.y:1814
 		{
 		}
-	case 277:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1540
+		//line sql.y:1816
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 278:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1544
+		//line sql.y:1820
 		{
 		}
-	case 279:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1546
+		//line sql.y:1822
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 280:
+	case 331:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1549
+		//line sql.y:1825
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 281:
+	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1551
+		//line sql.y:1827
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 282:
+	case 333:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1554
+		//line sql.y:1830
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 283:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1558
+		//line sql.y:1834
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 284:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1562
+		//line sql.y:1838
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 286:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1569
+		//line sql.y:1845
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 287:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1575
+		//line sql.y:1851
 		{
 			yyVAL.str = JoinStr
 		}
-	case 288:
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1579
+		//line sql.y:1855
 		{
 			yyVAL.str = JoinStr
 		}
-	case 289:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1583
+		//line sql.y:1859
 		{
 			yyVAL.str = JoinStr
 		}
-	case 290:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1589
+		//line sql.y:1865
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 291:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1595
+		//line sql.y:1871
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 292:
+	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1599
+		//line sql.y:1875
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 293:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1603
+		//line sql.y:1879
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 294:
+	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1607
+		//line sql.y:1883
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 295:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1613
+		//line sql.y:1889
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 296:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1617
+		//line sql.y:1893
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -4037,453 +4712,459 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 297:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1627
+		//line sql.y:1903
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 298:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1631
+		//line sql.y:1907
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 299:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1637
+		//line sql.y:1913
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 300:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1641
+		//line sql.y:1917
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 301:
+	case 352:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1646
+		//line sql.y:1922
 		{
 			yyVAL.indexHints = nil
 		}
-	case 302:
+	case 353:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1650
+		//line sql.y:1926
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 303:
+	case 354:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1654
+		//line sql.y:1930
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 304:
+	case 355:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1658
+		//line sql.y:1934
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 305:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1663
+		//line sql.y:1939
 		{
 			yyVAL.expr = nil
 		}
-	case 306:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1667
+		//line sql.y:1943
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 307:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1673
+		//line sql.y:1949
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 308:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1677
+		//line sql.y:1953
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 309:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1681
+		//line sql.y:1957
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 310:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1685
+		//line sql.y:1961
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 311:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1689
+		//line sql.y:1965
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 312:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1693
+		//line sql.y:1969
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 313:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1697
+		//line sql.y:1973
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 314:
+	case 365:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1703
+		//line sql.y:1979
 		{
 			yyVAL.str = ""
 		}
-	case 315:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1707
+		//line sql.y:1983
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 316:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1713
+		//line sql.y:1989
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 317:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1717
+		//line sql.y:1993
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 318:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1723
+		//line sql.y:1999
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 319:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1727
+		//line sql.y:2003
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 320:
+	case 371:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1731
+		//line sql.y:2007
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 321:
+	case 372:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1735
+		//line sql.y:2011
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 322:
+	case 373:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1739
+		//line sql.y:2015
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 323:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1743
+		//line sql.y:2019
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 324:
+	case 375:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1747
+		//line sql.y:2023
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 325:
+	case 376:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1751
+		//line sql.y:2027
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 326:
+	case 377:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1755
+		//line sql.y:2031
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 327:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1759
+		//line sql.y:2035
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 328:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1765
+		//line sql.y:2041
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 329:
+	case 380:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1769
+		//line sql.y:2045
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 330:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1773
+		//line sql.y:2049
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 331:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1777
+		//line sql.y:2053
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 332:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1781
+		//line sql.y:2057
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 333:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1785
+		//line sql.y:2061
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 334:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1791
+		//line sql.y:2067
 		{
 			yyVAL.str = EqualStr
 		}
-	case 335:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1795
+		//line sql.y:2071
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 336:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1799
+		//line sql.y:2075
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 337:
+	case 388:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1803
+		//line sql.y:2079
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 338:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1807
+		//line sql.y:2083
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 339:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1811
+		//line sql.y:2087
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 340:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1815
+		//line sql.y:2091
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 341:
+	case 392:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1820
+		//line sql.y:2096
 		{
 			yyVAL.expr = nil
 		}
-	case 342:
+	case 393:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1824
+		//line sql.y:2100
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 343:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1830
+		//line sql.y:2106
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 344:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1834
+		//line sql.y:2110
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 345:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1838
+		//line sql.y:2114
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 346:
+	case 397:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:2120
+		{
+			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
+		}
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1844
+		//line sql.y:2124
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 347:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1850
+		//line sql.y:2130
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 348:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1854
+		//line sql.y:2134
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 349:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1860
+		//line sql.y:2140
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 350:
+	case 402:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1864
+		//line sql.y:2144
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 351:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1868
+		//line sql.y:2148
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 352:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1872
+		//line sql.y:2152
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 353:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1876
+		//line sql.y:2156
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 354:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1880
+		//line sql.y:2160
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 355:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1884
+		//line sql.y:2164
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 356:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1888
+		//line sql.y:2168
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 357:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1892
+		//line sql.y:2172
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 358:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1896
+		//line sql.y:2176
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 359:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1900
+		//line sql.y:2180
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 360:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1904
+		//line sql.y:2184
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 361:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1908
+		//line sql.y:2188
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 362:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1912
+		//line sql.y:2192
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 363:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1916
+		//line sql.y:2196
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 364:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1920
+		//line sql.y:2200
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 365:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1924
+		//line sql.y:2204
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 366:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1928
+		//line sql.y:2208
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 367:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1932
+		//line sql.y:2212
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 368:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1936
+		//line sql.y:2216
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 369:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1940
+		//line sql.y:2220
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 370:
+	case 422:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1944
+		//line sql.y:2224
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 371:
+	case 423:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1948
+		//line sql.y:2228
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -4491,9 +5172,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 372:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1956
+		//line sql.y:2236
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -4507,21 +5188,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 373:
+	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1970
+		//line sql.y:2250
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 374:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1974
+		//line sql.y:2254
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 375:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1978
+		//line sql.y:2258
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -4529,395 +5210,479 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 380:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1996
+	case 432:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:2276
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
 		}
-	case 381:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2000
+	case 433:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line sql.y:2280
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs, Over: yyDollar[6].overClause}
 		}
-	case 382:
+	case 434:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		//line sql.y:2284
+		{
+			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs, Over: yyDollar[7].overClause}
+		}
+	case 435:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:2289
+		{
+			yyVAL.overClause = nil
+		}
+	case 436:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2004
+		//line sql.y:2293
 		{
-			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+			yyVAL.overClause = &OverClause{PartitionBy: yyDollar[3].exprs, OrderBy: yyDollar[4].orderBy, Frame: yyDollar[5].frameClause}
 		}
-	case 383:
+	case 437:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:2298
+		{
+			yyVAL.exprs = nil
+		}
+	case 438:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:2302
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 439:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:2307
+		{
+			yyVAL.frameClause = nil
+		}
+	case 440:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:2311
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[2].framePoint}
+		}
+	case 441:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		//line sql.y:2315
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
+		}
+	case 442:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:2321
+		{
+			yyVAL.str = RowsStr
+		}
+	case 443:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:2325
+		{
+			yyVAL.str = RangeStr
+		}
+	case 444:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:2331
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPrecedingStr}
+		}
+	case 445:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:2335
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowingStr}
+		}
+	case 446:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:2339
+		{
+			yyVAL.framePoint = &FramePoint{Type: CurrentRowStr}
+		}
+	case 447:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:2343
+		{
+			yyVAL.framePoint = &FramePoint{Type: PrecedingStr, Expr: yyDollar[1].expr}
+		}
+	case 448:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:2347
+		{
+			yyVAL.framePoint = &FramePoint{Type: FollowingStr, Expr: yyDollar[1].expr}
+		}
+	case 449:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2014
+		//line sql.y:2357
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 384:
+	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2018
+		//line sql.y:2361
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 385:
+	case 451:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2022
+		//line sql.y:2365
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 386:
+	case 452:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2026
+		//line sql.y:2369
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 387:
+	case 453:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2030
+		//line sql.y:2373
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 388:
+	case 454:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line sql.y:2034
+		//line sql.y:2377
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 389:
+	case 455:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2038
+		//line sql.y:2381
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 390:
+	case 456:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2042
+		//line sql.y:2385
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 391:
+	case 457:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2046
+		//line sql.y:2389
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colIdent}
 		}
-	case 392:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2056
+		//line sql.y:2399
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 393:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2060
+		//line sql.y:2403
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 394:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2064
+		//line sql.y:2407
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 395:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2068
+		//line sql.y:2411
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 396:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2073
+		//line sql.y:2416
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 397:
+	case 463:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2078
+		//line sql.y:2421
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 398:
+	case 464:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2083
+		//line sql.y:2426
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 399:
+	case 465:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2088
+		//line sql.y:2431
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 402:
+	case 468:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2102
+		//line sql.y:2445
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 403:
+	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2106
+		//line sql.y:2449
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 404:
+	case 470:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2110
+		//line sql.y:2453
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 405:
+	case 471:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2114
+		//line sql.y:2457
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 406:
+	case 472:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2120
+		//line sql.y:2463
 		{
 			yyVAL.str = ""
 		}
-	case 407:
+	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2124
+		//line sql.y:2467
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 408:
+	case 474:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2128
+		//line sql.y:2471
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 409:
+	case 475:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:2132
+		//line sql.y:2475
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 410:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2136
+		//line sql.y:2479
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 411:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2142
+		//line sql.y:2485
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 412:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2146
+		//line sql.y:2489
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 413:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2152
+		//line sql.y:2495
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 414:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2156
+		//line sql.y:2499
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 415:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2160
+		//line sql.y:2503
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 416:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2164
+		//line sql.y:2507
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 417:
+	case 483:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2168
+		//line sql.y:2511
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 418:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2172
+		//line sql.y:2515
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 419:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2178
+		//line sql.y:2521
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 420:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2182
+		//line sql.y:2525
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 421:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2186
+		//line sql.y:2529
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 422:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2190
+		//line sql.y:2533
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 423:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2194
+		//line sql.y:2537
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 424:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2198
+		//line sql.y:2541
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 425:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2202
+		//line sql.y:2545
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 426:
+	case 492:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2207
+		//line sql.y:2550
 		{
 			yyVAL.expr = nil
 		}
-	case 427:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2211
+		//line sql.y:2554
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 428:
+	case 494:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2216
+		//line sql.y:2559
 		{
 			yyVAL.str = string("")
 		}
-	case 429:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2220
+		//line sql.y:2563
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 430:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2226
+		//line sql.y:2569
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 431:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2230
+		//line sql.y:2573
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 432:
+	case 498:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2236
+		//line sql.y:2579
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 433:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2241
+		//line sql.y:2584
 		{
 			yyVAL.expr = nil
 		}
-	case 434:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2245
+		//line sql.y:2588
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 435:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2251
+		//line sql.y:2594
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 436:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2255
+		//line sql.y:2598
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 437:
+	case 503:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2259
+		//line sql.y:2602
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 438:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2265
+		//line sql.y:2608
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 439:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2269
+		//line sql.y:2612
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 440:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2273
+		//line sql.y:2616
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 441:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2277
+		//line sql.y:2620
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 442:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2281
+		//line sql.y:2624
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 443:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2285
+		//line sql.y:2628
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 444:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2289
+		//line sql.y:2632
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 445:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2293
+		//line sql.y:2636
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 446:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2299
+		//line sql.y:2642
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -4926,239 +5691,239 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 447:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2308
+		//line sql.y:2651
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 448:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2312
+		//line sql.y:2655
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 449:
+	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2317
+		//line sql.y:2660
 		{
 			yyVAL.exprs = nil
 		}
-	case 450:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2321
+		//line sql.y:2664
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 451:
+	case 517:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2326
+		//line sql.y:2669
 		{
 			yyVAL.expr = nil
 		}
-	case 452:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2330
+		//line sql.y:2673
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 453:
+	case 519:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2335
+		//line sql.y:2678
 		{
 			yyVAL.orderBy = nil
 		}
-	case 454:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2339
+		//line sql.y:2682
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 455:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2345
+		//line sql.y:2688
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 456:
+	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2349
+		//line sql.y:2692
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 457:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2355
+		//line sql.y:2698
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 458:
+	case 524:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2360
+		//line sql.y:2703
 		{
 			yyVAL.str = AscScr
 		}
-	case 459:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2364
+		//line sql.y:2707
 		{
 			yyVAL.str = AscScr
 		}
-	case 460:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2368
+		//line sql.y:2711
 		{
 			yyVAL.str = DescScr
 		}
-	case 461:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2373
+		//line sql.y:2716
 		{
 			yyVAL.limit = nil
 		}
-	case 462:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2377
+		//line sql.y:2720
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 463:
+	case 529:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2381
+		//line sql.y:2724
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 464:
+	case 530:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2385
+		//line sql.y:2728
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 465:
+	case 531:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2390
+		//line sql.y:2733
 		{
 			yyVAL.str = ""
 		}
-	case 466:
+	case 532:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2394
+		//line sql.y:2737
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 467:
+	case 533:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2398
+		//line sql.y:2741
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 468:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2411
+		//line sql.y:2754
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 469:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2415
+		//line sql.y:2758
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 470:
+	case 536:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2419
+		//line sql.y:2762
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 471:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2424
+		//line sql.y:2767
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 472:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2428
+		//line sql.y:2771
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 473:
+	case 539:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2432
+		//line sql.y:2775
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 474:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2439
+		//line sql.y:2782
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 475:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2443
+		//line sql.y:2786
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 476:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2447
+		//line sql.y:2790
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 477:
+	case 543:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2451
+		//line sql.y:2794
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 478:
+	case 544:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2456
+		//line sql.y:2799
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 479:
+	case 545:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2460
+		//line sql.y:2803
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 480:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2466
+		//line sql.y:2809
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 481:
+	case 547:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2470
+		//line sql.y:2813
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 482:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2476
+		//line sql.y:2819
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 483:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2480
+		//line sql.y:2823
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 484:
+	case 550:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2486
+		//line sql.y:2829
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 485:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2492
+		//line sql.y:2835
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -5166,300 +5931,228 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 486:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2502
+		//line sql.y:2845
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 487:
+	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2506
+		//line sql.y:2849
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 488:
+	case 554:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2512
+		//line sql.y:2855
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 489:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2518
+		//line sql.y:2861
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 490:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2522
+		//line sql.y:2865
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 491:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2528
+		//line sql.y:2871
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 492:
+	case 558:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2532
+		//line sql.y:2875
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 494:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2539
+		//line sql.y:2882
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 496:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2546
+		//line sql.y:2889
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 497:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2550
+		//line sql.y:2893
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 498:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2554
+		//line sql.y:2897
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 501:
+	case 567:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2563
+		//line sql.y:2906
 		{
 			yyVAL.byt = 0
 		}
-	case 502:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2565
+		//line sql.y:2908
 		{
 			yyVAL.byt = 1
 		}
-	case 503:
+	case 569:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2568
+		//line sql.y:2911
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 504:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2570
+		//line sql.y:2913
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 505:
+	case 571:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2573
+		//line sql.y:2916
 		{
 			yyVAL.str = ""
 		}
-	case 506:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2575
+		//line sql.y:2918
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 507:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2579
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 508:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2581
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 509:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2583
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 510:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2585
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 511:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2587
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 512:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2589
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 513:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2591
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 514:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2593
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 515:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2595
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 516:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2597
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 517:
+	case 573:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2600
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 518:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2602
-		{
-			yyVAL.empty = struct{}{}
-		}
-	case 519:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2604
+		//line sql.y:2921
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 520:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2608
+		//line sql.y:2923
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 521:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2610
+		//line sql.y:2925
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 522:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2613
+		//line sql.y:2928
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 523:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2615
+		//line sql.y:2930
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 524:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2617
+		//line sql.y:2932
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 525:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2620
+		//line sql.y:2935
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 526:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2622
+		//line sql.y:2937
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 527:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2626
+		//line sql.y:2941
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 528:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2630
+		//line sql.y:2945
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 530:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2637
+		//line sql.y:2952
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 531:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2643
+		//line sql.y:2958
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 532:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2647
+		//line sql.y:2962
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 534:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2654
+		//line sql.y:2969
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 704:
+	case 770:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2849
+		//line sql.y:3176
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 705:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2858
+		//line sql.y:3185
 		{
 			decNesting(yylex)
 		}
-	case 706:
+	case 772:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2863
+		//line sql.y:3190
 		{
 			forceEOF(yylex)
 		}
-	case 707:
+	case 773:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2868
+		//line sql.y:3195
 		{
 			forceEOF(yylex)
 		}
-	case 708:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2872
+		//line sql.y:3199
 		{
 			forceEOF(yylex)
 		}
-	case 709:
+	case 775:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2876
+		//line sql.y:3203
 		{
 			forceEOF(yylex)
 		}
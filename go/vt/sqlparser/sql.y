@@ -105,6 +105,15 @@ func forceEOF(yylex interface{}) {
   partDefs      []*PartitionDefinition
   partDef       *PartitionDefinition
   partSpec      *PartitionSpec
+  alterSpec     *AlterSpec
+  alterSpecs    []*AlterSpec
+  colPosition   *ColumnPosition
+  with          *With
+  cte           *CommonTableExpr
+  ctes          []*CommonTableExpr
+  overClause    *OverClause
+  frameClause   *FrameClause
+  framePoint    *FramePoint
   vindexParam   VindexParam
   vindexParams  []VindexParam
 }
@@ -157,7 +166,7 @@ func forceEOF(yylex interface{}) {
 %token <bytes> STATUS VARIABLES
 
 // Transaction Tokens
-%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK
+%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE
 
 // Type Tokens
 %token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -187,16 +196,28 @@ func forceEOF(yylex interface{}) {
 // Match
 %token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
+// CTE and window function tokens
+%token <bytes> RECURSIVE OVER ROWS RANGE UNBOUNDED PRECEDING FOLLOWING CURRENT ROW
+
 // MySQL reserved words that are unused by this grammar will map to this token.
+%token <bytes> MODIFY CHANGE
 %token <bytes> UNUSED
 
 %type <statement> command
-%type <selStmt> select_statement base_select union_lhs union_rhs
+%type <selStmt> select_statement base_select union_lhs union_rhs cte_select_statement
+%type <with> with_clause
+%type <ctes> common_table_expr_list
+%type <cte> common_table_expr
+%type <overClause> over_opt
+%type <exprs> partition_by_opt
+%type <frameClause> frame_opt
+%type <str> frame_unit
+%type <framePoint> frame_point
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement
-%type <ddl> create_table_prefix
+%type <ddl> create_table_prefix alter_table_prefix
 %type <statement> analyze_statement show_statement use_statement other_statement
-%type <statement> begin_statement commit_statement rollback_statement
+%type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement
 %type <bytes2> comment_opt comment_list
 %type <str> union_op insert_or_replace
 %type <str> distinct_opt straight_join_opt cache_opt match_option separator_opt
@@ -248,7 +269,7 @@ func forceEOF(yylex interface{}) {
 %type <bytes> for_from
 %type <str> ignore_opt default_opt
 %type <byt> exists_opt
-%type <empty> not_exists_opt non_add_drop_or_rename_operation to_opt index_opt constraint_opt
+%type <empty> not_exists_opt unsupported_alter_spec to_opt constraint_opt
 %type <bytes> reserved_keyword non_reserved_keyword
 %type <colIdent> sql_id reserved_sql_id col_alias as_ci_opt using_opt
 %type <expr> charset_value
@@ -281,7 +302,10 @@ func forceEOF(yylex interface{}) {
 %type <vindexParam> vindex_param
 %type <vindexParams> vindex_param_list vindex_params_opt
 %type <colIdent> vindex_type vindex_type_opt
-%type <bytes> alter_object_type
+%type <alterSpec> alter_spec
+%type <alterSpecs> alter_spec_list
+%type <str> alter_table_option
+%type <colPosition> column_position_opt
 
 %start any_command
 
@@ -302,6 +326,10 @@ command:
   {
     $$ = $1
   }
+| cte_select_statement
+  {
+    $$ = $1
+  }
 | stream_statement
 | insert_statement
 | update_statement
@@ -318,6 +346,8 @@ command:
 | begin_statement
 | commit_statement
 | rollback_statement
+| savepoint_statement
+| release_statement
 | other_statement
 
 select_statement:
@@ -338,6 +368,49 @@ select_statement:
     $$ = &Select{Comments: Comments($2), Cache: $3, SelectExprs: SelectExprs{Nextval{Expr: $5}}, From: TableExprs{&AliasedTableExpr{Expr: $7}}}
   }
 
+// cte_select_statement is a SELECT or UNION with a WITH clause.
+cte_select_statement:
+  with_clause select_statement
+  {
+    switch sel := $2.(type) {
+    case *Select:
+      sel.With = $1
+    case *Union:
+      sel.With = $1
+    }
+    $$ = $2
+  }
+
+with_clause:
+  WITH common_table_expr_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE common_table_expr_list
+  {
+    $$ = &With{Recursive: true, CTEs: $3}
+  }
+
+common_table_expr_list:
+  common_table_expr
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| common_table_expr_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Subquery: $3}
+  }
+| table_id '(' column_list ')' AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $3, Subquery: $6}
+  }
+
 stream_statement:
   STREAM comment_opt select_expression FROM table_name
   {
@@ -934,10 +1007,30 @@ index_info:
   {
     $$ = &IndexInfo{Type: string($1), Name: NewColIdent(string($2)), Unique: true}
   }
+| UNIQUE index_or_key
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Unique: true}
+  }
+| UNIQUE
+  {
+    $$ = &IndexInfo{Type: string($1), Unique: true}
+  }
 | index_or_key ID
   {
     $$ = &IndexInfo{Type: string($1), Name: NewColIdent(string($2)), Unique: false}
   }
+| index_or_key
+  {
+    $$ = &IndexInfo{Type: string($1), Unique: false}
+  }
+| FULLTEXT index_or_key ID
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent(string($3)), Unique: false}
+  }
+| SPATIAL index_or_key ID
+  {
+    $$ = &IndexInfo{Type: string($1) + " " + string($2), Name: NewColIdent(string($3)), Unique: false}
+  }
 
 index_or_key:
     INDEX
@@ -1009,71 +1102,234 @@ table_opt_value:
   }
 
 alter_statement:
-  ALTER ignore_opt TABLE table_name non_add_drop_or_rename_operation force_eof
+  alter_table_prefix alter_spec_list
   {
-    $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
+    if len($2) == 1 && $2[0].Action == RenameTableStr {
+      // Change this to a rename statement
+      $$ = &DDL{Action: RenameStr, Table: $1.Table, NewName: $2[0].NewName}
+    } else {
+      // Don't modify $1: it's the partial DDL used if the rest fails to parse.
+      $$ = &DDL{Action: AlterStr, Table: $1.Table, NewName: $1.NewName, AlterSpecs: $2}
+    }
   }
-| ALTER ignore_opt TABLE table_name ADD alter_object_type force_eof
+| alter_table_prefix unsupported_alter_spec force_eof
   {
-    $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
+    $$ = $1
   }
-| ALTER ignore_opt TABLE table_name DROP alter_object_type force_eof
+| alter_table_prefix alter_spec_list ',' unsupported_alter_spec force_eof
   {
-    $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
+    $$ = $1
   }
-| ALTER ignore_opt TABLE table_name ADD VINDEX sql_id '(' column_list ')' vindex_type_opt vindex_params_opt
+| alter_table_prefix ADD VINDEX sql_id '(' column_list ')' vindex_type_opt vindex_params_opt
   {
     $$ = &DDL{
         Action: AddColVindexStr,
-        Table: $4,
+        Table: $1.Table,
         VindexSpec: &VindexSpec{
-            Name: $7,
-            Type: $11,
-            Params: $12,
+            Name: $4,
+            Type: $8,
+            Params: $9,
         },
-        VindexCols: $9,
+        VindexCols: $6,
       }
   }
-| ALTER ignore_opt TABLE table_name DROP VINDEX sql_id
+| alter_table_prefix DROP VINDEX sql_id
   {
     $$ = &DDL{
         Action: DropColVindexStr,
-        Table: $4,
+        Table: $1.Table,
         VindexSpec: &VindexSpec{
-            Name: $7,
+            Name: $4,
         },
       }
   }
-| ALTER ignore_opt TABLE table_name RENAME to_opt table_name
+| ALTER VIEW table_name ddl_force_eof
+  {
+    $$ = &DDL{Action: AlterStr, Table: $3.ToViewName(), NewName: $3.ToViewName()}
+  }
+| alter_table_prefix partition_operation
   {
-    // Change this to a rename statement
-    $$ = &DDL{Action: RenameStr, Table: $4, NewName: $7}
+    $$ = &DDL{Action: AlterStr, Table: $1.Table, PartitionSpec: $2}
   }
-| ALTER ignore_opt TABLE table_name RENAME index_opt force_eof
+
+// The alterations that can't be parsed make Parse fall back
+// to this DDL, without AlterSpecs.
+alter_table_prefix:
+  ALTER ignore_opt TABLE table_name
   {
-    // Rename an index can just be an alter
     $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
+    setDDL(yylex, $$)
   }
-| ALTER VIEW table_name ddl_force_eof
+
+alter_spec_list:
+  alter_spec
   {
-    $$ = &DDL{Action: AlterStr, Table: $3.ToViewName(), NewName: $3.ToViewName()}
+    $$ = []*AlterSpec{$1}
   }
-| ALTER ignore_opt TABLE table_name partition_operation
+| alter_spec_list ',' alter_spec
   {
-    $$ = &DDL{Action: AlterStr, Table: $4, PartitionSpec: $5}
+    $$ = append($1, $3)
   }
 
-alter_object_type:
-  COLUMN
-| CONSTRAINT
-| FOREIGN
-| FULLTEXT
+alter_spec:
+  ADD column_definition column_position_opt
+  {
+    $$ = &AlterSpec{Action: AddColumnStr, Column: $2, Position: $3}
+  }
+| ADD COLUMN column_definition column_position_opt
+  {
+    $$ = &AlterSpec{Action: AddColumnStr, Column: $3, Position: $4}
+  }
+| ADD index_definition
+  {
+    $$ = &AlterSpec{Action: AddIndexStr, Index: $2}
+  }
+| DROP sql_id
+  {
+    $$ = &AlterSpec{Action: DropColumnStr, ColumnName: $2}
+  }
+| DROP COLUMN sql_id
+  {
+    $$ = &AlterSpec{Action: DropColumnStr, ColumnName: $3}
+  }
+| DROP index_or_key sql_id
+  {
+    $$ = &AlterSpec{Action: DropIndexStr, IndexName: $3}
+  }
+| DROP PRIMARY KEY
+  {
+    $$ = &AlterSpec{Action: DropPrimaryKeyStr}
+  }
+| MODIFY column_definition column_position_opt
+  {
+    $$ = &AlterSpec{Action: ModifyColumnStr, Column: $2, Position: $3}
+  }
+| MODIFY COLUMN column_definition column_position_opt
+  {
+    $$ = &AlterSpec{Action: ModifyColumnStr, Column: $3, Position: $4}
+  }
+| CHANGE sql_id column_definition column_position_opt
+  {
+    $$ = &AlterSpec{Action: ChangeColumnStr, ColumnName: $2, Column: $3, Position: $4}
+  }
+| CHANGE COLUMN sql_id column_definition column_position_opt
+  {
+    $$ = &AlterSpec{Action: ChangeColumnStr, ColumnName: $3, Column: $4, Position: $5}
+  }
+| RENAME to_opt table_name
+  {
+    $$ = &AlterSpec{Action: RenameTableStr, NewName: $3}
+  }
+| RENAME index_or_key sql_id TO sql_id
+  {
+    $$ = &AlterSpec{Action: RenameIndexStr, IndexName: $3, NewIndexName: $5}
+  }
+| alter_table_option
+  {
+    $$ = &AlterSpec{Action: TableOptionStr, Option: $1}
+  }
+
+column_position_opt:
+  {
+    $$ = nil
+  }
 | ID
-| INDEX
-| KEY
-| PRIMARY
-| SPATIAL
+  {
+    if NewColIdent(string($1)).Lowered() != "first" {
+      yylex.Error("expecting first or after")
+      return 1
+    }
+    $$ = &ColumnPosition{First: true}
+  }
+| ID sql_id
+  {
+    if NewColIdent(string($1)).Lowered() != "after" {
+      yylex.Error("expecting first or after")
+      return 1
+    }
+    $$ = &ColumnPosition{After: $2}
+  }
+
+// like table_option, but the first word can't start another alteration
+alter_table_option:
+  ID
+  {
+    if !alterTableOptions[NewColIdent(string($1)).Lowered()] {
+      yylex.Error("unknown table option")
+      return 1
+    }
+    $$ = string($1)
+  }
+| AUTO_INCREMENT
+  {
+    $$ = string($1)
+  }
+| CHARACTER
+  {
+    $$ = string($1)
+  }
+| CHARSET
+  {
+    $$ = string($1)
+  }
+| COLLATE
+  {
+    $$ = string($1)
+  }
+| COMMENT_KEYWORD
+  {
+    $$ = string($1)
+  }
+| DEFAULT
+  {
+    $$ = string($1)
+  }
+| FORCE
+  {
+    $$ = string($1)
+  }
+| LOCK
+  {
+    $$ = string($1)
+  }
+| alter_table_option table_opt_value
+  {
+    $$ = $1 + " " + $2
+  }
+| alter_table_option '=' table_opt_value
+  {
+    $$ = $1 + "=" + $3
+  }
+
+// The alterations that are not parsed yet. The rest of the statement
+// is ignored, and the DDL has no AlterSpecs.
+unsupported_alter_spec:
+  ALTER
+  { $$ = struct{}{} }
+| ORDER
+  { $$ = struct{}{} }
+| CONVERT
+  { $$ = struct{}{} }
 | PARTITION
+  { $$ = struct{}{} }
+| UNUSED
+  { $$ = struct{}{} }
+| ADD CONSTRAINT
+  { $$ = struct{}{} }
+| ADD FOREIGN
+  { $$ = struct{}{} }
+| ADD PARTITION
+  { $$ = struct{}{} }
+| ADD COLUMN openb
+  { $$ = struct{}{} }
+| DROP CONSTRAINT
+  { $$ = struct{}{} }
+| DROP FOREIGN KEY
+  { $$ = struct{}{} }
+| DROP PARTITION sql_id
+  { $$ = struct{}{} }
+| RENAME COLUMN
+  { $$ = struct{}{} }
 
 partition_operation:
   REORGANIZE PARTITION sql_id INTO openb partition_definitions closeb
@@ -1291,6 +1547,26 @@ rollback_statement:
   {
     $$ = &Rollback{}
   }
+| ROLLBACK TO sql_id
+  {
+    $$ = &SRollback{Name: $3}
+  }
+| ROLLBACK TO SAVEPOINT sql_id
+  {
+    $$ = &SRollback{Name: $4}
+  }
+
+savepoint_statement:
+  SAVEPOINT sql_id
+  {
+    $$ = &Savepoint{Name: $2}
+  }
+
+release_statement:
+  RELEASE SAVEPOINT sql_id
+  {
+    $$ = &Release{Name: $3}
+  }
 
 other_statement:
   DESC force_eof
@@ -1844,6 +2120,10 @@ subquery:
   {
     $$ = &Subquery{$2}
   }
+| openb cte_select_statement closeb
+  {
+    $$ = &Subquery{$2}
+  }
 
 expression_list:
   expression
@@ -1992,17 +2272,80 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
-| sql_id openb DISTINCT select_expression_list closeb
+| sql_id openb DISTINCT select_expression_list closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Over: $6}
   }
-| table_id '.' reserved_sql_id openb select_expression_list_opt closeb
+| table_id '.' reserved_sql_id openb select_expression_list_opt closeb over_opt
   {
-    $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
+    $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5, Over: $7}
+  }
+
+over_opt:
+  {
+    $$ = nil
+  }
+| OVER openb partition_by_opt order_by_opt frame_opt closeb
+  {
+    $$ = &OverClause{PartitionBy: $3, OrderBy: $4, Frame: $5}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsStr
+  }
+| RANGE
+  {
+    $$ = RangeStr
+  }
+
+frame_point:
+  UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingStr}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingStr}
+  }
+| CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowStr}
+  }
+| value PRECEDING
+  {
+    $$ = &FramePoint{Type: PrecedingStr, Expr: $1}
+  }
+| value FOLLOWING
+  {
+    $$ = &FramePoint{Type: FollowingStr, Expr: $1}
   }
 
 /*
@@ -2574,28 +2917,6 @@ ignore_opt:
 | IGNORE
   { $$ = IgnoreStr }
 
-non_add_drop_or_rename_operation:
-  ALTER
-  { $$ = struct{}{} }
-| AUTO_INCREMENT
-  { $$ = struct{}{} }
-| CHARACTER
-  { $$ = struct{}{} }
-| COMMENT_KEYWORD
-  { $$ = struct{}{} }
-| DEFAULT
-  { $$ = struct{}{} }
-| ORDER
-  { $$ = struct{}{} }
-| CONVERT
-  { $$ = struct{}{} }
-| PARTITION
-  { $$ = struct{}{} }
-| UNUSED
-  { $$ = struct{}{} }
-| ID
-  { $$ = struct{}{} }
-
 to_opt:
   { $$ = struct{}{} }
 | TO
@@ -2603,12 +2924,6 @@ to_opt:
 | AS
   { $$ = struct{}{} }
 
-index_opt:
-  INDEX
-  { $$ = struct{}{} }
-| KEY
-  { $$ = struct{}{} }
-
 constraint_opt:
   { $$ = struct{}{} }
 | UNIQUE
@@ -2729,7 +3044,10 @@ reserved_keyword:
 | OR
 | ORDER
 | OUTER
+| OVER
+| RECURSIVE
 | REGEXP
+| RELEASE
 | RENAME
 | REPLACE
 | RIGHT
@@ -2754,6 +3072,7 @@ reserved_keyword:
 | VALUES
 | WHEN
 | WHERE
+| WITH
 
 /*
   These are non-reserved Vitess, because they don't cause conflicts in the grammar.
@@ -2774,6 +3093,7 @@ non_reserved_keyword:
 | CHARSET
 | COMMENT_KEYWORD
 | COMMIT
+| CURRENT
 | DATE
 | DATETIME
 | DECIMAL
@@ -2782,6 +3102,7 @@ non_reserved_keyword:
 | ENUM
 | EXPANSION
 | FLOAT_TYPE
+| FOLLOWING
 | FOREIGN
 | FULLTEXT
 | GLOBAL
@@ -2798,19 +3119,25 @@ non_reserved_keyword:
 | MEDIUMINT
 | MEDIUMTEXT
 | MODE
+| MODIFY
 | NAMES
 | NCHAR
 | NUMERIC
 | OFFSET
 | OPTIMIZE
 | PARTITION
+| PRECEDING
 | PRIMARY
 | PROCEDURE
 | QUERY
+| RANGE
 | REAL
 | REORGANIZE
 | REPAIR
 | ROLLBACK
+| ROW
+| ROWS
+| SAVEPOINT
 | SESSION
 | SHARE
 | SIGNED
@@ -2828,6 +3155,7 @@ non_reserved_keyword:
 | TRANSACTION
 | TRIGGER
 | TRUNCATE
+| UNBOUNDED
 | UNSIGNED
 | UNUSED
 | VARBINARY
@@ -2840,7 +3168,6 @@ non_reserved_keyword:
 | VITESS_SHARDS
 | VITESS_TABLETS
 | VSCHEMA_TABLES
-| WITH
 | YEAR
 | ZEROFILL
 
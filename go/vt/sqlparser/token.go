@@ -46,6 +46,8 @@ type Tokenizer struct {
 	partialDDL    *DDL
 	nesting       int
 	multi         bool
+	recording     bool
+	recorded      []byte
 
 	buf     []byte
 	bufPos  int
@@ -109,7 +111,7 @@ var keywords = map[string]int{
 	"cascade":             UNUSED,
 	"case":                CASE,
 	"cast":                CAST,
-	"change":              UNUSED,
+	"change":              CHANGE,
 	"char":                CHAR,
 	"character":           CHARACTER,
 	"charset":             CHARSET,
@@ -124,6 +126,7 @@ var keywords = map[string]int{
 	"convert":             CONVERT,
 	"create":              CREATE,
 	"cross":               CROSS,
+	"current":             CURRENT,
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
@@ -169,6 +172,7 @@ var keywords = map[string]int{
 	"float":               FLOAT_TYPE,
 	"float4":              UNUSED,
 	"float8":              UNUSED,
+	"following":           FOLLOWING,
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
@@ -242,6 +246,7 @@ var keywords = map[string]int{
 	"mod":                 MOD,
 	"mode":                MODE,
 	"modifies":            UNUSED,
+	"modify":              MODIFY,
 	"names":               NAMES,
 	"natural":             NATURAL,
 	"nchar":               NCHAR,
@@ -261,19 +266,22 @@ var keywords = map[string]int{
 	"out":                 UNUSED,
 	"outer":               OUTER,
 	"outfile":             UNUSED,
+	"over":                OVER,
 	"partition":           PARTITION,
+	"preceding":           PRECEDING,
 	"precision":           UNUSED,
 	"primary":             PRIMARY,
 	"procedure":           PROCEDURE,
 	"query":               QUERY,
-	"range":               UNUSED,
+	"range":               RANGE,
 	"read":                UNUSED,
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
+	"recursive":           RECURSIVE,
 	"references":          UNUSED,
 	"regexp":              REGEXP,
-	"release":             UNUSED,
+	"release":             RELEASE,
 	"rename":              RENAME,
 	"reorganize":          REORGANIZE,
 	"repair":              REPAIR,
@@ -287,6 +295,9 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"row":                 ROW,
+	"rows":                ROWS,
+	"savepoint":           SAVEPOINT,
 	"schema":              UNUSED,
 	"schemas":             UNUSED,
 	"second_microsecond":  UNUSED,
@@ -335,6 +346,7 @@ var keywords = map[string]int{
 	"trigger":             TRIGGER,
 	"true":                TRUE,
 	"truncate":            TRUNCATE,
+	"unbounded":           UNBOUNDED,
 	"undo":                UNUSED,
 	"union":               UNION,
 	"unique":              UNIQUE,
@@ -846,7 +858,26 @@ func (tkn *Tokenizer) next() {
 		tkn.Position++
 		tkn.lastChar = uint16(tkn.buf[tkn.bufPos])
 		tkn.bufPos++
+		if tkn.recording {
+			tkn.recorded = append(tkn.recorded, byte(tkn.lastChar))
+		}
+	}
+}
+
+// startRecording starts to record the text that is read,
+// from the current character.
+func (tkn *Tokenizer) startRecording() {
+	tkn.recorded = tkn.recorded[:0]
+	if tkn.lastChar != 0 {
+		tkn.recorded = append(tkn.recorded, byte(tkn.lastChar))
 	}
+	tkn.recording = true
+}
+
+// stopRecording stops the recording, and returns the recorded text.
+func (tkn *Tokenizer) stopRecording() string {
+	tkn.recording = false
+	return string(tkn.recorded)
 }
 
 // reset clears any internal state.
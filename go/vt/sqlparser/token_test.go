@@ -18,6 +18,7 @@ package sqlparser
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -189,3 +190,37 @@ func TestSplitStatement(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitStatementToPieces(t *testing.T) {
+	testcases := []struct {
+		in   string
+		want []string
+	}{{
+		in:   "select * from table",
+		want: []string{"select * from table"},
+	}, {
+		in:   "select * from table1; select * from table2;",
+		want: []string{"select * from table1", "select * from table2"},
+	}, {
+		in:   "select * from /* comment ; */ table1;select * from table2 where semi = ';'",
+		want: []string{"select * from /* comment ; */ table1", "select * from table2 where semi = ';'"},
+	}, {
+		in:   "insert into t values (1);; ; update t set a = 2 ;\n",
+		want: []string{"insert into t values (1)", "update t set a = 2"},
+	}, {
+		in: " ; ",
+	}, {
+		in: "",
+	}}
+
+	for _, tcase := range testcases {
+		got, err := SplitStatementToPieces(tcase.in)
+		if err != nil {
+			t.Errorf("SplitStatementToPieces(%q): %v", tcase.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("SplitStatementToPieces(%q): %q, want %q", tcase.in, got, tcase.want)
+		}
+	}
+}
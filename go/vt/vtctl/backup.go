@@ -20,9 +20,11 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/wrangler"
@@ -39,12 +41,30 @@ func init() {
 		commandRemoveBackup,
 		"<keyspace/shard> <backup name>",
 		"Removes a backup for the BackupStorage."})
+	addCommand("Shards", command{
+		"PruneBackups",
+		commandPruneBackups,
+		"[-keep_last=<count>] [-keep_within=<duration>] [-keep_weekly=<count>] [-keep_monthly=<count>] [-dry_run] <keyspace/shard>",
+		"Removes the backups of a shard that the retention policy doesn't keep. A backup is kept if any of the rules keeps it: " +
+			"-keep_last keeps the most recent backups, -keep_within the ones taken within that duration (e.g. 168h), " +
+			"-keep_weekly and -keep_monthly the most recent backup of each of the most recent weeks and months that have backups. " +
+			"The most recent complete backup is always kept, and so is the most recent backup. Incomplete backups older than all the kept ones are removed too. " +
+			"With -dry_run, the backups that would be removed are only listed."})
+	addCommand("Shards", command{
+		"VerifyBackup",
+		commandVerifyBackup,
+		"[-concurrency=4] <keyspace/shard> [<backup name>]",
+		"Verifies a backup for the BackupStorage, by default the most recent one. All its files are read, uncompressed and decrypted, " +
+			"and checked against the hashes recorded in its MANIFEST. Nothing is restored."})
 
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
 		commandRestoreFromBackup,
-		"<tablet alias>",
-		"Stops mysqld and restores the data from the latest backup."})
+		"[-to_pos=<position>|-to_time=<time>] [-binlog_source_tablet=<tablet alias>|-binlog_server=<host:port>] <tablet alias>",
+		"Stops mysqld and restores the data from the latest backup.\n" +
+			"With -to_pos or -to_time, recovers the data as it was at that position, or at that time (in RFC 3339 format, e.g. 2006-01-02T15:04:05Z). " +
+			"The latest backup before it is restored, and the binlogs are replayed from the shard master up to it, or from -binlog_source_tablet or -binlog_server if set. " +
+			"The tablet is then DRAINED, with replication stopped."})
 }
 
 func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -99,13 +119,127 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	return bs.RemoveBackup(ctx, bucket, name)
 }
 
+func commandPruneBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keepLast := subFlags.Int("keep_last", 0, "Keeps that many most recent backups")
+	keepWithin := subFlags.Duration("keep_within", 0, "Keeps the backups taken within that duration")
+	keepWeekly := subFlags.Int("keep_weekly", 0, "Keeps the most recent backup of each of that many weeks")
+	keepMonthly := subFlags.Int("keep_monthly", 0, "Keeps the most recent backup of each of that many months")
+	dryRun := subFlags.Bool("dry_run", false, "Only lists the backups that would be removed")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action PruneBackups requires <keyspace/shard>")
+	}
+	policy := mysqlctl.RetentionPolicy{
+		KeepLast:    *keepLast,
+		KeepWithin:  *keepWithin,
+		KeepWeekly:  *keepWeekly,
+		KeepMonthly: *keepMonthly,
+	}
+	if policy.IsZero() {
+		return fmt.Errorf("action PruneBackups requires at least one of -keep_last, -keep_within, -keep_weekly and -keep_monthly")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	bucket := fmt.Sprintf("%v/%v", keyspace, shard)
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	_, err = mysqlctl.PruneBackups(ctx, bs, wr.Logger(), bucket, policy, *dryRun)
+	return err
+}
+
+func commandVerifyBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of files to verify concurrently")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 && subFlags.NArg() != 2 {
+		return fmt.Errorf("action VerifyBackup requires <keyspace/shard> [<backup name>]")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	bucket := fmt.Sprintf("%v/%v", keyspace, shard)
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if len(bhs) == 0 {
+		return fmt.Errorf("no backup in %v", bucket)
+	}
+	bh := bhs[len(bhs)-1]
+	if subFlags.NArg() == 2 {
+		bh = nil
+		for _, b := range bhs {
+			if b.Name() == subFlags.Arg(1) {
+				bh = b
+				break
+			}
+		}
+		if bh == nil {
+			return fmt.Errorf("no backup %v in %v", subFlags.Arg(1), bucket)
+		}
+	}
+	return mysqlctl.VerifyBackup(ctx, wr.Logger(), bh, *concurrency, nil /* hookExtraEnv */)
+}
+
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	toPos := subFlags.String("to_pos", "", "The replication position to recover to")
+	toTime := subFlags.String("to_time", "", "The time to recover to, in RFC 3339 format")
+	binlogSourceTablet := subFlags.String("binlog_source_tablet", "", "The tablet whose binlogs are replayed, instead of the shard master")
+	binlogServer := subFlags.String("binlog_server", "", "The host:port of the binlog server whose binlogs are replayed, instead of the shard master")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("the RestoreFromBackup command requires the <tablet alias> argument")
 	}
+	if *toPos != "" && *toTime != "" {
+		return fmt.Errorf("only one of -to_pos and -to_time can be specified")
+	}
+	if *binlogSourceTablet != "" && *binlogServer != "" {
+		return fmt.Errorf("only one of -binlog_source_tablet and -binlog_server can be specified")
+	}
+	if *toPos == "" && *toTime == "" && (*binlogSourceTablet != "" || *binlogServer != "") {
+		return fmt.Errorf("-binlog_source_tablet and -binlog_server require -to_pos or -to_time")
+	}
+
+	var restoreToTime time.Time
+	if *toTime != "" {
+		var err error
+		restoreToTime, err = time.Parse(time.RFC3339, *toTime)
+		if err != nil {
+			return fmt.Errorf("invalid -to_time: %v", err)
+		}
+	}
+	binlogSource := *binlogServer
+	if *binlogSourceTablet != "" {
+		sourceAlias, err := topoproto.ParseTabletAlias(*binlogSourceTablet)
+		if err != nil {
+			return err
+		}
+		sourceInfo, err := wr.TopoServer().GetTablet(ctx, sourceAlias)
+		if err != nil {
+			return err
+		}
+		binlogSource = topoproto.MysqlAddr(sourceInfo.Tablet)
+	}
 
 	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
 	if err != nil {
@@ -115,7 +249,7 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet)
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, *toPos, restoreToTime, binlogSource)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,199 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file contains the commands to inspect and reset sequences.
+
+func init() {
+	addCommand("Keyspaces", command{
+		"GetSequence",
+		commandGetSequence,
+		"<keyspace> <sequence table>",
+		"Displays the next value the sequence reserves and its cache size, read from the master of the keyspace. " +
+			"The values already reserved by the master are handed out first."})
+	addCommand("Keyspaces", command{
+		"ResetSequence",
+		commandResetSequence,
+		"[-next_id=<value>] [-cache=<size>] <keyspace> <sequence table>",
+		"Changes the next value the sequence reserves and/or its cache size. The values the master has reserved " +
+			"and not handed out yet are discarded. Setting -next_id lower than values already handed out causes duplicates."})
+}
+
+// sequenceState is the state of a sequence, as stored in its table.
+type sequenceState struct {
+	NextID int64
+	Cache  int64
+}
+
+func commandGetSequence(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace> and <sequence table> arguments are required for the GetSequence command")
+	}
+	keyspace := subFlags.Arg(0)
+	table := sqlparser.String(sqlparser.NewTableIdent(subFlags.Arg(1)))
+
+	var state *sequenceState
+	err := execSequenceTransaction(ctx, wr, keyspace, func(conn queryservice.QueryService, target *querypb.Target, transactionID int64) error {
+		var err error
+		state, err = readSequence(ctx, conn, target, transactionID, table)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), state)
+}
+
+func commandResetSequence(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	nextID := subFlags.Int64("next_id", 0, "the next value the sequence reserves")
+	cache := subFlags.Int64("cache", 0, "the number of values the master reserves at a time")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace> and <sequence table> arguments are required for the ResetSequence command")
+	}
+	if *nextID < 0 || *cache < 0 {
+		return fmt.Errorf("-next_id and -cache must be positive")
+	}
+	var sets []string
+	if *nextID != 0 {
+		sets = append(sets, fmt.Sprintf("next_id = %d", *nextID))
+	}
+	if *cache != 0 {
+		sets = append(sets, fmt.Sprintf("cache = %d", *cache))
+	}
+	if len(sets) == 0 {
+		return fmt.Errorf("at least one of -next_id and -cache is required for the ResetSequence command")
+	}
+	keyspace := subFlags.Arg(0)
+	table := sqlparser.String(sqlparser.NewTableIdent(subFlags.Arg(1)))
+
+	var state *sequenceState
+	err := execSequenceTransaction(ctx, wr, keyspace, func(conn queryservice.QueryService, target *querypb.Target, transactionID int64) error {
+		// Make sure the sequence exists, and lock its row.
+		if _, err := readSequence(ctx, conn, target, transactionID, table); err != nil {
+			return err
+		}
+		// The update goes through the tablet server, which discards
+		// the values it has cached for the sequence.
+		query := fmt.Sprintf("update %s set %s where id = 0", table, strings.Join(sets, ", "))
+		if _, err := conn.Execute(ctx, target, query, nil, transactionID, nil); err != nil {
+			return fmt.Errorf("cannot update sequence %s: %v", table, err)
+		}
+		var err error
+		state, err = readSequence(ctx, conn, target, transactionID, table)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), state)
+}
+
+// execSequenceTransaction runs f in a transaction on the master of the
+// unsharded keyspace the sequences are stored in.
+func execSequenceTransaction(ctx context.Context, wr *wrangler.Wrangler, keyspace string, f func(conn queryservice.QueryService, target *querypb.Target, transactionID int64) error) error {
+	shards, err := wr.TopoServer().GetShardNames(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	if len(shards) != 1 {
+		return fmt.Errorf("sequences are stored in unsharded keyspaces, keyspace %v has %v shards", keyspace, len(shards))
+	}
+	si, err := wr.TopoServer().GetShard(ctx, keyspace, shards[0])
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() {
+		return fmt.Errorf("shard %v/%v has no master", keyspace, shards[0])
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return err
+	}
+	conn, err := tabletconn.GetDialer()(tabletInfo.Tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return fmt.Errorf("cannot connect to tablet %v: %v", topoproto.TabletAliasString(si.MasterAlias), err)
+	}
+	defer conn.Close(ctx)
+
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shards[0],
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	transactionID, err := conn.Begin(ctx, target, nil)
+	if err != nil {
+		return fmt.Errorf("Begin failed: %v", err)
+	}
+	if err := f(conn, target, transactionID); err != nil {
+		if rerr := conn.Rollback(ctx, target, transactionID); rerr != nil {
+			wr.Logger().Warningf("Rollback failed: %v", rerr)
+		}
+		return err
+	}
+	if err := conn.Commit(ctx, target, transactionID); err != nil {
+		return fmt.Errorf("Commit failed: %v", err)
+	}
+	return nil
+}
+
+// readSequence reads the row of a sequence table, and locks it.
+func readSequence(ctx context.Context, conn queryservice.QueryService, target *querypb.Target, transactionID int64, table string) (*sequenceState, error) {
+	query := fmt.Sprintf("select next_id, cache from %s where id = 0 for update", table)
+	qr, err := conn.Execute(ctx, target, query, nil, transactionID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read sequence %s: %v", table, err)
+	}
+	if len(qr.Rows) != 1 {
+		return nil, fmt.Errorf("unexpected rows from reading sequence %s: %d", table, len(qr.Rows))
+	}
+	nextID, err := sqltypes.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return nil, fmt.Errorf("error loading sequence %s: %v", table, err)
+	}
+	cache, err := sqltypes.ToInt64(qr.Rows[0][1])
+	if err != nil {
+		return nil, fmt.Errorf("error loading sequence %s: %v", table, err)
+	}
+	return &sequenceState{
+		NextID: nextID,
+		Cache:  cache,
+	}, nil
+}
@@ -121,8 +121,10 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
@@ -355,8 +357,11 @@ var commands = []commandGroup{
 				"[-exclude_tables=''] [-include-views] <keyspace name>",
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
 			{"ApplySchema", commandApplySchema,
-				"[-allow_long_unavailability] [-wait_slave_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to slaves via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected."},
+				"[-allow_long_unavailability] [-online_ddl] [-wait_slave_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to slaves via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. If -online_ddl is set, ALTER TABLE statements are submitted as online schema migrations, which the masters run in the background if they have -enable_online_ddl. See the OnlineDDL command."},
+			{"OnlineDDL", commandOnlineDDL,
+				"<keyspace> show [<migration uuid>] | <keyspace> cancel <migration uuid>",
+				"Shows the online schema migrations of all the shards of a keyspace, with their status and progress, or cancels one of them. A cancelled migration leaves the table unchanged."},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-wait_slave_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -1890,6 +1895,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
 	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the SQL commands")
+	onlineDDL := subFlags.Bool("online_ddl", false, "Submit the ALTER TABLE statements as online schema migrations, instead of applying them directly.")
 	waitSlaveTimeout := subFlags.Duration("wait_slave_timeout", wrangler.DefaultWaitSlaveTimeout, "The amount of time to wait for slaves to receive the schema change via replication.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -1908,6 +1914,9 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if *allowLongUnavailability {
 		executor.AllowBigSchemaChange()
 	}
+	if *onlineDDL {
+		executor.EnableOnlineDDL()
+	}
 	return schemamanager.Run(
 		ctx,
 		schemamanager.NewPlainController(change, keyspace),
@@ -1915,6 +1924,76 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	)
 }
 
+func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 2 {
+		return fmt.Errorf("the <keyspace> and <command> arguments are required for the OnlineDDL command")
+	}
+	keyspace := subFlags.Arg(0)
+	uuid := subFlags.Arg(2)
+
+	var query string
+	var err error
+	switch command := subFlags.Arg(1); command {
+	case "show":
+		if subFlags.NArg() > 3 {
+			return fmt.Errorf("too many arguments for OnlineDDL show")
+		}
+		query, err = onlineddl.ShowQuery(uuid)
+	case "cancel":
+		if subFlags.NArg() != 3 {
+			return fmt.Errorf("the <migration uuid> argument is required for OnlineDDL cancel")
+		}
+		query, err = onlineddl.CancelQuery(uuid)
+	default:
+		return fmt.Errorf("unknown OnlineDDL command: %v", command)
+	}
+	if err != nil {
+		return err
+	}
+
+	shards, err := wr.TopoServer().GetShardNames(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	// The results of all the shards are merged, with an additional
+	// shard column.
+	qr := &sqltypes.Result{}
+	for _, shard := range shards {
+		si, err := wr.TopoServer().GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return err
+		}
+		if !si.HasMaster() {
+			return fmt.Errorf("shard %v/%v has no master", keyspace, shard)
+		}
+		qrproto, err := wr.ExecuteFetchAsDba(ctx, si.MasterAlias, query, 10000, false, false)
+		if err != nil {
+			return fmt.Errorf("cannot run the query on the master of %v/%v: %v", keyspace, shard, err)
+		}
+		shardResult := sqltypes.Proto3ToResult(qrproto)
+		if qr.Fields == nil && shardResult.Fields != nil {
+			qr.Fields = append([]*querypb.Field{{Name: "shard", Type: sqltypes.VarBinary}}, shardResult.Fields...)
+		}
+		for _, row := range shardResult.Rows {
+			qr.Rows = append(qr.Rows, append([]sqltypes.Value{sqltypes.NewVarBinary(shard)}, row...))
+		}
+		qr.RowsAffected += shardResult.RowsAffected
+	}
+
+	if subFlags.Arg(1) == "cancel" {
+		if qr.RowsAffected == 0 {
+			return fmt.Errorf("no queued or running migration %v", uuid)
+		}
+		wr.Logger().Printf("Requested the cancellation of migration %v on %v shard(s)\n", uuid, qr.RowsAffected)
+		return nil
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, qr)
+	return nil
+}
+
 func commandCopySchemaShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	tables := subFlags.String("tables", "", "Specifies a comma-separated list of tables to copy. Each is either an exact match, or a regular expression of the form /regexp/")
 	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
@@ -61,6 +61,18 @@ type Options struct {
 
 	// ExecutionMode must be set to one of the modes above
 	ExecutionMode string
+
+	// EstimateCost controls whether Run estimates the cost of the
+	// queries and attaches it to the explains
+	EstimateCost bool
+
+	// TableStats contains the optional statistics of the tables,
+	// used to estimate the rows returned by the routes
+	TableStats map[string]*TableStats
+
+	// Latency is the model used to simulate the latency of the
+	// queries. DefaultLatencyModel is used if it's not set
+	Latency *LatencyModel
 }
 
 // TabletQuery defines a query that was sent to a given tablet and how it was
@@ -127,6 +139,9 @@ type Explain struct {
 
 	// list of queries / bind vars sent to each tablet
 	TabletActions map[string]*TabletActions
+
+	// estimated cost of the plan(s), if requested
+	Cost *Cost `json:",omitempty"`
 }
 
 const (
@@ -155,6 +170,8 @@ func Init(vSchemaStr, sqlSchema string, opts *Options) error {
 		return fmt.Errorf("initVtgateExecutor: %v", err)
 	}
 
+	initCostEstimator(opts)
+
 	return nil
 }
 
@@ -250,11 +267,15 @@ func explain(sql string) (*Explain, error) {
 		return nil, err
 	}
 
-	return &Explain{
+	e := &Explain{
 		SQL:           sql,
 		Plans:         plans,
 		TabletActions: tabletActions,
-	}, nil
+	}
+	if estimator.attach {
+		e.Cost = estimator.planCost(sql, plans)
+	}
+	return e, nil
 }
 
 type outputQuery struct {
@@ -271,6 +292,21 @@ func ExplainsAsText(explains []*Explain) string {
 		fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
 		fmt.Fprintf(&b, "%s\n\n", explain.SQL)
 
+		var strategies []string
+		for _, plan := range explain.Plans {
+			strategies = append(strategies, joinStrategies(plan.Instructions)...)
+		}
+		for _, strategy := range strategies {
+			fmt.Fprintf(&b, "join strategy: %s\n", strategy)
+		}
+		if len(strategies) != 0 {
+			fmt.Fprintf(&b, "\n")
+		}
+
+		if explain.Cost != nil {
+			writeCostText(&b, explain.Cost)
+		}
+
 		queries := make([]outputQuery, 0, 4)
 		for tablet, actions := range explain.TabletActions {
 			for _, q := range actions.MysqlQueries {
@@ -300,6 +336,43 @@ func ExplainsAsText(explains []*Explain) string {
 	return string(b.Bytes())
 }
 
+// joinStrategies returns a description of the strategy of
+// every join in the plan, from the outermost to the innermost.
+func joinStrategies(prim engine.Primitive) []string {
+	switch prim := prim.(type) {
+	case *engine.Join:
+		strategy := prim.Strategy.String()
+		switch prim.Strategy {
+		case engine.BatchedJoin:
+			strategy = fmt.Sprintf("%s (batch size %d)", strategy, prim.BatchSize)
+		case engine.HashJoin:
+			strategy = fmt.Sprintf("%s (batch size %d, max rows %d)", strategy, prim.BatchSize, prim.MaxRows)
+		}
+		strategies := []string{strategy}
+		strategies = append(strategies, joinStrategies(prim.Left)...)
+		return append(strategies, joinStrategies(prim.Right)...)
+	case *engine.Subquery:
+		return joinStrategies(prim.Subquery)
+	case *engine.Limit:
+		return joinStrategies(prim.Input)
+	case *engine.OrderedAggregate:
+		return joinStrategies(prim.Input)
+	case *engine.Filter:
+		return joinStrategies(prim.Input)
+	case *engine.MemorySort:
+		return joinStrategies(prim.Input)
+	case *engine.Distinct:
+		return joinStrategies(prim.Source)
+	case *engine.Concatenate:
+		var strategies []string
+		for _, source := range prim.Sources {
+			strategies = append(strategies, joinStrategies(source)...)
+		}
+		return strategies
+	}
+	return nil
+}
+
 // ExplainsAsJSON returns a json representation of the explains
 func ExplainsAsJSON(explains []*Explain) string {
 	explainJSON, _ := jsonutil.MarshalIndentNoEscape(explains, "", "    ")
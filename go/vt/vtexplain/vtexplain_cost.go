@@ -0,0 +1,523 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file estimates the cost of the vtgate plans: the number of
+// rows returned by every route, the number of queries sent to the
+// tablets, and a simulated latency. The estimates are computed from
+// the plans and the optional table statistics, not from the simulated
+// execution, whose fake tablets always return the same rows.
+
+const (
+	// DefaultTableRows is the number of rows assumed for the tables
+	// that have no statistics.
+	DefaultTableRows = 1000
+
+	// defaultRowsPerKey is the number of rows assumed to share a
+	// value of a non-unique vindex, if the statistics of the table
+	// don't say. One row is assumed for the unique vindexes.
+	defaultRowsPerKey = 10
+)
+
+// TableStats contains the statistics of a table, used to estimate the
+// number of rows returned by the routes.
+type TableStats struct {
+	// Rows is the number of rows of the table, across all shards.
+	Rows int64
+
+	// RowsPerKey is the average number of rows that share a value
+	// of the vindex the table is routed with. A unique vindex maps
+	// a value to a single shard, but not necessarily to a single
+	// row, e.g. the orders of a user.
+	RowsPerKey int64 `json:",omitempty"`
+}
+
+// ParseTableStats parses the JSON representation of the statistics of
+// the tables, e.g. {"user": {"Rows": 1000000}}. The names of the
+// tables can be qualified by their keyspace, e.g. "ks.user".
+func ParseTableStats(statsStr string) (map[string]*TableStats, error) {
+	stats := make(map[string]*TableStats)
+	if err := json.Unmarshal([]byte(statsStr), &stats); err != nil {
+		return nil, err
+	}
+	for name, ts := range stats {
+		if ts == nil || ts.Rows < 0 || ts.RowsPerKey < 0 {
+			return nil, fmt.Errorf("invalid statistics for table %v", name)
+		}
+	}
+	return stats, nil
+}
+
+// LatencyModel describes how the latency of a query is simulated.
+// Every route is one round trip to its shards, and the executions of
+// the right side of a join follow the ones of its left side.
+type LatencyModel struct {
+	// RoundTrip is the latency of a query sent by vtgate to a
+	// single tablet.
+	RoundTrip time.Duration
+
+	// PerShard is the latency added by every additional shard of a
+	// route, since vtgate waits for the slowest of them.
+	PerShard time.Duration
+
+	// PerRow is the latency added by every row a route returns.
+	PerRow time.Duration
+}
+
+// DefaultLatencyModel is used if Options.Latency is not set.
+var DefaultLatencyModel = LatencyModel{
+	RoundTrip: time.Millisecond,
+	PerShard:  100 * time.Microsecond,
+	PerRow:    2 * time.Microsecond,
+}
+
+// Cost is the estimated cost of a query.
+type Cost struct {
+	// Rows is the estimated number of rows returned, or changed,
+	// by the query. The rows of the lookup vindexes are not
+	// included.
+	Rows int64
+
+	// RoundTrips is the estimated number of queries sent to the
+	// tablets.
+	RoundTrips int64
+
+	// MaxShards is the number of shards of the widest route.
+	MaxShards int
+
+	// Latency is the simulated latency of the query.
+	Latency time.Duration
+
+	// Routes contains the estimates of the routes, plan by plan.
+	// The plans of the queries of the lookup vindexes are included.
+	Routes []*RouteCost
+}
+
+// RouteCost is the estimated cost of a route of a plan.
+type RouteCost struct {
+	// Opcode is the opcode of the route, e.g. SelectScatter.
+	Opcode string
+
+	// Keyspace is the keyspace the route is sent to.
+	Keyspace string
+
+	// Query is the query sent to the shards.
+	Query string
+
+	// Shards is the number of shards the route is sent to.
+	Shards int
+
+	// Scatter is true if the route is sent to all the shards of a
+	// sharded keyspace, because it can't be routed with a vindex.
+	Scatter bool
+
+	// Rows is the estimated number of rows returned by one
+	// execution of the route.
+	Rows int64
+
+	// Executions is the estimated number of times the route is
+	// executed. It is more than one on the right side of a join.
+	Executions int64
+}
+
+// costEstimator estimates the cost of the plans of the topology
+// simulated by vtexplain.
+type costEstimator struct {
+	// attach is set if Run attaches the cost to the explains.
+	attach bool
+
+	numShards int
+	stats     map[string]*TableStats
+	latency   LatencyModel
+}
+
+var estimator *costEstimator
+
+func initCostEstimator(opts *Options) {
+	estimator = &costEstimator{
+		attach:    opts.EstimateCost,
+		numShards: opts.NumShards,
+		stats:     opts.TableStats,
+		latency:   DefaultLatencyModel,
+	}
+	if opts.Latency != nil {
+		estimator.latency = *opts.Latency
+	}
+}
+
+// planCost returns the estimated cost of executing all the plans of
+// sql.
+func (ce *costEstimator) planCost(sql string, plans []*engine.Plan) *Cost {
+	// The list bind variables of the plans come from the
+	// normalization of sql, and the join vars of BatchedJoin.
+	listSizes := make(map[string]int64)
+	if stmt, err := sqlparser.Parse(sql); err == nil {
+		bindVars := make(map[string]*querypb.BindVariable)
+		sqlparser.Normalize(stmt, bindVars, "vtg")
+		for name, bv := range bindVars {
+			if bv.Type == querypb.Type_TUPLE {
+				listSizes[name] = int64(len(bv.Values))
+			}
+		}
+	}
+
+	// The plans come from the plan cache, most recently used first:
+	// the plan of sql is the last one, after the plans of the
+	// queries of its lookup vindexes.
+	cost := &Cost{}
+	for i, plan := range plans {
+		// The plans of the lookup vindexes are executed once per
+		// lookup.
+		executions := int64(plan.ExecCount)
+		if executions < 1 {
+			executions = 1
+		}
+		rows, latency := ce.primitiveCost(cost, plan.Instructions, executions, listSizes)
+		if i == len(plans)-1 {
+			cost.Rows = rows
+		}
+		cost.Latency += time.Duration(executions) * latency
+	}
+	return cost
+}
+
+// primitiveCost returns the estimated number of rows returned by one
+// execution of prim, and its latency. The routes of prim are added to
+// cost as executed the given number of times. listSizes contains the
+// number of values of the list bind variables prim is executed with.
+func (ce *costEstimator) primitiveCost(cost *Cost, prim engine.Primitive, executions int64, listSizes map[string]int64) (int64, time.Duration) {
+	switch prim := prim.(type) {
+	case *engine.Route:
+		var shards int
+		var rows int64
+		switch prim.Opcode {
+		case engine.SelectEqualUnique, engine.SelectEqual:
+			shards, rows = 1, ce.vindexRows(prim.Keyspace, prim.Query, prim.Vindex)
+		case engine.SelectIN:
+			shards, rows = ce.inCost(prim.Keyspace, prim.Query, prim.Vindex, prim.Values, listSizes)
+		case engine.SelectScatter:
+			shards, rows = ce.keyspaceShards(prim.Keyspace), ce.tableRows(prim.Keyspace, prim.Query)
+		case engine.SelectUnsharded:
+			shards, rows = 1, ce.tableRows(prim.Keyspace, prim.Query)
+		default:
+			shards, rows = 1, 1
+		}
+		scatter := prim.Opcode == engine.SelectScatter && shards > 1
+		return rows, ce.addRoute(cost, prim.Opcode, prim.Keyspace, prim.Query, shards, scatter, rows, executions)
+	case *engine.Update:
+		var shards int
+		var rows int64
+		switch prim.Opcode {
+		case engine.UpdateEqual:
+			shards, rows = 1, ce.vindexRows(prim.Keyspace, prim.Query, prim.Vindex)
+		case engine.UpdateIN:
+			shards, rows = ce.inCost(prim.Keyspace, prim.Query, prim.Vindex, prim.Values, listSizes)
+		case engine.UpdateSharded:
+			shards, rows = ce.keyspaceShards(prim.Keyspace), ce.tableRows(prim.Keyspace, prim.Query)
+		default:
+			shards, rows = 1, ce.tableRows(prim.Keyspace, prim.Query)
+		}
+		scatter := prim.Opcode == engine.UpdateSharded && shards > 1
+		return rows, ce.addRoute(cost, prim.Opcode, prim.Keyspace, prim.Query, shards, scatter, rows, executions)
+	case *engine.Delete:
+		var shards int
+		var rows int64
+		switch prim.Opcode {
+		case engine.DeleteEqual:
+			shards, rows = 1, ce.vindexRows(prim.Keyspace, prim.Query, prim.Vindex)
+		case engine.DeleteIN:
+			shards, rows = ce.inCost(prim.Keyspace, prim.Query, prim.Vindex, prim.Values, listSizes)
+		case engine.DeleteSharded:
+			shards, rows = ce.keyspaceShards(prim.Keyspace), ce.tableRows(prim.Keyspace, prim.Query)
+		default:
+			shards, rows = 1, ce.tableRows(prim.Keyspace, prim.Query)
+		}
+		scatter := prim.Opcode == engine.DeleteSharded && shards > 1
+		return rows, ce.addRoute(cost, prim.Opcode, prim.Keyspace, prim.Query, shards, scatter, rows, executions)
+	case *engine.Insert:
+		query := prim.Query
+		rows := int64(1)
+		shards := 1
+		if prim.Opcode != engine.InsertUnsharded {
+			query = prim.Prefix + prim.Suffix
+			rows = int64(len(prim.Mid))
+			shards = minShards(rows, ce.keyspaceShards(prim.Keyspace))
+		} else if stmt, err := sqlparser.Parse(prim.Query); err == nil {
+			if ins, ok := stmt.(*sqlparser.Insert); ok {
+				if values, ok := ins.Rows.(sqlparser.Values); ok {
+					rows = int64(len(values))
+				}
+			}
+		}
+		return rows, ce.addRoute(cost, prim.Opcode, prim.Keyspace, query, shards, false, rows, executions)
+	case *engine.Join:
+		return ce.joinCost(cost, prim, executions, listSizes)
+	case *engine.Subquery:
+		return ce.primitiveCost(cost, prim.Subquery, executions, listSizes)
+	case *engine.Limit:
+		rows, latency := ce.primitiveCost(cost, prim.Input, executions, listSizes)
+		if count, err := sqltypes.ToInt64(prim.Count.Value); err == nil && prim.Count.Key == "" && count < rows {
+			rows = count
+		}
+		return rows, latency
+	case *engine.OrderedAggregate:
+		rows, latency := ce.primitiveCost(cost, prim.Input, executions, listSizes)
+		if len(prim.Keys) == 0 {
+			rows = 1
+		}
+		return rows, latency
+	case *engine.Filter:
+		return ce.primitiveCost(cost, prim.Input, executions, listSizes)
+	case *engine.MemorySort:
+		return ce.primitiveCost(cost, prim.Input, executions, listSizes)
+	case *engine.Distinct:
+		return ce.primitiveCost(cost, prim.Source, executions, listSizes)
+	case *engine.Concatenate:
+		var rows int64
+		var latency time.Duration
+		for _, source := range prim.Sources {
+			srows, slatency := ce.primitiveCost(cost, source, executions, listSizes)
+			rows += srows
+			latency += slatency
+		}
+		return rows, latency
+	}
+	// The other primitives, like VindexFunc, don't send queries
+	// to the tablets.
+	return 1, 0
+}
+
+// joinCost estimates the cost of a join. Right is executed once per
+// row of Left for NestedLoop, and once per batch of rows for BatchedJoin
+// and HashJoin.
+func (ce *costEstimator) joinCost(cost *Cost, jn *engine.Join, executions int64, listSizes map[string]int64) (int64, time.Duration) {
+	lrows, llatency := ce.primitiveCost(cost, jn.Left, executions, listSizes)
+
+	var rexecutions, rlistSize int64
+	switch jn.Strategy {
+	case engine.BatchedJoin, engine.HashJoin:
+		batchSize := int64(jn.BatchSize)
+		if batchSize < 1 {
+			batchSize = 1
+		}
+		rexecutions = (lrows + batchSize - 1) / batchSize
+		rlistSize = batchSize
+		if lrows < batchSize {
+			rlistSize = lrows
+		}
+	default:
+		rexecutions, rlistSize = lrows, 1
+	}
+	if rexecutions < 1 {
+		rexecutions = 1
+	}
+	if rlistSize < 1 {
+		rlistSize = 1
+	}
+	rlistSizes := listSizes
+	if jn.ListVar != "" {
+		rlistSizes = make(map[string]int64, len(listSizes)+1)
+		for name, size := range listSizes {
+			rlistSizes[name] = size
+		}
+		rlistSizes[jn.ListVar] = rlistSize
+	}
+	rrows, rlatency := ce.primitiveCost(cost, jn.Right, executions*rexecutions, rlistSizes)
+
+	var rows int64
+	switch jn.Strategy {
+	case engine.BatchedJoin, engine.HashJoin:
+		rows = lrows * rrows / rlistSize
+	default:
+		rows = lrows * rrows
+	}
+	if jn.Opcode == engine.LeftJoin && rows < lrows {
+		rows = lrows
+	}
+	return rows, llatency + time.Duration(rexecutions)*rlatency
+}
+
+// addRoute adds a route to cost, and returns the latency of one of
+// its executions.
+func (ce *costEstimator) addRoute(cost *Cost, opcode json.Marshaler, keyspace *vindexes.Keyspace, query string, shards int, scatter bool, rows, executions int64) time.Duration {
+	rc := &RouteCost{
+		Opcode:     opcodeName(opcode),
+		Query:      query,
+		Shards:     shards,
+		Scatter:    scatter,
+		Rows:       rows,
+		Executions: executions,
+	}
+	if keyspace != nil {
+		rc.Keyspace = keyspace.Name
+	}
+	cost.Routes = append(cost.Routes, rc)
+	cost.RoundTrips += executions * int64(shards)
+	if shards > cost.MaxShards {
+		cost.MaxShards = shards
+	}
+	return ce.latency.RoundTrip + time.Duration(shards-1)*ce.latency.PerShard + time.Duration(rows)*ce.latency.PerRow
+}
+
+// inCost returns the number of shards and rows of a route for the
+// values of an IN clause.
+func (ce *costEstimator) inCost(keyspace *vindexes.Keyspace, query string, vindex vindexes.Vindex, values []sqltypes.PlanValue, listSizes map[string]int64) (int, int64) {
+	numValues := int64(1)
+	if len(values) != 0 {
+		switch {
+		case values[0].ListKey != "":
+			if size, ok := listSizes[values[0].ListKey]; ok && size > 0 {
+				numValues = size
+			}
+		case values[0].Values != nil:
+			numValues = int64(len(values[0].Values))
+		}
+	}
+	return minShards(numValues, ce.keyspaceShards(keyspace)), numValues * ce.vindexRows(keyspace, query, vindex)
+}
+
+// vindexRows returns the number of rows of the tables of query for a
+// single value of vindex.
+func (ce *costEstimator) vindexRows(keyspace *vindexes.Keyspace, query string, vindex vindexes.Vindex) int64 {
+	defaultRows := int64(defaultRowsPerKey)
+	if vindex != nil && vindex.IsUnique() {
+		defaultRows = 1
+	}
+	var rows int64
+	for _, ts := range ce.queryStats(keyspace, query) {
+		tsRows := ts.RowsPerKey
+		if tsRows == 0 {
+			tsRows = defaultRows
+		}
+		if tsRows > ts.Rows {
+			tsRows = ts.Rows
+		}
+		if tsRows > rows {
+			rows = tsRows
+		}
+	}
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// tableRows returns the number of rows of the biggest table of query,
+// as an upper bound of the rows the query reads.
+func (ce *costEstimator) tableRows(keyspace *vindexes.Keyspace, query string) int64 {
+	var rows int64
+	for _, ts := range ce.queryStats(keyspace, query) {
+		if ts.Rows > rows {
+			rows = ts.Rows
+		}
+	}
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// queryStats returns the statistics of the tables of query.
+func (ce *costEstimator) queryStats(keyspace *vindexes.Keyspace, query string) []*TableStats {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil
+	}
+	var stats []*TableStats
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		aliased, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		if tableName, ok := aliased.Expr.(sqlparser.TableName); ok && !tableName.Name.IsEmpty() {
+			qualifier := tableName.Qualifier.String()
+			if qualifier == "" && keyspace != nil {
+				qualifier = keyspace.Name
+			}
+			stats = append(stats, ce.tableStats(qualifier, tableName.Name.String()))
+		}
+		return true, nil
+	}, stmt)
+	return stats
+}
+
+// tableStats returns the statistics of a table, or the default ones.
+func (ce *costEstimator) tableStats(keyspace, table string) *TableStats {
+	if ts, ok := ce.stats[keyspace+"."+table]; ok {
+		return ts
+	}
+	if ts, ok := ce.stats[table]; ok {
+		return ts
+	}
+	return &TableStats{Rows: DefaultTableRows}
+}
+
+// keyspaceShards returns the number of shards of a keyspace.
+func (ce *costEstimator) keyspaceShards(keyspace *vindexes.Keyspace) int {
+	if keyspace == nil || !keyspace.Sharded {
+		return 1
+	}
+	return ce.numShards
+}
+
+// minShards returns the number of shards a number of values maps to,
+// at most.
+func minShards(values int64, shards int) int {
+	if values < 1 {
+		return 1
+	}
+	if values < int64(shards) {
+		return int(values)
+	}
+	return shards
+}
+
+// writeCostText writes the text representation of a cost.
+func writeCostText(b *bytes.Buffer, cost *Cost) {
+	fmt.Fprintf(b, "estimated cost: %d rows, %d round trips, %d shards max, latency %v\n", cost.Rows, cost.RoundTrips, cost.MaxShards, cost.Latency)
+	for _, rc := range cost.Routes {
+		fmt.Fprintf(b, "route %s %s: %d shards, %d rows x %d executions: %s\n", rc.Opcode, rc.Keyspace, rc.Shards, rc.Rows, rc.Executions, rc.Query)
+	}
+	fmt.Fprintf(b, "\n")
+}
+
+// opcodeName returns the name of the opcode of a primitive.
+func opcodeName(opcode json.Marshaler) string {
+	data, err := opcode.MarshalJSON()
+	if err != nil {
+		return ""
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return ""
+	}
+	return name
+}
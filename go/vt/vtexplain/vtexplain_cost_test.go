@@ -0,0 +1,233 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/testfiles"
+)
+
+func costTestOpts(t *testing.T) *Options {
+	stats, err := ParseTableStats(`{
+		"user": {"Rows": 10000},
+		"ks_sharded.music": {"Rows": 50000, "RowsPerKey": 50},
+		"music_extra": {"Rows": 50000}
+	}`)
+	if err != nil {
+		t.Fatalf("ParseTableStats failed: %v", err)
+	}
+	opts := defaultTestOpts()
+	opts.EstimateCost = true
+	opts.TableStats = stats
+	return opts
+}
+
+func TestCost(t *testing.T) {
+	initTest(ModeMulti, costTestOpts(t), t)
+
+	testcases := []struct {
+		sql        string
+		rows       int64
+		roundTrips int64
+		maxShards  int
+		latency    time.Duration
+		routes     []RouteCost
+	}{{
+		sql:        "select * from user",
+		rows:       10000,
+		roundTrips: 4,
+		maxShards:  4,
+		latency:    time.Millisecond + 3*100*time.Microsecond + 10000*2*time.Microsecond,
+		routes: []RouteCost{{
+			Opcode:     "SelectScatter",
+			Keyspace:   "ks_sharded",
+			Shards:     4,
+			Scatter:    true,
+			Rows:       10000,
+			Executions: 1,
+		}},
+	}, {
+		// The two values of the normalized list go through the
+		// lookup vindex first.
+		sql:        "select * from user where name in ('alice', 'bob')",
+		rows:       2,
+		roundTrips: 4,
+		maxShards:  2,
+		latency:    2*(time.Millisecond+2*time.Microsecond) + time.Millisecond + 100*time.Microsecond + 2*2*time.Microsecond,
+		routes: []RouteCost{{
+			Opcode:     "SelectEqualUnique",
+			Keyspace:   "ks_sharded",
+			Shards:     1,
+			Rows:       1,
+			Executions: 2,
+		}, {
+			Opcode:     "SelectIN",
+			Keyspace:   "ks_sharded",
+			Shards:     2,
+			Rows:       2,
+			Executions: 1,
+		}},
+	}, {
+		// The right side is executed once for each of the 50
+		// rows of the user.
+		sql:        "select m.id, m.song, e.extra from music m join music_extra e on m.id = e.id where m.user_id = 100",
+		rows:       50,
+		roundTrips: 51,
+		maxShards:  1,
+		latency:    time.Millisecond + 50*2*time.Microsecond + 50*(time.Millisecond+2*time.Microsecond),
+		routes: []RouteCost{{
+			Opcode:     "SelectEqualUnique",
+			Keyspace:   "ks_sharded",
+			Shards:     1,
+			Rows:       50,
+			Executions: 1,
+		}, {
+			Opcode:     "SelectEqualUnique",
+			Keyspace:   "ks_sharded",
+			Shards:     1,
+			Rows:       1,
+			Executions: 50,
+		}},
+	}, {
+		// The right side is executed once per batch of 100 rows.
+		sql:        "select m.id, m.song, e.extra from music m join music_extra e on m.id = e.id",
+		rows:       50000,
+		roundTrips: 4 + 500*4,
+		maxShards:  4,
+		latency:    time.Millisecond + 3*100*time.Microsecond + 50000*2*time.Microsecond + 500*(time.Millisecond+3*100*time.Microsecond+100*2*time.Microsecond),
+		routes: []RouteCost{{
+			Opcode:     "SelectScatter",
+			Keyspace:   "ks_sharded",
+			Shards:     4,
+			Scatter:    true,
+			Rows:       50000,
+			Executions: 1,
+		}, {
+			Opcode:     "SelectIN",
+			Keyspace:   "ks_sharded",
+			Shards:     4,
+			Rows:       100,
+			Executions: 500,
+		}},
+	}, {
+		sql:        "update user set nickname = 'bob'",
+		rows:       10000,
+		roundTrips: 4,
+		maxShards:  4,
+		latency:    time.Millisecond + 3*100*time.Microsecond + 10000*2*time.Microsecond,
+		routes: []RouteCost{{
+			Opcode:     "UpdateSharded",
+			Keyspace:   "ks_sharded",
+			Shards:     4,
+			Scatter:    true,
+			Rows:       10000,
+			Executions: 1,
+		}},
+	}}
+
+	for _, tcase := range testcases {
+		explains, err := Run(tcase.sql)
+		if err != nil {
+			t.Fatalf("Run(%v) failed: %v", tcase.sql, err)
+		}
+		cost := explains[0].Cost
+		if cost == nil {
+			t.Fatalf("Run(%v) didn't estimate the cost", tcase.sql)
+		}
+		if cost.Rows != tcase.rows || cost.RoundTrips != tcase.roundTrips || cost.MaxShards != tcase.maxShards || cost.Latency != tcase.latency {
+			t.Errorf("Run(%v): rows %v, round trips %v, max shards %v, latency %v, want %v, %v, %v, %v", tcase.sql, cost.Rows, cost.RoundTrips, cost.MaxShards, cost.Latency, tcase.rows, tcase.roundTrips, tcase.maxShards, tcase.latency)
+		}
+		if len(cost.Routes) != len(tcase.routes) {
+			t.Errorf("Run(%v): %v routes, want %v", tcase.sql, len(cost.Routes), len(tcase.routes))
+			continue
+		}
+		for i, rc := range cost.Routes {
+			got := *rc
+			got.Query = ""
+			if got != tcase.routes[i] {
+				t.Errorf("Run(%v): route %v is %+v, want %+v", tcase.sql, i, got, tcase.routes[i])
+			}
+		}
+	}
+
+	// Without statistics, the tables are assumed to have
+	// DefaultTableRows rows.
+	initTest(ModeMulti, defaultTestOpts(), t)
+	explains, err := Run("select * from user")
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if explains[0].Cost != nil {
+		t.Errorf("Run estimated the cost without EstimateCost: %+v", explains[0].Cost)
+	}
+	opts := defaultTestOpts()
+	opts.EstimateCost = true
+	initTest(ModeMulti, opts, t)
+	explains, err = Run("select * from user")
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if got := explains[0].Cost.Rows; got != DefaultTableRows {
+		t.Errorf("Run without statistics: %v rows, want %v", got, DefaultTableRows)
+	}
+}
+
+func TestParseTableStats(t *testing.T) {
+	for _, stats := range []string{
+		`{"user": {"Rows": -1}}`,
+		`{"user": null}`,
+		`not json`,
+	} {
+		if _, err := ParseTableStats(stats); err == nil {
+			t.Errorf("ParseTableStats(%v) didn't fail", stats)
+		}
+	}
+}
+
+func TestWorkload(t *testing.T) {
+	initTest(ModeMulti, costTestOpts(t), t)
+
+	queryLog, err := ioutil.ReadFile(testfiles.Locate("vtexplain/workload-queries.log"))
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	report, err := RunWorkload(string(queryLog))
+	if err != nil {
+		t.Fatalf("RunWorkload failed: %v", err)
+	}
+
+	textOutFile := testfiles.Locate("vtexplain/workload-output.txt")
+	textOut, _ := ioutil.ReadFile(textOutFile)
+	reportText := WorkloadReportAsText(report)
+	if strings.TrimSpace(reportText) != strings.TrimSpace(string(textOut)) {
+		t.Errorf("Text output did not match, got:\n%s", reportText)
+	}
+
+	var data interface{}
+	if err := json.Unmarshal([]byte(WorkloadReportAsJSON(report)), &data); err != nil {
+		t.Errorf("invalid JSON report: %v", err)
+	}
+
+	if _, err := RunWorkload("# only comments\n\n"); err == nil {
+		t.Errorf("RunWorkload of an empty query log didn't fail")
+	}
+}
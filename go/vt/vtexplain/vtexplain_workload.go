@@ -0,0 +1,249 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+
+	"vitess.io/vitess/go/jsonutil"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file analyzes a whole workload, e.g. the query log of an
+// application, and aggregates the results per distinct query.
+
+// WorkloadReport is the aggregate analysis of a workload.
+type WorkloadReport struct {
+	// Queries is the number of statements of the workload.
+	Queries int
+
+	// Entries contains the analysis of every distinct query of
+	// the workload, the most expensive in total first.
+	Entries []*WorkloadEntry
+}
+
+// WorkloadEntry is the analysis of the statements of a workload that
+// only differ by their literals.
+type WorkloadEntry struct {
+	// SQL is the query, with its literals replaced by bind variables.
+	SQL string
+
+	// Example is the first statement of the workload that matched.
+	Example string
+
+	// Count is the number of statements of the workload that matched.
+	Count int
+
+	// Scatter is true if a route of the query is sent to all the
+	// shards of a keyspace.
+	Scatter bool
+
+	// NestedLoopJoins is the number of joins of the query that
+	// execute their right side once per row of their left side.
+	NestedLoopJoins int
+
+	// Cost is the estimated cost of one execution of the query.
+	Cost *Cost `json:",omitempty"`
+
+	// Error is set if the query is not supported.
+	Error string `json:",omitempty"`
+}
+
+// totalLatency returns the simulated latency of all the executions.
+func (we *WorkloadEntry) totalLatency() time.Duration {
+	if we.Cost == nil {
+		return 0
+	}
+	return time.Duration(we.Count) * we.Cost.Latency
+}
+
+// RunWorkload analyzes a query log, with one statement per line. Empty
+// lines and lines starting with # or -- are skipped. Every distinct
+// query is explained once, and the statements that fail to parse or
+// to plan are reported as unsupported instead of failing the analysis.
+func RunWorkload(queryLog string) (*WorkloadReport, error) {
+	report := &WorkloadReport{}
+	entries := make(map[string]*WorkloadEntry)
+	for _, line := range strings.Split(queryLog, "\n") {
+		sql := strings.TrimSpace(line)
+		if sql == "" || strings.HasPrefix(sql, "#") || strings.HasPrefix(sql, "--") {
+			continue
+		}
+		sql = strings.TrimSpace(strings.TrimSuffix(sql, ";"))
+		if sql == "" {
+			continue
+		}
+		report.Queries++
+
+		key, err := normalizeWorkloadQuery(sql)
+		if err != nil {
+			// Statements that don't parse are reported as is.
+			key = sql
+		}
+		if entry, ok := entries[key]; ok {
+			entry.Count++
+			continue
+		}
+		entry := &WorkloadEntry{
+			SQL:     key,
+			Example: sql,
+			Count:   1,
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		} else {
+			analyzeWorkloadEntry(entry)
+		}
+		entries[key] = entry
+		report.Entries = append(report.Entries, entry)
+	}
+	if report.Queries == 0 {
+		return nil, fmt.Errorf("no statements in the query log")
+	}
+
+	sort.SliceStable(report.Entries, func(i, j int) bool {
+		return report.Entries[i].totalLatency() > report.Entries[j].totalLatency()
+	})
+	return report, nil
+}
+
+// normalizeWorkloadQuery returns the query with its literals replaced
+// by bind variables, so the statements that only differ by their
+// literals are analyzed together.
+func normalizeWorkloadQuery(sql string) (string, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return "", err
+	}
+	sqlparser.Normalize(stmt, make(map[string]*querypb.BindVariable), "v")
+	return sqlparser.String(stmt), nil
+}
+
+// analyzeWorkloadEntry explains the example of entry, and fills in its
+// analysis.
+func analyzeWorkloadEntry(entry *WorkloadEntry) {
+	batchTime = sync2.NewBatcher(time.Duration(10 * time.Millisecond))
+	log.V(100).Infof("explain workload query %s", entry.Example)
+	e, err := explain(entry.Example)
+	if err != nil {
+		entry.Error = err.Error()
+		return
+	}
+	entry.Cost = e.Cost
+	if entry.Cost == nil {
+		entry.Cost = estimator.planCost(e.SQL, e.Plans)
+	}
+	for _, rc := range entry.Cost.Routes {
+		if rc.Scatter {
+			entry.Scatter = true
+		}
+	}
+	for _, plan := range e.Plans {
+		for _, strategy := range joinStrategies(plan.Instructions) {
+			if strings.HasPrefix(strategy, engine.NestedLoop.String()) {
+				entry.NestedLoopJoins++
+			}
+		}
+	}
+}
+
+// WorkloadReportAsText returns a text representation of the report:
+// the summary, the scatter queries, the queries with nested-loop
+// joins, the unsupported queries, and all the queries by their total
+// simulated latency.
+func WorkloadReportAsText(report *WorkloadReport) string {
+	var scatter, nestedLoop, unsupported []*WorkloadEntry
+	for _, entry := range report.Entries {
+		if entry.Error != "" {
+			unsupported = append(unsupported, entry)
+			continue
+		}
+		if entry.Scatter {
+			scatter = append(scatter, entry)
+		}
+		if entry.NestedLoopJoins != 0 {
+			nestedLoop = append(nestedLoop, entry)
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "workload: %d queries, %d distinct\n", report.Queries, len(report.Entries))
+	fmt.Fprintf(&b, "scatter: %d queries, %d distinct\n", countQueries(scatter), len(scatter))
+	fmt.Fprintf(&b, "nested-loop joins: %d queries, %d distinct\n", countQueries(nestedLoop), len(nestedLoop))
+	fmt.Fprintf(&b, "unsupported: %d queries, %d distinct\n", countQueries(unsupported), len(unsupported))
+	fmt.Fprintf(&b, "\n")
+
+	fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
+	fmt.Fprintf(&b, "scatter queries\n\n")
+	for _, entry := range scatter {
+		fmt.Fprintf(&b, "%d x %s\n", entry.Count, entry.SQL)
+		fmt.Fprintf(&b, "    %d shards, %d round trips, latency %v\n", entry.Cost.MaxShards, entry.Cost.RoundTrips, entry.Cost.Latency)
+	}
+	fmt.Fprintf(&b, "\n")
+
+	fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
+	fmt.Fprintf(&b, "nested-loop joins\n\n")
+	for _, entry := range nestedLoop {
+		fmt.Fprintf(&b, "%d x %s\n", entry.Count, entry.SQL)
+		fmt.Fprintf(&b, "    %d nested-loop joins, %d round trips, latency %v\n", entry.NestedLoopJoins, entry.Cost.RoundTrips, entry.Cost.Latency)
+	}
+	fmt.Fprintf(&b, "\n")
+
+	fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
+	fmt.Fprintf(&b, "unsupported queries\n\n")
+	for _, entry := range unsupported {
+		fmt.Fprintf(&b, "%d x %s\n", entry.Count, entry.SQL)
+		fmt.Fprintf(&b, "    %s\n", entry.Error)
+	}
+	fmt.Fprintf(&b, "\n")
+
+	fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
+	fmt.Fprintf(&b, "queries by total latency\n\n")
+	for _, entry := range report.Entries {
+		if entry.Cost == nil {
+			continue
+		}
+		fmt.Fprintf(&b, "%v (%d x %v): %s\n", entry.totalLatency(), entry.Count, entry.Cost.Latency, entry.SQL)
+	}
+	fmt.Fprintf(&b, "\n")
+	fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
+	return b.String()
+}
+
+func countQueries(entries []*WorkloadEntry) int {
+	count := 0
+	for _, entry := range entries {
+		count += entry.Count
+	}
+	return count
+}
+
+// WorkloadReportAsJSON returns a json representation of the report
+func WorkloadReportAsJSON(report *WorkloadReport) string {
+	reportJSON, _ := jsonutil.MarshalIndentNoEscape(report, "", "    ")
+	return string(reportJSON)
+}
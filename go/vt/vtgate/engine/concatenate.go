@@ -0,0 +1,128 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Concatenate)(nil)
+
+// errColumnCountMismatch is returned if the sources of a
+// Concatenate don't return the same number of columns.
+var errColumnCountMismatch = errors.New("the used SELECT statements have a different number of columns")
+
+// Concatenate is a primitive that returns the rows of all its
+// Sources, one after the other. It's used to perform a UNION ALL
+// of queries that can't be sent to the same route. The fields
+// of the result are the ones of the first source.
+type Concatenate struct {
+	Sources []Primitive
+}
+
+// MarshalJSON serializes the Concatenate into a JSON representation.
+// It's used for testing and diagnostics.
+func (c *Concatenate) MarshalJSON() ([]byte, error) {
+	marshalConcatenate := struct {
+		Opcode  string
+		Sources []Primitive
+	}{
+		Opcode:  "Concatenate",
+		Sources: c.Sources,
+	}
+	return json.Marshal(marshalConcatenate)
+}
+
+// Execute satisfies the Primitive interface.
+func (c *Concatenate) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	width := -1
+	for i, source := range c.Sources {
+		qr, err := source.Execute(vcursor, bindVars, wantfields)
+		if err != nil {
+			return nil, err
+		}
+		if width, err = checkColumnCount(width, qr); err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result.Fields = qr.Fields
+			result.Extras = qr.Extras
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (c *Concatenate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	width := -1
+	for i, source := range c.Sources {
+		first := i == 0
+		err := source.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+			var err error
+			if width, err = checkColumnCount(width, qr); err != nil {
+				return err
+			}
+			if first {
+				return callback(qr)
+			}
+			// Only the fields of the first source are sent.
+			if len(qr.Rows) == 0 {
+				return nil
+			}
+			return callback(&sqltypes.Result{Rows: qr.Rows})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFields satisfies the Primitive interface.
+func (c *Concatenate) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return c.Sources[0].GetFields(vcursor, bindVars)
+}
+
+// checkColumnCount verifies that qr has the same number of
+// columns as the previous results, and returns that number.
+// A width of -1 means that it's not known yet.
+func checkColumnCount(width int, qr *sqltypes.Result) (int, error) {
+	cur := -1
+	switch {
+	case len(qr.Fields) != 0:
+		cur = len(qr.Fields)
+	case len(qr.Rows) != 0:
+		cur = len(qr.Rows[0])
+	}
+	switch {
+	case cur == -1:
+		return width, nil
+	case width == -1:
+		return cur, nil
+	case cur != width:
+		return width, errColumnCountMismatch
+	}
+	return width, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestConcatenateExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|varbinary",
+	)
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a",
+			"2|b",
+			"3|c",
+		)},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"uid|name",
+				"int64|varbinary",
+			),
+			"4|d",
+			"1|a",
+		)},
+	}
+	c := &Concatenate{
+		Sources: []Primitive{leftPrim, rightPrim},
+	}
+
+	result, err := c.Execute(nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|b",
+		"3|c",
+		"4|d",
+		"1|a",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("c.Execute:\n%v, want\n%v", result, wantResult)
+	}
+
+	// StreamExecute only sends the fields of the first source.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	var results []*sqltypes.Result
+	err = c.StreamExecute(nil, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResults := sqltypes.MakeTestStreamingResults(
+		fields,
+		"1|a",
+		"2|b",
+		"---",
+		"3|c",
+		"---",
+		"4|d",
+		"1|a",
+	)
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("c.StreamExecute:\n%s, want\n%s", sqltypes.PrintResults(results), sqltypes.PrintResults(wantResults))
+	}
+
+	leftPrim.rewind()
+	result, err = c.GetFields(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result.Fields, fields) {
+		t.Errorf("c.GetFields:\n%v, want\n%v", result.Fields, fields)
+	}
+}
+
+func TestConcatenateColumnCountMismatch(t *testing.T) {
+	c := &Concatenate{
+		Sources: []Primitive{
+			&fakePrimitive{
+				results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"id|col",
+						"int64|varbinary",
+					),
+					"1|a",
+				)},
+			},
+			&fakePrimitive{
+				results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"id",
+						"int64",
+					),
+					"2",
+				)},
+			},
+		},
+	}
+
+	want := "the used SELECT statements have a different number of columns"
+	if _, err := c.Execute(nil, nil, true); err == nil || err.Error() != want {
+		t.Errorf("c.Execute(): %v, want %s", err, want)
+	}
+}
+
+func TestConcatenateInputFail(t *testing.T) {
+	c := &Concatenate{
+		Sources: []Primitive{
+			&fakePrimitive{
+				results: []*sqltypes.Result{sqltypes.MakeTestResult(
+					sqltypes.MakeTestFields(
+						"id",
+						"int64",
+					),
+					"1",
+				)},
+			},
+			&fakePrimitive{sendErr: errors.New("input fail")},
+		},
+	}
+
+	want := "input fail"
+	if _, err := c.Execute(nil, nil, false); err == nil || err.Error() != want {
+		t.Errorf("c.Execute(): %v, want %s", err, want)
+	}
+	c.Sources[0].(*fakePrimitive).rewind()
+	if err := c.StreamExecute(nil, nil, false, func(_ *sqltypes.Result) error { return nil }); err == nil || err.Error() != want {
+		t.Errorf("c.StreamExecute(): %v, want %s", err, want)
+	}
+}
@@ -56,6 +56,10 @@ type Delete struct {
 
 	// OwnedVindexQuery is used for deleting lookup vindex entries.
 	OwnedVindexQuery string
+
+	// Limit specifies the max number of rows a multi-shard
+	// delete can change.
+	Limit *sqltypes.PlanValue
 }
 
 // MarshalJSON serializes the Delete into a JSON representation.
@@ -76,6 +80,7 @@ func (del *Delete) MarshalJSON() ([]byte, error) {
 		Values           []sqltypes.PlanValue `json:",omitempty"`
 		Table            string               `json:",omitempty"`
 		OwnedVindexQuery string               `json:",omitempty"`
+		Limit            *sqltypes.PlanValue  `json:",omitempty"`
 	}{
 		Opcode:           del.Opcode,
 		Keyspace:         del.Keyspace,
@@ -84,6 +89,7 @@ func (del *Delete) MarshalJSON() ([]byte, error) {
 		Values:           del.Values,
 		Table:            tname,
 		OwnedVindexQuery: del.OwnedVindexQuery,
+		Limit:            del.Limit,
 	}
 	return jsonutil.MarshalNoEscape(marshalDelete)
 }
@@ -105,12 +111,17 @@ const (
 	// DeleteSharded is for routing a scattered
 	// delete statement.
 	DeleteSharded
+	// DeleteIN is for routing a delete statement
+	// to the shards of a list of values. Requires:
+	// A Vindex, and a single Value, which is a list.
+	DeleteIN
 )
 
 var delName = map[DeleteOpcode]string{
 	DeleteUnsharded: "DeleteUnsharded",
 	DeleteEqual:     "DeleteEqual",
 	DeleteSharded:   "DeleteSharded",
+	DeleteIN:        "DeleteIN",
 }
 
 // MarshalJSON serializes the DeleteOpcode as a JSON string.
@@ -128,6 +139,8 @@ func (del *Delete) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		return del.execDeleteEqual(vcursor, bindVars)
 	case DeleteSharded:
 		return del.execDeleteSharded(vcursor, bindVars)
+	case DeleteIN:
+		return del.execDeleteIN(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported opcode: %v", del)
@@ -182,38 +195,50 @@ func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*que
 	if err != nil {
 		return err
 	}
-	if len(result.Rows) == 0 {
-		return nil
-	}
-	colnum := 0
-	for _, colVindex := range del.Table.Owned {
-		ids := make([][]sqltypes.Value, len(result.Rows))
-		for range colVindex.Columns {
-			for rowIdx, row := range result.Rows {
-				ids[rowIdx] = append(ids[rowIdx], row[colnum])
-			}
-			colnum++
-		}
-		if err = colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, ids, ksid); err != nil {
-			return err
-		}
-	}
-	return nil
+	return deleteLookupEntries(vcursor, del.Table, result.Rows, ksid)
 }
 
 func (del *Delete) execDeleteSharded(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	rss, _, err := vcursor.ResolveDestinations(del.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	rss, err := resolveDMLShards(vcursor, del.Keyspace, nil, nil, bindVars)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execDeleteSharded")
 	}
+	result, err := del.execMultiShard(vcursor, bindVars, rss)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execDeleteSharded")
+	}
+	return result, nil
+}
 
-	queries := make([]*querypb.BoundQuery, len(rss))
-	sql := sqlannotation.AnnotateIfDML(del.Query, nil)
-	for i := range rss {
-		queries[i] = &querypb.BoundQuery{
-			Sql:           sql,
-			BindVariables: bindVars,
-		}
+func (del *Delete) execDeleteIN(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, err := resolveDMLShards(vcursor, del.Keyspace, del.Vindex, del.Values, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execDeleteIN")
+	}
+	result, err := del.execMultiShard(vcursor, bindVars, rss)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execDeleteIN")
+	}
+	return result, nil
+}
+
+// execMultiShard sends the delete to the shards. If the table has owned
+// vindexes, the rows to be deleted are fetched from all the shards first,
+// and their vindex entries are deleted. The transaction that does it can't
+// be autocommitted.
+func (del *Delete) execMultiShard(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	if del.OwnedVindexQuery == "" {
+		return execMultiShardDML(vcursor, del.Query, bindVars, rss, del.Limit, true /* canAutocommit */)
+	}
+	rows, ksids, err := selectOwnedRows(vcursor, del.OwnedVindexQuery, bindVars, rss, del.Table)
+	if err != nil {
+		return nil, err
+	}
+	if err := deleteOwnedEntries(vcursor, del.Table, rows, ksids); err != nil {
+		return nil, err
 	}
-	return vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, true /* canAutocommit */)
+	return execMultiShardDML(vcursor, del.Query, bindVars, rss, del.Limit, false /* canAutocommit */)
 }
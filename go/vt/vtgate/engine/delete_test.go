@@ -252,6 +252,112 @@ func TestDeleteSharded(t *testing.T) {
 	expectError(t, "Execute", err, "execDeleteSharded: shard_error")
 }
 
+func TestDeleteShardedOwnedVindex(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		Opcode:           DeleteSharded,
+		Keyspace:         ks.Keyspace,
+		Query:            "dummy_delete",
+		Table:            ks.Tables["t1"],
+		OwnedVindexQuery: "dummy_subquery",
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c1|c2|c3",
+			"int64|int64|int64|int64",
+		),
+		"1|4|5|6",
+		"2|7|8|9",
+		"1|10|11|12",
+	)}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		// The subquery is sent to all shards.
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} sharded.20-: dummy_subquery {} false false`,
+		// The keyspace ids are computed from the ids: the rows of id 1 are deleted first.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"10" from2: type:INT64 value:"11" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"12" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		// Then, the row of id 2.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"7" from2: type:INT64 value:"8" toc: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"9" toc: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		// The DML can't be autocommitted.
+		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
+	})
+}
+
+func TestDeleteIN(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		Opcode:   DeleteIN,
+		Keyspace: ks.Keyspace,
+		Query:    "dummy_delete",
+		Vindex:   ks.Vindexes["hash"],
+		Values: []sqltypes.PlanValue{{
+			Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}},
+		}},
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20", "20-"},
+	}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true true`,
+	})
+
+	// Failure case
+	del.Values = []sqltypes.PlanValue{{ListKey: "aa"}}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execDeleteIN: missing bind var aa")
+}
+
+func TestDeleteShardedLimit(t *testing.T) {
+	del := &Delete{
+		Opcode: DeleteSharded,
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		Query: "dummy_delete",
+		Limit: &sqltypes.PlanValue{Value: sqltypes.NewInt64(3)},
+	}
+
+	// The shards are executed one at a time, until
+	// 3 rows are deleted.
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-", "40-"},
+		results: []*sqltypes.Result{{RowsAffected: 2}, {RowsAffected: 1}},
+	}
+	result, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.-20: dummy_delete {__limit: type:UINT64 value:"3" } true false`,
+		`ExecuteMultiShard ks.20-: dummy_delete {__limit: type:UINT64 value:"1" } true false`,
+	})
+	if result.RowsAffected != 3 {
+		t.Errorf("RowsAffected: %d, want 3", result.RowsAffected)
+	}
+}
+
 func TestDeleteNoStream(t *testing.T) {
 	del := &Delete{}
 	err := del.StreamExecute(nil, nil, false, nil)
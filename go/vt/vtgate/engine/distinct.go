@@ -0,0 +1,162 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Distinct)(nil)
+
+// Distinct is a primitive that removes the duplicate rows
+// returned by its Source. It's used to perform a UNION of
+// queries that can't be sent to the same route.
+// Numbers are compared by value, and all other values are
+// compared as binary strings. To honor the collation of a
+// text column, KeyCols must reference its weight string.
+type Distinct struct {
+	// KeyCols are the columns that are compared to find the
+	// duplicates. A text column is replaced by the column of
+	// its weight string. If nil, all the columns are compared.
+	KeyCols []int
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int
+
+	Source Primitive
+}
+
+// MarshalJSON serializes the Distinct into a JSON representation.
+// It's used for testing and diagnostics.
+func (d *Distinct) MarshalJSON() ([]byte, error) {
+	marshalDistinct := struct {
+		Opcode              string
+		KeyCols             []int `json:",omitempty"`
+		TruncateColumnCount int   `json:",omitempty"`
+		Source              Primitive
+	}{
+		Opcode:              "Distinct",
+		KeyCols:             d.KeyCols,
+		TruncateColumnCount: d.TruncateColumnCount,
+		Source:              d.Source,
+	}
+	return json.Marshal(marshalDistinct)
+}
+
+// Execute satisfies the Primitive interface.
+func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	qr, err := d.Source.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{
+		Fields: qr.Fields,
+		Extras: qr.Extras,
+		Rows:   newRowSet(d.KeyCols).filter(qr.Rows),
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result.Truncate(d.TruncateColumnCount), nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (d *Distinct) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	seen := newRowSet(d.KeyCols)
+	return d.Source.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		rows := seen.filter(qr.Rows)
+		if len(qr.Fields) == 0 && len(rows) == 0 {
+			return nil
+		}
+		return callback((&sqltypes.Result{Fields: qr.Fields, Rows: rows}).Truncate(d.TruncateColumnCount))
+	})
+}
+
+// GetFields satisfies the Primitive interface.
+func (d *Distinct) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := d.Source.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(d.TruncateColumnCount), nil
+}
+
+// rowSet remembers the rows it has seen, by the values
+// of their keyCols, or of all their columns if nil.
+type rowSet struct {
+	keyCols []int
+	seen    map[string]struct{}
+}
+
+func newRowSet(keyCols []int) *rowSet {
+	return &rowSet{
+		keyCols: keyCols,
+		seen:    make(map[string]struct{}),
+	}
+}
+
+// filter returns the rows that were not seen before.
+func (rs *rowSet) filter(rows [][]sqltypes.Value) [][]sqltypes.Value {
+	var out [][]sqltypes.Value
+	for _, row := range rows {
+		key := rowKey(row, rs.keyCols)
+		if _, ok := rs.seen[key]; ok {
+			continue
+		}
+		rs.seen[key] = struct{}{}
+		out = append(out, row)
+	}
+	return out
+}
+
+// rowKey encodes the keyCols of a row, or all its columns if
+// nil, into a string that's the same for all the rows that
+// are equal. Each value is prefixed by its kind and its length.
+func rowKey(row []sqltypes.Value, keyCols []int) string {
+	if keyCols != nil {
+		key := make([]sqltypes.Value, len(keyCols))
+		for i, col := range keyCols {
+			key[i] = row[col]
+		}
+		row = key
+	}
+	var buf []byte
+	var lenBuf [binary.MaxVarintLen64]byte
+	for _, v := range row {
+		kind, val := byte('b'), v.ToBytes()
+		switch {
+		case v.IsNull():
+			kind = 'n'
+		case v.IsIntegral() || v.IsFloat() || v.Type() == sqltypes.Decimal:
+			// Normalize numbers so that 1, 1.0 and 1e0 are equal.
+			if r, ok := new(big.Rat).SetString(v.ToString()); ok {
+				kind, val = 'r', []byte(r.RatString())
+			}
+		}
+		buf = append(buf, kind)
+		n := binary.PutUvarint(lenBuf[:], uint64(len(val)))
+		buf = append(buf, lenBuf[:n]...)
+		buf = append(buf, val...)
+	}
+	return string(buf)
+}
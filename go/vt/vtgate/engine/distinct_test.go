@@ -0,0 +1,179 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestDistinctExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id|col",
+		"decimal|varbinary",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a",
+			"1.0|a",
+			"2|a",
+			"null|a",
+			"2|b",
+			"null|a",
+			"2|a",
+		)},
+	}
+	d := &Distinct{Source: fp}
+
+	result, err := d.Execute(nil, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|a",
+		"null|a",
+		"2|b",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("d.Execute:\n%v, want\n%v", result, wantResult)
+	}
+
+	// Duplicates are removed across streaming results.
+	fp.rewind()
+	var results []*sqltypes.Result
+	err = d.StreamExecute(nil, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResults := sqltypes.MakeTestStreamingResults(
+		fields,
+		"1|a",
+		"---",
+		"2|a",
+		"null|a",
+		"---",
+		"2|b",
+	)
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("d.StreamExecute:\n%s, want\n%s", sqltypes.PrintResults(results), sqltypes.PrintResults(wantResults))
+	}
+}
+
+func TestDistinctWeightString(t *testing.T) {
+	// textcol is compared by its weight string, which is truncated.
+	fields := sqltypes.MakeTestFields(
+		"id|textcol|weight_string(textcol)",
+		"int64|varchar|varbinary",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a|A",
+			"1|A|A",
+			"1|b|B",
+			"2|a|A",
+		)},
+	}
+	d := &Distinct{
+		KeyCols:             []int{0, 2},
+		TruncateColumnCount: 2,
+		Source:              fp,
+	}
+
+	result, err := d.Execute(nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		fields[:2],
+		"1|a",
+		"1|b",
+		"2|a",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("d.Execute:\n%v, want\n%v", result, wantResult)
+	}
+
+	fp.rewind()
+	var results []*sqltypes.Result
+	err = d.StreamExecute(nil, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResults := sqltypes.MakeTestStreamingResults(
+		fields[:2],
+		"1|a",
+		"---",
+		"1|b",
+		"2|a",
+	)
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("d.StreamExecute:\n%s, want\n%s", sqltypes.PrintResults(results), sqltypes.PrintResults(wantResults))
+	}
+}
+
+func TestRowKey(t *testing.T) {
+	testcases := []struct {
+		row1, row2 []sqltypes.Value
+		equal      bool
+	}{{
+		row1:  []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarBinary("a")},
+		row2:  []sqltypes.Value{sqltypes.NewFloat64(1), sqltypes.NewVarBinary("a")},
+		equal: true,
+	}, {
+		row1:  []sqltypes.Value{sqltypes.NewVarBinary("ab"), sqltypes.NewVarBinary("c")},
+		row2:  []sqltypes.Value{sqltypes.NewVarBinary("a"), sqltypes.NewVarBinary("bc")},
+		equal: false,
+	}, {
+		row1:  []sqltypes.Value{sqltypes.NULL},
+		row2:  []sqltypes.Value{sqltypes.NewVarBinary("")},
+		equal: false,
+	}, {
+		row1:  []sqltypes.Value{sqltypes.NewVarChar("a")},
+		row2:  []sqltypes.Value{sqltypes.NewVarChar("A")},
+		equal: false,
+	}}
+	for _, tcase := range testcases {
+		if got := rowKey(tcase.row1, nil) == rowKey(tcase.row2, nil); got != tcase.equal {
+			t.Errorf("rowKey(%v) == rowKey(%v): %v, want %v", tcase.row1, tcase.row2, got, tcase.equal)
+		}
+	}
+}
+
+func TestDistinctInputFail(t *testing.T) {
+	d := &Distinct{Source: &fakePrimitive{sendErr: errors.New("input fail")}}
+
+	want := "input fail"
+	if _, err := d.Execute(nil, nil, false); err == nil || err.Error() != want {
+		t.Errorf("d.Execute(): %v, want %s", err, want)
+	}
+	if err := d.StreamExecute(nil, nil, false, func(_ *sqltypes.Result) error { return nil }); err == nil || err.Error() != want {
+		t.Errorf("d.StreamExecute(): %v, want %s", err, want)
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlannotation"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file contains the functions shared by the multi-shard
+// opcodes of Update and Delete, and by sharded REPLACE inserts.
+
+// resolveDMLShards returns the shards targeted by a multi-shard DML.
+// If vindex is nil, these are all the shards of the keyspace. Otherwise,
+// these are the shards of the list of values, as mapped by the vindex.
+func resolveDMLShards(vcursor VCursor, keyspace *vindexes.Keyspace, vindex vindexes.Vindex, values []sqltypes.PlanValue, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, error) {
+	if vindex == nil {
+		rss, _, err := vcursor.ResolveDestinations(keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+		return rss, err
+	}
+	keys, err := values[0].ResolveList(bindVars)
+	if err != nil {
+		return nil, err
+	}
+	destinations, err := vindex.Map(vcursor, keys)
+	if err != nil {
+		return nil, err
+	}
+	rss, _, err := vcursor.ResolveDestinations(keyspace.Name, nil, destinations)
+	return rss, err
+}
+
+// selectOwnedRows executes the query that fetches the owned vindex
+// columns of the rows changed by a multi-shard DML. The first column
+// it returns is the column of the primary vindex of the table. It's
+// converted into the keyspace id of each row, and removed from the
+// returned rows.
+func selectOwnedRows(vcursor VCursor, query string, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, table *vindexes.Table) (rows [][]sqltypes.Value, ksids [][]byte, err error) {
+	result, err := vcursor.ExecuteMultiShard(rss, dmlQueries(query, bindVars, len(rss)), false /* isDML */, false /* canAutocommit */)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ownedRows(vcursor, result, table)
+}
+
+// ownedRows splits the result of an owned vindex query into the
+// owned vindex values and the keyspace ids of the rows. The first
+// column of the result is the primary vindex column.
+func ownedRows(vcursor VCursor, result *sqltypes.Result, table *vindexes.Table) (rows [][]sqltypes.Value, ksids [][]byte, err error) {
+	if len(result.Rows) == 0 {
+		return nil, nil, nil
+	}
+	ids := make([]sqltypes.Value, len(result.Rows))
+	for i, row := range result.Rows {
+		ids[i] = row[0]
+	}
+	destinations, err := table.ColumnVindexes[0].Vindex.Map(vcursor, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows = make([][]sqltypes.Value, len(result.Rows))
+	ksids = make([][]byte, len(result.Rows))
+	for i, destination := range destinations {
+		ksid, ok := destination.(key.DestinationKeyspaceID)
+		if !ok {
+			return nil, nil, fmt.Errorf("cannot map vindex to unique keyspace id: %v", destination)
+		}
+		rows[i] = result.Rows[i][1:]
+		ksids[i] = ksid
+	}
+	return rows, ksids, nil
+}
+
+// deleteOwnedEntries deletes the entries of the owned vindexes for
+// the rows returned by selectOwnedRows, one keyspace id at a time.
+func deleteOwnedEntries(vcursor VCursor, table *vindexes.Table, rows [][]sqltypes.Value, ksids [][]byte) error {
+	var order []string
+	groups := make(map[string][][]sqltypes.Value)
+	for i, row := range rows {
+		ksid := string(ksids[i])
+		if _, ok := groups[ksid]; !ok {
+			order = append(order, ksid)
+		}
+		groups[ksid] = append(groups[ksid], row)
+	}
+	for _, ksid := range order {
+		if err := deleteLookupEntries(vcursor, table, groups[ksid], []byte(ksid)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteLookupEntries deletes the entries of the owned vindexes
+// for the rows, which all have the same keyspace id.
+func deleteLookupEntries(vcursor VCursor, table *vindexes.Table, rows [][]sqltypes.Value, ksid []byte) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	colnum := 0
+	for _, colVindex := range table.Owned {
+		ids := make([][]sqltypes.Value, len(rows))
+		for range colVindex.Columns {
+			for rowIdx, row := range rows {
+				ids[rowIdx] = append(ids[rowIdx], row[colnum])
+			}
+			colnum++
+		}
+		if err := colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, ids, ksid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execMultiShardDML sends a DML to the shards. If limit is set, the
+// shards are executed one at a time, until the limit is reached. The
+// number of rows that can still be changed is sent to each of them in
+// the LimitVarName bind variable. The limit is only valid for deletes:
+// an update doesn't count the rows it matches without changing them.
+func execMultiShardDML(vcursor VCursor, query string, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, limit *sqltypes.PlanValue, canAutocommit bool) (*sqltypes.Result, error) {
+	sql := sqlannotation.AnnotateIfDML(query, nil)
+	if limit == nil {
+		return vcursor.ExecuteMultiShard(rss, dmlQueries(sql, bindVars, len(rss)), true /* isDML */, canAutocommit)
+	}
+	v, err := limit.ResolveValue(bindVars)
+	if err != nil {
+		return nil, err
+	}
+	left, err := sqltypes.ToUint64(v)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	for _, rs := range rss {
+		if left == 0 {
+			break
+		}
+		bv := combineVars(bindVars, map[string]*querypb.BindVariable{LimitVarName: sqltypes.Uint64BindVariable(left)})
+		qr, err := execShard(vcursor, sql, bv, rs, true /* isDML */, false /* canAutocommit */)
+		if err != nil {
+			return nil, err
+		}
+		result.RowsAffected += qr.RowsAffected
+		if qr.RowsAffected >= left {
+			break
+		}
+		left -= qr.RowsAffected
+	}
+	return result, nil
+}
+
+// dmlQueries returns the queries that send sql to n shards.
+func dmlQueries(sql string, bindVars map[string]*querypb.BindVariable, n int) []*querypb.BoundQuery {
+	queries := make([]*querypb.BoundQuery, n)
+	for i := range queries {
+		queries[i] = &querypb.BoundQuery{
+			Sql:           sql,
+			BindVariables: bindVars,
+		}
+	}
+	return queries
+}
@@ -65,6 +65,10 @@ func (t noopVCursor) ResolveDestinations(keyspace string, ids []*querypb.Value,
 	panic("unimplemented")
 }
 
+func (t noopVCursor) SequenceCache() *SequenceCache {
+	return nil
+}
+
 // loggingVCursor logs requests and allows you to verify
 // that the correct requests were made.
 type loggingVCursor struct {
@@ -79,6 +83,8 @@ type loggingVCursor struct {
 	curResult int
 	resultErr error
 
+	sequenceCache *SequenceCache
+
 	log []string
 }
 
@@ -187,6 +193,10 @@ func (f *loggingVCursor) Rewind() {
 	f.log = nil
 }
 
+func (f *loggingVCursor) SequenceCache() *SequenceCache {
+	return f.sequenceCache
+}
+
 func (f *loggingVCursor) nextResult() (*sqltypes.Result, error) {
 	if f.results == nil || f.curResult >= len(f.results) {
 		return &sqltypes.Result{}, f.resultErr
@@ -0,0 +1,294 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Filter)(nil)
+
+// Filter is a primitive that only returns the rows of its Input
+// for which the Predicate is true. It's used to evaluate a HAVING
+// clause on the results of an aggregation performed by vtgate.
+type Filter struct {
+	Predicate FilterExpr
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. The columns after it are only needed
+	// to evaluate the predicate. If 0, no truncation happens.
+	TruncateColumnCount int
+
+	Input Primitive
+}
+
+// MarshalJSON serializes the Filter into a JSON representation.
+// It's used for testing and diagnostics.
+func (f *Filter) MarshalJSON() ([]byte, error) {
+	marshalFilter := struct {
+		Opcode              string
+		Predicate           FilterExpr
+		TruncateColumnCount int `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "Filter",
+		Predicate:           f.Predicate,
+		TruncateColumnCount: f.TruncateColumnCount,
+		Input:               f.Input,
+	}
+	return json.Marshal(marshalFilter)
+}
+
+// Execute satisfies the Primitive interface.
+func (f *Filter) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := f.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields: result.Fields,
+		Extras: result.Extras,
+	}
+	out.Rows, err = f.filter(result.Rows, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	out.RowsAffected = uint64(len(out.Rows))
+	return out.Truncate(f.TruncateColumnCount), nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (f *Filter) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return f.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		rows, err := f.filter(qr.Rows, bindVars)
+		if err != nil {
+			return err
+		}
+		if len(qr.Fields) == 0 && len(rows) == 0 {
+			return nil
+		}
+		return callback((&sqltypes.Result{Fields: qr.Fields, Rows: rows}).Truncate(f.TruncateColumnCount))
+	})
+}
+
+// GetFields satisfies the Primitive interface.
+func (f *Filter) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := f.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: qr.Fields}
+	return qr.Truncate(f.TruncateColumnCount), nil
+}
+
+func (f *Filter) filter(rows [][]sqltypes.Value, bindVars map[string]*querypb.BindVariable) ([][]sqltypes.Value, error) {
+	var out [][]sqltypes.Value
+	for _, row := range rows {
+		v, err := f.Predicate.evaluate(row, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		if isTrue(v) {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+// FilterExpr is an expression that can be evaluated by a Filter
+// or a Join against a row. Boolean results are returned as 1 or 0, and NULL
+// stands for unknown, like in MySQL.
+type FilterExpr interface {
+	evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error)
+}
+
+var (
+	filterTrue  = sqltypes.NewInt64(1)
+	filterFalse = sqltypes.NewInt64(0)
+)
+
+func boolValue(b bool) sqltypes.Value {
+	if b {
+		return filterTrue
+	}
+	return filterFalse
+}
+
+// isTrue returns true if v is neither NULL nor zero. Values
+// that are not numbers are treated as zero.
+func isTrue(v sqltypes.Value) bool {
+	if v.IsNull() {
+		return false
+	}
+	f, err := sqltypes.ToFloat64(v)
+	return err == nil && f != 0
+}
+
+// FilterColumn is the value of a column of the row.
+type FilterColumn struct {
+	Col int
+}
+
+func (c *FilterColumn) evaluate(row []sqltypes.Value, _ map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	if c.Col >= len(row) {
+		return sqltypes.NULL, fmt.Errorf("BUG: filter column %d out of range for row of %d columns", c.Col, len(row))
+	}
+	return row[c.Col], nil
+}
+
+// FilterValue is a constant, or a bind variable.
+type FilterValue struct {
+	Value sqltypes.PlanValue
+}
+
+func (v *FilterValue) evaluate(_ []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	return v.Value.ResolveValue(bindVars)
+}
+
+// FilterComparison compares two expressions. The supported operators
+// are =, !=, <, <=, >, >= and <=>.
+type FilterComparison struct {
+	Operator    string
+	Left, Right FilterExpr
+}
+
+func (c *FilterComparison) evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	left, err := c.Left.evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	right, err := c.Right.evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if c.Operator != sqlparser.NullSafeEqualStr && (left.IsNull() || right.IsNull()) {
+		return sqltypes.NULL, nil
+	}
+	cmp, err := sqltypes.NullsafeCompare(left, right)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch c.Operator {
+	case sqlparser.EqualStr, sqlparser.NullSafeEqualStr:
+		return boolValue(cmp == 0), nil
+	case sqlparser.NotEqualStr:
+		return boolValue(cmp != 0), nil
+	case sqlparser.LessThanStr:
+		return boolValue(cmp < 0), nil
+	case sqlparser.LessEqualStr:
+		return boolValue(cmp <= 0), nil
+	case sqlparser.GreaterThanStr:
+		return boolValue(cmp > 0), nil
+	case sqlparser.GreaterEqualStr:
+		return boolValue(cmp >= 0), nil
+	}
+	return sqltypes.NULL, fmt.Errorf("BUG: unexpected comparison operator: %s", c.Operator)
+}
+
+// These constants list the operators of a FilterLogical.
+const (
+	FilterAnd = "and"
+	FilterOr  = "or"
+)
+
+// FilterLogical combines two expressions with AND or OR.
+type FilterLogical struct {
+	Operator    string
+	Left, Right FilterExpr
+}
+
+func (l *FilterLogical) evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	left, err := l.Left.evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	right, err := l.Right.evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch l.Operator {
+	case FilterAnd:
+		// false wins over unknown.
+		if (!left.IsNull() && !isTrue(left)) || (!right.IsNull() && !isTrue(right)) {
+			return filterFalse, nil
+		}
+		if left.IsNull() || right.IsNull() {
+			return sqltypes.NULL, nil
+		}
+		return filterTrue, nil
+	case FilterOr:
+		// true wins over unknown.
+		if isTrue(left) || isTrue(right) {
+			return filterTrue, nil
+		}
+		if left.IsNull() || right.IsNull() {
+			return sqltypes.NULL, nil
+		}
+		return filterFalse, nil
+	}
+	return sqltypes.NULL, fmt.Errorf("BUG: unexpected logical operator: %s", l.Operator)
+}
+
+// FilterNot negates an expression.
+type FilterNot struct {
+	Expr FilterExpr
+}
+
+func (n *FilterNot) evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	v, err := n.Expr.evaluate(row, bindVars)
+	if err != nil || v.IsNull() {
+		return sqltypes.NULL, err
+	}
+	return boolValue(!isTrue(v)), nil
+}
+
+// FilterIsNull checks if an expression is NULL, or
+// is not NULL if Not is set.
+type FilterIsNull struct {
+	Not  bool `json:",omitempty"`
+	Expr FilterExpr
+}
+
+func (n *FilterIsNull) evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	v, err := n.Expr.evaluate(row, bindVars)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return boolValue(v.IsNull() != n.Not), nil
+}
+
+// FilterCoalesce returns the value of the first of its expressions
+// that is not NULL. It's used for COALESCE and IFNULL.
+type FilterCoalesce struct {
+	Exprs []FilterExpr
+}
+
+func (c *FilterCoalesce) evaluate(row []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	for _, expr := range c.Exprs {
+		v, err := expr.evaluate(row, bindVars)
+		if err != nil || !v.IsNull() {
+			return v, err
+		}
+	}
+	return sqltypes.NULL, nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestFilterExecute(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|count(*)|max(id)",
+				"varbinary|int64|int64",
+			),
+			"a|1|10",
+			"b|2|20",
+			"c|3|null",
+			"d|4|40",
+		)},
+	}
+
+	// having count(*) >= :n and max(id) is not null
+	f := &Filter{
+		Predicate: &FilterLogical{
+			Operator: FilterAnd,
+			Left: &FilterComparison{
+				Operator: sqlparser.GreaterEqualStr,
+				Left:     &FilterColumn{Col: 1},
+				Right:    &FilterValue{Value: sqltypes.PlanValue{Key: "n"}},
+			},
+			Right: &FilterIsNull{
+				Not:  true,
+				Expr: &FilterColumn{Col: 2},
+			},
+		},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+	bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(2)}
+
+	result, err := f.Execute(nil, bindVars, false)
+	if err != nil {
+		t.Error(err)
+	}
+	wantFields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varbinary|int64",
+	)
+	wantResult := sqltypes.MakeTestResult(
+		wantFields,
+		"b|2",
+		"d|4",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("f.Execute:\n%v, want\n%v", result, wantResult)
+	}
+
+	fp.rewind()
+	var results []*sqltypes.Result
+	err = f.StreamExecute(nil, bindVars, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	wantResults := sqltypes.MakeTestStreamingResults(
+		wantFields,
+		"b|2",
+		"---",
+		"d|4",
+	)
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("f.StreamExecute:\n%s, want\n%s", sqltypes.PrintResults(results), sqltypes.PrintResults(wantResults))
+	}
+
+	fp.rewind()
+	result, err = f.GetFields(nil, bindVars)
+	if err != nil {
+		t.Error(err)
+	}
+	wantResult = &sqltypes.Result{Fields: wantFields}
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("f.GetFields:\n%v, want\n%v", result, wantResult)
+	}
+}
+
+func TestFilterExprs(t *testing.T) {
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NULL,
+		sqltypes.NewVarBinary("abc"),
+	}
+	one := &FilterValue{Value: sqltypes.PlanValue{Value: sqltypes.NewInt64(1)}}
+	zero := &FilterValue{Value: sqltypes.PlanValue{Value: sqltypes.NewInt64(0)}}
+	null := &FilterValue{}
+	testcases := []struct {
+		expr FilterExpr
+		want sqltypes.Value
+	}{{
+		expr: &FilterComparison{Operator: sqlparser.EqualStr, Left: &FilterColumn{Col: 0}, Right: one},
+		want: filterTrue,
+	}, {
+		expr: &FilterComparison{Operator: sqlparser.NotEqualStr, Left: &FilterColumn{Col: 0}, Right: one},
+		want: filterFalse,
+	}, {
+		expr: &FilterComparison{Operator: sqlparser.LessThanStr, Left: zero, Right: &FilterColumn{Col: 0}},
+		want: filterTrue,
+	}, {
+		expr: &FilterComparison{Operator: sqlparser.GreaterThanStr, Left: &FilterColumn{Col: 1}, Right: zero},
+		want: sqltypes.NULL,
+	}, {
+		expr: &FilterComparison{Operator: sqlparser.NullSafeEqualStr, Left: &FilterColumn{Col: 1}, Right: null},
+		want: filterTrue,
+	}, {
+		expr: &FilterComparison{
+			Operator: sqlparser.EqualStr,
+			Left:     &FilterColumn{Col: 2},
+			Right:    &FilterValue{Value: sqltypes.PlanValue{Value: sqltypes.NewVarBinary("abc")}},
+		},
+		want: filterTrue,
+	}, {
+		expr: &FilterLogical{Operator: FilterAnd, Left: null, Right: zero},
+		want: filterFalse,
+	}, {
+		expr: &FilterLogical{Operator: FilterAnd, Left: null, Right: one},
+		want: sqltypes.NULL,
+	}, {
+		expr: &FilterLogical{Operator: FilterOr, Left: null, Right: one},
+		want: filterTrue,
+	}, {
+		expr: &FilterLogical{Operator: FilterOr, Left: null, Right: zero},
+		want: sqltypes.NULL,
+	}, {
+		expr: &FilterNot{Expr: zero},
+		want: filterTrue,
+	}, {
+		expr: &FilterNot{Expr: null},
+		want: sqltypes.NULL,
+	}, {
+		expr: &FilterIsNull{Expr: &FilterColumn{Col: 1}},
+		want: filterTrue,
+	}, {
+		expr: &FilterIsNull{Not: true, Expr: &FilterColumn{Col: 1}},
+		want: filterFalse,
+	}, {
+		expr: &FilterCoalesce{Exprs: []FilterExpr{&FilterColumn{Col: 1}, zero, one}},
+		want: sqltypes.NewInt64(0),
+	}, {
+		expr: &FilterCoalesce{Exprs: []FilterExpr{null, &FilterColumn{Col: 1}}},
+		want: sqltypes.NULL,
+	}}
+	for _, tcase := range testcases {
+		got, err := tcase.expr.evaluate(row, nil)
+		if err != nil {
+			t.Errorf("evaluate(%#v) failed: %v", tcase.expr, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("evaluate(%#v): %v, want %v", tcase.expr, got, tcase.want)
+		}
+	}
+}
+
+func TestFilterInputFail(t *testing.T) {
+	f := &Filter{
+		Predicate: &FilterColumn{Col: 0},
+		Input:     &fakePrimitive{sendErr: errors.New("input fail")},
+	}
+
+	want := "input fail"
+	if _, err := f.Execute(nil, nil, false); err == nil || err.Error() != want {
+		t.Errorf("f.Execute(): %v, want %s", err, want)
+	}
+	if err := f.StreamExecute(nil, nil, false, func(_ *sqltypes.Result) error { return nil }); err == nil || err.Error() != want {
+		t.Errorf("f.StreamExecute(): %v, want %s", err, want)
+	}
+}
@@ -17,10 +17,12 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 
 	"vitess.io/vitess/go/jsonutil"
 	"vitess.io/vitess/go/sqltypes"
@@ -46,8 +48,8 @@ type Insert struct {
 	Keyspace *vindexes.Keyspace
 
 	// Query specifies the query to be executed.
-	// For InsertSharded plans, this value is unused,
-	// and Prefix, Mid and Suffix are used instead.
+	// For InsertSharded plans and INSERT ... SELECT, this value
+	// is unused, and Prefix, Mid and Suffix are used instead.
 	Query string
 
 	// VindexValues specifies values for all the vindex columns.
@@ -63,10 +65,51 @@ type Insert struct {
 	// Generate is only set for inserts where a sequence must be generated.
 	Generate *Generate
 
-	// Prefix, Mid and Suffix are for sharded insert plans.
+	// Prefix, Mid and Suffix are for sharded insert plans and
+	// INSERT ... SELECT.
 	Prefix string
 	Mid    []string
 	Suffix string
+
+	// OwnedVindexQuery is used for InsertShardedReplace. It selects
+	// the primary vindex column and the owned vindex columns of the
+	// table. The where clause that finds the rows that will be replaced
+	// is added at execution time.
+	OwnedVindexQuery string
+
+	// UniqueKeyQuery is used for InsertShardedReplace. It returns the
+	// columns of the unique keys of the table, in the format of
+	// SHOW INDEX.
+	UniqueKeyQuery string
+
+	// Input is set for INSERT ... SELECT into a sharded keyspace,
+	// or into an unsharded table with an auto-increment column.
+	// It returns the rows to insert, whose values are in the order
+	// of the insert columns. VindexValues and Mid are then built
+	// from these rows, BatchSize rows at a time.
+	Input Primitive
+
+	// VindexOffsets are the positions of the vindex columns in the
+	// rows of Input. VindexOffsets[i][j] is the position of the j'th
+	// column of the i'th colVindex.
+	VindexOffsets [][]int
+
+	// AutoIncOffset is the position of the auto-increment column in
+	// the rows of Input. It's only used if Generate is set.
+	AutoIncOffset int
+
+	// BatchSize is the max number of rows of Input inserted at once.
+	BatchSize int
+
+	// source is the Insert that a batch of INSERT ... SELECT was
+	// built from. The batch uses its cache of unique keys.
+	source *Insert
+
+	// mu protects keyVindexes and keyVindexesLoaded, which cache
+	// the result of uniqueKeyVindexes.
+	mu                sync.Mutex
+	keyVindexes       []int
+	keyVindexesLoaded bool
 }
 
 // MarshalJSON serializes the Insert into a JSON representation.
@@ -86,6 +129,13 @@ func (ins *Insert) MarshalJSON() ([]byte, error) {
 		Prefix   string               `json:",omitempty"`
 		Mid      []string             `json:",omitempty"`
 		Suffix   string               `json:",omitempty"`
+
+		OwnedVindexQuery string    `json:",omitempty"`
+		UniqueKeyQuery   string    `json:",omitempty"`
+		Input            Primitive `json:",omitempty"`
+		VindexOffsets    [][]int   `json:",omitempty"`
+		AutoIncOffset    *int      `json:",omitempty"`
+		BatchSize        int       `json:",omitempty"`
 	}{
 		Opcode:   ins.Opcode,
 		Keyspace: ins.Keyspace,
@@ -96,6 +146,15 @@ func (ins *Insert) MarshalJSON() ([]byte, error) {
 		Prefix:   ins.Prefix,
 		Mid:      ins.Mid,
 		Suffix:   ins.Suffix,
+
+		OwnedVindexQuery: ins.OwnedVindexQuery,
+		UniqueKeyQuery:   ins.UniqueKeyQuery,
+		Input:            ins.Input,
+		VindexOffsets:    ins.VindexOffsets,
+		BatchSize:        ins.BatchSize,
+	}
+	if ins.Input != nil && ins.Generate != nil {
+		marshalInsert.AutoIncOffset = &ins.AutoIncOffset
 	}
 	return jsonutil.MarshalNoEscape(marshalInsert)
 }
@@ -110,6 +169,10 @@ type Generate struct {
 	// values will be generated based on how many were not
 	// supplied (NULL).
 	Values sqltypes.PlanValue
+	// Uncached is set for uncached sequences. Their values are
+	// reserved in the transaction of the insert, so that they're
+	// released if it's rolled back.
+	Uncached bool `json:",omitempty"`
 }
 
 // InsertOpcode is a number representing the opcode
@@ -128,12 +191,17 @@ const (
 	// InsertShardedIgnore is for INSERT IGNORE and
 	// INSERT...ON DUPLICATE KEY constructs.
 	InsertShardedIgnore
+	// InsertShardedReplace is for REPLACE constructs. The
+	// owned vindex entries of the rows being replaced are
+	// deleted before the new ones are created.
+	InsertShardedReplace
 )
 
 var insName = map[InsertOpcode]string{
-	InsertUnsharded:     "InsertUnsharded",
-	InsertSharded:       "InsertSharded",
-	InsertShardedIgnore: "InsertShardedIgnore",
+	InsertUnsharded:      "InsertUnsharded",
+	InsertSharded:        "InsertSharded",
+	InsertShardedIgnore:  "InsertShardedIgnore",
+	InsertShardedReplace: "InsertShardedReplace",
 }
 
 // MarshalJSON serializes the InsertOpcode as a JSON string.
@@ -146,8 +214,14 @@ func (code InsertOpcode) MarshalJSON() ([]byte, error) {
 func (ins *Insert) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
 	switch ins.Opcode {
 	case InsertUnsharded:
+		if ins.Input != nil {
+			return ins.execInsertSelect(vcursor, bindVars)
+		}
 		return ins.execInsertUnsharded(vcursor, bindVars)
-	case InsertSharded, InsertShardedIgnore:
+	case InsertSharded, InsertShardedIgnore, InsertShardedReplace:
+		if ins.Input != nil {
+			return ins.execInsertSelect(vcursor, bindVars)
+		}
 		return ins.execInsertSharded(vcursor, bindVars)
 	default:
 		// Unreachable.
@@ -170,7 +244,6 @@ func (ins *Insert) execInsertUnsharded(vcursor VCursor, bindVars map[string]*que
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execInsertUnsharded")
 	}
-
 	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execInsertUnsharded")
@@ -198,22 +271,165 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execInsertSharded")
 	}
-	rss, queries, err := ins.getInsertShardedRoute(vcursor, bindVars)
+	result, err := ins.insertShardedRows(vcursor, bindVars, true /* canAutocommit */)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execInsertSharded")
 	}
 
-	result, err := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, true /* canAutocommit */)
+	if insertID != 0 {
+		result.InsertID = uint64(insertID)
+	}
+	return result, nil
+}
+
+// insertUnshardedRows sends the rows of an unsharded insert to the only
+// shard of its keyspace.
+func (ins *Insert) insertUnshardedRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, canAutocommit bool) (*sqltypes.Result, error) {
+	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
 	if err != nil {
-		return nil, vterrors.Wrap(err, "execInsertSharded")
+		return nil, err
+	}
+	if len(rss) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "Keyspace does not have exactly one shard: %v", rss)
 	}
+	query := ins.Prefix + strings.Join(ins.Mid, ",") + ins.Suffix
+	return execShard(vcursor, query, bindVars, rss[0], true, canAutocommit)
+}
 
-	if insertID != 0 {
-		result.InsertID = uint64(insertID)
+// insertShardedRows routes the rows of a sharded insert to their shards.
+func (ins *Insert) insertShardedRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, canAutocommit bool) (*sqltypes.Result, error) {
+	rss, queries, err := ins.getInsertShardedRoute(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, canAutocommit)
+}
+
+// execInsertSelect executes Input, and inserts the rows it returns
+// like the VALUES of an insert. If there's more than one batch of rows,
+// the insert can't be autocommitted.
+func (ins *Insert) execInsertSelect(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := ins.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	batchSize := ins.BatchSize
+	if batchSize <= 0 {
+		batchSize = len(qr.Rows)
+	}
+	canAutocommit := len(qr.Rows) <= batchSize
+	result := &sqltypes.Result{}
+	for start := 0; start < len(qr.Rows); start += batchSize {
+		end := start + batchSize
+		if end > len(qr.Rows) {
+			end = len(qr.Rows)
+		}
+		batch, err := ins.selectBatch(qr.Rows[start:end])
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execInsertSelect")
+		}
+		// The bind vars of the rows are specific to each batch.
+		bv := combineVars(bindVars, nil)
+		insertID, err := batch.processGenerate(vcursor, bv)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execInsertSelect")
+		}
+		var batchResult *sqltypes.Result
+		if ins.Opcode == InsertUnsharded {
+			batchResult, err = batch.insertUnshardedRows(vcursor, bv, canAutocommit)
+		} else {
+			batchResult, err = batch.insertShardedRows(vcursor, bv, canAutocommit)
+		}
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execInsertSelect")
+		}
+		result.RowsAffected += batchResult.RowsAffected
+		if result.InsertID == 0 {
+			// As in MySQL, the insert id is the first one generated.
+			result.InsertID = batchResult.InsertID
+			if insertID != 0 {
+				result.InsertID = uint64(insertID)
+			}
+		}
 	}
 	return result, nil
 }
 
+// selectBatch returns a copy of the Insert that inserts the rows
+// of a batch returned by Input. The vindex and auto-increment
+// columns are sent as bind vars, and the other ones as literals.
+func (ins *Insert) selectBatch(rows [][]sqltypes.Value) (*Insert, error) {
+	batch := &Insert{
+		Opcode:           ins.Opcode,
+		Keyspace:         ins.Keyspace,
+		Query:            ins.Query,
+		Table:            ins.Table,
+		Generate:         ins.Generate,
+		Prefix:           ins.Prefix,
+		Suffix:           ins.Suffix,
+		OwnedVindexQuery: ins.OwnedVindexQuery,
+		UniqueKeyQuery:   ins.UniqueKeyQuery,
+		VindexOffsets:    ins.VindexOffsets,
+		AutoIncOffset:    ins.AutoIncOffset,
+		BatchSize:        ins.BatchSize,
+		source:           ins,
+	}
+
+	colVars := make(map[int]sqlparser.ColIdent)
+	batch.VindexValues = make([]sqltypes.PlanValue, len(ins.VindexOffsets))
+	for vIdx, offsets := range ins.VindexOffsets {
+		batch.VindexValues[vIdx].Values = make([]sqltypes.PlanValue, len(offsets))
+		for colIdx, offset := range offsets {
+			colVars[offset] = ins.Table.ColumnVindexes[vIdx].Columns[colIdx]
+			values := make([]sqltypes.PlanValue, len(rows))
+			for rowNum, row := range rows {
+				if offset >= len(row) {
+					return nil, fmt.Errorf("select returned %d columns, the vindex column %v is at position %d", len(row), colVars[offset], offset+1)
+				}
+				if ins.Generate != nil && offset == ins.AutoIncOffset {
+					values[rowNum].Key = SeqVarName + strconv.Itoa(rowNum)
+				} else {
+					values[rowNum].Value = row[offset]
+				}
+			}
+			batch.VindexValues[vIdx].Values[colIdx].Values = values
+		}
+	}
+	if ins.Generate != nil {
+		generate := *ins.Generate
+		generate.Values = sqltypes.PlanValue{Values: make([]sqltypes.PlanValue, len(rows))}
+		for rowNum, row := range rows {
+			if ins.AutoIncOffset >= len(row) {
+				return nil, fmt.Errorf("select returned %d columns, the auto-increment column is at position %d", len(row), ins.AutoIncOffset+1)
+			}
+			generate.Values.Values[rowNum].Value = row[ins.AutoIncOffset]
+		}
+		batch.Generate = &generate
+	}
+
+	batch.Mid = make([]string, len(rows))
+	buf := &bytes.Buffer{}
+	for rowNum, row := range rows {
+		buf.Reset()
+		buf.WriteByte('(')
+		for i, v := range row {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			if col, ok := colVars[i]; ok {
+				buf.WriteString(":" + insertVarName(col, rowNum))
+			} else if ins.Generate != nil && i == ins.AutoIncOffset {
+				buf.WriteString(":" + SeqVarName + strconv.Itoa(rowNum))
+			} else {
+				v.EncodeSQL(buf)
+			}
+		}
+		buf.WriteByte(')')
+		batch.Mid[rowNum] = buf.String()
+	}
+	return batch, nil
+}
+
 // processGenerate generates new values using a sequence if necessary.
 // If no value was generated, it returns 0. Values are generated only
 // for cases where none are supplied.
@@ -244,14 +460,7 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 		if len(rss) != 1 {
 			return 0, vterrors.Wrapf(err, "processGenerate len(rss)=%v", len(rss))
 		}
-		bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
-		qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
-		if err != nil {
-			return 0, err
-		}
-		// If no rows are returned, it's an internal error, and the code
-		// must panic, which will be caught and reported.
-		insertID, err = sqltypes.ToInt64(qr.Rows[0][0])
+		insertID, err = ins.nextSequenceValues(vcursor, rss[0], count)
 		if err != nil {
 			return 0, err
 		}
@@ -270,6 +479,36 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 	return insertID, nil
 }
 
+// nextSequenceValues returns the first of count values generated
+// by the sequence. The values of uncached sequences are reserved in
+// the transaction of the session. Otherwise, they're reserved in a
+// separate transaction, or taken from the block leased by vtgate.
+func (ins *Insert) nextSequenceValues(vcursor VCursor, rs *srvtopo.ResolvedShard, count int64) (int64, error) {
+	reserve := func(n int64) (int64, error) {
+		queries := []*querypb.BoundQuery{{
+			Sql:           ins.Generate.Query,
+			BindVariables: map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(n)},
+		}}
+		var qr *sqltypes.Result
+		var err error
+		if ins.Generate.Uncached {
+			qr, err = vcursor.ExecuteMultiShard([]*srvtopo.ResolvedShard{rs}, queries, false /* isDML */, false /* canAutocommit */)
+		} else {
+			qr, err = vcursor.ExecuteStandalone(queries[0].Sql, queries[0].BindVariables, rs)
+		}
+		if err != nil {
+			return 0, err
+		}
+		// If no rows are returned, it's an internal error, and the code
+		// must panic, which will be caught and reported.
+		return sqltypes.ToInt64(qr.Rows[0][0])
+	}
+	if sc := vcursor.SequenceCache(); sc != nil && !ins.Generate.Uncached {
+		return sc.Next(ins.Generate.Keyspace.Name+"."+ins.Generate.Query, count, reserve)
+	}
+	return reserve(count)
+}
+
 // getInsertShardedRoute performs all the vindex related work
 // and returns a map of shard to queries.
 // Using the primary vindex, it computes the target keyspace ids.
@@ -323,13 +562,18 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
 	}
+	if ins.Opcode == InsertShardedReplace && ins.OwnedVindexQuery != "" {
+		if err := ins.deleteReplacedEntries(vcursor, vindexRowsValues, keyspaceIDs, bindVars); err != nil {
+			return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		}
+	}
 
 	for vIdx := 1; vIdx < len(vindexRowsValues); vIdx++ {
 		colVindex := ins.Table.ColumnVindexes[vIdx]
 		var err error
 		if colVindex.Owned {
 			switch ins.Opcode {
-			case InsertSharded:
+			case InsertSharded, InsertShardedReplace:
 				err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, bindVars, keyspaceIDs)
 			case InsertShardedIgnore:
 				// For InsertShardedIgnore, the work is substantially different.
@@ -435,6 +679,305 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexKeys [][]sqltypes.Value
 	return keyspaceIDs, nil
 }
 
+// deleteReplacedEntries deletes the owned vindex entries of the existing
+// rows that a REPLACE deletes. These are the rows that have the same values
+// as an inserted row for any unique key of the table. Only the values of
+// the vindex columns are known here. So, every unique key must have the
+// same columns as the primary vindex or an owned vindex.
+func (ins *Insert) deleteReplacedEntries(vcursor VCursor, vindexRowsValues [][][]sqltypes.Value, ksids [][]byte, bindVars map[string]*querypb.BindVariable) error {
+	indexes := make([]*querypb.Value, len(ksids))
+	destinations := make([]key.Destination, len(ksids))
+	for i, ksid := range ksids {
+		indexes[i] = &querypb.Value{
+			Value: strconv.AppendInt(nil, int64(i), 10),
+		}
+		destinations[i] = key.DestinationKeyspaceID(ksid)
+	}
+	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
+	if err != nil {
+		return err
+	}
+	keyVindexes, err := ins.uniqueKeyVindexes(vcursor, rss[0])
+	if err != nil {
+		return err
+	}
+	if len(keyVindexes) == 0 {
+		// Nothing can be replaced.
+		return nil
+	}
+
+	// A row that has the same value as an inserted row for the primary
+	// vindex is on the shard of the inserted row, where the REPLACE
+	// deletes it. So, each shard looks for the rows inserted into it.
+	var shards []*srvtopo.ResolvedShard
+	localKeys := make(map[string][]replacedKey)
+	for i, indexValues := range indexesPerRss {
+		shard := rss[i].Target.Shard
+		shards = append(shards, rss[i])
+		for _, indexValue := range indexValues {
+			rowNum, _ := strconv.Atoi(string(indexValue.Value))
+			for _, vIdx := range keyVindexes {
+				localKeys[shard] = append(localKeys[shard], replacedKey{vIdx: vIdx, rowNum: rowNum})
+			}
+		}
+	}
+
+	// But a row that has the same value for an owned lookup vindex
+	// can be on any shard. The lookup vindex finds it, and it's
+	// deleted explicitly before the REPLACE.
+	remoteKeys, err := ins.lookupReplacedKeys(vcursor, keyVindexes, vindexRowsValues, ksids)
+	if err != nil {
+		return err
+	}
+	remoteKeysPerShard := make(map[string][]replacedKey)
+	var remoteShards []*srvtopo.ResolvedShard
+	if len(remoteKeys) != 0 {
+		indexes := make([]*querypb.Value, len(remoteKeys))
+		destinations := make([]key.Destination, len(remoteKeys))
+		for i, rk := range remoteKeys {
+			indexes[i] = &querypb.Value{
+				Value: strconv.AppendInt(nil, int64(i), 10),
+			}
+			destinations[i] = key.DestinationKeyspaceID(rk.ksid)
+		}
+		rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
+		if err != nil {
+			return err
+		}
+		for i, indexValues := range indexesPerRss {
+			shard := rss[i].Target.Shard
+			if _, ok := localKeys[shard]; !ok {
+				shards = append(shards, rss[i])
+			}
+			remoteShards = append(remoteShards, rss[i])
+			for _, indexValue := range indexValues {
+				i, _ := strconv.Atoi(string(indexValue.Value))
+				remoteKeysPerShard[shard] = append(remoteKeysPerShard[shard], remoteKeys[i])
+			}
+		}
+	}
+
+	queries := make([]*querypb.BoundQuery, len(shards))
+	for i, rs := range shards {
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("%s where ", ins.OwnedVindexQuery)
+		keys := localKeys[rs.Target.Shard]
+		for _, rk := range remoteKeysPerShard[rs.Target.Shard] {
+			if !containsKey(keys, rk) {
+				keys = append(keys, rk)
+			}
+		}
+		ins.writeKeyConditions(buf, keys, vindexRowsValues, bindVars)
+		buf.Myprintf(" for update")
+		queries[i] = &querypb.BoundQuery{
+			Sql:           buf.String(),
+			BindVariables: bindVars,
+		}
+	}
+	result, err := vcursor.ExecuteMultiShard(shards, queries, false /* isDML */, false /* canAutocommit */)
+	if err != nil {
+		return err
+	}
+	rows, rowKsids, err := ownedRows(vcursor, result, ins.Table)
+	if err != nil {
+		return err
+	}
+	if err := deleteOwnedEntries(vcursor, ins.Table, rows, rowKsids); err != nil {
+		return err
+	}
+	if len(remoteShards) == 0 {
+		return nil
+	}
+
+	queries = make([]*querypb.BoundQuery, len(remoteShards))
+	for i, rs := range remoteShards {
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("delete from %v where ", ins.Table.Name)
+		ins.writeKeyConditions(buf, remoteKeysPerShard[rs.Target.Shard], vindexRowsValues, bindVars)
+		queries[i] = &querypb.BoundQuery{
+			Sql:           buf.String(),
+			BindVariables: bindVars,
+		}
+	}
+	_, err = vcursor.ExecuteMultiShard(remoteShards, queries, true /* isDML */, false /* canAutocommit */)
+	return err
+}
+
+// replacedKey is a unique key value of an inserted row that can
+// match an existing row. For a row found by a lookup vindex, ksid
+// is the keyspace id of that row.
+type replacedKey struct {
+	vIdx   int
+	rowNum int
+	ksid   []byte
+}
+
+// containsKey returns true if keys has the value of rk.
+func containsKey(keys []replacedKey, rk replacedKey) bool {
+	for _, k := range keys {
+		if k.vIdx == rk.vIdx && k.rowNum == rk.rowNum {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupReplacedKeys returns the unique key values of the inserted
+// rows that an owned lookup vindex maps to a keyspace id other than
+// the one of the inserted row.
+func (ins *Insert) lookupReplacedKeys(vcursor VCursor, keyVindexes []int, vindexRowsValues [][][]sqltypes.Value, ksids [][]byte) ([]replacedKey, error) {
+	var remoteKeys []replacedKey
+	for _, vIdx := range keyVindexes {
+		if vIdx == 0 {
+			continue
+		}
+		ids := make([]sqltypes.Value, len(ksids))
+		for rowNum := range ksids {
+			ids[rowNum] = vindexRowsValues[vIdx][rowNum][0]
+		}
+		destinations, err := ins.Table.ColumnVindexes[vIdx].Vindex.Map(vcursor, ids)
+		if err != nil {
+			return nil, err
+		}
+		for rowNum, destination := range destinations {
+			var found [][]byte
+			switch d := destination.(type) {
+			case key.DestinationKeyspaceID:
+				found = [][]byte{d}
+			case key.DestinationKeyspaceIDs:
+				found = d
+			}
+			for _, ksid := range found {
+				if !bytes.Equal(ksid, ksids[rowNum]) {
+					remoteKeys = append(remoteKeys, replacedKey{vIdx: vIdx, rowNum: rowNum, ksid: ksid})
+				}
+			}
+		}
+	}
+	return remoteKeys, nil
+}
+
+// writeKeyConditions writes the conditions of a list of unique
+// key values, separated by or.
+func (ins *Insert) writeKeyConditions(buf *sqlparser.TrackedBuffer, keys []replacedKey, vindexRowsValues [][][]sqltypes.Value, bindVars map[string]*querypb.BindVariable) {
+	for i, rk := range keys {
+		if i > 0 {
+			buf.Myprintf(" or ")
+		}
+		ins.writeKeyCondition(buf, rk.vIdx, rk.rowNum, vindexRowsValues[rk.vIdx][rk.rowNum], bindVars)
+	}
+}
+
+// uniqueKeyVindexes returns the indexes of the colVindexes that have
+// the same columns as the unique keys of the table. It fails if a
+// unique key doesn't match the primary vindex or an owned vindex.
+// The unique keys are read from rs once, and cached.
+func (ins *Insert) uniqueKeyVindexes(vcursor VCursor, rs *srvtopo.ResolvedShard) ([]int, error) {
+	cache := ins
+	if ins.source != nil {
+		cache = ins.source
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if cache.keyVindexesLoaded {
+		return cache.keyVindexes, nil
+	}
+
+	queries := []*querypb.BoundQuery{{
+		Sql:           ins.UniqueKeyQuery,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	result, err := vcursor.ExecuteMultiShard([]*srvtopo.ResolvedShard{rs}, queries, false /* isDML */, false /* canAutocommit */)
+	if err != nil {
+		return nil, err
+	}
+	// The columns of SHOW INDEX start with Table, Non_unique, Key_name,
+	// Seq_in_index and Column_name. The columns of a key are returned
+	// in order.
+	var names []string
+	keys := make(map[string][]string)
+	for _, row := range result.Rows {
+		if len(row) < 5 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected unique key row: %v", row)
+		}
+		name := row[2].ToString()
+		if _, ok := keys[name]; !ok {
+			names = append(names, name)
+		}
+		keys[name] = append(keys[name], row[4].ToString())
+	}
+	var keyVindexes []int
+	for _, name := range names {
+		vIdx := ins.keyVindex(keys[name])
+		if vIdx < 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: replace into %v: unique key %s is not the column list of the primary vindex or an owned vindex", ins.Table.Name, name)
+		}
+		if vIdx > 0 && len(keys[name]) > 1 {
+			// Lookup vindexes map the value of their first column.
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: replace into %v: unique key %s is a multi-column lookup vindex", ins.Table.Name, name)
+		}
+		keyVindexes = append(keyVindexes, vIdx)
+	}
+	cache.keyVindexes = keyVindexes
+	cache.keyVindexesLoaded = true
+	return keyVindexes, nil
+}
+
+// keyVindex returns the index of the primary vindex or owned vindex
+// whose columns are the columns of a key, or -1 if there's none.
+func (ins *Insert) keyVindex(columns []string) int {
+	for vIdx, colVindex := range ins.Table.ColumnVindexes {
+		if vIdx != 0 && !colVindex.Owned {
+			continue
+		}
+		if len(colVindex.Columns) != len(columns) {
+			continue
+		}
+		match := true
+		for _, column := range columns {
+			if !columnIn(column, colVindex.Columns) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return vIdx
+		}
+	}
+	return -1
+}
+
+// columnIn returns true if the column is in the list.
+func columnIn(column string, columns []sqlparser.ColIdent) bool {
+	for _, col := range columns {
+		if col.EqualString(column) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeKeyCondition writes the condition that matches the rows that
+// have the same values as a row for the columns of a colVindex, and
+// sets the bind vars for those values.
+func (ins *Insert) writeKeyCondition(buf *sqlparser.TrackedBuffer, vIdx, rowNum int, values []sqltypes.Value, bindVars map[string]*querypb.BindVariable) {
+	columns := ins.Table.ColumnVindexes[vIdx].Columns
+	if len(columns) > 1 {
+		buf.Myprintf("(")
+	}
+	for colIdx, col := range columns {
+		if colIdx > 0 {
+			buf.Myprintf(" and ")
+		}
+		name := insertVarName(col, rowNum)
+		bindVars[name] = sqltypes.ValueBindVariable(values[colIdx])
+		buf.Myprintf("%v = :%s", col, name)
+	}
+	if len(columns) > 1 {
+		buf.Myprintf(")")
+	}
+}
+
 // processOwned creates vindex entries for the values of an owned column for InsertSharded.
 func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, bv map[string]*querypb.BindVariable, ksids [][]byte) error {
 	for rowNum, rowColumnKeys := range vindexColumnsKeys {
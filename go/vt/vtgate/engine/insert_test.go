@@ -119,6 +119,195 @@ func TestInsertUnshardedGenerate(t *testing.T) {
 	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 4})
 }
 
+func TestInsertUnshardedGenerateUncached(t *testing.T) {
+	ins := &Insert{
+		Opcode: InsertUnsharded,
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: false,
+		},
+		Query: "dummy_insert",
+		Generate: &Generate{
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks2",
+				Sharded: false,
+			},
+			Query: "dummy_generate",
+			Values: sqltypes.PlanValue{
+				Values: []sqltypes.PlanValue{
+					{Value: sqltypes.NULL},
+					{Value: sqltypes.NewInt64(2)},
+				},
+			},
+			Uncached: true,
+		},
+	}
+
+	vc := &loggingVCursor{
+		shards: []string{"0"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"4",
+			),
+			{InsertID: 1},
+		},
+		// The cache must not be used for uncached sequences.
+		sequenceCache: NewSequenceCache(10),
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		// The sequence value is reserved in the transaction.
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks2.0: dummy_generate {n: type:INT64 value:"1" } false false`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: dummy_insert {__seq0: type:INT64 value:"4" __seq1: type:INT64 value:"2" } true true`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 4})
+}
+
+func TestInsertUnshardedGenerateCache(t *testing.T) {
+	ins := &Insert{
+		Opcode: InsertUnsharded,
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: false,
+		},
+		Query: "dummy_insert",
+		Generate: &Generate{
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks2",
+				Sharded: false,
+			},
+			Query: "dummy_generate",
+			Values: sqltypes.PlanValue{
+				Values: []sqltypes.PlanValue{
+					{Value: sqltypes.NULL},
+					{Value: sqltypes.NULL},
+				},
+			},
+		},
+	}
+
+	vc := &loggingVCursor{
+		shards: []string{"0"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"4",
+			),
+			{},
+			{},
+		},
+		sequenceCache: NewSequenceCache(10),
+	}
+	for _, want := range []uint64{4, 6} {
+		result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectResult(t, "Execute", result, &sqltypes.Result{InsertID: want})
+	}
+	vc.ExpectLog(t, []string{
+		// A block of 10 values is leased by the first insert.
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"10"  ks2 0`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: dummy_insert {__seq0: type:INT64 value:"4" __seq1: type:INT64 value:"5" } true true`,
+		// The second insert takes its values from the block.
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: dummy_insert {__seq0: type:INT64 value:"6" __seq1: type:INT64 value:"7" } true true`,
+	})
+}
+
+func TestInsertUnshardedSelect(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col|id",
+					"varchar|int64",
+				),
+				"a|1",
+				"b|null",
+				"c|null",
+			),
+		},
+	}
+	// insert into t1(col, id) select ...: id is the auto-inc column.
+	ins := &Insert{
+		Opcode: InsertUnsharded,
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: false,
+		},
+		Generate: &Generate{
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks2",
+				Sharded: false,
+			},
+			Query: "dummy_generate",
+		},
+		Prefix:        "prefix ",
+		Suffix:        " suffix",
+		Input:         input,
+		AutoIncOffset: 1,
+		BatchSize:     2,
+	}
+
+	vc := &loggingVCursor{
+		shards: []string{"0"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"4",
+			),
+			{RowsAffected: 2},
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"5",
+			),
+			{RowsAffected: 1},
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input.ExpectLog(t, []string{
+		`Execute  false`,
+	})
+	vc.ExpectLog(t, []string{
+		// First batch: one value is generated.
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1"  ks2 0`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: prefix ('a', :__seq0),('b', :__seq1) suffix {__seq0: type:INT64 value:"1" __seq1: type:INT64 value:"4" } true false`,
+		// Second batch: the bind vars start over.
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1"  ks2 0`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: prefix ('c', :__seq0) suffix {__seq0: type:INT64 value:"5" } true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3, InsertID: 4})
+}
+
 func TestInsertShardedSimple(t *testing.T) {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -1371,3 +1560,503 @@ func TestInsertShardedUnownedReverseMapFail(t *testing.T) {
 	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
 	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: value must be supplied for column [c3]")
 }
+
+func TestInsertShardedSelect(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col|id",
+					"varchar|int64",
+				),
+				"a|1",
+				"b|null",
+				"c|2",
+			),
+		},
+	}
+	// insert into t1(col, id) select ...: id is also the auto-inc column.
+	ins := &Insert{
+		Opcode:   InsertSharded,
+		Keyspace: ks.Keyspace,
+		Table:    ks.Tables["t1"],
+		Generate: &Generate{
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks2",
+				Sharded: false,
+			},
+			Query: "dummy_generate",
+		},
+		Prefix:        "prefix ",
+		Suffix:        " suffix",
+		Input:         input,
+		VindexOffsets: [][]int{{1}},
+		AutoIncOffset: 1,
+		BatchSize:     2,
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"4",
+			),
+			{RowsAffected: 2},
+			{RowsAffected: 1},
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input.ExpectLog(t, []string{
+		`Execute  false`,
+	})
+	vc.ExpectLog(t, []string{
+		// First batch: one value is generated.
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1"  ks2 -20`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(d2fd8867d50d2dfe)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix ('a', :_id0) suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{__seq0: type:INT64 value:"1" __seq1: type:INT64 value:"4" _id0: type:INT64 value:"1" _id1: type:INT64 value:"4" } ` +
+			`sharded.-20: prefix ('b', :_id1) suffix /* vtgate:: keyspace_id:d2fd8867d50d2dfe */ ` +
+			`{__seq0: type:INT64 value:"1" __seq1: type:INT64 value:"4" _id0: type:INT64 value:"1" _id1: type:INT64 value:"4" } ` +
+			`true false`,
+		// Second batch: the bind vars start over.
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix ('c', :_id0) suffix /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{__seq0: type:INT64 value:"2" _id0: type:INT64 value:"2" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3, InsertID: 4})
+}
+
+func TestInsertShardedReplaceOwned(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := &Insert{
+		Opcode:   InsertShardedReplace,
+		Keyspace: ks.Keyspace,
+		VindexValues: []sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// 2 rows.
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(2),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// 2 rows.
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}, {
+					Value: sqltypes.NewInt64(20),
+				}},
+			}},
+		}},
+		Table:            ks.Tables["t1"],
+		Prefix:           "prefix",
+		Mid:              []string{" mid1", " mid2"},
+		Suffix:           " suffix",
+		OwnedVindexQuery: "dummy_subquery",
+		UniqueKeyQuery:   "dummy_keys",
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-", "-20"},
+		results: []*sqltypes.Result{
+			// id and c3 are unique keys.
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"Table|Non_unique|Key_name|Seq_in_index|Column_name",
+					"varchar|int64|varchar|int64|varchar",
+				),
+				"t1|0|PRIMARY|1|id",
+				"t1|0|c3_key|1|c3",
+			),
+			// No row has c3 = 10 or c3 = 20.
+			{},
+			{},
+			// The row with id 1 exists, and has c3 = 5.
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|c3",
+					"int64|int64",
+				),
+				"1|5",
+			),
+		},
+	}
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.20-: dummy_keys {} false false`,
+		`Execute select toc from lkp1 where from = :from from: type:INT64 value:"10"  false`,
+		`Execute select toc from lkp1 where from = :from from: type:INT64 value:"20"  false`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: dummy_subquery where id = :_id0 or c3 = :_c30 for update ` +
+			`{_c30: type:INT64 value:"10" _c31: type:INT64 value:"20" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`sharded.-20: dummy_subquery where id = :_id1 or c3 = :_c31 for update ` +
+			`{_c30: type:INT64 value:"10" _c31: type:INT64 value:"20" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`false false`,
+		// The entry of the replaced row is deleted.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0), (:from1, :toc1) ` +
+			`from0: type:INT64 value:"10" from1: type:INT64 value:"20" ` +
+			`toc0: type:VARBINARY value:"\026k@\264J\272K\326" toc1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c30: type:INT64 value:"10" _c31: type:INT64 value:"20" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`sharded.-20: prefix mid2 suffix /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{_c30: type:INT64 value:"10" _c31: type:INT64 value:"20" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`true true`,
+	})
+}
+
+func TestInsertShardedReplaceUniqueKeys(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	// The unique keys are cached by the Insert, so each case uses a new one.
+	newInsert := func() *Insert {
+		return &Insert{
+			Opcode:   InsertShardedReplace,
+			Keyspace: ks.Keyspace,
+			VindexValues: []sqltypes.PlanValue{{
+				// colVindex columns: id
+				Values: []sqltypes.PlanValue{{
+					// 1 row.
+					Values: []sqltypes.PlanValue{{
+						Value: sqltypes.NewInt64(1),
+					}},
+				}},
+			}, {
+				// colVindex columns: c3
+				Values: []sqltypes.PlanValue{{
+					// 1 row.
+					Values: []sqltypes.PlanValue{{
+						Value: sqltypes.NewInt64(10),
+					}},
+				}},
+			}},
+			Table:            ks.Tables["t1"],
+			Prefix:           "prefix",
+			Mid:              []string{" mid1"},
+			Suffix:           " suffix",
+			OwnedVindexQuery: "dummy_subquery",
+			UniqueKeyQuery:   "dummy_keys",
+		}
+	}
+	keyFields := sqltypes.MakeTestFields(
+		"Table|Non_unique|Key_name|Seq_in_index|Column_name",
+		"varchar|int64|varchar|int64|varchar",
+	)
+
+	// The primary vindex column is not a unique key:
+	// only c3 is used to find the replaced rows.
+	ins := newInsert()
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(keyFields, "t1|0|c3_key|1|c3"),
+			{},
+			{},
+		},
+	}
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_keys {} false false`,
+		`Execute select toc from lkp1 where from = :from from: type:INT64 value:"10"  false`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery where c3 = :_c30 for update {_c30: type:INT64 value:"10" _id0: type:INT64 value:"1" } false false`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) ` +
+			`from0: type:INT64 value:"10" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c30: type:INT64 value:"10" _id0: type:INT64 value:"1" } true true`,
+	})
+
+	// The unique keys are read once.
+	vc = &loggingVCursor{
+		shards: []string{"-20", "20-"},
+	}
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`Execute select toc from lkp1 where from = :from from: type:INT64 value:"10"  false`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery where c3 = :_c30 for update {_c30: type:INT64 value:"10" _id0: type:INT64 value:"1" } false false`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) ` +
+			`from0: type:INT64 value:"10" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c30: type:INT64 value:"10" _id0: type:INT64 value:"1" } true true`,
+	})
+
+	// No unique keys: nothing is replaced.
+	vc = &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{
+			{},
+		},
+	}
+	_, err = newInsert().Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_keys {} false false`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) ` +
+			`from0: type:INT64 value:"10" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c30: type:INT64 value:"10" _id0: type:INT64 value:"1" } true true`,
+	})
+
+	// A unique key that is not a vindex is not supported.
+	vc = &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(keyFields, "t1|0|PRIMARY|1|id", "t1|0|c4_key|1|c4", "t1|0|c4_key|2|c3"),
+		},
+	}
+	_, err = newInsert().Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: unsupported: replace into t1: unique key c4_key is not the column list of the primary vindex or an owned vindex")
+}
+
+func TestInsertShardedReplaceLookup(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := &Insert{
+		Opcode:   InsertShardedReplace,
+		Keyspace: ks.Keyspace,
+		VindexValues: []sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// 1 row.
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// 1 row.
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}},
+			}},
+		}},
+		Table:            ks.Tables["t1"],
+		Prefix:           "prefix",
+		Mid:              []string{" mid1"},
+		Suffix:           " suffix",
+		OwnedVindexQuery: "dummy_subquery",
+		UniqueKeyQuery:   "dummy_keys",
+	}
+
+	// The row with id 2 has c3 = 10, and is on another shard.
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20", "20-", "-20"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"Table|Non_unique|Key_name|Seq_in_index|Column_name",
+					"varchar|int64|varchar|int64|varchar",
+				),
+				"t1|0|PRIMARY|1|id",
+				"t1|0|c3_key|1|c3",
+			),
+			{
+				Rows: [][]sqltypes.Value{{
+					sqltypes.NewVarBinary("\x06\xe7\xea\x22\xce\x92\x70\x8f"),
+				}},
+			},
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|c3",
+					"int64|int64",
+				),
+				"2|10",
+			),
+		},
+	}
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_keys {} false false`,
+		`Execute select toc from lkp1 where from = :from from: type:INT64 value:"10"  false`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: dummy_subquery where id = :_id0 or c3 = :_c30 for update {_c30: type:INT64 value:"10" _id0: type:INT64 value:"1" } ` +
+			`sharded.20-: dummy_subquery where c3 = :_c30 for update {_c30: type:INT64 value:"10" _id0: type:INT64 value:"1" } ` +
+			`false false`,
+		// The entry of the replaced row is deleted, and then the row.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"10" toc: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ExecuteMultiShard sharded.20-: delete from t1 where c3 = :_c30 {_c30: type:INT64 value:"10" _id0: type:INT64 value:"1" } true false`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) ` +
+			`from0: type:INT64 value:"10" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c30: type:INT64 value:"10" _id0: type:INT64 value:"1" } true true`,
+	})
+}
@@ -46,10 +46,55 @@ type Join struct {
 	// be built from the LHS result before invoking
 	// the RHS subqquery.
 	Vars map[string]int `json:",omitempty"`
+
+	// EvalCols defines the row against which Predicate
+	// and NullValues are evaluated. It uses the same
+	// encoding as Cols.
+	EvalCols []int `json:",omitempty"`
+
+	// Predicate, if set, is evaluated against every
+	// joined row, and the rows for which it's not true
+	// are discarded. It's used for the WHERE clause of a
+	// LeftJoin, because it must be evaluated after the
+	// unmatched rows are null-extended.
+	Predicate FilterExpr `json:",omitempty"`
+
+	// NullValues defines how the values of the Right
+	// columns are computed for the null-extended rows of
+	// a LeftJoin. The keys are positions in Cols. The
+	// columns that are not listed are NULL.
+	NullValues map[int]FilterExpr `json:",omitempty"`
+
+	// Strategy specifies how the rows of Right are fetched
+	// and matched with the rows of Left. The default is
+	// NestedLoop, which executes Right once per Left row.
+	Strategy JoinStrategy `json:",omitempty"`
+
+	// Key specifies the columns compared by the BatchedJoin
+	// and HashJoin strategies.
+	Key *JoinKey `json:",omitempty"`
+
+	// ListVar is the bind variable used by BatchedJoin and
+	// HashJoin to send the key values of a batch of Left rows
+	// to Right.
+	ListVar string `json:",omitempty"`
+
+	// BatchSize is the max number of Left rows in a batch
+	// for the BatchedJoin and HashJoin strategies.
+	BatchSize int `json:",omitempty"`
+
+	// MaxRows is the max number of rows Right can return
+	// for a batch of the HashJoin strategy. The join fails
+	// if there are more. Zero means no limit.
+	MaxRows int `json:",omitempty"`
 }
 
 // Execute performs a non-streaming exec.
 func (jn *Join) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	switch jn.Strategy {
+	case BatchedJoin, HashJoin:
+		return jn.executeBatched(vcursor, bindVars, wantfields)
+	}
 	joinVars := make(map[string]*querypb.BindVariable)
 	lresult, err := jn.Left.Execute(vcursor, bindVars, wantfields)
 	if err != nil {
@@ -79,21 +124,21 @@ func (jn *Join) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariab
 			wantfields = false
 			result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
 		}
-		for _, rrow := range rresult.Rows {
-			result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
-		}
-		if jn.Opcode == LeftJoin && len(rresult.Rows) == 0 {
-			result.Rows = append(result.Rows, joinRows(lrow, nil, jn.Cols))
-			result.RowsAffected++
-		} else {
-			result.RowsAffected += uint64(len(rresult.Rows))
+		result.Rows, err = jn.appendJoined(result.Rows, lrow, rresult.Rows, bindVars)
+		if err != nil {
+			return nil, err
 		}
 	}
+	result.RowsAffected = uint64(len(result.Rows))
 	return result, nil
 }
 
 // StreamExecute performs a streaming exec.
 func (jn *Join) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	switch jn.Strategy {
+	case BatchedJoin, HashJoin:
+		return jn.streamExecuteBatched(vcursor, bindVars, wantfields, callback)
+	}
 	joinVars := make(map[string]*querypb.BindVariable)
 	err := jn.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
 		for _, lrow := range lresult.Rows {
@@ -111,7 +156,13 @@ func (jn *Join) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 					result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
 				}
 				for _, rrow := range rresult.Rows {
-					result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
+					row, ok, err := jn.joinRow(lrow, rrow, bindVars)
+					if err != nil {
+						return err
+					}
+					if ok {
+						result.Rows = append(result.Rows, row)
+					}
 				}
 				if len(rresult.Rows) != 0 {
 					rowSent = true
@@ -122,13 +173,16 @@ func (jn *Join) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 				return err
 			}
 			if jn.Opcode == LeftJoin && !rowSent {
-				result := &sqltypes.Result{}
-				result.Rows = [][]sqltypes.Value{joinRows(
-					lrow,
-					nil,
-					jn.Cols,
-				)}
-				return callback(result)
+				row, ok, err := jn.joinRow(lrow, nil, bindVars)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				if err := callback(&sqltypes.Result{Rows: [][]sqltypes.Value{row}}); err != nil {
+					return err
+				}
 			}
 		}
 		if wantfields {
@@ -160,6 +214,9 @@ func (jn *Join) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVari
 	for k := range jn.Vars {
 		joinVars[k] = sqltypes.NullBindVariable
 	}
+	if jn.ListVar != "" {
+		joinVars[jn.ListVar] = sqltypes.NullBindVariable
+	}
 	rresult, err := jn.Right.GetFields(vcursor, combineVars(bindVars, joinVars))
 	if err != nil {
 		return nil, err
@@ -168,6 +225,33 @@ func (jn *Join) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVari
 	return result, nil
 }
 
+// joinRow builds the row that joins lrow with rrow, which is nil
+// for the null-extended row of a LeftJoin. ok is false if the row
+// doesn't satisfy the Predicate.
+func (jn *Join) joinRow(lrow, rrow []sqltypes.Value, bindVars map[string]*querypb.BindVariable) (row []sqltypes.Value, ok bool, err error) {
+	row = joinRows(lrow, rrow, jn.Cols)
+	if jn.Predicate == nil && (rrow != nil || len(jn.NullValues) == 0) {
+		return row, true, nil
+	}
+	evalRow := joinRows(lrow, rrow, jn.EvalCols)
+	if rrow == nil {
+		for i, expr := range jn.NullValues {
+			row[i], err = expr.evaluate(evalRow, bindVars)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+	}
+	if jn.Predicate == nil {
+		return row, true, nil
+	}
+	v, err := jn.Predicate.evaluate(evalRow, bindVars)
+	if err != nil {
+		return nil, false, err
+	}
+	return row, isTrue(v), nil
+}
+
 func joinFields(lfields, rfields []*querypb.Field, cols []int) []*querypb.Field {
 	fields := make([]*querypb.Field, len(cols))
 	for i, index := range cols {
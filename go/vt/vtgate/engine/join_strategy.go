@@ -0,0 +1,387 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"math/big"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// JoinStrategy is a number representing the strategy
+// used by the Join primitive to match the rows of
+// Left and Right.
+type JoinStrategy int
+
+// This is the list of JoinStrategy values.
+const (
+	// NestedLoop executes Right once for every row of Left,
+	// with the join vars set to the values of that row.
+	NestedLoop = JoinStrategy(iota)
+	// BatchedJoin executes Right once for every BatchSize rows
+	// of Left, with ListVar set to the distinct key values of
+	// the batch. The rows returned by Right are then matched
+	// with the rows of Left by comparing their keys.
+	BatchedJoin
+	// HashJoin is executed like BatchedJoin, but is chosen for
+	// a Right that is confined to a single shard: its batches
+	// are bigger, because each one costs a single query. The
+	// memory it uses is bounded: the join fails if Right returns
+	// more than MaxRows rows for a batch. For both strategies,
+	// a hash table is built from the smaller of the Left batch
+	// and the Right result, and the rows of the other one are
+	// matched against it.
+	HashJoin
+)
+
+var joinStrategyName = map[JoinStrategy]string{
+	NestedLoop:  "NestedLoop",
+	BatchedJoin: "BatchedJoin",
+	HashJoin:    "HashJoin",
+}
+
+func (code JoinStrategy) String() string {
+	return joinStrategyName[code]
+}
+
+// MarshalJSON serializes the JoinStrategy as a JSON string.
+// It's used for testing and diagnostics.
+func (code JoinStrategy) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// JoinKey specifies the columns of the Left and Right
+// results that must be equal for two rows to join.
+type JoinKey struct {
+	LeftCol, RightCol int
+}
+
+// executeBatched performs a non-streaming exec for the BatchedJoin
+// and HashJoin strategies.
+func (jn *Join) executeBatched(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := jn.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	var rfields []*querypb.Field
+	if wantfields || (jn.Strategy == BatchedJoin && len(lresult.Rows) != 0) {
+		rresult, err := jn.rightFields(vcursor, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		rfields = rresult.Fields
+	}
+	for start := 0; start < len(lresult.Rows); start += jn.BatchSize {
+		end := start + jn.BatchSize
+		if end > len(lresult.Rows) {
+			end = len(lresult.Rows)
+		}
+		rows, err := jn.executeBatch(vcursor, bindVars, lresult.Rows[start:end], rfields)
+		if err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, rows...)
+	}
+	if wantfields {
+		result.Fields = joinFields(lresult.Fields, rfields, jn.Cols)
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// streamExecuteBatched performs a streaming exec for the BatchedJoin
+// and HashJoin strategies. The Left rows are streamed, and each batch
+// is joined as soon as it's full.
+func (jn *Join) streamExecuteBatched(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var rfields []*querypb.Field
+	loadFields := func() error {
+		if rfields != nil {
+			return nil
+		}
+		rresult, err := jn.rightFields(vcursor, bindVars)
+		if err != nil {
+			return err
+		}
+		rfields = rresult.Fields
+		return nil
+	}
+	var batch [][]sqltypes.Value
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if jn.Strategy == BatchedJoin {
+			if err := loadFields(); err != nil {
+				return err
+			}
+		}
+		rows, err := jn.executeBatch(vcursor, bindVars, batch, rfields)
+		batch = nil
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return callback(&sqltypes.Result{Rows: rows})
+	}
+	err := jn.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		if wantfields && len(lresult.Fields) != 0 {
+			wantfields = false
+			if err := loadFields(); err != nil {
+				return err
+			}
+			if err := callback(&sqltypes.Result{Fields: joinFields(lresult.Fields, rfields, jn.Cols)}); err != nil {
+				return err
+			}
+		}
+		for _, lrow := range lresult.Rows {
+			batch = append(batch, lrow)
+			if len(batch) < jn.BatchSize {
+				continue
+			}
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// executeBatch joins a batch of Left rows with the rows returned by
+// Right. The joined rows are returned in the order of lrows. rfields
+// are the fields of Right, which are only needed by BatchedJoin.
+//
+// The keys are matched by their value, which is only correct if mysql
+// would compare them the same way: they must all be numbers, or all
+// binary strings. Text values can only be compared using their
+// collation, which is not known here, and mysql converts strings to
+// numbers when comparing them with numbers. If the keys are not of
+// the same kind, Right is executed once per row, like NestedLoop, and
+// all the rows it returns are a match.
+func (jn *Join) executeBatch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrows [][]sqltypes.Value, rfields []*querypb.Field) (rows [][]sqltypes.Value, err error) {
+	if !jn.sameKeyKind(lrows, rfields) {
+		return jn.executeRows(vcursor, bindVars, lrows)
+	}
+
+	var keys []sqltypes.Value
+	seen := make(map[string]bool)
+	for _, lrow := range lrows {
+		k, ok := joinKey(lrow[jn.Key.LeftCol])
+		if !ok || seen[k] {
+			continue
+		}
+		seen[k] = true
+		keys = append(keys, lrow[jn.Key.LeftCol])
+	}
+	var rrows [][]sqltypes.Value
+	// If all keys are NULL, nothing can match.
+	if len(keys) != 0 {
+		rresult, err := jn.Right.Execute(vcursor, jn.listVars(bindVars, keys), false)
+		if err != nil {
+			return nil, err
+		}
+		if jn.MaxRows != 0 && len(rresult.Rows) > jn.MaxRows {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "hash join: the right side returned %d rows for a batch of %d rows, which is more than the limit of %d", len(rresult.Rows), len(lrows), jn.MaxRows)
+		}
+		rrows = rresult.Rows
+	}
+	matches := jn.hashMatch(lrows, rrows)
+	for i, lrow := range lrows {
+		rows, err = jn.appendJoined(rows, lrow, matches[i], bindVars)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// executeRows joins the Left rows by executing Right once per row,
+// with ListVar set to the key of the row. All the rows returned by
+// Right are a match.
+func (jn *Join) executeRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrows [][]sqltypes.Value) (rows [][]sqltypes.Value, err error) {
+	for _, lrow := range lrows {
+		var rrows [][]sqltypes.Value
+		if key := lrow[jn.Key.LeftCol]; !key.IsNull() {
+			rresult, err := jn.Right.Execute(vcursor, jn.listVars(bindVars, []sqltypes.Value{key}), false)
+			if err != nil {
+				return nil, err
+			}
+			rrows = rresult.Rows
+		}
+		rows, err = jn.appendJoined(rows, lrow, rrows, bindVars)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// rightFields returns the fields of Right. Like the join
+// vars of NestedLoop, ListVar is NULL.
+func (jn *Join) rightFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return jn.Right.GetFields(vcursor, combineVars(bindVars, map[string]*querypb.BindVariable{jn.ListVar: sqltypes.NullBindVariable}))
+}
+
+// listVars returns the bind vars for executing Right with ListVar
+// set to the specified values.
+func (jn *Join) listVars(bindVars map[string]*querypb.BindVariable, values []sqltypes.Value) map[string]*querypb.BindVariable {
+	list := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, len(values)),
+	}
+	for i, v := range values {
+		list.Values[i] = sqltypes.ValueToProto(v)
+	}
+	return combineVars(bindVars, map[string]*querypb.BindVariable{jn.ListVar: list})
+}
+
+// hashMatch returns the matching Right rows for each Left row.
+// The hash table is built from the smaller of the two. In either
+// case, the Right rows of a match are in the order of rrows.
+func (jn *Join) hashMatch(lrows, rrows [][]sqltypes.Value) [][][]sqltypes.Value {
+	buildLeft := len(lrows) < len(rrows)
+	matches := make([][][]sqltypes.Value, len(lrows))
+	if !buildLeft {
+		table := make(map[string][][]sqltypes.Value)
+		for _, rrow := range rrows {
+			if k, ok := joinKey(rrow[jn.Key.RightCol]); ok {
+				table[k] = append(table[k], rrow)
+			}
+		}
+		for i, lrow := range lrows {
+			if k, ok := joinKey(lrow[jn.Key.LeftCol]); ok {
+				matches[i] = table[k]
+			}
+		}
+		return matches
+	}
+
+	table := make(map[string][]int)
+	for i, lrow := range lrows {
+		if k, ok := joinKey(lrow[jn.Key.LeftCol]); ok {
+			table[k] = append(table[k], i)
+		}
+	}
+	for _, rrow := range rrows {
+		k, ok := joinKey(rrow[jn.Key.RightCol])
+		if !ok {
+			continue
+		}
+		for _, i := range table[k] {
+			matches[i] = append(matches[i], rrow)
+		}
+	}
+	return matches
+}
+
+// appendJoined appends the rows produced by joining lrow
+// with its matching rrows.
+func (jn *Join) appendJoined(rows [][]sqltypes.Value, lrow []sqltypes.Value, rrows [][]sqltypes.Value, bindVars map[string]*querypb.BindVariable) ([][]sqltypes.Value, error) {
+	for _, rrow := range rrows {
+		row, ok, err := jn.joinRow(lrow, rrow, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rows = append(rows, row)
+		}
+	}
+	if jn.Opcode == LeftJoin && len(rrows) == 0 {
+		row, ok, err := jn.joinRow(lrow, nil, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// joinKey returns the key used to match v with the values of the
+// other side of the join. Numbers are compared by value, and other
+// values as binary strings. So, a number never matches a string:
+// see sameKeyKind.
+// ok is false if v can't match anything, which is the case for NULL.
+func joinKey(v sqltypes.Value) (key string, ok bool) {
+	if v.IsNull() {
+		return "", false
+	}
+	if isNumberKey(v) {
+		r, ok := new(big.Rat).SetString(v.ToString())
+		if !ok {
+			return "", false
+		}
+		return "r" + r.RatString(), true
+	}
+	return "b" + v.ToString(), true
+}
+
+func isNumberKey(v sqltypes.Value) bool {
+	return isNumberType(v.Type())
+}
+
+func isNumberType(typ querypb.Type) bool {
+	return sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal
+}
+
+// sameKeyKind returns true if the non-NULL keys of lrows and the
+// values of the Right key column can be matched by their joinKey:
+// they must all be numbers, or all binary strings. This is checked
+// before Right is executed, using the type of its key column in
+// rfields. The planner only chooses HashJoin if both columns are
+// numbers, so rfields is not needed for it.
+func (jn *Join) sameKeyKind(lrows [][]sqltypes.Value, rfields []*querypb.Field) bool {
+	var numbers, binaries bool
+	if jn.Strategy == HashJoin {
+		numbers = true
+	} else {
+		switch typ := rfields[jn.Key.RightCol].Type; {
+		case typ == sqltypes.Null:
+		case isNumberType(typ):
+			numbers = true
+		case sqltypes.IsBinary(typ):
+			binaries = true
+		default:
+			return false
+		}
+	}
+	for _, row := range lrows {
+		v := row[jn.Key.LeftCol]
+		switch {
+		case v.IsNull():
+		case isNumberKey(v):
+			numbers = true
+		case v.IsBinary():
+			binaries = true
+		default:
+			return false
+		}
+	}
+	return !(numbers && binaries)
+}
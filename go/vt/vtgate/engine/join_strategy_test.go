@@ -0,0 +1,575 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestBatchedJoinExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"1|c",
+				"null|d",
+				"3|e",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|col4",
+		"varchar|int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"x|1",
+				"y|2",
+				"z|1",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	jn := &Join{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, -2, 1},
+		Strategy:  BatchedJoin,
+		Key:       &JoinKey{LeftCol: 0, RightCol: 1},
+		ListVar:   "list",
+		BatchSize: 3,
+	}
+	r, err := jn.Execute(nil, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`GetFields a: type:INT64 value:"10" list: `,
+		`Execute a: type:INT64 value:"10" list:  true`,
+		`Execute a: type:INT64 value:"10" list: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" >  false`,
+		`Execute a: type:INT64 value:"10" list: type:TUPLE values:<type:INT64 value:"3" >  false`,
+	})
+	wantFields := sqltypes.MakeTestFields(
+		"col1|col2|col3",
+		"int64|varchar|varchar",
+	)
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"1|a|x",
+		"1|a|z",
+		"2|b|y",
+		"1|c|x",
+		"1|c|z",
+	))
+
+	// Left Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.Opcode = LeftJoin
+	r, err = wrapStreamExecute(jn, nil, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute a: type:INT64 value:"10"  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`GetFields a: type:INT64 value:"10" list: `,
+		`Execute a: type:INT64 value:"10" list:  true`,
+		`Execute a: type:INT64 value:"10" list: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" >  false`,
+		`Execute a: type:INT64 value:"10" list: type:TUPLE values:<type:INT64 value:"3" >  false`,
+	})
+	expectResult(t, "jn.StreamExecute", r, sqltypes.MakeTestResult(
+		wantFields,
+		"1|a|x",
+		"1|a|z",
+		"2|b|y",
+		"1|c|x",
+		"1|c|z",
+		"null|d|null",
+		"3|e|null",
+	))
+}
+
+func TestBatchedJoinText(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"varchar",
+				),
+				"a",
+				"null",
+				"b",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col2",
+		"varchar",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"A",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+		},
+	}
+
+	// Text values are not compared by the join:
+	// Right is executed once per row.
+	jn := &Join{
+		Opcode:    LeftJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		Strategy:  BatchedJoin,
+		Key:       &JoinKey{LeftCol: 0, RightCol: 0},
+		ListVar:   "list",
+		BatchSize: 100,
+	}
+	r, err := jn.Execute(nil, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`GetFields list: `,
+		`Execute list:  true`,
+		`Execute list: type:TUPLE values:<type:VARCHAR value:"a" >  false`,
+		`Execute list: type:TUPLE values:<type:VARCHAR value:"b" >  false`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"varchar|varchar",
+		),
+		"a|A",
+		"null|null",
+		"b|null",
+	))
+}
+
+func TestBatchedJoinNoResult(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"int64",
+				),
+				"null",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col2",
+					"int64",
+				),
+			),
+		},
+	}
+
+	// A NULL key can't match: Right is not executed.
+	jn := &Join{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		Strategy:  BatchedJoin,
+		Key:       &JoinKey{LeftCol: 0, RightCol: 0},
+		ListVar:   "list",
+		BatchSize: 100,
+	}
+	r, err := jn.Execute(nil, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`GetFields list: `,
+		`Execute list:  true`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|int64",
+		),
+	))
+}
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"1.0|c",
+				"null|d",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|col4",
+		"decimal|varchar",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"1|x",
+				"3|y",
+				"1.00|z",
+			),
+		},
+	}
+
+	// The hash table is built from the Right rows.
+	jn := &Join{
+		Opcode:    LeftJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-2, 2},
+		Strategy:  HashJoin,
+		Key:       &JoinKey{LeftCol: 0, RightCol: 0},
+		ListVar:   "list",
+		BatchSize: 10,
+	}
+	r, err := jn.Execute(nil, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`GetFields list: `,
+		`Execute list:  true`,
+		`Execute list: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" >  false`,
+	})
+	wantFields := sqltypes.MakeTestFields(
+		"col2|col4",
+		"varchar|varchar",
+	)
+	wantResult := sqltypes.MakeTestResult(
+		wantFields,
+		"a|x",
+		"a|z",
+		"b|null",
+		"c|x",
+		"c|z",
+		"d|null",
+	)
+	expectResult(t, "jn.Execute", r, wantResult)
+
+	// The hash table is built from the Left rows.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	rightPrim.results = rightPrim.results[1:]
+	rightPrim.results[0].Rows = append(rightPrim.results[0].Rows, rightPrim.results[0].Rows...)
+	jn.Opcode = NormalJoin
+	r, err = jn.Execute(nil, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult = sqltypes.MakeTestResult(
+		wantFields,
+		"a|x",
+		"a|z",
+		"a|x",
+		"a|z",
+		"c|x",
+		"c|z",
+		"c|x",
+		"c|z",
+	)
+	wantResult.Fields = nil
+	expectResult(t, "jn.Execute", r, wantResult)
+}
+
+func TestHashJoinStreamExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|col4",
+		"int64|varchar",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"3|x",
+				"1|y",
+			),
+		},
+	}
+
+	jn := &Join{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-2, 2},
+		Strategy:  HashJoin,
+		Key:       &JoinKey{LeftCol: 0, RightCol: 0},
+		ListVar:   "list",
+		BatchSize: 10,
+	}
+	r, err := wrapStreamExecute(jn, nil, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`GetFields list: `,
+		`Execute list:  true`,
+		`Execute list: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" > values:<type:INT64 value:"3" >  false`,
+	})
+	expectResult(t, "jn.StreamExecute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col2|col4",
+			"varchar|varchar",
+		),
+		"a|y",
+		"c|x",
+	))
+}
+
+func TestHashJoinText(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"varchar",
+				),
+				"a",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col2",
+					"varchar",
+				),
+				"A",
+			),
+		},
+	}
+
+	// Text values are not compared by the join:
+	// Right is executed once per row.
+	jn := &Join{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		Strategy:  HashJoin,
+		Key:       &JoinKey{LeftCol: 0, RightCol: 0},
+		ListVar:   "list",
+		BatchSize: 10,
+	}
+	r, err := jn.Execute(nil, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`Execute list: type:TUPLE values:<type:VARCHAR value:"a" >  false`,
+	})
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"varchar|varchar",
+		),
+		"a|A",
+	)
+	wantResult.Fields = nil
+	expectResult(t, "jn.Execute", r, wantResult)
+}
+
+func TestBatchedJoinKeyKinds(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"int64",
+				),
+				"5",
+				"6",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col2",
+		"varchar",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"5",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"6.0",
+			),
+		},
+	}
+
+	// The Right key column is text, which vtgate can't compare
+	// with the numbers of the Left rows: this is known from the
+	// fields of Right, and Right is executed once per row.
+	jn := &Join{
+		Opcode:    LeftJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		Strategy:  BatchedJoin,
+		Key:       &JoinKey{LeftCol: 0, RightCol: 0},
+		ListVar:   "list",
+		BatchSize: 10,
+	}
+	r, err := jn.Execute(nil, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`GetFields list: `,
+		`Execute list:  true`,
+		`Execute list: type:TUPLE values:<type:INT64 value:"5" >  false`,
+		`Execute list: type:TUPLE values:<type:INT64 value:"6" >  false`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"5|5",
+		"6|6.0",
+	))
+}
+
+func TestHashJoinMaxRows(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"int64",
+				),
+				"1",
+				"2",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col2",
+					"int64",
+				),
+				"1",
+				"1",
+				"2",
+			),
+		},
+	}
+
+	jn := &Join{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		Cols:      []int{-1, 1},
+		Strategy:  HashJoin,
+		Key:       &JoinKey{LeftCol: 0, RightCol: 0},
+		ListVar:   "list",
+		BatchSize: 10,
+		MaxRows:   2,
+	}
+	_, err := jn.Execute(nil, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "jn.Execute", err, "hash join: the right side returned 3 rows for a batch of 2 rows, which is more than the limit of 2")
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.MaxRows = 3
+	r, err := jn.Execute(nil, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Rows) != 3 {
+		t.Errorf("jn.Execute: %d rows, want 3", len(r.Rows))
+	}
+}
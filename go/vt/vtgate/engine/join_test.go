@@ -440,3 +440,88 @@ func TestGetFieldsErrors(t *testing.T) {
 	_, err = jn.GetFields(nil, map[string]*querypb.BindVariable{})
 	expectError(t, "jn.GetFields", err, "right err")
 }
+
+func TestLeftJoinPredicate(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col3|col4",
+		"int64|int64",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"4|0",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"5|1",
+				"6|0",
+			),
+		},
+	}
+
+	// col4 is computed by Right. It must be 1 for
+	// the null-extended rows.
+	// The predicate is: col3 is null or col1 > 2.
+	jn := &Join{
+		Opcode: LeftJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-1, 1, 2},
+		Vars: map[string]int{
+			"bv": 1,
+		},
+		EvalCols: []int{-1, 1},
+		Predicate: &FilterLogical{
+			Operator: FilterOr,
+			Left:     &FilterIsNull{Expr: &FilterColumn{Col: 1}},
+			Right: &FilterComparison{
+				Operator: ">",
+				Left:     &FilterColumn{Col: 0},
+				Right:    &FilterValue{Value: sqltypes.PlanValue{Value: sqltypes.NewInt64(2)}},
+			},
+		},
+		NullValues: map[int]FilterExpr{
+			2: &FilterIsNull{Expr: &FilterValue{}},
+		},
+	}
+	r, err := jn.Execute(nil, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col3|col4",
+			"int64|int64|int64",
+		),
+		"2|null|1",
+		"3|5|1",
+		"3|6|0",
+	)
+	expectResult(t, "jn.Execute", r, wantResult)
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	rightPrim.results = append([]*sqltypes.Result{sqltypes.MakeTestResult(rightFields)}, rightPrim.results...)
+	r, err = wrapStreamExecute(jn, nil, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "jn.StreamExecute", r, wantResult)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"sort"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*MemorySort)(nil)
+
+// MemorySort is a primitive that sorts the rows of its Input
+// in memory. It's used when the ordering can't be pushed down
+// to a route, like for the ORDER BY of a UNION that spans
+// multiple routes. All the rows are read before any is returned.
+type MemorySort struct {
+	OrderBy []OrderbyParams
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int
+
+	Input Primitive
+}
+
+// MarshalJSON serializes the MemorySort into a JSON representation.
+// It's used for testing and diagnostics.
+func (ms *MemorySort) MarshalJSON() ([]byte, error) {
+	marshalMemorySort := struct {
+		Opcode              string
+		OrderBy             []OrderbyParams
+		TruncateColumnCount int `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "MemorySort",
+		OrderBy:             ms.OrderBy,
+		TruncateColumnCount: ms.TruncateColumnCount,
+		Input:               ms.Input,
+	}
+	return json.Marshal(marshalMemorySort)
+}
+
+// Execute satisfies the Primitive interface.
+func (ms *MemorySort) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := ms.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	sorted := *result
+	sorted.Rows = make([][]sqltypes.Value, len(result.Rows))
+	copy(sorted.Rows, result.Rows)
+	if err := ms.sort(sorted.Rows); err != nil {
+		return nil, err
+	}
+	return sorted.Truncate(ms.TruncateColumnCount), nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (ms *MemorySort) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var rows [][]sqltypes.Value
+	err := ms.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			if err := callback((&sqltypes.Result{Fields: qr.Fields}).Truncate(ms.TruncateColumnCount)); err != nil {
+				return err
+			}
+		}
+		rows = append(rows, qr.Rows...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := ms.sort(rows); err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return callback((&sqltypes.Result{Rows: rows}).Truncate(ms.TruncateColumnCount))
+}
+
+// GetFields satisfies the Primitive interface.
+func (ms *MemorySort) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := ms.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(ms.TruncateColumnCount), nil
+}
+
+// sort sorts the rows in place. The sort is stable, which
+// preserves the order of the input for equal rows.
+func (ms *MemorySort) sort(rows [][]sqltypes.Value) error {
+	var err error
+	sort.SliceStable(rows, func(i, j int) bool {
+		// If there are any errors below, the function sets
+		// the external err and returns true. Once err is set,
+		// all subsequent calls return true. This will make
+		// sort think that all the rows are already sorted.
+		if err != nil {
+			return true
+		}
+		for _, order := range ms.OrderBy {
+			var cmp int
+			cmp, err = sqltypes.NullsafeCompare(rows[i][order.Col], rows[j][order.Col])
+			if err != nil {
+				return true
+			}
+			if cmp == 0 {
+				continue
+			}
+			if order.Desc {
+				cmp = -cmp
+			}
+			return cmp < 0
+		}
+		return false
+	})
+	return err
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestMemorySortExecute(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"c1|c2|weight_string(c2)",
+				"int64|varchar|varbinary",
+			),
+			"1|b|B",
+			"2|a|A",
+			"3|B|B",
+			"null|c|C",
+			"5|a|A",
+		)},
+	}
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{
+			Col:  2,
+			Desc: false,
+		}, {
+			Col:  0,
+			Desc: true,
+		}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	result, err := ms.Execute(nil, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantFields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"int64|varchar",
+	)
+	wantResult := sqltypes.MakeTestResult(
+		wantFields,
+		"5|a",
+		"2|a",
+		"3|B",
+		"1|b",
+		"null|c",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("ms.Execute:\n%v, want\n%v", result, wantResult)
+	}
+
+	fp.rewind()
+	var results []*sqltypes.Result
+	err = ms.StreamExecute(nil, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResults := sqltypes.MakeTestStreamingResults(
+		wantFields,
+		"5|a",
+		"2|a",
+		"3|B",
+		"1|b",
+		"null|c",
+	)
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("ms.StreamExecute:\n%s, want\n%s", sqltypes.PrintResults(results), sqltypes.PrintResults(wantResults))
+	}
+
+	fp.rewind()
+	result, err = ms.GetFields(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result.Fields, wantFields) {
+		t.Errorf("ms.GetFields:\n%v, want\n%v", result.Fields, wantFields)
+	}
+}
+
+func TestMemorySortCompareFail(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"c1",
+				"varchar",
+			),
+			"b",
+			"a",
+		)},
+	}
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{Col: 0}},
+		Input:   fp,
+	}
+
+	want := "types are not comparable: VARCHAR vs VARCHAR"
+	if _, err := ms.Execute(nil, nil, false); err == nil || err.Error() != want {
+		t.Errorf("ms.Execute(): %v, want %s", err, want)
+	}
+}
+
+func TestMemorySortInputFail(t *testing.T) {
+	ms := &MemorySort{Input: &fakePrimitive{sendErr: errors.New("input fail")}}
+
+	want := "input fail"
+	if _, err := ms.Execute(nil, nil, false); err == nil || err.Error() != want {
+		t.Errorf("ms.Execute(): %v, want %s", err, want)
+	}
+	if err := ms.StreamExecute(nil, nil, false, func(_ *sqltypes.Result) error { return nil }); err == nil || err.Error() != want {
+		t.Errorf("ms.StreamExecute(): %v, want %s", err, want)
+	}
+}
@@ -18,6 +18,8 @@ package engine
 
 import (
 	"fmt"
+	"math/big"
+	"strconv"
 
 	"vitess.io/vitess/go/sqltypes"
 
@@ -32,6 +34,11 @@ var _ Primitive = (*OrderedAggregate)(nil)
 // is that the underlying primitive is a scatter select with pre-sorted
 // rows.
 type OrderedAggregate struct {
+	// HasDistinct is true if one of the aggregates is distinct.
+	// The rows must then also be sorted by the KeyCol of that
+	// aggregate, after the Keys.
+	HasDistinct bool `json:",omitempty"`
+
 	// Aggregates specifies the aggregation parameters for each
 	// aggregation function: function opcode and input column number.
 	Aggregates []AggregateParams
@@ -54,6 +61,19 @@ type OrderedAggregate struct {
 type AggregateParams struct {
 	Opcode AggregateOpcode
 	Col    int
+
+	// KeyCol is the column used to compare the values of a
+	// distinct aggregate. It's the weight_string of Col for
+	// text columns, and Col otherwise.
+	KeyCol int `json:",omitempty"`
+
+	// CountCol is the column that holds the partial counts
+	// of an AVG. Col holds the partial sums.
+	CountCol int `json:",omitempty"`
+
+	// Alias is the name of the result column, if it differs
+	// from the name of the input column.
+	Alias string `json:",omitempty"`
 }
 
 // AggregateOpcode is the aggregation Opcode.
@@ -65,6 +85,9 @@ const (
 	AggregateSum
 	AggregateMin
 	AggregateMax
+	AggregateCountDistinct
+	AggregateSumDistinct
+	AggregateAvg
 )
 
 // SupportedAggregates maps the list of supported aggregate
@@ -74,9 +97,28 @@ var SupportedAggregates = map[string]AggregateOpcode{
 	"sum":   AggregateSum,
 	"min":   AggregateMin,
 	"max":   AggregateMax,
+	"avg":   AggregateAvg,
 }
 
+// DistinctAggregates maps the opcodes of the aggregate functions
+// that have a distinct variant to the opcode of that variant.
+var DistinctAggregates = map[AggregateOpcode]AggregateOpcode{
+	AggregateCount: AggregateCountDistinct,
+	AggregateSum:   AggregateSumDistinct,
+}
+
+var (
+	countZero = sqltypes.MakeTrusted(sqltypes.Int64, []byte("0"))
+	countOne  = sqltypes.MakeTrusted(sqltypes.Int64, []byte("1"))
+)
+
 func (code AggregateOpcode) String() string {
+	switch code {
+	case AggregateCountDistinct:
+		return "count_distinct"
+	case AggregateSumDistinct:
+		return "sum_distinct"
+	}
 	for k, v := range SupportedAggregates {
 		if v == code {
 			return k
@@ -106,15 +148,16 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 		return nil, err
 	}
 	out := &sqltypes.Result{
-		Fields: result.Fields,
+		Fields: oa.convertFields(result.Fields),
 		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
 		Extras: result.Extras,
 	}
 	// This code is similar to the one in StreamExecute.
 	var current []sqltypes.Value
+	var curDistinct sqltypes.Value
 	for _, row := range result.Rows {
 		if current == nil {
-			current = row
+			current, curDistinct = oa.convertRow(row)
 			continue
 		}
 
@@ -124,16 +167,22 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 		}
 
 		if equal {
-			current, err = oa.merge(result.Fields, current, row)
+			current, curDistinct, err = oa.merge(out.Fields, current, row, curDistinct)
 			if err != nil {
 				return nil, err
 			}
 			continue
 		}
+		if current, err = oa.finalize(out.Fields, current); err != nil {
+			return nil, err
+		}
 		out.Rows = append(out.Rows, current)
-		current = row
+		current, curDistinct = oa.convertRow(row)
 	}
 	if current != nil {
+		if current, err = oa.finalize(out.Fields, current); err != nil {
+			return nil, err
+		}
 		out.Rows = append(out.Rows, current)
 	}
 	out.RowsAffected = uint64(len(out.Rows))
@@ -143,15 +192,23 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 // StreamExecute is a Primitive function.
 func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	var current []sqltypes.Value
+	var curDistinct sqltypes.Value
 	var fields []*querypb.Field
 
 	cb := func(qr *sqltypes.Result) error {
 		return callback(qr.Truncate(oa.TruncateColumnCount))
 	}
+	emit := func() error {
+		row, err := oa.finalize(fields, current)
+		if err != nil {
+			return err
+		}
+		return cb(&sqltypes.Result{Rows: [][]sqltypes.Value{row}})
+	}
 
 	err := oa.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
-			fields = qr.Fields
+			fields = oa.convertFields(qr.Fields)
 			if err := cb(&sqltypes.Result{Fields: fields}); err != nil {
 				return err
 			}
@@ -159,7 +216,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 		// This code is similar to the one in Execute.
 		for _, row := range qr.Rows {
 			if current == nil {
-				current = row
+				current, curDistinct = oa.convertRow(row)
 				continue
 			}
 
@@ -169,16 +226,16 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 			}
 
 			if equal {
-				current, err = oa.merge(fields, current, row)
+				current, curDistinct, err = oa.merge(fields, current, row, curDistinct)
 				if err != nil {
 					return err
 				}
 				continue
 			}
-			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+			if err := emit(); err != nil {
 				return err
 			}
-			current = row
+			current, curDistinct = oa.convertRow(row)
 		}
 		return nil
 	})
@@ -187,7 +244,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	}
 
 	if current != nil {
-		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+		if err := emit(); err != nil {
 			return err
 		}
 	}
@@ -200,9 +257,77 @@ func (oa *OrderedAggregate) GetFields(vcursor VCursor, bindVars map[string]*quer
 	if err != nil {
 		return nil, err
 	}
+	qr = &sqltypes.Result{Fields: oa.convertFields(qr.Fields)}
 	return qr.Truncate(oa.TruncateColumnCount), nil
 }
 
+// convertFields returns the fields of the result. The input fields
+// of distinct aggregates and AVG hold the partial values received
+// from the shards, so the corresponding result fields are changed
+// to reflect the final values.
+func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Field {
+	var newFields []*querypb.Field
+	for _, aggr := range oa.Aggregates {
+		if aggr.Alias == "" {
+			continue
+		}
+		if newFields == nil {
+			newFields = make([]*querypb.Field, len(fields))
+			copy(newFields, fields)
+		}
+		field := *fields[aggr.Col]
+		field.Name = aggr.Alias
+		switch aggr.Opcode {
+		case AggregateCountDistinct:
+			field.Type = sqltypes.Int64
+		case AggregateSumDistinct:
+			if !sqltypes.IsFloat(field.Type) {
+				field.Type = sqltypes.Decimal
+			}
+		case AggregateAvg:
+			if !sqltypes.IsFloat(field.Type) {
+				if field.Type != sqltypes.Decimal {
+					field.Decimals = 0
+				}
+				field.Type = sqltypes.Decimal
+				field.Decimals = avgDecimals(field.Decimals)
+			}
+		}
+		newFields[aggr.Col] = &field
+	}
+	if newFields == nil {
+		return fields
+	}
+	return newFields
+}
+
+// convertRow returns the first row of a group. If there's a distinct
+// aggregate, its column is converted to the first partial value, and
+// the distinct value is returned.
+func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistinct sqltypes.Value) {
+	if !oa.HasDistinct {
+		return row, sqltypes.NULL
+	}
+	newRow = sqltypes.CopyRow(row)
+	for _, aggr := range oa.Aggregates {
+		switch aggr.Opcode {
+		case AggregateCountDistinct:
+			curDistinct = row[aggr.KeyCol]
+			if row[aggr.Col].IsNull() {
+				newRow[aggr.Col] = countZero
+			} else {
+				newRow[aggr.Col] = countOne
+			}
+		case AggregateSumDistinct:
+			curDistinct = row[aggr.KeyCol]
+			if v := row[aggr.Col]; !v.IsNull() && !sqltypes.IsFloat(v.Type()) {
+				newRow[aggr.Col] = sqltypes.MakeTrusted(sqltypes.Decimal, v.ToBytes())
+			}
+		}
+	}
+	return newRow, curDistinct
+}
+
 func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error) {
 	for _, key := range oa.Keys {
 		cmp, err := sqltypes.NullsafeCompare(row1[key], row2[key])
@@ -216,23 +341,107 @@ func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error)
 	return true, nil
 }
 
-func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value) ([]sqltypes.Value, error) {
+func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistinct sqltypes.Value) ([]sqltypes.Value, sqltypes.Value, error) {
 	result := sqltypes.CopyRow(row1)
 	for _, aggr := range oa.Aggregates {
+		if aggr.Opcode == AggregateCountDistinct || aggr.Opcode == AggregateSumDistinct {
+			if row2[aggr.KeyCol].IsNull() {
+				continue
+			}
+			cmp, err := sqltypes.NullsafeCompare(curDistinct, row2[aggr.KeyCol])
+			if err != nil {
+				return nil, sqltypes.NULL, err
+			}
+			if cmp == 0 {
+				continue
+			}
+			curDistinct = row2[aggr.KeyCol]
+		}
 		var err error
 		switch aggr.Opcode {
-		case AggregateCount, AggregateSum:
+		case AggregateCount, AggregateSum, AggregateSumDistinct:
 			result[aggr.Col], err = sqltypes.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], fields[aggr.Col].Type)
 		case AggregateMin:
 			result[aggr.Col], err = sqltypes.Min(row1[aggr.Col], row2[aggr.Col])
 		case AggregateMax:
 			result[aggr.Col], err = sqltypes.Max(row1[aggr.Col], row2[aggr.Col])
+		case AggregateCountDistinct:
+			result[aggr.Col], err = sqltypes.NullsafeAdd(row1[aggr.Col], countOne, fields[aggr.Col].Type)
+		case AggregateAvg:
+			result[aggr.Col], err = sqltypes.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], fields[aggr.Col].Type)
+			if err != nil {
+				return nil, sqltypes.NULL, err
+			}
+			result[aggr.CountCol], err = sqltypes.NullsafeAdd(row1[aggr.CountCol], row2[aggr.CountCol], fields[aggr.CountCol].Type)
 		default:
-			return nil, fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
+			return nil, sqltypes.NULL, fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
+		}
+		if err != nil {
+			return nil, sqltypes.NULL, err
+		}
+	}
+	return result, curDistinct, nil
+}
+
+// finalize computes the final values of the aggregates of a group
+// that cannot be merged as they come, like AVG.
+func (oa *OrderedAggregate) finalize(fields []*querypb.Field, row []sqltypes.Value) ([]sqltypes.Value, error) {
+	result := row
+	copied := false
+	for _, aggr := range oa.Aggregates {
+		if aggr.Opcode != AggregateAvg {
+			continue
 		}
+		// The row may come straight from the input.
+		if !copied {
+			result = sqltypes.CopyRow(row)
+			copied = true
+		}
+		var err error
+		result[aggr.Col], err = average(row[aggr.Col], row[aggr.CountCol], fields[aggr.Col])
 		if err != nil {
 			return nil, err
 		}
 	}
 	return result, nil
 }
+
+// average divides a sum by a count. Like MySQL, the result is a
+// DOUBLE for floating point sums, and a DECIMAL with 4 more digits
+// after the decimal point otherwise. field is the converted field
+// of the result.
+func average(sum, count sqltypes.Value, field *querypb.Field) (sqltypes.Value, error) {
+	if sum.IsNull() || count.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	n, err := sqltypes.ToUint64(count)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if n == 0 {
+		return sqltypes.NULL, nil
+	}
+	if sqltypes.IsFloat(field.Type) {
+		f, err := sqltypes.ToFloat64(sum)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return sqltypes.MakeTrusted(field.Type, strconv.AppendFloat(nil, f/float64(n), 'g', -1, 64)), nil
+	}
+	r, ok := new(big.Rat).SetString(sum.ToString())
+	if !ok {
+		return sqltypes.NULL, fmt.Errorf("could not parse sum for average: %v", sum)
+	}
+	r.Quo(r, new(big.Rat).SetInt(new(big.Int).SetUint64(n)))
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(r.FloatString(int(field.Decimals)))), nil
+}
+
+// avgDecimals returns the number of digits after the decimal point
+// of an AVG, given the one of the SUM.
+func avgDecimals(decimals uint32) uint32 {
+	decimals += 4
+	if decimals > 30 {
+		decimals = 30
+	}
+	return decimals
+}
@@ -367,7 +367,7 @@ func TestMerge(t *testing.T) {
 		"1|3|2.8|2|bc",
 	)
 
-	merged, err := oa.merge(fields, r.Rows[0], r.Rows[1])
+	merged, _, err := oa.merge(fields, r.Rows[0], r.Rows[1], sqltypes.NULL)
 	if err != nil {
 		t.Error(err)
 	}
@@ -377,7 +377,7 @@ func TestMerge(t *testing.T) {
 	}
 
 	// swap and retry
-	merged, err = oa.merge(fields, r.Rows[1], r.Rows[0])
+	merged, _, err = oa.merge(fields, r.Rows[1], r.Rows[0], sqltypes.NULL)
 	if err != nil {
 		t.Error(err)
 	}
@@ -385,3 +385,172 @@ func TestMerge(t *testing.T) {
 		t.Errorf("oa.merge(row1, row2): %v, want %v", merged, want)
 	}
 }
+
+func TestOrderedAggregateAvg(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|sum(a)|sum(f)|count(a)|count(f)",
+				"varbinary|decimal|float64|int64|int64",
+			),
+			"a|1|1.5|1|1",
+			"a|2|3|2|1",
+			"b|null|null|0|0",
+			"c|10|2|4|4",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode:   AggregateAvg,
+			Col:      1,
+			CountCol: 3,
+			Alias:    "avg(a)",
+		}, {
+			Opcode:   AggregateAvg,
+			Col:      2,
+			CountCol: 4,
+			Alias:    "avg(f)",
+		}},
+		Keys:                []int{0},
+		TruncateColumnCount: 3,
+		Input:               fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	if err != nil {
+		t.Error(err)
+	}
+
+	wantFields := sqltypes.MakeTestFields(
+		"col|avg(a)|avg(f)",
+		"varbinary|decimal|float64",
+	)
+	wantFields[1].Decimals = 4
+	wantResult := sqltypes.MakeTestResult(
+		wantFields,
+		"a|1.0000|2.25",
+		"b|null|null",
+		"c|2.5000|0.5",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("oa.Execute:\n%v, want\n%v", result, wantResult)
+	}
+
+	// StreamExecute must return the same rows.
+	fp.rewind()
+	var results []*sqltypes.Result
+	err = oa.StreamExecute(nil, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	wantResults := sqltypes.MakeTestStreamingResults(
+		wantFields,
+		"a|1.0000|2.25",
+		"---",
+		"b|null|null",
+		"---",
+		"c|2.5000|0.5",
+	)
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("oa.StreamExecute:\n%s, want\n%s", sqltypes.PrintResults(results), sqltypes.PrintResults(wantResults))
+	}
+}
+
+func TestOrderedAggregateCountDistinct(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|textcol|weight_string(textcol)",
+				"int64|varchar|varbinary",
+			),
+			"1|null|null",
+			"1|a|A",
+			"1|A|A",
+			"1|b|B",
+			"2|null|null",
+			"3|c|C",
+			"3|d|D",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			KeyCol: 2,
+			Alias:  "count(distinct textcol)",
+		}},
+		Keys:                []int{0},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	if err != nil {
+		t.Error(err)
+	}
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(distinct textcol)",
+			"int64|int64",
+		),
+		"1|2",
+		"2|0",
+		"3|2",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("oa.Execute:\n%v, want\n%v", result, wantResult)
+	}
+}
+
+func TestOrderedAggregateSumDistinct(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|id",
+				"int64|int64",
+			),
+			"1|2",
+			"1|2",
+			"1|3",
+			"2|null",
+			"3|4",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateSumDistinct,
+			Col:    1,
+			KeyCol: 1,
+			Alias:  "sum(distinct id)",
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	if err != nil {
+		t.Error(err)
+	}
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|sum(distinct id)",
+			"int64|decimal",
+		),
+		"1|5",
+		"2|null",
+		"3|4",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("oa.Execute:\n%v, want\n%v", result, wantResult)
+	}
+}
@@ -37,6 +37,10 @@ const SeqVarName = "__seq"
 // to different shards.
 const ListVarName = "__vals"
 
+// LimitVarName is a reserved bind var name for the number
+// of rows a multi-shard DML with a LIMIT can still change.
+const LimitVarName = "__limit"
+
 // VCursor defines the interface the engine will use
 // to execute routes.
 type VCursor interface {
@@ -55,6 +59,10 @@ type VCursor interface {
 	// Resolver methods, from key.Destination to srvtopo.ResolvedShard.
 	// Will replace all of the Topo functions.
 	ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error)
+
+	// SequenceCache returns the cache of the blocks leased from
+	// sequences, or nil if vtgate doesn't lease blocks.
+	SequenceCache() *SequenceCache
 }
 
 // Plan represents the execution strategy for a given query.
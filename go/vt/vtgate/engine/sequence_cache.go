@@ -0,0 +1,80 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"sync"
+)
+
+// SequenceCache hands out the values of blocks that a vtgate
+// leases from sequences. The values left in the blocks are
+// lost when the vtgate restarts.
+type SequenceCache struct {
+	blockSize int64
+
+	mu     sync.Mutex
+	blocks map[string]*sequenceBlock
+}
+
+// sequenceBlock is the part of a leased block that is not handed out yet.
+type sequenceBlock struct {
+	// mu serializes the leases of a sequence.
+	mu      sync.Mutex
+	nextVal int64
+	lastVal int64
+}
+
+// NewSequenceCache creates a SequenceCache that leases blocks of
+// blockSize values.
+func NewSequenceCache(blockSize int64) *SequenceCache {
+	return &SequenceCache{
+		blockSize: blockSize,
+		blocks:    make(map[string]*sequenceBlock),
+	}
+}
+
+// Next returns the first of count consecutive values of a sequence.
+// If the block of the sequence doesn't have enough values left, they
+// are discarded, and a new block is leased by calling lease with its
+// size. lease returns the first value of the block.
+func (sc *SequenceCache) Next(sequence string, count int64, lease func(n int64) (int64, error)) (int64, error) {
+	sc.mu.Lock()
+	block, ok := sc.blocks[sequence]
+	if !ok {
+		block = &sequenceBlock{}
+		sc.blocks[sequence] = block
+	}
+	sc.mu.Unlock()
+
+	block.mu.Lock()
+	defer block.mu.Unlock()
+	if block.nextVal+count > block.lastVal {
+		size := sc.blockSize
+		if size < count {
+			size = count
+		}
+		first, err := lease(size)
+		if err != nil {
+			return 0, err
+		}
+		block.nextVal = first
+		block.lastVal = first + size
+	}
+	ret := block.nextVal
+	block.nextVal += count
+	return ret, nil
+}
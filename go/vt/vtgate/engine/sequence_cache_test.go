@@ -0,0 +1,88 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSequenceCache(t *testing.T) {
+	sc := NewSequenceCache(5)
+	var leases []string
+	next := int64(1)
+	lease := func(n int64) (int64, error) {
+		leases = append(leases, fmt.Sprintf("lease %d", n))
+		first := next
+		next += n
+		return first, nil
+	}
+
+	testcases := []struct {
+		sequence string
+		count    int64
+		want     int64
+	}{{
+		sequence: "ks.seq1",
+		count:    2,
+		want:     1,
+	}, {
+		sequence: "ks.seq1",
+		count:    3,
+		want:     3,
+	}, {
+		// The block is used up.
+		sequence: "ks.seq1",
+		count:    1,
+		want:     6,
+	}, {
+		// A count larger than the block size gets its own block,
+		// and the rest of the previous block is discarded.
+		sequence: "ks.seq1",
+		count:    7,
+		want:     11,
+	}, {
+		// Each sequence has its own block.
+		sequence: "ks.seq2",
+		count:    1,
+		want:     18,
+	}}
+	for _, tcase := range testcases {
+		got, err := sc.Next(tcase.sequence, tcase.count, lease)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tcase.want {
+			t.Errorf("Next(%s, %d): %d, want %d", tcase.sequence, tcase.count, got, tcase.want)
+		}
+	}
+	wantLeases := []string{"lease 5", "lease 5", "lease 7", "lease 5"}
+	if !reflect.DeepEqual(leases, wantLeases) {
+		t.Errorf("leases: %v, want %v", leases, wantLeases)
+	}
+
+	// A failed lease doesn't hand out values.
+	_, err := sc.Next("ks.seq3", 1, func(n int64) (int64, error) {
+		return 0, errors.New("lease failed")
+	})
+	want := "lease failed"
+	if err == nil || err.Error() != want {
+		t.Errorf("Next: %v, want %s", err, want)
+	}
+}
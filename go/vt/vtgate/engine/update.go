@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -24,6 +25,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlannotation"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -59,6 +61,16 @@ type Update struct {
 
 	// OwnedVindexQuery is used for updating changes in lookup vindexes.
 	OwnedVindexQuery string
+
+	// ChangedPrimaryValue is set if the update changes the column
+	// of the primary vindex. If the new value maps to another shard,
+	// the updated rows are fetched by MoveQuery, deleted by
+	// MoveDeleteQuery, and inserted into the new shard, without
+	// the generated columns returned by MoveGeneratedQuery.
+	ChangedPrimaryValue *sqltypes.PlanValue
+	MoveQuery           string
+	MoveDeleteQuery     string
+	MoveGeneratedQuery  string
 }
 
 // MarshalJSON serializes the Update into a JSON representation.
@@ -80,6 +92,10 @@ func (upd *Update) MarshalJSON() ([]byte, error) {
 		ChangedVindexValues map[string][]sqltypes.PlanValue `json:",omitempty"`
 		Table               string                          `json:",omitempty"`
 		OwnedVindexQuery    string                          `json:",omitempty"`
+		ChangedPrimaryValue *sqltypes.PlanValue             `json:",omitempty"`
+		MoveQuery           string                          `json:",omitempty"`
+		MoveDeleteQuery     string                          `json:",omitempty"`
+		MoveGeneratedQuery  string                          `json:",omitempty"`
 	}{
 		Opcode:              upd.Opcode,
 		Keyspace:            upd.Keyspace,
@@ -89,6 +105,10 @@ func (upd *Update) MarshalJSON() ([]byte, error) {
 		ChangedVindexValues: upd.ChangedVindexValues,
 		Table:               tname,
 		OwnedVindexQuery:    upd.OwnedVindexQuery,
+		ChangedPrimaryValue: upd.ChangedPrimaryValue,
+		MoveQuery:           upd.MoveQuery,
+		MoveDeleteQuery:     upd.MoveDeleteQuery,
+		MoveGeneratedQuery:  upd.MoveGeneratedQuery,
 	}
 	return jsonutil.MarshalNoEscape(marshalUpdate)
 }
@@ -106,11 +126,20 @@ const (
 	// to a single shard: Requires: A Vindex, and
 	// a single Value.
 	UpdateEqual
+	// UpdateSharded is for routing a scattered
+	// update statement.
+	UpdateSharded
+	// UpdateIN is for routing an update statement
+	// to the shards of a list of values. Requires:
+	// A Vindex, and a single Value, which is a list.
+	UpdateIN
 )
 
 var updName = map[UpdateOpcode]string{
 	UpdateUnsharded: "UpdateUnsharded",
 	UpdateEqual:     "UpdateEqual",
+	UpdateSharded:   "UpdateSharded",
+	UpdateIN:        "UpdateIN",
 }
 
 // MarshalJSON serializes the UpdateOpcode as a JSON string.
@@ -126,6 +155,10 @@ func (upd *Update) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		return upd.execUpdateUnsharded(vcursor, bindVars)
 	case UpdateEqual:
 		return upd.execUpdateEqual(vcursor, bindVars)
+	case UpdateSharded:
+		return upd.execUpdateSharded(vcursor, bindVars)
+	case UpdateIN:
+		return upd.execUpdateIN(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported opcode: %v", upd)
@@ -165,6 +198,15 @@ func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb
 	if len(ksid) == 0 {
 		return &sqltypes.Result{}, nil
 	}
+	if upd.ChangedPrimaryValue != nil {
+		moved, err := upd.execMove(vcursor, bindVars, rs, ksid)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execUpdateEqual")
+		}
+		if moved != nil {
+			return moved, nil
+		}
+	}
 	if len(upd.ChangedVindexValues) != 0 {
 		if err := upd.updateVindexEntries(vcursor, upd.OwnedVindexQuery, bindVars, rs, ksid); err != nil {
 			return nil, vterrors.Wrap(err, "execUpdateEqual")
@@ -174,6 +216,192 @@ func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb
 	return execShard(vcursor, rewritten, bindVars, rs, true /* isDML */, true /* canAutocommit */)
 }
 
+func (upd *Update) execUpdateSharded(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, err := resolveDMLShards(vcursor, upd.Keyspace, nil, nil, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execUpdateSharded")
+	}
+	result, err := upd.execMultiShard(vcursor, bindVars, rss)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execUpdateSharded")
+	}
+	return result, nil
+}
+
+func (upd *Update) execUpdateIN(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, err := resolveDMLShards(vcursor, upd.Keyspace, upd.Vindex, upd.Values, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execUpdateIN")
+	}
+	result, err := upd.execMultiShard(vcursor, bindVars, rss)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execUpdateIN")
+	}
+	return result, nil
+}
+
+// execMultiShard sends the update to the shards. If it changes owned
+// vindexes, the row to be updated is fetched from all the shards first,
+// and its vindex entries are updated. The transaction that does it can't
+// be autocommitted.
+func (upd *Update) execMultiShard(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	if len(upd.ChangedVindexValues) == 0 {
+		return execMultiShardDML(vcursor, upd.Query, bindVars, rss, nil /* limit */, true /* canAutocommit */)
+	}
+	rows, ksids, err := selectOwnedRows(vcursor, upd.OwnedVindexQuery, bindVars, rss, upd.Table)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) > 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: update changes multiple rows in the vindex")
+	}
+	if len(rows) == 1 {
+		if err := upd.updateLookupEntries(vcursor, rows[0], ksids[0], bindVars); err != nil {
+			return nil, err
+		}
+	}
+	return execMultiShardDML(vcursor, upd.Query, bindVars, rss, nil /* limit */, false /* canAutocommit */)
+}
+
+// execMove performs an update that changes the primary vindex column,
+// if the rows move to another shard. Otherwise, it returns a nil result,
+// and the update is performed like any other.
+// The rows are updated in place first, which lets MySQL evaluate the
+// new values. They're then fetched, deleted and inserted into their
+// new shard, and all their owned vindex entries are recreated with
+// the new keyspace id. This all happens in the transaction of the
+// session, which can be committed with 2PC.
+func (upd *Update) execMove(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard, ksid []byte) (*sqltypes.Result, error) {
+	value, err := upd.ChangedPrimaryValue.ResolveValue(bindVars)
+	if err != nil {
+		return nil, err
+	}
+	newRs, newKsid, err := resolveSingleShard(vcursor, upd.Table.ColumnVindexes[0].Vindex, upd.Keyspace, value)
+	if err != nil {
+		return nil, err
+	}
+	if len(newKsid) == 0 {
+		return nil, fmt.Errorf("could not map %v to a keyspace id", value)
+	}
+	if bytes.Equal(newKsid, ksid) {
+		return nil, nil
+	}
+
+	if upd.OwnedVindexQuery != "" {
+		owned, err := execShard(vcursor, upd.OwnedVindexQuery, bindVars, rs, false /* isDML */, false /* canAutocommit */)
+		if err != nil {
+			return nil, err
+		}
+		if err := deleteLookupEntries(vcursor, upd.Table, owned.Rows, ksid); err != nil {
+			return nil, err
+		}
+	}
+	rewritten := sqlannotation.AddKeyspaceIDs(upd.Query, [][]byte{ksid}, "")
+	result, err := execShard(vcursor, rewritten, bindVars, rs, true /* isDML */, false /* canAutocommit */)
+	if err != nil {
+		return nil, err
+	}
+	moved, err := execShard(vcursor, upd.MoveQuery, bindVars, rs, false /* isDML */, false /* canAutocommit */)
+	if err != nil {
+		return nil, err
+	}
+	if len(moved.Rows) == 0 {
+		return result, nil
+	}
+	generated, err := execShard(vcursor, upd.MoveGeneratedQuery, bindVars, rs, false /* isDML */, false /* canAutocommit */)
+	if err != nil {
+		return nil, err
+	}
+	rewritten = sqlannotation.AddKeyspaceIDs(upd.MoveDeleteQuery, [][]byte{ksid}, "")
+	if _, err := execShard(vcursor, rewritten, bindVars, rs, true /* isDML */, false /* canAutocommit */); err != nil {
+		return nil, err
+	}
+	if err := upd.createMovedEntries(vcursor, moved, newKsid); err != nil {
+		return nil, err
+	}
+	if _, err := execShard(vcursor, upd.moveInsertQuery(moved, generated, newKsid), bindVars, newRs, true /* isDML */, false /* canAutocommit */); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// createMovedEntries creates the entries of the owned vindexes for the
+// rows moved to the new keyspace id. The vindex columns are found by
+// name in the fields of the rows.
+func (upd *Update) createMovedEntries(vcursor VCursor, moved *sqltypes.Result, ksid []byte) error {
+	ksids := make([][]byte, len(moved.Rows))
+	for i := range ksids {
+		ksids[i] = ksid
+	}
+	for _, colVindex := range upd.Table.Owned {
+		ids := make([][]sqltypes.Value, len(moved.Rows))
+		for _, col := range colVindex.Columns {
+			pos := -1
+			for i, field := range moved.Fields {
+				if col.EqualString(field.Name) {
+					pos = i
+					break
+				}
+			}
+			if pos == -1 {
+				return fmt.Errorf("vindex column %v is missing from the rows to move", col)
+			}
+			for rowIdx, row := range moved.Rows {
+				ids[rowIdx] = append(ids[rowIdx], row[pos])
+			}
+		}
+		if err := colVindex.Vindex.(vindexes.Lookup).Create(vcursor, ids, ksids, false /* ignoreMode */); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveInsertQuery returns the insert of the moved rows into their new
+// shard. The generated columns, listed in the rows of generated, are
+// left out: MySQL computes them.
+func (upd *Update) moveInsertQuery(moved, generated *sqltypes.Result, ksid []byte) string {
+	var columns []int
+	for i, field := range moved.Fields {
+		isGenerated := false
+		for _, row := range generated.Rows {
+			if sqlparser.NewColIdent(row[0].ToString()).EqualString(field.Name) {
+				isGenerated = true
+				break
+			}
+		}
+		if !isGenerated {
+			columns = append(columns, i)
+		}
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("insert into %v(", upd.Table.Name)
+	for i, col := range columns {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(moved.Fields[col].Name))
+	}
+	buf.WriteString(") values ")
+	for i, row := range moved.Rows {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteByte('(')
+		for j, col := range columns {
+			if j != 0 {
+				buf.WriteString(", ")
+			}
+			row[col].EncodeSQL(buf)
+		}
+		buf.WriteByte(')')
+	}
+	return sqlannotation.AddKeyspaceIDs(buf.String(), [][]byte{ksid}, "")
+}
+
 // updateVindexEntries performs an update when a vindex is being modified
 // by the statement.
 // Note: the commit order may be different from the DML order because it's possible
@@ -191,12 +419,18 @@ func (upd *Update) updateVindexEntries(vcursor VCursor, query string, bindVars m
 	if len(subQueryResult.Rows) > 1 {
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: update changes multiple rows in the vindex")
 	}
+	return upd.updateLookupEntries(vcursor, subQueryResult.Rows[0], ksid, bindVars)
+}
+
+// updateLookupEntries changes the entries of the owned vindexes
+// for a row whose vindex columns are being changed.
+func (upd *Update) updateLookupEntries(vcursor VCursor, row []sqltypes.Value, ksid []byte, bindVars map[string]*querypb.BindVariable) error {
 	colnum := 0
 	for _, colVindex := range upd.Table.Owned {
 		// Fetch the column values. colnum must keep incrementing.
 		fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
 		for range colVindex.Columns {
-			fromIds = append(fromIds, subQueryResult.Rows[0][colnum])
+			fromIds = append(fromIds, row[colnum])
 			colnum++
 		}
 
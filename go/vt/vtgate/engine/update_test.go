@@ -225,6 +225,178 @@ func TestUpdateEqualChangedVindex(t *testing.T) {
 	expectError(t, "Execute", err, "execUpdateEqual: unsupported: update changes multiple rows in the vindex")
 }
 
+func TestUpdateEqualChangedPrimaryVindex(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		Opcode:              UpdateEqual,
+		Keyspace:            ks.Keyspace,
+		Query:               "dummy_update",
+		Vindex:              ks.Vindexes["hash"],
+		Values:              []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}},
+		ChangedVindexValues: map[string][]sqltypes.PlanValue{},
+		ChangedPrimaryValue: &sqltypes.PlanValue{Value: sqltypes.NewInt64(2)},
+		Table:               ks.Tables["t1"],
+		OwnedVindexQuery:    "dummy_subquery",
+		MoveQuery:           "dummy_move",
+		MoveDeleteQuery:     "dummy_move_delete",
+		MoveGeneratedQuery:  "dummy_move_generated",
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"c1|c2|c3",
+					"int64|int64|int64",
+				),
+				"4|5|6",
+			),
+			nil,
+			nil,
+			{RowsAffected: 1},
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|c1|c2|c3|val|gen",
+					"int64|int64|int64|int64|varchar|int64",
+				),
+				"2|4|5|6|it's|7",
+			),
+			// gen is a generated column.
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"column_name",
+					"varchar",
+				),
+				"gen",
+			),
+		},
+	}
+	result, err := upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		// The new value maps to 20-.
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		// The entries of the old row are deleted.
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} false false`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		// The row is updated in place, then moved.
+		`ExecuteMultiShard sharded.-20: dummy_update /* vtgate:: keyspace_id:166b40b44aba4bd6 */ {} true false`,
+		`ExecuteMultiShard sharded.-20: dummy_move {} false false`,
+		`ExecuteMultiShard sharded.-20: dummy_move_generated {} false false`,
+		`ExecuteMultiShard sharded.-20: dummy_move_delete /* vtgate:: keyspace_id:166b40b44aba4bd6 */ {} true false`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from10, :from20, :toc0) from10: type:INT64 value:"4" from20: type:INT64 value:"5" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"6" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ExecuteMultiShard sharded.20-: insert into t1(id, c1, c2, c3, val) values (2, 4, 5, 6, 'it\'s') /* vtgate:: keyspace_id:06e7ea22ce92708f */ {} true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 1})
+
+	// The new value maps to the same shard: it's a regular update.
+	upd.ChangedPrimaryValue = &sqltypes.PlanValue{Value: sqltypes.NewInt64(1)}
+	vc = &loggingVCursor{
+		shards: []string{"-20", "20-"},
+	}
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_update /* vtgate:: keyspace_id:166b40b44aba4bd6 */ {} true true`,
+	})
+}
+
+func TestUpdateShardedChangedVindex(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		Opcode:   UpdateSharded,
+		Keyspace: ks.Keyspace,
+		Query:    "dummy_update",
+		ChangedVindexValues: map[string][]sqltypes.PlanValue{
+			"onecol": {{
+				Value: sqltypes.NewInt64(3),
+			}},
+		},
+		Table:            ks.Tables["t1"],
+		OwnedVindexQuery: "dummy_subquery",
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c1|c2|c3",
+			"int64|int64|int64|int64",
+		),
+		"1|4|5|6",
+	)}
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+	_, err := upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		// The subquery is sent to all shards.
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} sharded.20-: dummy_subquery {} false false`,
+		// The keyspace id is computed from the id, and 6 is replaced by 3.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"3" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		// The DML can't be autocommitted.
+		`ExecuteMultiShard sharded.-20: dummy_update {} sharded.20-: dummy_update {} true false`,
+	})
+
+	// Failure case: multiple rows changing.
+	results = []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|c1|c2|c3",
+			"int64|int64|int64|int64",
+		),
+		"1|4|5|6",
+		"2|7|8|9",
+	)}
+	vc = &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execUpdateSharded: unsupported: update changes multiple rows in the vindex")
+}
+
+func TestUpdateIN(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		Opcode:   UpdateIN,
+		Keyspace: ks.Keyspace,
+		Query:    "dummy_update",
+		Vindex:   ks.Vindexes["hash"],
+		Values: []sqltypes.PlanValue{{
+			Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}},
+		}},
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "20-"},
+	}
+	_, err := upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		// Both values map to the same shard.
+		`ExecuteMultiShard sharded.20-: dummy_update {} true true`,
+	})
+}
+
 func TestUpdateNoStream(t *testing.T) {
 	upd := &Update{}
 	err := upd.StreamExecute(nil, nil, false, nil)
@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -80,6 +81,10 @@ type Executor struct {
 	plans            *cache.LRUCache
 	vschemaStats     *VSchemaStats
 
+	// sequences is set if the values of sequences are leased
+	// in blocks by this vtgate.
+	sequences *engine.SequenceCache
+
 	vm VSchemaManager
 }
 
@@ -205,6 +210,8 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		return e.handleCommit(ctx, safeSession, sql, bindVars, target, logStats)
 	case sqlparser.StmtRollback:
 		return e.handleRollback(ctx, safeSession, sql, bindVars, target, logStats)
+	case sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease:
+		return e.handleSavepoint(ctx, safeSession, sql, logStats)
 	case sqlparser.StmtSet:
 		return e.handleSet(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtShow:
@@ -526,6 +533,67 @@ func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession,
 	return &sqltypes.Result{}, err
 }
 
+// handleSavepoint runs the savepoint statement on all the shards that
+// participate in the transaction, and records the savepoints in the
+// session. The shards that join the transaction later get the active
+// savepoints replayed, see ScatterConn.
+func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, sql string, logStats *LogStats) (*sqltypes.Result, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	var name string
+	switch stmt := stmt.(type) {
+	case *sqlparser.Savepoint:
+		name = stmt.Name.Lowered()
+	case *sqlparser.SRollback:
+		name = stmt.Name.Lowered()
+	case *sqlparser.Release:
+		name = stmt.Name.Lowered()
+	default:
+		// This code is unreachable.
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unrecognized savepoint statement: %v", sql)
+	}
+
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	defer func() {
+		logStats.ExecuteTime = time.Since(execStart)
+	}()
+
+	if !safeSession.InTransaction() {
+		// Like in MySQL, a savepoint outside of a transaction is
+		// released right away.
+		if _, ok := stmt.(*sqlparser.Savepoint); ok {
+			return &sqltypes.Result{}, nil
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", name)
+	}
+	if _, ok := stmt.(*sqlparser.Savepoint); !ok && !safeSession.HasSavepoint(name) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", name)
+	}
+
+	logStats.ShardQueries = uint32(len(safeSession.ShardSessions))
+	if err := e.txConn.ExecuteAll(ctx, safeSession, sqlparser.String(stmt)); err != nil {
+		// The other shards may have applied the statement: the
+		// transaction can't be committed anymore.
+		safeSession.SetRollback()
+		if rbErr := e.txConn.Rollback(ctx, safeSession); rbErr != nil {
+			log.Warningf("rollback after a failed savepoint statement failed: %v", rbErr)
+		}
+		return nil, err
+	}
+	switch stmt.(type) {
+	case *sqlparser.Savepoint:
+		safeSession.SetSavepoint(name)
+	case *sqlparser.SRollback:
+		safeSession.RollbackToSavepoint(name)
+	case *sqlparser.Release:
+		safeSession.ReleaseSavepoint(name)
+	}
+	return &sqltypes.Result{}, nil
+}
+
 func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (*sqltypes.Result, error) {
 	vals, scope, err := sqlparser.ExtractSetValues(sql)
 	execStart := time.Now()
@@ -543,6 +611,7 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 	}
 
 	for k, v := range vals {
+		k = strings.TrimPrefix(k, "@@")
 		switch k {
 		case "autocommit":
 			val, ok := v.(int64)
@@ -675,12 +744,33 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 				return nil, fmt.Errorf("unexpected value for charset/names: %v", val)
 			}
 		default:
-			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported construct: %s", sql)
+			if !sqlparser.IsPassthroughSystemVariable(k) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported construct: %s", sql)
+			}
+			// The session variables are passed through to the
+			// tablets, which set them on the connections they use
+			// for the session.
+			safeSession.SetSystemVariable(k, systemVariableValue(v))
 		}
 	}
 	return &sqltypes.Result{}, nil
 }
 
+// systemVariableValue returns the SQL expression for a value returned
+// by sqlparser.ExtractSetValues, or "" for the default value.
+func systemVariableValue(v interface{}) string {
+	switch v := v.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case string:
+		if strings.EqualFold(v, "default") {
+			return ""
+		}
+		return sqlparser.String(sqlparser.NewStrVal([]byte(v)))
+	}
+	return "null"
+}
+
 func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, target querypb.Target, logStats *LogStats) (*sqltypes.Result, error) {
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
@@ -217,6 +217,137 @@ func TestExecutorTransactionsAutoCommit(t *testing.T) {
 	}
 }
 
+func TestExecutorSavepoints(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	// Outside a transaction, savepoints are released right away.
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "savepoint a", nil); err != nil {
+		t.Fatal(err)
+	}
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "rollback to savepoint a", nil)
+	want := "SAVEPOINT a does not exist"
+	if err == nil || err.Error() != want {
+		t.Errorf("rollback to savepoint a: %v, want %s", err, want)
+	}
+
+	// Savepoints are set on the shards that are in the transaction,
+	// and replayed on the ones that join it later.
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "begin", nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, sql := range []string{
+		"select id from user where id = 1",
+		"savepoint A",
+		"savepoint b",
+		"select id from user where id = 3",
+	} {
+		if _, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := session.Savepoints, []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("session.Savepoints: %v, want %v", got, want)
+	}
+	testQueries(t, "sbc1", sbc1, []*querypb.BoundQuery{{
+		Sql:           "select id from user where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "savepoint A",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "savepoint b",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+	testQueries(t, "sbc2", sbc2, []*querypb.BoundQuery{{
+		Sql:           "savepoint a",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "savepoint b",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select id from user where id = 3",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+
+	// ROLLBACK TO SAVEPOINT and RELEASE SAVEPOINT run on all shards.
+	sbc1.Queries = nil
+	sbc2.Queries = nil
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "rollback to a", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := session.Savepoints, []string{"a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("session.Savepoints: %v, want %v", got, want)
+	}
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "release savepoint a", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := session.Savepoints; len(got) != 0 {
+		t.Errorf("session.Savepoints: %v, want none", got)
+	}
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "rollback to a",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "release savepoint a",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	testQueries(t, "sbc1", sbc1, wantQueries)
+	testQueries(t, "sbc2", sbc2, wantQueries)
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "release savepoint b", nil)
+	want = "SAVEPOINT b does not exist"
+	if err == nil || err.Error() != want {
+		t.Errorf("release savepoint b: %v, want %s", err, want)
+	}
+
+	// Commit clears the savepoints.
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "savepoint c", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "commit", nil); err != nil {
+		t.Fatal(err)
+	}
+	wantSession := &vtgatepb.Session{TargetString: "@master", Autocommit: true}
+	if !proto.Equal(session.Session, wantSession) {
+		t.Errorf("commit: %v, want %v", session.Session, wantSession)
+	}
+}
+
+func TestExecutorSavepointsShardFailure(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	for _, sql := range []string{
+		"begin",
+		"select id from user where id = 1",
+		"select id from user where id = 3",
+		"savepoint a",
+	} {
+		if _, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// If one shard fails, the transaction is rolled back on all of them.
+	sbc2.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "rollback to a", nil); err == nil {
+		t.Error("rollback to a: nil, want error")
+	}
+	if session.InTransaction() {
+		t.Error("session.InTransaction: true, want false")
+	}
+	if sbc1.RollbackCount.Get() != 1 || sbc2.RollbackCount.Get() != 1 {
+		t.Errorf("RollbackCount: %d, %d, want 1, 1", sbc1.RollbackCount.Get(), sbc2.RollbackCount.Get())
+	}
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "commit", nil); err != nil {
+		t.Fatal(err)
+	}
+	if sbc1.CommitCount.Get() != 0 || sbc2.CommitCount.Get() != 0 {
+		t.Errorf("CommitCount: %d, %d, want 0, 0", sbc1.CommitCount.Get(), sbc2.CommitCount.Get())
+	}
+}
+
 func TestExecutorSet(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 
@@ -305,9 +436,35 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set character_set_results='abcd'",
 		err: "disallowed value for character_set_results: abcd",
+	}, {
+		in: "set sql_mode = 'ANSI', group_concat_max_len = 4096, @@time_zone = '+00:00'",
+		out: &vtgatepb.Session{
+			Autocommit: true,
+			SystemVariables: map[string]string{
+				"sql_mode":             "'ANSI'",
+				"group_concat_max_len": "4096",
+				"time_zone":            "'+00:00'",
+			},
+			Options: &querypb.ExecuteOptions{
+				SystemVariables: map[string]string{
+					"sql_mode":             "'ANSI'",
+					"group_concat_max_len": "4096",
+					"time_zone":            "'+00:00'",
+				},
+			},
+		},
+	}, {
+		in:  "set sql_mode = default",
+		out: &vtgatepb.Session{Autocommit: true},
+	}, {
+		in:  "set @foo = 1",
+		err: "unsupported construct: set @foo = 1",
 	}, {
 		in:  "set foo=1",
 		err: "unsupported construct: set foo=1",
+	}, {
+		in:  "set sql_log_bin = 0",
+		err: "unsupported construct: set sql_log_bin = 0",
 	}, {
 		in:  "set names utf8",
 		out: &vtgatepb.Session{Autocommit: true},
@@ -357,6 +514,37 @@ func TestExecutorSet(t *testing.T) {
 	}
 }
 
+func TestExecutorSystemVariables(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	// The session variables are passed to the tablets.
+	for _, sql := range []string{
+		"set time_zone = 'UTC'",
+		"select id from user where id = 1",
+		"set time_zone = default",
+		"select id from user where id = 1",
+	} {
+		if _, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(sbc1.Options) != 2 {
+		t.Fatalf("sbc1.Options: %v, want 2 entries", sbc1.Options)
+	}
+	want := map[string]string{"time_zone": "'UTC'"}
+	if got := sbc1.Options[0].SystemVariables; !reflect.DeepEqual(got, want) {
+		t.Errorf("SystemVariables: %v, want %v", got, want)
+	}
+	// Back to the defaults, nothing is passed anymore.
+	if got := sbc1.Options[1].SystemVariables; got != nil {
+		t.Errorf("SystemVariables: %v, want nil", got)
+	}
+	if got := session.SystemVariables; got != nil {
+		t.Errorf("session.SystemVariables: %v, want nil", got)
+	}
+}
+
 func TestExecutorAutocommit(t *testing.T) {
 	executor, _, _, sbclookup := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
@@ -168,6 +168,8 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, vschema VSchema) (*en
 		return nil, errors.New("unsupported construct: commit")
 	case *sqlparser.Rollback:
 		return nil, errors.New("unsupported construct: rollback")
+	case *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
+		return nil, errors.New("unsupported construct: savepoint")
 	default:
 		panic(fmt.Sprintf("BUG: unexpected statement type: %T", stmt))
 	}
@@ -0,0 +1,378 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*concatenate)(nil)
+var _ columnOriginator = (*concatenate)(nil)
+
+// concatenate is the builder for a UNION whose two sides
+// could not be merged into a single route. The rows of both
+// sides are concatenated by vtgate. For a UNION without ALL,
+// the duplicates are then removed by an engine.Distinct.
+// An ORDER BY on the union is performed by an engine.MemorySort.
+// Both compare the text columns by their weight string.
+// A LIMIT on the union is performed by a limit builder on top
+// of concatenate.
+//
+// The sides of the union are independent of each other: they
+// can't reference each other's columns. So, concatenate is
+// a leaf node for the rest of the plan, and it's the origin
+// of its result columns.
+type concatenate struct {
+	order         int
+	symtab        *symtab
+	resultColumns []*resultColumn
+	lhs, rhs      builder
+
+	// hasStar is set if the first SELECT of the union has a
+	// '*' expression. The result columns are unknown in that case.
+	hasStar bool
+
+	// weightStrings maps the number of a column to the number
+	// of the column that contains its weight string.
+	weightStrings map[int]int
+	// keepWeightStrings is set if the weight strings are used by an
+	// enclosing union. They must not be truncated from the results.
+	keepWeightStrings bool
+
+	econcat   *engine.Concatenate
+	edistinct *engine.Distinct
+	esort     *engine.MemorySort
+}
+
+// newConcatenate builds a new concatenate.
+func newConcatenate(union *sqlparser.Union, lhs, rhs builder, vschema VSchema) (*concatenate, error) {
+	c := &concatenate{
+		order:         maxInt(lhs.MaxOrder(), rhs.MaxOrder()) + 1,
+		symtab:        newSymtab(vschema),
+		lhs:           lhs,
+		rhs:           rhs,
+		weightStrings: make(map[int]int),
+		econcat:       &engine.Concatenate{},
+	}
+	if union.Type != sqlparser.UnionAllStr {
+		c.edistinct = &engine.Distinct{}
+	}
+
+	// The names of the result columns are the ones of the first SELECT.
+	sel := firstSelect(union)
+	for _, expr := range sel.SelectExprs {
+		if _, ok := expr.(*sqlparser.AliasedExpr); !ok {
+			c.hasStar = true
+			break
+		}
+	}
+	if !c.hasStar {
+		lcols := lhs.ResultColumns()
+		for i, expr := range sel.SelectExprs {
+			aliased := expr.(*sqlparser.AliasedExpr)
+			rc := &resultColumn{
+				alias:  aliased.As,
+				column: &column{origin: c, colnum: i},
+			}
+			if col, ok := aliased.Expr.(*sqlparser.ColName); ok && rc.alias.IsEmpty() {
+				rc.alias = col.Name
+			}
+			// The lhs doesn't have result columns if it's a
+			// union that was merged into a single route.
+			if i < len(lcols) {
+				rc.column.typ = lcols[i].column.typ
+			}
+			c.resultColumns = append(c.resultColumns, rc)
+		}
+	}
+	c.symtab.ResultColumns = c.resultColumns
+	if err := c.setDistinctKeys(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// setDistinctKeys makes the engine.Distinct compare the text
+// columns by their weight string, which honors their collation.
+func (c *concatenate) setDistinctKeys() error {
+	if c.edistinct == nil {
+		return nil
+	}
+	keyCols := make([]int, len(c.resultColumns))
+	hasText := false
+	for i, rc := range c.resultColumns {
+		keyCols[i] = i
+		if !sqltypes.IsText(rc.column.typ) {
+			continue
+		}
+		weightColnum, err := c.SupplyWeightString(i)
+		if err != nil {
+			return err
+		}
+		keyCols[i] = weightColnum
+		hasText = true
+	}
+	if hasText {
+		c.edistinct.KeyCols = keyCols
+		c.edistinct.TruncateColumnCount = len(c.resultColumns)
+	}
+	return nil
+}
+
+// firstSelect returns the first SELECT of a statement.
+func firstSelect(stmt sqlparser.SelectStatement) *sqlparser.Select {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return stmt
+	case *sqlparser.Union:
+		return firstSelect(stmt.Left)
+	case *sqlparser.ParenSelect:
+		return firstSelect(stmt.Select)
+	}
+	panic(fmt.Sprintf("BUG: unexpected SELECT type: %T", stmt))
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Symtab satisfies the builder interface.
+func (c *concatenate) Symtab() *symtab {
+	return c.symtab.Resolve()
+}
+
+// Order returns the order of the concatenate.
+func (c *concatenate) Order() int {
+	return c.order
+}
+
+// MaxOrder satisfies the builder interface.
+func (c *concatenate) MaxOrder() int {
+	return c.order
+}
+
+// SetOrder satisfies the builder interface.
+// Both sides are independent. So, they're numbered
+// from the same starting point.
+func (c *concatenate) SetOrder(order int) {
+	c.lhs.SetOrder(order)
+	c.rhs.SetOrder(order)
+	c.order = maxInt(c.lhs.MaxOrder(), c.rhs.MaxOrder()) + 1
+}
+
+// Primitive satisfies the builder interface.
+func (c *concatenate) Primitive() engine.Primitive {
+	c.econcat.Sources = nil
+	for _, bldr := range []builder{c.lhs, c.rhs} {
+		source := bldr.Primitive()
+		// Flatten the UNION ALL of more than two SELECTs.
+		if inner, ok := source.(*engine.Concatenate); ok {
+			c.econcat.Sources = append(c.econcat.Sources, inner.Sources...)
+			continue
+		}
+		c.econcat.Sources = append(c.econcat.Sources, source)
+	}
+
+	var prim engine.Primitive = c.econcat
+	if c.edistinct != nil {
+		c.edistinct.Source = prim
+		prim = c.edistinct
+		// The weight strings are truncated by the MemorySort
+		// if it needs them.
+		if c.keepWeightStrings || (c.esort != nil && c.esort.TruncateColumnCount != 0) {
+			c.edistinct.TruncateColumnCount = 0
+		}
+	}
+	if c.esort != nil {
+		c.esort.Input = prim
+		prim = c.esort
+		if c.keepWeightStrings {
+			c.esort.TruncateColumnCount = 0
+		}
+	}
+	return prim
+}
+
+// Leftmost satisfies the builder interface.
+func (c *concatenate) Leftmost() columnOriginator {
+	return c
+}
+
+// ResultColumns satisfies the builder interface.
+func (c *concatenate) ResultColumns() []*resultColumn {
+	return c.resultColumns
+}
+
+// PushFilter satisfies the builder interface.
+func (c *concatenate) PushFilter(_ sqlparser.Expr, whereType string, _ columnOriginator) error {
+	return errors.New("unsupported: filtering on results of cross-shard union")
+}
+
+// PushSelect satisfies the builder interface.
+func (c *concatenate) PushSelect(expr *sqlparser.AliasedExpr, origin columnOriginator) (rc *resultColumn, colnum int, err error) {
+	return nil, 0, errors.New("unsupported: expression on results of cross-shard union")
+}
+
+// PushOrderBy sets the order by for the union. It can only
+// reference the result columns, by name or by number.
+// Text columns are sorted using their weight string,
+// which is requested from both sides of the union.
+func (c *concatenate) PushOrderBy(orderBy sqlparser.OrderBy) error {
+	// Treat order by null as nil order by.
+	if len(orderBy) == 1 {
+		if _, ok := orderBy[0].Expr.(*sqlparser.NullVal); ok {
+			return nil
+		}
+	}
+	if len(orderBy) == 0 {
+		return nil
+	}
+	if c.hasStar {
+		return errors.New("unsupported: in cross-shard union: order by with '*' expression")
+	}
+
+	c.esort = &engine.MemorySort{}
+	for _, order := range orderBy {
+		colnum := -1
+		switch expr := order.Expr.(type) {
+		case *sqlparser.SQLVal:
+			var err error
+			if colnum, err = ResultFromNumber(c.resultColumns, expr); err != nil {
+				return fmt.Errorf("invalid order by: %v", err)
+			}
+		case *sqlparser.ColName:
+			if !expr.Qualifier.IsEmpty() {
+				return fmt.Errorf("unsupported: in cross-shard union: qualified order by expression: %s", sqlparser.String(expr))
+			}
+			for i, rc := range c.resultColumns {
+				if !rc.alias.Equal(expr.Name) {
+					continue
+				}
+				if colnum != -1 {
+					return fmt.Errorf("ambiguous symbol reference: %s", sqlparser.String(expr))
+				}
+				colnum = i
+			}
+			if colnum == -1 {
+				return fmt.Errorf("unsupported: in cross-shard union: order by must reference a column in the select list: %s", sqlparser.String(order))
+			}
+		default:
+			return fmt.Errorf("unsupported: in cross-shard union: complex order by expression: %s", sqlparser.String(expr))
+		}
+
+		if sqltypes.IsText(c.resultColumns[colnum].column.typ) {
+			weightColnum, err := c.SupplyWeightString(colnum)
+			if err != nil {
+				return err
+			}
+			colnum = weightColnum
+			c.esort.TruncateColumnCount = len(c.resultColumns)
+		}
+		c.esort.OrderBy = append(c.esort.OrderBy, engine.OrderbyParams{
+			Col:  colnum,
+			Desc: order.Direction == sqlparser.DescScr,
+		})
+	}
+	return nil
+}
+
+// PushOrderByNull satisfies the builder interface.
+func (c *concatenate) PushOrderByNull() {
+}
+
+// PushOrderByRand satisfies the builder interface.
+func (c *concatenate) PushOrderByRand() {
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The call is ignored because the rows returned by either
+// side are not all the rows of the union.
+func (c *concatenate) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
+
+// PushMisc satisfies the builder interface.
+func (c *concatenate) PushMisc(sel *sqlparser.Select) {
+}
+
+// Wireup satisfies the builder interface.
+// Each side is wired up on its own.
+func (c *concatenate) Wireup(bldr builder, jt *jointab) error {
+	if err := c.rhs.Wireup(c.rhs, jt); err != nil {
+		return err
+	}
+	return c.lhs.Wireup(c.lhs, jt)
+}
+
+// SupplyVar satisfies the builder interface.
+func (c *concatenate) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	panic("BUG: the sides of a union cannot reference each other")
+}
+
+// SupplyCol satisfies the builder interface.
+func (c *concatenate) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colnum int) {
+	panic("BUG: nothing should depend on UNION")
+}
+
+// SupplyWeightString requests the weight string of the specified
+// column from both sides of the union. Both sides must return it
+// as the same column.
+func (c *concatenate) SupplyWeightString(colnum int) (weightColnum int, err error) {
+	if weightColnum, ok := c.weightStrings[colnum]; ok {
+		return weightColnum, nil
+	}
+	lcol, err := supplyUnionWeightString(c.lhs, colnum)
+	if err != nil {
+		return 0, err
+	}
+	rcol, err := supplyUnionWeightString(c.rhs, colnum)
+	if err != nil {
+		return 0, err
+	}
+	if lcol != rcol {
+		return 0, errors.New("unsupported: in cross-shard union: cannot compare text column: the SELECT statements have a different number of columns")
+	}
+	c.weightStrings[colnum] = lcol
+	return lcol, nil
+}
+
+// supplyUnionWeightString requests the weight string of the
+// specified column from one side of a union.
+func supplyUnionWeightString(bldr builder, colnum int) (int, error) {
+	switch bldr := bldr.(type) {
+	case *route:
+		sel, ok := bldr.Select.(*sqlparser.Select)
+		if !ok || colnum >= len(sel.SelectExprs) || colnum >= len(bldr.ResultColumns()) {
+			break
+		}
+		if _, ok := sel.SelectExprs[colnum].(*sqlparser.AliasedExpr); !ok {
+			break
+		}
+		return bldr.SupplyWeightString(colnum), nil
+	case *concatenate:
+		bldr.keepWeightStrings = true
+		return bldr.SupplyWeightString(colnum)
+	}
+	return 0, errors.New("unsupported: in cross-shard union: cannot compare text column of a complex SELECT")
+}
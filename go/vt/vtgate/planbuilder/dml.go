@@ -78,17 +78,35 @@ func buildUpdatePlan(upd *sqlparser.Update, vschema VSchema) (*engine.Update, er
 	if eupd.Table == nil {
 		return nil, errors.New("internal error: table.vindexTable is mysteriously nil")
 	}
+	eupd.Opcode = engine.UpdateEqual
 	eupd.Vindex, eupd.Values, err = getDMLRouting(upd.Where, eupd.Table)
 	if err != nil {
-		return nil, err
+		eupd.Opcode = engine.UpdateSharded
+		if vindex, values, ok := getDMLINRouting(upd.Where, eupd.Table); ok {
+			eupd.Opcode, eupd.Vindex, eupd.Values = engine.UpdateIN, vindex, values
+		}
 	}
-	eupd.Opcode = engine.UpdateEqual
+	multiShard := eupd.Opcode != engine.UpdateEqual
 
 	if eupd.ChangedVindexValues, err = buildChangedVindexesValues(eupd, upd, eupd.Table.ColumnVindexes); err != nil {
 		return nil, err
 	}
 	if len(eupd.ChangedVindexValues) != 0 {
-		eupd.OwnedVindexQuery = generateUpdateSubquery(upd, eupd.Table)
+		eupd.OwnedVindexQuery = generateUpdateSubquery(upd, eupd.Table, multiShard)
+	}
+	if eupd.ChangedPrimaryValue != nil {
+		if multiShard {
+			return nil, errors.New("unsupported: multi shard update changing the primary vindex")
+		}
+		if len(eupd.Table.Owned) != 0 {
+			eupd.OwnedVindexQuery = generateUpdateSubquery(upd, eupd.Table, false)
+		}
+		eupd.MoveQuery, eupd.MoveDeleteQuery, eupd.MoveGeneratedQuery = generateMoveQueries(upd, eupd.Table)
+	}
+	if multiShard && upd.Limit != nil {
+		// The shards only report the rows they changed, not the
+		// ones they matched: the limit can't be split across them.
+		return nil, errors.New("unsupported: multi shard update with limit")
 	}
 	return eupd, nil
 }
@@ -100,8 +118,9 @@ func generateQuery(statement sqlparser.Statement) string {
 }
 
 // buildChangedVindexesValues adds to the plan all the lookup vindexes that are changing.
-// Updates can only be performed to secondary lookup vindexes with no complex expressions
-// in the set clause.
+// Updates can only be performed to the primary vindex and to secondary lookup vindexes
+// with no complex expressions in the set clause. The new value of the primary vindex is
+// set in ChangedPrimaryValue.
 func buildChangedVindexesValues(eupd *engine.Update, update *sqlparser.Update, colVindexes []*vindexes.ColumnVindex) (map[string][]sqltypes.PlanValue, error) {
 	changedVindexes := make(map[string][]sqltypes.PlanValue)
 	for i, vindex := range colVindexes {
@@ -136,7 +155,13 @@ func buildChangedVindexesValues(eupd *engine.Update, update *sqlparser.Update, c
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Need to provide order by clause when using limit. Invalid update on vindex: %v", vindex.Name)
 		}
 		if i == 0 {
-			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can't update primary vindex columns. Invalid update on vindex: %v", vindex.Name)
+			// The rows move to the shard of the new value,
+			// which is handled separately.
+			if !vindex.Vindex.IsUnique() || len(vindex.Columns) != 1 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update a unique single-column primary vindex. Invalid update on vindex: %v", vindex.Name)
+			}
+			eupd.ChangedPrimaryValue = &vindexValues[0]
+			continue
 		}
 		if _, ok := vindex.Vindex.(vindexes.Lookup); !ok {
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update lookup vindexes. Invalid update on vindex: %v", vindex.Name)
@@ -186,36 +211,65 @@ func buildDeletePlan(del *sqlparser.Delete, vschema VSchema) (*engine.Delete, er
 	if err != nil {
 		return nil, err
 	}
+	edel.Opcode = engine.DeleteEqual
 	edel.Vindex, edel.Values, err = getDMLRouting(del.Where, edel.Table)
-	// We couldn't generate a route for a single shard
-	// Execute a delete sharded
 	if err != nil {
+		// We couldn't generate a route for a single shard.
 		edel.Opcode = engine.DeleteSharded
-	} else {
-		edel.Opcode = engine.DeleteEqual
+		if vindex, values, ok := getDMLINRouting(del.Where, edel.Table); ok {
+			edel.Opcode, edel.Vindex, edel.Values = engine.DeleteIN, vindex, values
+		}
 	}
+	multiShard := edel.Opcode != engine.DeleteEqual
 
-	if edel.Opcode == engine.DeleteSharded {
-		if len(edel.Table.Owned) != 0 {
-			return edel, errors.New("unsupported: multi shard delete on a table with owned lookup vindexes")
+	edel.OwnedVindexQuery = generateDeleteSubquery(del, edel.Table, multiShard)
+	if multiShard && del.Limit != nil {
+		// The limit of each shard is only known at execution
+		// time, so the subquery can't fetch the same rows.
+		if edel.OwnedVindexQuery != "" {
+			return nil, errors.New("unsupported: multi shard delete with limit on a table with owned lookup vindexes")
 		}
-		if del.Limit != nil {
-			return edel, errors.New("unsupported: multi shard delete with limit")
+		if edel.Limit, err = buildMultiShardLimit(del.Limit, del.OrderBy); err != nil {
+			return nil, err
 		}
+		edel.Query = generateQuery(del)
 	}
-	edel.OwnedVindexQuery = generateDeleteSubquery(del, edel.Table)
 	return edel, nil
 }
 
+// buildMultiShardLimit returns the limit of a multi-shard DML, and
+// replaces its value by the engine.LimitVarName bind variable. The
+// shards are executed one at a time, which doesn't preserve any order:
+// so, the limit can't be combined with an ORDER BY.
+func buildMultiShardLimit(limit *sqlparser.Limit, orderBy sqlparser.OrderBy) (*sqltypes.PlanValue, error) {
+	if len(orderBy) != 0 {
+		return nil, errors.New("unsupported: multi shard DML with order by and limit")
+	}
+	if limit.Offset != nil {
+		return nil, errors.New("unsupported: multi shard DML with limit offset")
+	}
+	pv, err := sqlparser.NewPlanValue(limit.Rowcount)
+	if err != nil {
+		return nil, err
+	}
+	limit.Rowcount = sqlparser.NewValArg([]byte(":" + engine.LimitVarName))
+	return &pv, nil
+}
+
 // generateDeleteSubquery generates the query to fetch the rows
 // that will be deleted. This allows VTGate to clean up any
-// owned vindexes as needed.
-func generateDeleteSubquery(del *sqlparser.Delete, table *vindexes.Table) string {
+// owned vindexes as needed. For a multi-shard delete, the query
+// also fetches the column of the primary vindex first, which
+// gives the keyspace id of each row.
+func generateDeleteSubquery(del *sqlparser.Delete, table *vindexes.Table, multiShard bool) string {
 	if len(table.Owned) == 0 {
 		return ""
 	}
 	buf := sqlparser.NewTrackedBuffer(nil)
 	buf.WriteString("select ")
+	if multiShard {
+		buf.Myprintf("%v, ", table.ColumnVindexes[0].Columns[0])
+	}
 	for vIdx, cv := range table.Owned {
 		for cIdx, column := range cv.Columns {
 			if cIdx == 0 && vIdx == 0 {
@@ -229,9 +283,14 @@ func generateDeleteSubquery(del *sqlparser.Delete, table *vindexes.Table) string
 	return buf.String()
 }
 
-func generateUpdateSubquery(upd *sqlparser.Update, table *vindexes.Table) string {
+// generateUpdateSubquery generates the query to fetch the vindex
+// columns of the row that will be updated, like generateDeleteSubquery.
+func generateUpdateSubquery(upd *sqlparser.Update, table *vindexes.Table, multiShard bool) string {
 	buf := sqlparser.NewTrackedBuffer(nil)
 	buf.WriteString("select ")
+	if multiShard {
+		buf.Myprintf("%v, ", table.ColumnVindexes[0].Columns[0])
+	}
 	for vIdx, cv := range table.Owned {
 		for cIdx, column := range cv.Columns {
 			if cIdx == 0 && vIdx == 0 {
@@ -245,6 +304,31 @@ func generateUpdateSubquery(upd *sqlparser.Update, table *vindexes.Table) string
 	return buf.String()
 }
 
+// generateMoveQueries generates the queries that fetch and delete the
+// rows of an update that changes the primary vindex column, once they
+// have been updated in their old shard. They're found by the new value
+// of the column, which can't be shared by any other row of that shard.
+// The third query lists the generated columns of the table, which can't
+// be inserted in the new shard.
+func generateMoveQueries(upd *sqlparser.Update, table *vindexes.Table) (string, string, string) {
+	primary := table.ColumnVindexes[0].Columns[0]
+	var value sqlparser.Expr
+	for _, assignment := range upd.Exprs {
+		if primary.Equal(assignment.Name.Name) {
+			value = assignment.Expr
+		}
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select * from %v where %v = %v for update", table.Name, primary, value)
+	query := buf.String()
+	buf = sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("delete from %v where %v = %v", table.Name, primary, value)
+	deleteQuery := buf.String()
+	buf = sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select column_name from information_schema.columns where table_schema = database() and table_name = %v and extra in ('VIRTUAL GENERATED', 'STORED GENERATED')", sqlparser.NewStrVal([]byte(table.Name.String())))
+	return query, deleteQuery, buf.String()
+}
+
 // getDMLRouting returns the vindex and values for the DML,
 // If it cannot find a unique vindex match, it returns an error.
 func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (vindexes.Vindex, []sqltypes.PlanValue, error) {
@@ -262,6 +346,21 @@ func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (vindexes.Vind
 	return nil, nil, errors.New("unsupported: multi-shard where clause in DML")
 }
 
+// getDMLINRouting returns the vindex and values for a multi-shard
+// DML whose where clause restricts the column of a vindex to a list
+// of values. The values are returned as a single list.
+func getDMLINRouting(where *sqlparser.Where, table *vindexes.Table) (vindexes.Vindex, []sqltypes.PlanValue, bool) {
+	if where == nil {
+		return nil, nil, false
+	}
+	for _, index := range table.Ordered {
+		if pv, ok := getINMatch(where.Expr, index.Columns[0]); ok {
+			return index.Vindex, []sqltypes.PlanValue{pv}, true
+		}
+	}
+	return nil, nil, false
+}
+
 // extractValueFromUpdate given an UpdateExpr attempts to extracts the Value
 // it's holding. At the moment it only supports: StrVal, HexVal, IntVal, ValArg.
 // If a complex expression is provided (e.g set name = name + 1), the update will be rejected.
@@ -308,6 +407,38 @@ func getMatch(node sqlparser.Expr, col sqlparser.ColIdent) (pv sqltypes.PlanValu
 	return sqltypes.PlanValue{}, false
 }
 
+// getINMatch returns the list of values of an IN or an equality
+// constraint on the specified column.
+func getINMatch(node sqlparser.Expr, col sqlparser.ColIdent) (pv sqltypes.PlanValue, ok bool) {
+	for _, filter := range splitAndExpression(nil, node) {
+		comparison, ok := skipParenthesis(filter).(*sqlparser.ComparisonExpr)
+		if !ok || !nameMatch(comparison.Left, col) {
+			continue
+		}
+		switch comparison.Operator {
+		case sqlparser.EqualStr:
+			if !sqlparser.IsValue(comparison.Right) {
+				continue
+			}
+			pv, err := sqlparser.NewPlanValue(comparison.Right)
+			if err != nil {
+				continue
+			}
+			return sqltypes.PlanValue{Values: []sqltypes.PlanValue{pv}}, true
+		case sqlparser.InStr:
+			if !sqlparser.IsSimpleTuple(comparison.Right) {
+				continue
+			}
+			pv, err := sqlparser.NewPlanValue(comparison.Right)
+			if err != nil {
+				continue
+			}
+			return pv, true
+		}
+	}
+	return sqltypes.PlanValue{}, false
+}
+
 func nameMatch(node sqlparser.Expr, col sqlparser.ColIdent) bool {
 	colname, ok := node.(*sqlparser.ColName)
 	return ok && colname.Name.Equal(col)
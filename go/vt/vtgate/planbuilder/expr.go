@@ -21,7 +21,9 @@ import (
 	"errors"
 	"fmt"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
 )
 
 // splitAndExpression breaks up the Expr into AND-separated conditions
@@ -157,6 +159,26 @@ func hasSubquery(node sqlparser.SQLNode) bool {
 	return has
 }
 
+// hasWindowFunctions returns true if the select expressions or
+// the ORDER BY clause of sel have window functions. The subqueries
+// are not inspected: they're checked when they're built.
+func hasWindowFunctions(sel *sqlparser.Select) bool {
+	has := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			return false, nil
+		case *sqlparser.FuncExpr:
+			if node.Over != nil {
+				has = true
+				return false, errors.New("dummy")
+			}
+		}
+		return true, nil
+	}, sel.SelectExprs, sel.OrderBy)
+	return has
+}
+
 func validateSubquerySamePlan(keyspace string, bldr builder, vschema VSchema, nodes ...sqlparser.SQLNode) bool {
 	samePlan := true
 
@@ -214,6 +236,135 @@ func validateSubquerySamePlan(keyspace string, bldr builder, vschema VSchema, no
 	return true
 }
 
+// filterBuilder converts an expression into an engine.FilterExpr
+// that vtgate can evaluate.
+type filterBuilder struct {
+	// leaf converts the nodes whose values come from the
+	// row, like columns. It returns nil for the other nodes.
+	leaf func(sqlparser.Expr) (engine.FilterExpr, error)
+
+	// unsupported returns the error for a node that
+	// can't be converted.
+	unsupported func(sqlparser.Expr) error
+}
+
+func (fb *filterBuilder) build(expr sqlparser.Expr) (engine.FilterExpr, error) {
+	fexpr, err := fb.leaf(expr)
+	if err != nil || fexpr != nil {
+		return fexpr, err
+	}
+	switch node := expr.(type) {
+	case *sqlparser.AndExpr:
+		return fb.buildLogical(engine.FilterAnd, node.Left, node.Right)
+	case *sqlparser.OrExpr:
+		return fb.buildLogical(engine.FilterOr, node.Left, node.Right)
+	case *sqlparser.NotExpr:
+		inner, err := fb.build(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.FilterNot{Expr: inner}, nil
+	case *sqlparser.ParenExpr:
+		return fb.build(node.Expr)
+	case *sqlparser.ComparisonExpr:
+		switch node.Operator {
+		case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.LessThanStr, sqlparser.LessEqualStr,
+			sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr, sqlparser.NullSafeEqualStr:
+		default:
+			return nil, fb.unsupported(node)
+		}
+		left, err := fb.build(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := fb.build(node.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.FilterComparison{Operator: node.Operator, Left: left, Right: right}, nil
+	case *sqlparser.IsExpr:
+		if node.Operator != sqlparser.IsNullStr && node.Operator != sqlparser.IsNotNullStr {
+			return nil, fb.unsupported(node)
+		}
+		inner, err := fb.build(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.FilterIsNull{Not: node.Operator == sqlparser.IsNotNullStr, Expr: inner}, nil
+	case *sqlparser.FuncExpr:
+		switch node.Name.Lowered() {
+		case "coalesce", "ifnull":
+		default:
+			return nil, fb.unsupported(node)
+		}
+		if node.Distinct || (node.Name.Lowered() == "ifnull" && len(node.Exprs) != 2) {
+			return nil, fb.unsupported(node)
+		}
+		coalesce := &engine.FilterCoalesce{}
+		for _, sexpr := range node.Exprs {
+			aexpr, ok := sexpr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return nil, fb.unsupported(node)
+			}
+			inner, err := fb.build(aexpr.Expr)
+			if err != nil {
+				return nil, err
+			}
+			coalesce.Exprs = append(coalesce.Exprs, inner)
+		}
+		return coalesce, nil
+	case *sqlparser.SQLVal:
+		if node.Type == sqlparser.FloatVal {
+			return &engine.FilterValue{Value: sqltypes.PlanValue{Value: sqltypes.MakeTrusted(sqltypes.Float64, node.Val)}}, nil
+		}
+		pv, err := sqlparser.NewPlanValue(node)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.FilterValue{Value: pv}, nil
+	case *sqlparser.NullVal:
+		return &engine.FilterValue{}, nil
+	}
+	return nil, fb.unsupported(expr)
+}
+
+func (fb *filterBuilder) buildLogical(operator string, left, right sqlparser.Expr) (engine.FilterExpr, error) {
+	l, err := fb.build(left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := fb.build(right)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.FilterLogical{Operator: operator, Left: l, Right: r}, nil
+}
+
+// isStrict returns true if expr is NULL whenever one of the
+// columns it references is NULL. This is the case if it's
+// only made of operators that return NULL for a NULL operand.
+func isStrict(expr sqlparser.Expr) bool {
+	strict := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			return false, nil
+		case *sqlparser.SQLVal, *sqlparser.NullVal, *sqlparser.ParenExpr,
+			*sqlparser.BinaryExpr, *sqlparser.UnaryExpr, *sqlparser.NotExpr:
+			return true, nil
+		case *sqlparser.ComparisonExpr:
+			switch node.Operator {
+			case sqlparser.InStr, sqlparser.NotInStr, sqlparser.NullSafeEqualStr:
+			default:
+				return true, nil
+			}
+		}
+		strict = false
+		return false, nil
+	}, expr)
+	return strict
+}
+
 func valEqual(a, b sqlparser.Expr) bool {
 	switch a := a.(type) {
 	case *sqlparser.ColName:
@@ -76,6 +76,13 @@ func processTableExpr(tableExpr sqlparser.TableExpr, vschema VSchema) (builder,
 func processAliasedTable(tableExpr *sqlparser.AliasedTableExpr, vschema VSchema) (builder, error) {
 	switch expr := tableExpr.Expr.(type) {
 	case sqlparser.TableName:
+		if subroute := findCTE(expr, vschema); subroute != nil {
+			alias := tableExpr.As
+			if alias.IsEmpty() {
+				alias = expr.Name
+			}
+			return buildDerivedRoute(tableExpr, alias, subroute, vschema)
+		}
 		return buildTablePrimitive(tableExpr, expr, vschema)
 	case *sqlparser.Subquery:
 		var err error
@@ -96,42 +103,47 @@ func processAliasedTable(tableExpr *sqlparser.AliasedTableExpr, vschema VSchema)
 		if !ok {
 			return newSubquery(tableExpr.As, subplan, vschema), nil
 		}
+		return buildDerivedRoute(tableExpr, tableExpr.As, subroute, vschema)
+	}
+	panic(fmt.Sprintf("BUG: unexpected table expression type: %T", tableExpr.Expr))
+}
 
-		// Since a route is more versatile than a subquery, we
-		// build a route primitive that has the subquery in its
-		// FROM clause. This allows for other constructs to be
-		// later pushed into it.
-		table := &vindexes.Table{
-			Keyspace: subroute.ERoute.Keyspace,
+// buildDerivedRoute builds a route for a table expression that selects
+// from subroute: a subquery, or a common table expression. Since a route
+// is more versatile than a subquery, we build a route primitive that has
+// the table expression in its FROM clause. This allows for other constructs
+// to be later pushed into it. The table is named alias, and exposes the
+// vindex columns of subroute.
+func buildDerivedRoute(tableExpr *sqlparser.AliasedTableExpr, alias sqlparser.TableIdent, subroute *route, vschema VSchema) (builder, error) {
+	table := &vindexes.Table{
+		Keyspace: subroute.ERoute.Keyspace,
+	}
+	for _, rc := range subroute.ResultColumns() {
+		if rc.column.Vindex == nil {
+			continue
 		}
-		for _, rc := range subroute.ResultColumns() {
-			if rc.column.Vindex == nil {
-				continue
+		// Check if a colvindex of the same name already exists.
+		// Dups are not allowed in subqueries in this situation.
+		for _, colVindex := range table.ColumnVindexes {
+			if colVindex.Columns[0].Equal(rc.alias) {
+				return nil, fmt.Errorf("duplicate column aliases: %v", rc.alias)
 			}
-			// Check if a colvindex of the same name already exists.
-			// Dups are not allowed in subqueries in this situation.
-			for _, colVindex := range table.ColumnVindexes {
-				if colVindex.Columns[0].Equal(rc.alias) {
-					return nil, fmt.Errorf("duplicate column aliases: %v", rc.alias)
-				}
-			}
-			table.ColumnVindexes = append(table.ColumnVindexes, &vindexes.ColumnVindex{
-				Columns: []sqlparser.ColIdent{rc.alias},
-				Vindex:  rc.column.Vindex,
-			})
 		}
-		rb := newRoute(
-			&sqlparser.Select{From: sqlparser.TableExprs([]sqlparser.TableExpr{tableExpr})},
-			subroute.ERoute,
-			subroute.condition,
-			vschema,
-		)
-		// AddVindexTable can never fail because symtab is empty.
-		_ = rb.symtab.AddVindexTable(sqlparser.TableName{Name: tableExpr.As}, table, rb)
-		subroute.Redirect = rb
-		return rb, nil
+		table.ColumnVindexes = append(table.ColumnVindexes, &vindexes.ColumnVindex{
+			Columns: []sqlparser.ColIdent{rc.alias},
+			Vindex:  rc.column.Vindex,
+		})
 	}
-	panic(fmt.Sprintf("BUG: unexpected table expression type: %T", tableExpr.Expr))
+	rb := newRoute(
+		&sqlparser.Select{From: sqlparser.TableExprs([]sqlparser.TableExpr{tableExpr})},
+		subroute.ERoute,
+		subroute.condition,
+		vschema,
+	)
+	// AddVindexTable can never fail because symtab is empty.
+	_ = rb.symtab.AddVindexTable(sqlparser.TableName{Name: alias}, table, rb)
+	subroute.Redirect = rb
+	return rb, nil
 }
 
 // buildTablePrimitive builds a primitive based on the table name.
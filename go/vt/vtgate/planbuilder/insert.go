@@ -27,6 +27,10 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
+// insertSelectBatchSize is the max number of rows an
+// INSERT ... SELECT sends to the shards at once.
+const insertSelectBatchSize = 500
+
 // buildInsertPlan builds the route for an INSERT statement.
 func buildInsertPlan(ins *sqlparser.Insert, vschema VSchema) (*engine.Insert, error) {
 	table, err := vschema.FindTable(ins.Table)
@@ -36,10 +40,7 @@ func buildInsertPlan(ins *sqlparser.Insert, vschema VSchema) (*engine.Insert, er
 	if !table.Keyspace.Sharded {
 		return buildInsertUnshardedPlan(ins, table, vschema)
 	}
-	if ins.Action == sqlparser.ReplaceStr {
-		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
-	}
-	return buildInsertShardedPlan(ins, table)
+	return buildInsertShardedPlan(ins, table, vschema)
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema VSchema) (*engine.Insert, error) {
@@ -48,15 +49,24 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vsch
 		Table:    table,
 		Keyspace: table.Keyspace,
 	}
+	if eins.Table.AutoIncrement != nil {
+		// The values of the auto-inc column are generated by
+		// vtgate, so the rows are selected first, by their own
+		// plan.
+		switch sel := ins.Rows.(type) {
+		case *sqlparser.Select, *sqlparser.Union:
+			if len(ins.Columns) == 0 {
+				return nil, errors.New("column list required for tables with auto-inc columns")
+			}
+			return buildInsertSelectPlan(ins, eins, sel.(sqlparser.SelectStatement), vschema)
+		}
+	}
 	if !validateSubquerySamePlan(eins.Keyspace.Name, nil, vschema, ins) {
 		return nil, errors.New("unsupported: sharded subquery in insert values")
 	}
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
 	case *sqlparser.Select, *sqlparser.Union:
-		if eins.Table.AutoIncrement != nil {
-			return nil, errors.New("unsupported: auto-inc and select in insert")
-		}
 		eins.Query = generateQuery(ins)
 		return eins, nil
 	case sqlparser.Values:
@@ -85,7 +95,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vsch
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (*engine.Insert, error) {
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema VSchema) (*engine.Insert, error) {
 	eins := &engine.Insert{
 		Opcode:   engine.InsertSharded,
 		Table:    table,
@@ -100,13 +110,19 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (*engi
 		}
 		eins.Opcode = engine.InsertShardedIgnore
 	}
+	if ins.Action == sqlparser.ReplaceStr {
+		eins.Opcode = engine.InsertShardedReplace
+		eins.OwnedVindexQuery, eins.UniqueKeyQuery = generateReplaceSubquery(eins.Table)
+	}
 	if len(ins.Columns) == 0 {
 		return nil, errors.New("no column list")
 	}
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
-	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+	case *sqlparser.Select:
+		return buildInsertSelectPlan(ins, eins, insertValues, vschema)
+	case *sqlparser.Union:
+		return buildInsertSelectPlan(ins, eins, insertValues, vschema)
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -152,13 +168,100 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (*engi
 	return eins, nil
 }
 
+// buildInsertSelectPlan builds the plan of an INSERT ... SELECT into
+// a sharded keyspace, or into an unsharded table with an auto-inc
+// column. The select is executed by its own plan, possibly cross-shard,
+// and the rows it returns are inserted in batches. Vindex and auto-inc
+// columns missing from the insert are added to both the column list
+// and the select, with NULL values.
+func buildInsertSelectPlan(ins *sqlparser.Insert, eins *engine.Insert, sel sqlparser.SelectStatement, vschema VSchema) (*engine.Insert, error) {
+	eins.VindexOffsets = make([][]int, len(eins.Table.ColumnVindexes))
+	for vIdx, colVindex := range eins.Table.ColumnVindexes {
+		eins.VindexOffsets[vIdx] = make([]int, len(colVindex.Columns))
+		for colIdx, col := range colVindex.Columns {
+			eins.VindexOffsets[vIdx][colIdx] = findOrAddSelectColumn(ins, sel, col)
+		}
+	}
+	if eins.Table.AutoIncrement != nil {
+		eins.AutoIncOffset = findOrAddSelectColumn(ins, sel, eins.Table.AutoIncrement.Column)
+		eins.Generate = &engine.Generate{
+			Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
+			Uncached: eins.Table.AutoIncrement.Sequence.Uncached,
+		}
+	}
+
+	var err error
+	switch sel := sel.(type) {
+	case *sqlparser.Select:
+		eins.Input, err = buildSelectPlan(sel, vschema)
+	case *sqlparser.Union:
+		eins.Input, err = buildUnionPlan(sel, vschema)
+	}
+	if err != nil {
+		return nil, err
+	}
+	eins.BatchSize = insertSelectBatchSize
+	eins.Query = generateQuery(ins)
+	generateInsertShardedQuery(ins, eins, nil)
+	return eins, nil
+}
+
+// findOrAddSelectColumn is like findOrAddColumn for an INSERT ... SELECT.
+func findOrAddSelectColumn(ins *sqlparser.Insert, sel sqlparser.SelectStatement, col sqlparser.ColIdent) int {
+	for i, column := range ins.Columns {
+		if col.Equal(column) {
+			return i
+		}
+	}
+	ins.Columns = append(ins.Columns, col)
+	appendNullColumn(sel)
+	return len(ins.Columns) - 1
+}
+
+// appendNullColumn adds a NULL column to the result of a select.
+func appendNullColumn(sel sqlparser.SelectStatement) {
+	switch sel := sel.(type) {
+	case *sqlparser.Select:
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.NullVal{}})
+	case *sqlparser.Union:
+		appendNullColumn(sel.Left)
+		appendNullColumn(sel.Right)
+	case *sqlparser.ParenSelect:
+		appendNullColumn(sel.Select)
+	}
+}
+
+// generateReplaceSubquery generates the query that fetches the owned
+// vindex columns of the rows replaced by a REPLACE, and the query that
+// fetches the unique keys of the table. The first column of the owned
+// vindex query is the column of the primary vindex, which gives the
+// keyspace id of each row. Its where clause is added by the engine.
+func generateReplaceSubquery(table *vindexes.Table) (string, string) {
+	if len(table.Owned) == 0 {
+		return "", ""
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select %v", table.ColumnVindexes[0].Columns[0])
+	for _, cv := range table.Owned {
+		for _, column := range cv.Columns {
+			buf.Myprintf(", %v", column)
+		}
+	}
+	buf.Myprintf(" from %v", table.Name)
+	ownedVindexQuery := buf.String()
+	buf = sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("show index from %v where Non_unique = 0", table.Name)
+	return ownedVindexQuery, buf.String()
+}
+
 func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, valueTuples sqlparser.Values) {
 	prefixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	midBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	eins.Mid = make([]string, len(valueTuples))
-	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
-		node.Comments, node.Ignore,
+	prefixBuf.Myprintf("%s %v%sinto %v%v values ",
+		node.Action, node.Comments, node.Ignore,
 		node.Table, node.Columns)
 	eins.Prefix = prefixBuf.String()
 	for rowNum, val := range valueTuples {
@@ -183,6 +286,7 @@ func modifyForAutoinc(ins *sqlparser.Insert, eins *engine.Insert) error {
 		Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
 		Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
 		Values:   autoIncValues,
+		Uncached: eins.Table.AutoIncrement.Sequence.Uncached,
 	}
 	return nil
 }
@@ -18,13 +18,31 @@ package planbuilder
 
 import (
 	"errors"
+	"fmt"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 )
 
 var _ builder = (*join)(nil)
 
+const (
+	// joinBatchSize is the number of LHS rows sent
+	// per RHS query by a batched join.
+	joinBatchSize = 100
+
+	// hashJoinBatchSize is the number of LHS rows sent
+	// per RHS query by a hash join. It also bounds the
+	// size of its hash table.
+	hashJoinBatchSize = 1000
+
+	// hashJoinMaxRows is the max number of rows a hash
+	// join accepts from the RHS for a batch. It bounds
+	// the memory used by the join, which fails beyond it.
+	hashJoinMaxRows = 100000
+)
+
 // join is used to build a Join primitive.
 // It's used to build a normal join or a left join
 // operation.
@@ -161,16 +179,105 @@ func (jb *join) ResultColumns() []*resultColumn {
 }
 
 // PushFilter satisfies the builder interface.
+// For a left join, a filter that references the RHS must also be
+// applied to the null-extended rows. If the filter is strict, it
+// rejects all of them. So, the left join can be turned into a normal
+// join, and the filter can be pushed down. Otherwise, the filter is
+// evaluated by the join itself.
 func (jb *join) PushFilter(filter sqlparser.Expr, whereType string, origin columnOriginator) error {
 	if jb.isOnLeft(origin.Order()) {
 		return jb.Left.PushFilter(filter, whereType, origin)
 	}
 	if jb.ejoin.Opcode == engine.LeftJoin {
-		return errors.New("unsupported: cross-shard left join and where clause")
+		if !isStrict(filter) {
+			return jb.pushPredicate(filter)
+		}
+		jb.ejoin.Opcode = engine.NormalJoin
 	}
 	return jb.Right.PushFilter(filter, whereType, origin)
 }
 
+// pushPredicate adds the filter to the predicate evaluated by the join.
+func (jb *join) pushPredicate(filter sqlparser.Expr) error {
+	fb := &filterBuilder{
+		leaf: func(expr sqlparser.Expr) (engine.FilterExpr, error) {
+			return jb.buildEvalCol(expr, false)
+		},
+		unsupported: func(sqlparser.Expr) error {
+			return fmt.Errorf("unsupported: cross-shard left join and where clause: %s", sqlparser.String(filter))
+		},
+	}
+	expr, err := fb.build(filter)
+	if err != nil {
+		return err
+	}
+	if jb.ejoin.Predicate != nil {
+		expr = &engine.FilterLogical{Operator: engine.FilterAnd, Left: jb.ejoin.Predicate, Right: expr}
+	}
+	jb.ejoin.Predicate = expr
+	return nil
+}
+
+// pushNullValue specifies how the value of a RHS expression, which is
+// the colnum column of the join, is computed for the null-extended rows
+// of a left join. If the expression is strict, the value is NULL, which
+// is the default.
+func (jb *join) pushNullValue(expr sqlparser.Expr, colnum int) error {
+	if isStrict(expr) {
+		return nil
+	}
+	fb := &filterBuilder{
+		leaf: func(node sqlparser.Expr) (engine.FilterExpr, error) {
+			return jb.buildEvalCol(node, true)
+		},
+		unsupported: func(sqlparser.Expr) error {
+			return fmt.Errorf("unsupported: cross-shard left join and column expressions: %s", sqlparser.String(expr))
+		},
+	}
+	fexpr, err := fb.build(expr)
+	if err != nil {
+		return err
+	}
+	if jb.ejoin.NullValues == nil {
+		jb.ejoin.NullValues = make(map[int]engine.FilterExpr)
+	}
+	jb.ejoin.NullValues[colnum] = fexpr
+	return nil
+}
+
+// buildEvalCol converts a column into a reference to the EvalCols
+// of the join, which supply the values of the columns it evaluates.
+// If nullRight is set, the columns of the RHS are converted to NULL,
+// which is their value in a null-extended row.
+func (jb *join) buildEvalCol(expr sqlparser.Expr, nullRight bool) (engine.FilterExpr, error) {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	order := col.Metadata.(*column).Origin().Order()
+	if order < jb.Left.Leftmost().Order() || order > jb.rightMaxOrder {
+		return nil, fmt.Errorf("unsupported: cross-shard left join and reference to an outer column: %s", sqlparser.String(col))
+	}
+	var evalCol int
+	if jb.isOnLeft(order) {
+		_, colnum := jb.Left.SupplyCol(col)
+		evalCol = -colnum - 1
+	} else {
+		if nullRight {
+			return &engine.FilterValue{}, nil
+		}
+		_, colnum := jb.Right.SupplyCol(col)
+		evalCol = colnum + 1
+	}
+	for i, c := range jb.ejoin.EvalCols {
+		if c == evalCol {
+			return &engine.FilterColumn{Col: i}, nil
+		}
+	}
+	jb.ejoin.EvalCols = append(jb.ejoin.EvalCols, evalCol)
+	return &engine.FilterColumn{Col: len(jb.ejoin.EvalCols) - 1}, nil
+}
+
 // PushSelect satisfies the builder interface.
 func (jb *join) PushSelect(expr *sqlparser.AliasedExpr, origin columnOriginator) (rc *resultColumn, colnum int, err error) {
 	if jb.isOnLeft(origin.Order()) {
@@ -180,9 +287,10 @@ func (jb *join) PushSelect(expr *sqlparser.AliasedExpr, origin columnOriginator)
 		}
 		jb.ejoin.Cols = append(jb.ejoin.Cols, -colnum-1)
 	} else {
-		// Pushing of non-trivial expressions not allowed for RHS of left joins.
-		if _, ok := expr.Expr.(*sqlparser.ColName); !ok && jb.ejoin.Opcode == engine.LeftJoin {
-			return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
+		if jb.ejoin.Opcode == engine.LeftJoin {
+			if err := jb.pushNullValue(expr.Expr, len(jb.ejoin.Cols)); err != nil {
+				return nil, 0, err
+			}
 		}
 
 		rc, colnum, err = jb.Right.PushSelect(expr, origin)
@@ -222,6 +330,7 @@ func (jb *join) PushMisc(sel *sqlparser.Select) {
 
 // Wireup satisfies the builder interface.
 func (jb *join) Wireup(bldr builder, jt *jointab) error {
+	jb.chooseStrategy(jt)
 	err := jb.Right.Wireup(bldr, jt)
 	if err != nil {
 		return err
@@ -284,3 +393,125 @@ func (jb *join) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colnum int)
 func (jb *join) isOnLeft(nodeNum int) bool {
 	return nodeNum <= jb.leftMaxOrder
 }
+
+// chooseStrategy chooses how the rows of the RHS are fetched and
+// matched with the rows of the LHS. It must be called before the
+// nodes are wired up, because it rewrites the RHS query.
+//
+// The cost of the default nested loop is one RHS query per LHS row.
+// The other strategies require the RHS to be a route that depends
+// on the LHS only through an equality between two columns.
+// The strategy is chosen as follows:
+// If the LHS returns at most one row, the nested loop is the cheapest.
+// Otherwise, the equality is replaced with an IN clause that lists the
+// values of a batch of LHS rows, and the RHS is executed once per
+// batch. If the equality was used for routing the RHS, the RHS is
+// routed by the IN clause instead. The values of the two columns are then matched by
+// vtgate. If the RHS is confined to a single shard without the
+// equality, and both columns are known to be numbers, a hash join
+// uses bigger batches. Otherwise, a batched join is used.
+func (jb *join) chooseStrategy(jt *jointab) {
+	rb, ok := jb.Right.(*route)
+	if !ok || rb.Redirect != nil {
+		return
+	}
+	if lb, ok := jb.Left.(*route); ok && lb.ERoute.Opcode == engine.SelectEqualUnique {
+		return
+	}
+	switch rb.ERoute.Opcode {
+	case engine.SelectNext, engine.SelectDBA:
+		return
+	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok || sel.Where == nil || sel.Distinct != "" || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return
+	}
+
+	// Find the references to the columns of the LHS.
+	var refs []*sqlparser.ColName
+	minOrder := jb.Left.Leftmost().Order()
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return true, nil
+		}
+		if c, ok := col.Metadata.(*column); ok {
+			if order := c.Origin().Order(); order >= minOrder && order <= jb.leftMaxOrder {
+				refs = append(refs, col)
+			}
+		}
+		return true, nil
+	}, sel)
+	if len(refs) != 1 {
+		return
+	}
+	filters := splitAndExpression(nil, sel.Where.Expr)
+	index := -1
+	var lcol, rcol *sqlparser.ColName
+	for i, filter := range filters {
+		comparison, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok || comparison.Operator != sqlparser.EqualStr {
+			continue
+		}
+		left, lok := comparison.Left.(*sqlparser.ColName)
+		right, rok := comparison.Right.(*sqlparser.ColName)
+		if !lok || !rok {
+			continue
+		}
+		switch {
+		case left == refs[0] && rb.isLocal(right):
+			lcol, rcol = left, right
+		case right == refs[0] && rb.isLocal(left):
+			lcol, rcol = right, left
+		default:
+			continue
+		}
+		index = i
+		break
+	}
+	if index == -1 {
+		return
+	}
+
+	listVar := jt.GenerateVar(lcol)
+	in := &sqlparser.ComparisonExpr{
+		Operator: sqlparser.InStr,
+		Left:     rcol,
+		Right:    sqlparser.ListArg("::" + listVar),
+	}
+	filters[index] = in
+	setWhere(sel, filters)
+	routedByJoin := rb.condition == sqlparser.Expr(lcol)
+	if routedByJoin {
+		rb.updateRoute(engine.SelectIN, rb.ERoute.Vindex, in)
+	}
+	jb.ejoin.ListVar = listVar
+	singleShard := rb.ERoute.Opcode == engine.SelectUnsharded || rb.ERoute.Opcode == engine.SelectEqualUnique
+	if singleShard && !routedByJoin && isNumeric(lcol) && isNumeric(rcol) {
+		jb.ejoin.Strategy = engine.HashJoin
+		jb.ejoin.BatchSize = hashJoinBatchSize
+		jb.ejoin.MaxRows = hashJoinMaxRows
+	} else {
+		jb.ejoin.Strategy = engine.BatchedJoin
+		jb.ejoin.BatchSize = joinBatchSize
+	}
+	_, lcolnum := jb.Left.SupplyCol(lcol)
+	_, rcolnum := rb.SupplyCol(rcol)
+	jb.ejoin.Key = &engine.JoinKey{LeftCol: lcolnum, RightCol: rcolnum}
+}
+
+// isNumeric returns true if the type of the column is known to be
+// a number. The values of two such columns are compared by vtgate
+// like mysql does.
+func isNumeric(col *sqlparser.ColName) bool {
+	typ := col.Metadata.(*column).typ
+	return sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal
+}
+
+// setWhere sets the WHERE clause of sel to the AND of the filters.
+func setWhere(sel *sqlparser.Select, filters []sqlparser.Expr) {
+	sel.Where = nil
+	for _, filter := range filters {
+		sel.AddWhere(filter)
+	}
+}
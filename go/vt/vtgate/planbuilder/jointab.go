@@ -46,27 +46,36 @@ func (jt *jointab) Procure(bldr builder, col *sqlparser.ColName, to int) string
 	from, joinVar := jt.Lookup(col)
 	// If joinVar is empty, generate a unique name.
 	if joinVar == "" {
-		suffix := ""
-		i := 0
-		for {
-			if !col.Qualifier.IsEmpty() {
-				joinVar = col.Qualifier.Name.CompliantName() + "_" + col.Name.CompliantName() + suffix
-			} else {
-				joinVar = col.Name.CompliantName() + suffix
-			}
-			if _, ok := jt.vars[joinVar]; !ok {
-				break
-			}
-			i++
-			suffix = strconv.Itoa(i)
-		}
-		jt.vars[joinVar] = struct{}{}
+		joinVar = jt.GenerateVar(col)
 		jt.refs[col.Metadata.(*column)] = joinVar
 	}
 	bldr.SupplyVar(from, to, col, joinVar)
 	return joinVar
 }
 
+// GenerateVar generates a unique join var name for the specified
+// column. Unlike Procure, the name is not associated with the column.
+// It's used for vars that don't hold the value of the column, like
+// the list of values sent by a batched join.
+func (jt *jointab) GenerateVar(col *sqlparser.ColName) string {
+	suffix := ""
+	i := 0
+	for {
+		var joinVar string
+		if !col.Qualifier.IsEmpty() {
+			joinVar = col.Qualifier.Name.CompliantName() + "_" + col.Name.CompliantName() + suffix
+		} else {
+			joinVar = col.Name.CompliantName() + suffix
+		}
+		if _, ok := jt.vars[joinVar]; !ok {
+			jt.vars[joinVar] = struct{}{}
+			return joinVar
+		}
+		i++
+		suffix = strconv.Itoa(i)
+	}
+}
+
 // Lookup returns the order of the route that supplies the column and
 // the join var name if one has already been assigned for it.
 func (jt *jointab) Lookup(col *sqlparser.ColName) (order int, joinVar string) {
@@ -53,12 +53,48 @@ var _ builder = (*orderedAggregate)(nil)
 //      Keys: []int{0, 1},
 //      Input: (Scatter Route with the order by request),
 //    }
+//
+// Partial aggregates that cannot be merged as they come are split
+// further. AVG is sent as a SUM and a COUNT, and COUNT(DISTINCT) or
+// SUM(DISTINCT) send their expression as an extra grouping column:
+// 'select col1, count(distinct col2) from t group by col1'
+// will be sent as:
+// 'select col1, col2 from t group by col1, col2 order by col1, col2'
+//
+// A HAVING clause that references aggregates is evaluated by an
+// engine.Filter on top of the OrderedAggregate.
 type orderedAggregate struct {
 	symtab        *symtab
 	resultColumns []*resultColumn
 	order         int
 	input         *route
 	eaggr         *engine.OrderedAggregate
+
+	// aggregateCols maps the aggregate expressions pushed to
+	// the route to their column number, so that HAVING can
+	// reference them.
+	aggregateCols map[string]int
+
+	// avgCounts are the COUNT expressions of AVG aggregates.
+	// They're pushed to the route after all the select
+	// expressions because they're not part of the result.
+	avgCounts []avgCount
+
+	// extraDistinct is the expression of the distinct aggregate,
+	// if any. It's pushed as an extra grouping column.
+	extraDistinct *sqlparser.ColName
+
+	// having is the list of HAVING conditions to be evaluated
+	// after aggregation.
+	having  []engine.FilterExpr
+	efilter *engine.Filter
+}
+
+// avgCount is the COUNT expression to push down for the
+// AVG aggregate at index aggr in eaggr.Aggregates.
+type avgCount struct {
+	aggr int
+	expr *sqlparser.FuncExpr
 }
 
 // checkAggregates analyzes the select expression for aggregates. If it determines
@@ -80,6 +116,9 @@ func checkAggregates(sel *sqlparser.Select, bldr builder) (builder, error) {
 	if len(sel.GroupBy) > 0 {
 		hasAggregates = true
 	}
+	if sel.Having != nil && nodeHasAggregates(sel.Having.Expr) {
+		hasAggregates = true
+	}
 	if !hasAggregates {
 		return bldr, nil
 	}
@@ -126,10 +165,11 @@ func checkAggregates(sel *sqlparser.Select, bldr builder) (builder, error) {
 
 	// We need an aggregator primitive.
 	return &orderedAggregate{
-		symtab: rb.Symtab(),
-		order:  rb.Order() + 1,
-		input:  rb,
-		eaggr:  &engine.OrderedAggregate{},
+		symtab:        rb.Symtab(),
+		order:         rb.Order() + 1,
+		input:         rb,
+		eaggr:         &engine.OrderedAggregate{},
+		aggregateCols: make(map[string]int),
 	}, nil
 }
 
@@ -252,6 +292,10 @@ func (oa *orderedAggregate) SetOrder(order int) {
 // Primitive satisfies the builder interface.
 func (oa *orderedAggregate) Primitive() engine.Primitive {
 	oa.eaggr.Input = oa.input.Primitive()
+	if oa.efilter != nil {
+		oa.efilter.Input = oa.eaggr
+		return oa.efilter
+	}
 	return oa.eaggr
 }
 
@@ -266,8 +310,128 @@ func (oa *orderedAggregate) ResultColumns() []*resultColumn {
 }
 
 // PushFilter satisfies the builder interface.
-func (oa *orderedAggregate) PushFilter(_ sqlparser.Expr, whereType string, _ columnOriginator) error {
-	return errors.New("unsupported: filtering on results of aggregates")
+// Only HAVING clauses can reach oa. Conditions that don't reference
+// aggregates are pushed down to the route. The others are evaluated
+// by oa after aggregation.
+func (oa *orderedAggregate) PushFilter(filter sqlparser.Expr, whereType string, origin columnOriginator) error {
+	if whereType != sqlparser.HavingStr {
+		return errors.New("unsupported: filtering on results of aggregates")
+	}
+	if origin != oa && !nodeHasAggregates(filter) {
+		return oa.input.PushFilter(filter, whereType, origin)
+	}
+	expr, err := oa.buildFilterExpr(filter)
+	if err != nil {
+		return err
+	}
+	oa.having = append(oa.having, expr)
+	return nil
+}
+
+// buildFilterExpr converts a HAVING condition into an engine.FilterExpr.
+// Aggregates that are not in the select list are pushed down to the
+// route as extra columns.
+func (oa *orderedAggregate) buildFilterExpr(expr sqlparser.Expr) (engine.FilterExpr, error) {
+	// vtgate can't mimic mysql's collations: text comparisons are
+	// left to mysql, which can only happen for single-shard routes.
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if node, ok := node.(*sqlparser.ComparisonExpr); ok {
+			if isTextOperand(node.Left, node.Right) || isTextOperand(node.Right, node.Left) {
+				return false, fmt.Errorf("unsupported: in scatter query: having comparison of text values: %s", sqlparser.String(node))
+			}
+		}
+		return true, nil
+	}, expr)
+	if err != nil {
+		return nil, err
+	}
+	fb := &filterBuilder{
+		leaf: oa.buildFilterLeaf,
+		unsupported: func(node sqlparser.Expr) error {
+			switch node := node.(type) {
+			case *sqlparser.ComparisonExpr:
+				return fmt.Errorf("unsupported: in scatter query: having operator: %s", node.Operator)
+			case *sqlparser.IsExpr:
+				return fmt.Errorf("unsupported: in scatter query: having operator: %s", node.Operator)
+			}
+			return fmt.Errorf("unsupported: in scatter query: complex having expression: %s", sqlparser.String(node))
+		},
+	}
+	return fb.build(expr)
+}
+
+// isTextOperand returns true if expr, compared with other, makes
+// a text comparison: its value comes from a text column, or it's a
+// string literal compared with something that's not a number.
+func isTextOperand(expr, other sqlparser.Expr) bool {
+	switch node := expr.(type) {
+	case *sqlparser.SQLVal:
+		return node.Type == sqlparser.StrVal && !isNumericOperand(other)
+	case *sqlparser.ColName:
+		c, ok := node.Metadata.(*column)
+		return ok && sqltypes.IsText(c.typ)
+	case *sqlparser.ParenExpr:
+		return isTextOperand(node.Expr, other)
+	case *sqlparser.FuncExpr:
+		switch node.Name.Lowered() {
+		case "min", "max", "coalesce", "ifnull":
+		default:
+			return false
+		}
+		for _, sexpr := range node.Exprs {
+			if aexpr, ok := sexpr.(*sqlparser.AliasedExpr); ok && isTextOperand(aexpr.Expr, other) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isNumericOperand returns true if expr is known to be a number.
+func isNumericOperand(expr sqlparser.Expr) bool {
+	switch node := expr.(type) {
+	case *sqlparser.SQLVal:
+		return node.Type == sqlparser.IntVal || node.Type == sqlparser.FloatVal
+	case *sqlparser.ColName:
+		c, ok := node.Metadata.(*column)
+		return ok && (sqltypes.IsIntegral(c.typ) || sqltypes.IsFloat(c.typ) || c.typ == sqltypes.Decimal)
+	case *sqlparser.ParenExpr:
+		return isNumericOperand(node.Expr)
+	case *sqlparser.FuncExpr:
+		switch node.Name.Lowered() {
+		case "count", "sum", "avg":
+			return true
+		}
+	}
+	return false
+}
+
+// buildFilterLeaf converts the columns and the aggregates
+// of a HAVING condition into references to the columns of oa.
+func (oa *orderedAggregate) buildFilterLeaf(expr sqlparser.Expr) (engine.FilterExpr, error) {
+	switch node := expr.(type) {
+	case *sqlparser.ColName:
+		c := node.Metadata.(*column)
+		for i, rc := range oa.resultColumns {
+			if rc.column == c {
+				return &engine.FilterColumn{Col: i}, nil
+			}
+		}
+		return nil, fmt.Errorf("unsupported: in scatter query: having column must reference a column in the select list: %s", sqlparser.String(node))
+	case *sqlparser.FuncExpr:
+		if _, ok := engine.SupportedAggregates[node.Name.Lowered()]; !ok {
+			return nil, nil
+		}
+		if col, ok := oa.aggregateCols[sqlparser.String(node)]; ok {
+			return &engine.FilterColumn{Col: col}, nil
+		}
+		_, col, err := oa.pushAggregate(&sqlparser.AliasedExpr{Expr: node}, oa.input)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.FilterColumn{Col: col}, nil
+	}
+	return nil, nil
 }
 
 // PushSelect satisfies the builder interface.
@@ -284,18 +448,15 @@ func (oa *orderedAggregate) PushFilter(_ sqlparser.Expr, whereType string, _ col
 // the rows be correctly ordered for a merge sort.
 func (oa *orderedAggregate) PushSelect(expr *sqlparser.AliasedExpr, origin columnOriginator) (rc *resultColumn, colnum int, err error) {
 	if inner, ok := expr.Expr.(*sqlparser.FuncExpr); ok {
-		if opcode, ok := engine.SupportedAggregates[inner.Name.Lowered()]; ok {
-			innerRC, innerCol, _ := oa.input.PushSelect(expr, origin)
-
-			// Add to Aggregates.
-			oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
-				Opcode: opcode,
-				Col:    innerCol,
-			})
+		if _, ok := engine.SupportedAggregates[inner.Name.Lowered()]; ok {
+			alias, _, err := oa.pushAggregate(expr, origin)
+			if err != nil {
+				return nil, 0, err
+			}
 
 			// Build a new rc with oa as origin because it's semantically different
 			// from the expression we pushed down.
-			rc := &resultColumn{alias: innerRC.alias, column: &column{origin: oa}}
+			rc := &resultColumn{alias: alias, column: &column{origin: oa}}
 			oa.resultColumns = append(oa.resultColumns, rc)
 			return rc, len(oa.resultColumns) - 1, nil
 		}
@@ -311,6 +472,103 @@ func (oa *orderedAggregate) PushSelect(expr *sqlparser.AliasedExpr, origin colum
 	return innerRC, len(oa.resultColumns) - 1, nil
 }
 
+// pushAggregate pushes the partial aggregate for expr to the route,
+// and adds the corresponding entry to Aggregates. It returns the
+// alias of the result column, and the route column that holds the
+// partial aggregate.
+func (oa *orderedAggregate) pushAggregate(expr *sqlparser.AliasedExpr, origin columnOriginator) (alias sqlparser.ColIdent, innerCol int, err error) {
+	inner := expr.Expr.(*sqlparser.FuncExpr)
+	opcode := engine.SupportedAggregates[inner.Name.Lowered()]
+	switch {
+	case inner.Distinct && opcode == engine.AggregateAvg:
+		return alias, 0, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(inner))
+	case inner.Distinct && opcode != engine.AggregateMin && opcode != engine.AggregateMax:
+		alias, innerCol, err = oa.pushDistinctAggregate(expr, origin, engine.DistinctAggregates[opcode])
+	case opcode == engine.AggregateAvg:
+		alias, innerCol = oa.pushAvg(expr, origin)
+	default:
+		// MIN and MAX are not affected by DISTINCT.
+		var innerRC *resultColumn
+		innerRC, innerCol, _ = oa.input.PushSelect(expr, origin)
+		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
+			Opcode: opcode,
+			Col:    innerCol,
+		})
+		alias = innerRC.alias
+	}
+	if err != nil {
+		return alias, 0, err
+	}
+	oa.aggregateCols[sqlparser.String(inner)] = innerCol
+	return alias, innerCol, nil
+}
+
+// pushDistinctAggregate pushes the expression of a COUNT(DISTINCT) or
+// SUM(DISTINCT) to the route. The expression is later added to the
+// group by and order by of the route by PushOrderBy, which allows oa
+// to skip the duplicate values as they come.
+func (oa *orderedAggregate) pushDistinctAggregate(expr *sqlparser.AliasedExpr, origin columnOriginator, opcode engine.AggregateOpcode) (alias sqlparser.ColIdent, innerCol int, err error) {
+	inner := expr.Expr.(*sqlparser.FuncExpr)
+	if oa.extraDistinct != nil {
+		return alias, 0, fmt.Errorf("unsupported: only one distinct aggregation allowed in a select: %s", sqlparser.String(inner))
+	}
+	var innerExpr *sqlparser.AliasedExpr
+	if len(inner.Exprs) == 1 {
+		innerExpr, _ = inner.Exprs[0].(*sqlparser.AliasedExpr)
+	}
+	if innerExpr == nil {
+		return alias, 0, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(inner))
+	}
+	_, innerCol, _ = oa.input.PushSelect(&sqlparser.AliasedExpr{Expr: innerExpr.Expr}, origin)
+	oa.extraDistinct, err = oa.input.BuildColName(innerCol)
+	if err != nil {
+		return alias, 0, fmt.Errorf("unsupported: in scatter query: %s: %v", sqlparser.String(inner), err)
+	}
+	oa.eaggr.HasDistinct = true
+	oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
+		Opcode: opcode,
+		Col:    innerCol,
+		KeyCol: innerCol,
+		Alias:  aggregateAlias(expr),
+	})
+	return expr.As, innerCol, nil
+}
+
+// pushAvg pushes the SUM of an AVG to the route. The COUNT is pushed
+// later, by Wireup.
+func (oa *orderedAggregate) pushAvg(expr *sqlparser.AliasedExpr, origin columnOriginator) (alias sqlparser.ColIdent, innerCol int) {
+	inner := expr.Expr.(*sqlparser.FuncExpr)
+	sum := &sqlparser.AliasedExpr{
+		Expr: &sqlparser.FuncExpr{
+			Name:  sqlparser.NewColIdent("sum"),
+			Exprs: inner.Exprs,
+		},
+	}
+	_, innerCol, _ = oa.input.PushSelect(sum, origin)
+	oa.avgCounts = append(oa.avgCounts, avgCount{
+		aggr: len(oa.eaggr.Aggregates),
+		expr: &sqlparser.FuncExpr{
+			Name:  sqlparser.NewColIdent("count"),
+			Exprs: inner.Exprs,
+		},
+	})
+	oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
+		Opcode: engine.AggregateAvg,
+		Col:    innerCol,
+		Alias:  aggregateAlias(expr),
+	})
+	return expr.As, innerCol
+}
+
+// aggregateAlias returns the name of the result column for an
+// aggregate whose partial value is computed by a different expression.
+func aggregateAlias(expr *sqlparser.AliasedExpr) string {
+	if !expr.As.IsEmpty() {
+		return expr.As.String()
+	}
+	return sqlparser.String(expr.Expr)
+}
+
 func (oa *orderedAggregate) MakeDistinct() error {
 	for i, rc := range oa.resultColumns {
 		// If the column origin is oa (and not the underlying route),
@@ -438,6 +696,18 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) error {
 		order := &sqlparser.Order{Expr: col, Direction: sqlparser.AscScr}
 		oa.input.PushOrderBy(order)
 	}
+
+	// The expression of a distinct aggregate must come right after
+	// the keys, so that the duplicate values of a group are together.
+	if oa.extraDistinct != nil {
+		groupBy := append(sqlparser.GroupBy(nil), oa.input.Select.(*sqlparser.Select).GroupBy...)
+		groupBy = append(groupBy, oa.extraDistinct)
+		_ = oa.input.SetGroupBy(groupBy)
+		order := &sqlparser.Order{Expr: oa.extraDistinct, Direction: sqlparser.AscScr}
+		if err := oa.input.PushOrderBy(order); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -452,7 +722,13 @@ func (oa *orderedAggregate) PushOrderByRand() {
 }
 
 // SetUpperLimit satisfies the builder interface.
+// The limit can't be pushed down if groups can be dropped after
+// aggregation, or if the route returns more than one row for
+// each group.
 func (oa *orderedAggregate) SetUpperLimit(count *sqlparser.SQLVal) {
+	if len(oa.having) != 0 || oa.eaggr.HasDistinct {
+		return
+	}
 	oa.input.SetUpperLimit(count)
 }
 
@@ -467,13 +743,39 @@ func (oa *orderedAggregate) PushMisc(sel *sqlparser.Select) {
 // compare those instead. This is because we currently don't have the
 // ability to mimic mysql's collation behavior.
 func (oa *orderedAggregate) Wireup(bldr builder, jt *jointab) error {
+	for _, ac := range oa.avgCounts {
+		_, colnum, _ := oa.input.PushSelect(&sqlparser.AliasedExpr{Expr: ac.expr}, oa.input)
+		oa.eaggr.Aggregates[ac.aggr].CountCol = colnum
+	}
 	for i, colnum := range oa.eaggr.Keys {
 		if sqltypes.IsText(oa.resultColumns[colnum].column.typ) {
-			// len(oa.resultColumns) does not change. No harm using the value multiple times.
-			oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
 			oa.eaggr.Keys[i] = oa.input.SupplyWeightString(colnum)
 		}
 	}
+	for i, aggr := range oa.eaggr.Aggregates {
+		if aggr.Opcode != engine.AggregateCountDistinct && aggr.Opcode != engine.AggregateSumDistinct {
+			continue
+		}
+		if sqltypes.IsText(oa.input.ResultColumns()[aggr.Col].column.typ) {
+			oa.eaggr.Aggregates[i].KeyCol = oa.input.SupplyWeightString(aggr.Col)
+		}
+	}
+	// Columns that were added for oa's own use are not returned.
+	if len(oa.input.ResultColumns()) > len(oa.resultColumns) {
+		oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+	}
+	if len(oa.having) != 0 {
+		predicate := oa.having[0]
+		for _, expr := range oa.having[1:] {
+			predicate = &engine.FilterLogical{Operator: engine.FilterAnd, Left: predicate, Right: expr}
+		}
+		// The filter needs the extra columns, so it does the truncation.
+		oa.efilter = &engine.Filter{
+			Predicate:           predicate,
+			TruncateColumnCount: oa.eaggr.TruncateColumnCount,
+		}
+		oa.eaggr.TruncateColumnCount = 0
+	}
 	return oa.input.Wireup(bldr, jt)
 }
 
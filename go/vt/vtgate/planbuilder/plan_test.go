@@ -153,6 +153,7 @@ func TestPlan(t *testing.T) {
 	testFile(t, "postprocess_cases.txt", vschema)
 	testFile(t, "select_cases.txt", vschema)
 	testFile(t, "symtab_cases.txt", vschema)
+	testFile(t, "union_cases.txt", vschema)
 	testFile(t, "unsupported_cases.txt", vschema)
 	testFile(t, "vindex_func_cases.txt", vschema)
 	testFile(t, "wireup_cases.txt", vschema)
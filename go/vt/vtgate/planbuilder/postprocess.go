@@ -63,8 +63,11 @@ func pushGroupBy(sel *sqlparser.Select, bldr builder) error {
 // order by references columns of the left-most route. Otherwise, the
 // function returns an unsupported error.
 func pushOrderBy(orderBy sqlparser.OrderBy, bldr builder) error {
-	if oa, ok := bldr.(*orderedAggregate); ok {
-		return oa.PushOrderBy(orderBy)
+	switch bldr := bldr.(type) {
+	case *orderedAggregate:
+		return bldr.PushOrderBy(orderBy)
+	case *concatenate:
+		return bldr.PushOrderBy(orderBy)
 	}
 
 	switch len(orderBy) {
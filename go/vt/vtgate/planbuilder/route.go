@@ -772,9 +772,6 @@ func (rb *route) SubqueryCanMerge(inner *route) error {
 // the RHS of a union can be merged with the current route. If not, it
 // returns an appropriate error.
 func (rb *route) UnionCanMerge(right *route) error {
-	if rb.ERoute.Opcode == engine.SelectNext || right.ERoute.Opcode == engine.SelectNext {
-		return errors.New("unsupported: UNION on sequence tables")
-	}
 	if rb.ERoute.Keyspace.Name != right.ERoute.Keyspace.Name {
 		return errors.New("unsupported: UNION on different keyspaces")
 	}
@@ -41,6 +41,15 @@ func buildSelectPlan(sel *sqlparser.Select, vschema VSchema) (primitive engine.P
 
 // processSelect builds a primitive tree for the given query or subquery.
 func processSelect(sel *sqlparser.Select, vschema VSchema, outer builder) (builder, error) {
+	var cv *cteVSchema
+	if sel.With != nil {
+		var err error
+		cv, err = processWith(sel.With, vschema)
+		if err != nil {
+			return nil, err
+		}
+		vschema = cv
+	}
 	bldr, err := processTableExprs(sel.From, vschema)
 	if err != nil {
 		return nil, err
@@ -54,6 +63,10 @@ func processSelect(sel *sqlparser.Select, vschema VSchema, outer builder) (build
 			return nil, err
 		}
 	}
+	err = checkSingleRoute(sel, bldr)
+	if err != nil {
+		return nil, err
+	}
 	bldr, err = checkAggregates(sel, bldr)
 	if err != nil {
 		return nil, err
@@ -77,9 +90,32 @@ func processSelect(sel *sqlparser.Select, vschema VSchema, outer builder) (build
 		return nil, err
 	}
 	bldr.PushMisc(sel)
+	if cv != nil {
+		err = cv.pushWith(sel.With, bldr)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return bldr, nil
 }
 
+// checkSingleRoute returns an error if the query uses constructs
+// that can only be sent to a single shard, and bldr is not a
+// single-shard route. This is called after the WHERE clause is
+// pushed, because it can narrow down the route.
+func checkSingleRoute(sel *sqlparser.Select, bldr builder) error {
+	if rb, ok := bldr.(*route); ok && rb.IsSingle() {
+		return nil
+	}
+	if sel.With != nil {
+		return errWithCrossShard
+	}
+	if hasWindowFunctions(sel) {
+		return errors.New("unsupported: window functions in cross-shard query")
+	}
+	return nil
+}
+
 // pushFilter identifies the target route for the specified bool expr,
 // pushes it down, and updates the route info if the new constraint improves
 // the primitive. This function can push to a WHERE or HAVING clause.
@@ -39,6 +39,15 @@ func buildUnionPlan(union *sqlparser.Union, vschema VSchema) (primitive engine.P
 }
 
 func processUnion(union *sqlparser.Union, vschema VSchema, outer builder) (builder, error) {
+	var cv *cteVSchema
+	if union.With != nil {
+		var err error
+		cv, err = processWith(union.With, vschema)
+		if err != nil {
+			return nil, err
+		}
+		vschema = cv
+	}
 	lbldr, err := processPart(union.Left, vschema, outer)
 	if err != nil {
 		return nil, err
@@ -62,6 +71,12 @@ func processUnion(union *sqlparser.Union, vschema VSchema, outer builder) (build
 	if err != nil {
 		return nil, err
 	}
+	if cv != nil {
+		err = cv.pushWith(union.With, bldr)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return bldr, nil
 }
 
@@ -84,17 +99,17 @@ func processPart(part sqlparser.SelectStatement, vschema VSchema, outer builder)
 	return bldr, nil
 }
 
+// unionRouteMerge merges both sides of the union into a single
+// route if possible. Otherwise, it builds a concatenate that
+// performs the union in vtgate.
 func unionRouteMerge(union *sqlparser.Union, left, right builder, vschema VSchema) (builder, error) {
-	lroute, ok := left.(*route)
-	if !ok {
-		return nil, errors.New("unsupported construct: SELECT of UNION is non-trivial")
+	lroute, lok := left.(*route)
+	rroute, rok := right.(*route)
+	if (lok && lroute.ERoute.Opcode == engine.SelectNext) || (rok && rroute.ERoute.Opcode == engine.SelectNext) {
+		return nil, errors.New("unsupported: UNION on sequence tables")
 	}
-	rroute, ok := right.(*route)
-	if !ok {
-		return nil, errors.New("unsupported construct: SELECT of UNION is non-trivial")
-	}
-	if err := lroute.UnionCanMerge(rroute); err != nil {
-		return nil, err
+	if !lok || !rok || lroute.UnionCanMerge(rroute) != nil {
+		return newConcatenate(union, left, right, vschema)
 	}
 	rb := newRoute(
 		&sqlparser.Union{Type: union.Type, Left: union.Left, Right: union.Right, Lock: union.Lock},
@@ -0,0 +1,130 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// This file has functions to analyze the WITH clause.
+
+var errWithCrossShard = errors.New("unsupported: WITH clause in cross-shard query")
+
+// cteVSchema is the VSchema of a query that has a WITH clause.
+// It resolves the names of the common table expressions to the
+// routes of their subqueries, and defers all the other lookups
+// to the VSchema of the enclosing query.
+//
+// A query with a WITH clause can only be sent to a single shard,
+// with the WITH clause in front of it. References to a common
+// table expression are built like subqueries in the FROM clause,
+// which lets the planner figure out where its rows are.
+type cteVSchema struct {
+	VSchema
+	routes map[string]*route
+	ctes   []*route
+}
+
+// processWith builds the routes of the common table expressions
+// of a WITH clause, in order: a common table expression can reference
+// the previous ones. A recursive common table expression can also
+// reference itself. The reference is resolved with the first part of
+// its UNION, which is the one that doesn't reference it.
+func processWith(with *sqlparser.With, vschema VSchema) (*cteVSchema, error) {
+	cv := &cteVSchema{
+		VSchema: vschema,
+		routes:  make(map[string]*route),
+	}
+	for _, cte := range with.CTEs {
+		name := cte.Name.String()
+		if union, ok := cte.Subquery.Select.(*sqlparser.Union); ok && with.Recursive {
+			anchor, err := processCTE(union.Left, cv)
+			if err != nil {
+				return nil, err
+			}
+			cv.routes[name] = anchor
+		}
+		rb, err := processCTE(cte.Subquery.Select, cv)
+		if err != nil {
+			return nil, err
+		}
+		cv.routes[name] = rb
+		cv.ctes = append(cv.ctes, rb)
+	}
+	return cv, nil
+}
+
+// processCTE builds the route of the subquery of a common table expression.
+func processCTE(stmt sqlparser.SelectStatement, vschema VSchema) (*route, error) {
+	bldr, err := processPart(stmt, vschema, nil)
+	if err != nil {
+		return nil, err
+	}
+	rb, ok := bldr.(*route)
+	if !ok {
+		return nil, errWithCrossShard
+	}
+	return rb, nil
+}
+
+// findCTE returns the route of the common table expression
+// named by tableName, or nil if it's not one.
+func findCTE(tableName sqlparser.TableName, vschema VSchema) *route {
+	if !tableName.Qualifier.IsEmpty() {
+		return nil
+	}
+	for {
+		cv, ok := vschema.(*cteVSchema)
+		if !ok {
+			return nil
+		}
+		if rb, ok := cv.routes[tableName.Name.String()]; ok {
+			return rb
+		}
+		vschema = cv.VSchema
+	}
+}
+
+// pushWith merges the common table expressions that are not
+// referenced by the query into bldr, and puts the WITH clause
+// in front of its query. bldr must be a single-shard route.
+func (cv *cteVSchema) pushWith(with *sqlparser.With, bldr builder) error {
+	rb, ok := bldr.(*route)
+	if !ok || !rb.IsSingle() {
+		return errWithCrossShard
+	}
+	for _, cte := range cv.ctes {
+		// The common table expressions that are referenced
+		// were redirected to the route of the reference.
+		if cte.Redirect != nil {
+			continue
+		}
+		if err := rb.SubqueryCanMerge(cte); err != nil {
+			return err
+		}
+		cte.Redirect = rb
+	}
+	switch stmt := rb.Select.(type) {
+	case *sqlparser.Select:
+		stmt.With = with
+	case *sqlparser.Union:
+		stmt.With = with
+	}
+	return nil
+}
@@ -19,8 +19,10 @@ package vtgate
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
+	"strings"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -50,6 +52,10 @@ var (
 	mysqlSslKey  = flag.String("mysql_server_ssl_key", "", "Path to ssl key for mysql server plugin SSL")
 	mysqlSslCa   = flag.String("mysql_server_ssl_ca", "", "Path to ssl CA for mysql server plugin SSL. If specified, server will require and validate client certs.")
 
+	mysqlServerCompression = flag.String("mysql_server_compression", "", "Comma separated list of the compression algorithms the server accepts for the MySQL protocol: zlib and/or zstd. Compression is only used if the client asks for it. By default, compression is disabled.")
+
+	mysqlServerRSAKey = flag.String("mysql_server_rsa_key", "", "Path to the PEM RSA private key used by the caching_sha2_password and sha256_password auth methods over non-SSL connections. If not set, a key is generated when first needed.")
+
 	mysqlSlowConnectWarnThreshold = flag.Duration("mysql_slow_connect_warn_threshold", 0, "Warn if it takes more than the given threshold for a mysql connection to establish")
 
 	busyConnections int32
@@ -84,6 +90,28 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 }
 
 func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	return vh.execute(c, query, make(map[string]*querypb.BindVariable), callback)
+}
+
+// ComPrepare is part of the mysql.Handler interface. The fields of the
+// result are only known once the statement is planned and executed,
+// so none are returned here.
+func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string) ([]*querypb.Field, error) {
+	return nil, nil
+}
+
+// ComStmtExecute is part of the mysql.Handler interface.
+func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	bindVars := make(map[string]*querypb.BindVariable, len(prepare.BindVars))
+	for k, v := range prepare.BindVars {
+		bindVars[k] = v
+	}
+	return vh.execute(c, prepare.PrepareStmt, bindVars, callback)
+}
+
+// execute runs the query for the connection, reusing or creating the
+// session stored in its ClientData.
+func (vh *vtgateHandler) execute(c *mysql.Conn, query string, bindVars map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
 	// FIXME(alainjobart): Add some kind of timeout to the context.
 	ctx := context.Background()
 
@@ -125,10 +153,10 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 		session.TargetString = c.SchemaName
 	}
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
-		err := vh.vtg.StreamExecute(ctx, session, query, make(map[string]*querypb.BindVariable), callback)
+		err := vh.vtg.StreamExecute(ctx, session, query, bindVars, callback)
 		return mysql.NewSQLErrorFromError(err)
 	}
-	session, result, err := vh.vtg.Execute(ctx, session, query, make(map[string]*querypb.BindVariable))
+	session, result, err := vh.vtg.Execute(ctx, session, query, bindVars)
 	c.ClientData = session
 	err = mysql.NewSQLErrorFromError(err)
 	if err != nil {
@@ -178,6 +206,24 @@ func initMySQLProtocol() {
 			}
 		}
 		mysqlListener.AllowClearTextWithoutTLS = *mysqlAllowClearTextWithoutTLS
+		if *mysqlServerCompression != "" {
+			mysqlListener.Compression = strings.Split(*mysqlServerCompression, ",")
+			for _, algorithm := range mysqlListener.Compression {
+				if algorithm != mysql.CompressionZlib && algorithm != mysql.CompressionZstd {
+					log.Exitf("invalid mysql_server_compression algorithm: %v", algorithm)
+				}
+			}
+		}
+		if *mysqlServerRSAKey != "" {
+			data, err := ioutil.ReadFile(*mysqlServerRSAKey)
+			if err != nil {
+				log.Exitf("cannot read mysql_server_rsa_key: %v", err)
+			}
+			mysqlListener.RSAKey, err = mysql.ParseRSAPrivateKey(data)
+			if err != nil {
+				log.Exitf("cannot parse mysql_server_rsa_key: %v", err)
+			}
+		}
 
 		// Check for the connection threshold
 		if *mysqlSlowConnectWarnThreshold != 0 {
@@ -18,7 +18,9 @@ package vtgate
 
 import (
 	"io/ioutil"
+	"net"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -26,6 +28,10 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 type testHandler struct {
@@ -43,6 +49,14 @@ func (th *testHandler) ComQuery(c *mysql.Conn, q string, callback func(*sqltypes
 	return nil
 }
 
+func (th *testHandler) ComPrepare(c *mysql.Conn, q string) ([]*querypb.Field, error) {
+	return nil, nil
+}
+
+func (th *testHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	return nil
+}
+
 func TestConnectionUnixSocket(t *testing.T) {
 	th := &testHandler{}
 
@@ -155,3 +169,58 @@ func TestConnectionRespectsExistingUnixSocket(t *testing.T) {
 		t.Errorf("Error: %v, want prefix %s", err, want)
 	}
 }
+
+func TestMultiStatements(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	authServer := mysql.NewAuthServerStatic()
+	authServer.Entries["user1"] = []*mysql.AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	l, err := mysql.NewListener("tcp", "127.0.0.1:0", authServer, newVtgateHandler(rpcVTGate))
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+
+	params := &mysql.ConnParams{
+		Host:   "127.0.0.1",
+		Port:   l.Addr().(*net.TCPAddr).Port,
+		Uname:  "user1",
+		Pass:   "password1",
+		DbName: "@master",
+	}
+	params.EnableMultiStatements()
+	c, err := mysql.Connect(context.Background(), params)
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	defer c.Close()
+
+	// The statements share the session, so they run in the
+	// same transaction.
+	var results []*sqltypes.Result
+	result, more, err := c.ExecuteFetchMulti("begin; select id from t1; commit", 10, false)
+	for {
+		if err != nil {
+			t.Fatalf("ExecuteFetchMulti failed: %v", err)
+		}
+		results = append(results, result)
+		if !more {
+			break
+		}
+		result, more, err = c.ReadQueryResult(10, false)
+	}
+	if len(results) != 3 || !reflect.DeepEqual(results[1].Rows, sandboxconn.SingleRowResult.Rows) {
+		t.Errorf("ExecuteFetchMulti returned: %v", results)
+	}
+	if got, want := sbc.BeginCount.Get(), int64(1); got != want {
+		t.Errorf("begin count: %v, want %v", got, want)
+	}
+	if got, want := sbc.CommitCount.Get(), int64(1); got != want {
+		t.Errorf("commit count: %v, want %v", got, want)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -73,6 +74,7 @@ func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
 	newSession := proto.Clone(sessn).(*vtgatepb.Session)
 	newSession.InTransaction = false
 	newSession.ShardSessions = nil
+	newSession.Savepoints = nil
 	newSession.Autocommit = true
 	return NewSafeSession(newSession)
 }
@@ -161,6 +163,97 @@ func (session *SafeSession) isSingleDB(txMode vtgatepb.TransactionMode) bool {
 		(session.TransactionMode == vtgatepb.TransactionMode_UNSPECIFIED && txMode == vtgatepb.TransactionMode_SINGLE)
 }
 
+// HasSavepoint returns true if the savepoint is active.
+func (session *SafeSession) HasSavepoint(name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.findSavepoint(name) != -1
+}
+
+// SavepointNames returns the active savepoints, in the order they
+// were set.
+func (session *SafeSession) SavepointNames() []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return append([]string(nil), session.Savepoints...)
+}
+
+// SetSavepoint records a new savepoint. Like in MySQL, it replaces
+// an existing savepoint with the same name.
+func (session *SafeSession) SetSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = append(session.Savepoints[:i], session.Savepoints[i+1:]...)
+	}
+	session.Savepoints = append(session.Savepoints, name)
+}
+
+// RollbackToSavepoint releases the savepoints set after the
+// specified one.
+func (session *SafeSession) RollbackToSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = session.Savepoints[:i+1]
+	}
+}
+
+// ReleaseSavepoint releases the specified savepoint, and the ones
+// set after it.
+func (session *SafeSession) ReleaseSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = session.Savepoints[:i]
+	}
+}
+
+func (session *SafeSession) findSavepoint(name string) int {
+	for i, savepoint := range session.Savepoints {
+		if savepoint == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetSystemVariable records a session variable set by the client.
+// An empty value resets the variable to its default. The variables
+// are copied to the options, which pass them to the tablets.
+func (session *SafeSession) SetSystemVariable(name, value string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if value == "" {
+		delete(session.SystemVariables, name)
+	} else {
+		if session.SystemVariables == nil {
+			session.SystemVariables = make(map[string]string)
+		}
+		session.SystemVariables[name] = value
+	}
+
+	// The options may be in use by in-flight queries:
+	// they are copied instead of being modified.
+	options := &querypb.ExecuteOptions{}
+	if session.Options != nil {
+		options = proto.Clone(session.Options).(*querypb.ExecuteOptions)
+	}
+	options.SystemVariables = nil
+	if len(session.SystemVariables) == 0 {
+		// Back to the defaults: the tablets can use any connection.
+		session.SystemVariables = nil
+	} else {
+		options.SystemVariables = make(map[string]string, len(session.SystemVariables))
+		for name, value := range session.SystemVariables {
+			options.SystemVariables[name] = value
+		}
+	}
+	if session.Options != nil || options.SystemVariables != nil {
+		session.Options = options
+	}
+}
+
 // SetRollback sets the flag indicating that the transaction must be rolled back.
 // The call is a no-op if the session is not in a transaction.
 func (session *SafeSession) SetRollback() {
@@ -194,4 +287,5 @@ func (session *SafeSession) Reset() {
 	session.Session.InTransaction = false
 	session.SingleDb = false
 	session.ShardSessions = nil
+	session.Savepoints = nil
 }
@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -137,7 +138,7 @@ func (stc *ScatterConn) Execute(
 			var innerqr *sqltypes.Result
 			if shouldBegin {
 				var err error
-				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, query, bindVars, options)
+				innerqr, transactionID, err = stc.beginExecute(ctx, rs, session, query, bindVars, options)
 				if err != nil {
 					return transactionID, err
 				}
@@ -196,7 +197,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			case canCommit:
 				innerqr, err = stc.executeAutocommit(ctx, rs, queries[i].Sql, queries[i].BindVariables, opts)
 			case shouldBegin:
-				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, opts)
+				innerqr, transactionID, err = stc.beginExecute(ctx, rs, session, queries[i].Sql, queries[i].BindVariables, opts)
 			default:
 				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, transactionID, opts)
 			}
@@ -212,6 +213,55 @@ func (stc *ScatterConn) ExecuteMultiShard(
 	return qr, err
 }
 
+// beginExecute begins a transaction on the shard and executes the
+// query in it. If the session has active savepoints, they are set in
+// the new transaction first, so a later ROLLBACK TO SAVEPOINT also
+// applies to the shard.
+func (stc *ScatterConn) beginExecute(ctx context.Context, rs *srvtopo.ResolvedShard, session *SafeSession, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	savepoints := session.SavepointNames()
+	if len(savepoints) == 0 {
+		return rs.QueryService.BeginExecute(ctx, rs.Target, sql, bindVariables, options)
+	}
+	transactionID, err := stc.beginWithSavepoints(ctx, rs, savepoints, options)
+	if err != nil {
+		return nil, transactionID, err
+	}
+	qr, err := rs.QueryService.Execute(ctx, rs.Target, sql, bindVariables, transactionID, options)
+	return qr, transactionID, err
+}
+
+// beginExecuteBatch is the ExecuteBatch version of beginExecute.
+func (stc *ScatterConn) beginExecuteBatch(ctx context.Context, rs *srvtopo.ResolvedShard, session *SafeSession, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, error) {
+	savepoints := session.SavepointNames()
+	if len(savepoints) == 0 {
+		return rs.QueryService.BeginExecuteBatch(ctx, rs.Target, queries, asTransaction, options)
+	}
+	transactionID, err := stc.beginWithSavepoints(ctx, rs, savepoints, options)
+	if err != nil {
+		return nil, transactionID, err
+	}
+	qrs, err := rs.QueryService.ExecuteBatch(ctx, rs.Target, queries, asTransaction, transactionID, options)
+	return qrs, transactionID, err
+}
+
+// beginWithSavepoints begins a transaction on the shard, and sets the
+// savepoints in it. The transaction ID is returned even if setting a
+// savepoint failed, so the transaction gets rolled back with the
+// session.
+func (stc *ScatterConn) beginWithSavepoints(ctx context.Context, rs *srvtopo.ResolvedShard, savepoints []string, options *querypb.ExecuteOptions) (int64, error) {
+	transactionID, err := rs.QueryService.Begin(ctx, rs.Target, options)
+	if err != nil {
+		return 0, err
+	}
+	for _, name := range savepoints {
+		sql := sqlparser.String(&sqlparser.Savepoint{Name: sqlparser.NewColIdent(name)})
+		if _, err := rs.QueryService.Execute(ctx, rs.Target, sql, nil, transactionID, options); err != nil {
+			return transactionID, err
+		}
+	}
+	return transactionID, nil
+}
+
 func (stc *ScatterConn) executeAutocommit(ctx context.Context, rs *srvtopo.ResolvedShard, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
 	queries := []*querypb.BoundQuery{{
 		Sql:           sql,
@@ -254,7 +304,7 @@ func (stc *ScatterConn) ExecuteEntityIds(
 			var err error
 
 			if shouldBegin {
-				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, sqls[i], bindVars[i], options)
+				innerqr, transactionID, err = stc.beginExecute(ctx, rs, session, sqls[i], bindVars[i], options)
 			} else {
 				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, sqls[i], bindVars[i], transactionID, options)
 			}
@@ -372,7 +422,7 @@ func (stc *ScatterConn) ExecuteBatch(
 			shouldBegin, transactionID := transactionInfo(req.rs.Target, session, false)
 			var innerqrs []sqltypes.Result
 			if shouldBegin {
-				innerqrs, transactionID, err = req.rs.QueryService.BeginExecuteBatch(ctx, req.rs.Target, req.queries, asTransaction, options)
+				innerqrs, transactionID, err = stc.beginExecuteBatch(ctx, req.rs, session, req.queries, asTransaction, options)
 				if transactionID != 0 {
 					if appendErr := session.Append(&vtgatepb.Session_ShardSession{
 						Target:        req.rs.Target,
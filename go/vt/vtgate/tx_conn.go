@@ -169,6 +169,19 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	})
 }
 
+// ExecuteAll executes the statement in the transaction of every
+// shard that participates in the session. It is used for the
+// statements that apply to the whole transaction, like savepoints.
+func (txc *TxConn) ExecuteAll(ctx context.Context, session *SafeSession, sql string) error {
+	if !session.InTransaction() {
+		return nil
+	}
+	return txc.runSessions(session.ShardSessions, func(s *vtgatepb.Session_ShardSession) error {
+		_, err := txc.gateway.Execute(ctx, s.Target, sql, nil, s.TransactionId, session.Options)
+		return err
+	})
+}
+
 // Resolve resolves the specified 2PC transaction.
 func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 	mmShard, err := dtids.ShardSession(dtid)
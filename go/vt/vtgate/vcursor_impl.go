@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -148,6 +149,12 @@ func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedS
 	return vc.executor.scatterConn.StreamExecuteMulti(vc.ctx, query+vc.trailingComments, rss, bindVars, vc.target.TabletType, vc.safeSession.Options, callback)
 }
 
+// SequenceCache returns the cache of the sequence values leased by
+// this vtgate, or nil if values are not leased in blocks.
+func (vc *vcursorImpl) SequenceCache() *engine.SequenceCache {
+	return vc.executor.sequences
+}
+
 func (vc *vcursorImpl) ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	return vc.executor.resolver.resolver.ResolveDestinations(vc.ctx, keyspace, vc.target.TabletType, ids, destinations)
 }
@@ -41,6 +41,7 @@ type VSchema struct {
 // Table represents a table in VSchema.
 type Table struct {
 	IsSequence     bool                 `json:"is_sequence,omitempty"`
+	Uncached       bool                 `json:"uncached,omitempty"`
 	Name           sqlparser.TableIdent `json:"name"`
 	Keyspace       *Keyspace            `json:"-"`
 	ColumnVindexes []*ColumnVindex      `json:"column_vindexes,omitempty"`
@@ -200,8 +201,14 @@ func buildTables(source *vschemapb.SrvVSchema, vschema *VSchema) error {
 				vschema.uniqueTables[tname] = t
 			}
 			vschema.Keyspaces[ksname].Tables[tname] = t
-			if table.Type == "sequence" {
+			switch table.Type {
+			case "sequence":
 				t.IsSequence = true
+			case "uncached_sequence":
+				// The values of an uncached sequence are reserved
+				// in the transaction that uses them.
+				t.IsSequence = true
+				t.Uncached = true
 			}
 			if keyspace.Sharded && len(table.ColumnVindexes) == 0 {
 				return fmt.Errorf("missing primary col vindex for table: %s", tname)
@@ -663,6 +663,31 @@ func TestBuildVSchemaDupSeq(t *testing.T) {
 	}
 }
 
+func TestBuildVSchemaUncachedSeq(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ksa": {
+				Tables: map[string]*vschemapb.Table{
+					"seq": {
+						Type: "uncached_sequence",
+					},
+				},
+			},
+		},
+	}
+	vschema, err := BuildVSchema(&good)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := vschema.FindTable("ksa", "seq")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.IsSequence || !got.Uncached {
+		t.Errorf("seq: IsSequence: %v, Uncached: %v, want true, true", got.IsSequence, got.Uncached)
+	}
+}
+
 func TestBuildVSchemaDupTable(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -430,7 +430,7 @@ func TestQueryDeadline(t *testing.T) {
 		t.Errorf("Unexpected error code: %d, want %d", got, want)
 	}
 
-	_, err = conn.ReadQueryResult(1000, false)
+	_, _, err = conn.ReadQueryResult(1000, false)
 	if err != nil {
 		t.Errorf("unexpected error %v", err)
 	}
@@ -76,6 +76,24 @@ func (mh *proxyHandler) ConnectionClosed(c *mysql.Conn) {
 }
 
 func (mh *proxyHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	return mh.execute(c, query, make(map[string]*querypb.BindVariable), callback)
+}
+
+// ComPrepare is part of the mysql.Handler interface.
+func (mh *proxyHandler) ComPrepare(c *mysql.Conn, query string) ([]*querypb.Field, error) {
+	return nil, nil
+}
+
+// ComStmtExecute is part of the mysql.Handler interface.
+func (mh *proxyHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	bindVars := make(map[string]*querypb.BindVariable, len(prepare.BindVars))
+	for k, v := range prepare.BindVars {
+		bindVars[k] = v
+	}
+	return mh.execute(c, prepare.PrepareStmt, bindVars, callback)
+}
+
+func (mh *proxyHandler) execute(c *mysql.Conn, query string, bindVars map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error {
 	// FIXME(alainjobart): Add some kind of timeout to the context.
 	ctx := context.Background()
 
@@ -106,7 +124,7 @@ func (mh *proxyHandler) ComQuery(c *mysql.Conn, query string, callback func(*sql
 	if c.SchemaName != "" {
 		session.TargetString = c.SchemaName
 	}
-	session, result, err := mh.mp.Execute(ctx, session, query, make(map[string]*querypb.BindVariable))
+	session, result, err := mh.mp.Execute(ctx, session, query, bindVars)
 	c.ClientData = session
 	err = mysql.NewSQLErrorFromError(err)
 	if err != nil {
@@ -1158,6 +1158,9 @@ func agentRPCTestPromoteSlavePanic(ctx context.Context, t *testing.T, client tmc
 var testBackupConcurrency = 24
 var testBackupCalled = false
 var testRestoreFromBackupCalled = false
+var testRestoreToPos = "MariaDB/1-345-789"
+var testRestoreToTime = time.Unix(1500000000, 0)
+var testBinlogSource = "binlog-server:3306"
 
 func (fra *fakeRPCAgent) Backup(ctx context.Context, concurrency int, logger logutil.Logger) error {
 	if fra.panics {
@@ -1190,17 +1193,20 @@ func agentRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+func (fra *fakeRPCAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTime time.Time, binlogSource string) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "RestoreFromBackup restoreToPos", restoreToPos, testRestoreToPos)
+	compare(fra.t, "RestoreFromBackup restoreToTime", restoreToTime, testRestoreToTime)
+	compare(fra.t, "RestoreFromBackup binlogSource", binlogSource, testBinlogSource)
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
 }
 
 func agentRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreToPos, testRestoreToTime, testBinlogSource)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1209,7 +1215,7 @@ func agentRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmc
 }
 
 func agentRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreToPos, testRestoreToTime, testBinlogSource)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -457,7 +457,11 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 		})
 	})
 
-	return s.agent.RestoreFromBackup(ctx, logger)
+	var restoreToTime time.Time
+	if request.RestoreToTimestamp != 0 {
+		restoreToTime = time.Unix(request.RestoreToTimestamp, 0)
+	}
+	return s.agent.RestoreFromBackup(ctx, logger, request.RestoreToPos, restoreToTime, request.BinlogSource)
 }
 
 // registration glue
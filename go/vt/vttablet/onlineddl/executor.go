@@ -0,0 +1,749 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package onlineddl runs the online schema migrations of a master tablet.
+//
+// A migration is an ALTER TABLE that was queued in _vt.schema_migrations
+// by 'vtctl ApplySchema -online_ddl'. It is applied to a shadow table,
+// created like the original one. Triggers on the original table keep the
+// shadow table in sync while the existing rows are copied, in chunks of
+// primary key ranges, throttled with a throttler.Throttler on the
+// replication lag of the replicas of the shard. Then the tables are
+// swapped with an atomic RENAME TABLE.
+//
+// The progress of a migration is saved after each chunk, so a restarted
+// or newly promoted master resumes it.
+package onlineddl
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/throttler"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	// migrationsStarted counts the migrations started by this tablet.
+	migrationsStarted = stats.NewInt("OnlineDDLMigrationsStarted")
+	// migrationsEnded counts the migrations that ended, by final status.
+	migrationsEnded = stats.NewCounters("OnlineDDLMigrationsEnded")
+	// rowsCopied counts the rows copied to the shadow tables.
+	rowsCopied = stats.NewInt("OnlineDDLRowsCopied")
+	// throttledChunks counts the chunks delayed by the throttler.
+	throttledChunks = stats.NewInt("OnlineDDLThrottledChunks")
+	// executorErrors counts the errors of the Executor.
+	executorErrors = stats.NewInt("OnlineDDLErrors")
+)
+
+// errCancelled is returned by the steps of a migration when
+// a cancellation was requested.
+var errCancelled = errors.New("migration cancelled")
+
+// Executor runs on master tablets. At a regular interval, it picks the
+// oldest pending migration of _vt.schema_migrations and runs it.
+// Only one migration runs at a time.
+type Executor struct {
+	dbconfigs dbconfigs.DBConfigs
+
+	enabled           bool
+	interval          time.Duration
+	chunkSize         int
+	maxRate           int64
+	maxReplicationLag int64
+	topoServer        *topo.Server
+	healthCheckCells  []string
+	reloadSchema      func(context.Context) error
+	errorLog          *logutil.ThrottledLogger
+
+	mu           sync.Mutex
+	isOpen       bool
+	tableCreated bool
+	ticks        *timer.Timer
+	// keyspace and shard are the ones of the tablet, whose replicas
+	// are monitored for replication lag.
+	keyspace string
+	shard    string
+	// ctx is cancelled when the Executor is closed. The running
+	// migration then stops after its current step.
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewExecutor creates a new Executor. reloadSchema is called after a
+// migration changed a table. The replication lag of the replicas is
+// monitored in the cell of the tablet, unless other cells are configured.
+// Without a topoServer, the replication lag is not monitored.
+func NewExecutor(config tabletenv.TabletConfig, topoServer *topo.Server, cell string, reloadSchema func(context.Context) error) *Executor {
+	if !config.OnlineDDLEnable {
+		return &Executor{}
+	}
+	if config.OnlineDDLChunkSize <= 0 {
+		log.Errorf("Invalid online schema migrations chunk size %v, online schema migrations will be disabled", config.OnlineDDLChunkSize)
+		return &Executor{}
+	}
+	maxRate := config.OnlineDDLMaxRate
+	if maxRate <= 0 {
+		maxRate = throttler.MaxRateModuleDisabled
+	}
+	maxReplicationLag := config.OnlineDDLMaxReplicationLagSec
+	if maxReplicationLag <= 0 || topoServer == nil {
+		maxReplicationLag = throttler.ReplicationLagModuleDisabled
+	}
+	healthCheckCells := config.OnlineDDLHealthCheckCells
+	if len(healthCheckCells) == 0 {
+		healthCheckCells = []string{cell}
+	}
+	return &Executor{
+		enabled:           true,
+		interval:          config.OnlineDDLCheckInterval,
+		chunkSize:         config.OnlineDDLChunkSize,
+		maxRate:           maxRate,
+		maxReplicationLag: maxReplicationLag,
+		topoServer:        topoServer,
+		healthCheckCells:  healthCheckCells,
+		reloadSchema:      reloadSchema,
+		ticks:             timer.NewTimer(config.OnlineDDLCheckInterval),
+		errorLog:          logutil.NewThrottledLogger("OnlineDDL", 60*time.Second),
+	}
+}
+
+// InitDBConfig must be called before Open.
+func (e *Executor) InitDBConfig(dbcfgs dbconfigs.DBConfigs) {
+	e.dbconfigs = dbcfgs
+}
+
+// Open starts looking for migrations to run. Open may be called
+// multiple times, as long as it was closed since last invocation.
+func (e *Executor) Open(keyspace, shard string) {
+	if !e.enabled {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.isOpen {
+		return
+	}
+	log.Info("Starting online schema migrations executor")
+	e.keyspace, e.shard = keyspace, shard
+	e.ctx, e.cancel = context.WithCancel(context.Background())
+	e.ticks.Start(e.onTick)
+	e.isOpen = true
+}
+
+// Close stops the running migration, if any, and stops looking for
+// migrations. The stopped migration is resumed after the next Open.
+func (e *Executor) Close() {
+	if !e.enabled {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.isOpen {
+		return
+	}
+	e.cancel()
+	e.ticks.Stop()
+	log.Info("Stopped online schema migrations executor")
+	e.isOpen = false
+}
+
+func (e *Executor) onTick() {
+	defer tabletenv.LogError()
+	if err := e.runNextMigration(e.ctx); err != nil {
+		e.recordError(err)
+	}
+}
+
+func (e *Executor) recordError(err error) {
+	e.errorLog.Errorf("%v", err)
+	executorErrors.Add(1)
+}
+
+// migration is a row of _vt.schema_migrations.
+type migration struct {
+	id         int64
+	uuid       string
+	table      string
+	sql        string
+	status     string
+	stage      string
+	lastPK     string
+	rowsCopied int64
+}
+
+// shadowTable is the table the ALTER is applied to.
+func (m *migration) shadowTable() string {
+	return fmt.Sprintf("_vt_omg_%d_new", m.id)
+}
+
+// oldTable is the name of the original table after the cut-over.
+func (m *migration) oldTable() string {
+	return fmt.Sprintf("_vt_omg_%d_old", m.id)
+}
+
+// trigger returns the name of the trigger for the event.
+func (m *migration) trigger(event string) string {
+	return fmt.Sprintf("_vt_omg_%d_%s", m.id, event)
+}
+
+// runNextMigration runs the oldest pending migration, if any.
+func (e *Executor) runNextMigration(ctx context.Context) error {
+	conn, err := dbconnpool.NewDBConnection(&e.dbconfigs.Dba, stats.NewTimings(""))
+	if err != nil {
+		return fmt.Errorf("cannot connect to run online schema migrations: %v", err)
+	}
+	defer conn.Close()
+
+	if !e.tableCreated {
+		for _, query := range []string{sqlCreateSidecarDB, sqlCreateMigrationsTable} {
+			if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+				return fmt.Errorf("cannot create the schema_migrations table: %v", err)
+			}
+		}
+		e.tableCreated = true
+	}
+
+	qr, err := conn.ExecuteFetch(sqlSelectNextMigration, 1, false)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 0 {
+		return nil
+	}
+	m, err := newMigration(qr.Rows[0])
+	if err != nil {
+		return err
+	}
+	return e.runMigration(ctx, conn, m)
+}
+
+func newMigration(row []sqltypes.Value) (*migration, error) {
+	id, err := sqltypes.ToInt64(row[0])
+	if err != nil {
+		return nil, err
+	}
+	rowsCopied, err := sqltypes.ToInt64(row[7])
+	if err != nil {
+		return nil, err
+	}
+	return &migration{
+		id:         id,
+		uuid:       row[1].ToString(),
+		table:      row[2].ToString(),
+		sql:        row[3].ToString(),
+		status:     row[4].ToString(),
+		stage:      row[5].ToString(),
+		lastPK:     row[6].ToString(),
+		rowsCopied: rowsCopied,
+	}, nil
+}
+
+// runMigration runs a migration to its end, unless the Executor is
+// closed. It records the final status in _vt.schema_migrations.
+func (e *Executor) runMigration(ctx context.Context, conn *dbconnpool.DBConnection, m *migration) error {
+	if m.status == StatusCancelling {
+		return e.endMigration(conn, m, StatusCancelled, errCancelled)
+	}
+	if m.status == StatusQueued {
+		log.Infof("Starting online schema migration %v: %v", m.uuid, m.sql)
+		migrationsStarted.Add(1)
+		var tableRows int64
+		if qr, err := e.fetch(conn, sqlSelectTableRows, m.table); err == nil && len(qr.Rows) == 1 {
+			tableRows, _ = sqltypes.ToInt64(qr.Rows[0][0])
+		}
+		if err := e.exec(conn, sqlStartMigration, tableRows, m.id); err != nil {
+			return err
+		}
+		m.status = StatusRunning
+	} else {
+		log.Infof("Resuming online schema migration %v at stage %q", m.uuid, m.stage)
+	}
+
+	err := e.migrate(ctx, conn, m)
+	switch {
+	case err == nil:
+		log.Infof("Online schema migration %v is complete", m.uuid)
+		return e.endMigration(conn, m, StatusComplete, nil)
+	case err == errCancelled:
+		log.Infof("Online schema migration %v was cancelled", m.uuid)
+		return e.endMigration(conn, m, StatusCancelled, err)
+	case ctx.Err() != nil:
+		// The Executor was closed. The migration will be resumed.
+		log.Infof("Online schema migration %v was interrupted: %v", m.uuid, err)
+		return nil
+	default:
+		log.Errorf("Online schema migration %v failed: %v", m.uuid, err)
+		return e.endMigration(conn, m, StatusFailed, err)
+	}
+}
+
+// endMigration removes the artifacts of the migration, and records its
+// final status.
+func (e *Executor) endMigration(conn *dbconnpool.DBConnection, m *migration, status string, cause error) error {
+	message := ""
+	if cause != nil {
+		message = cause.Error()
+	}
+	if err := e.cleanup(conn, m); err != nil {
+		message = fmt.Sprintf("%v (cleanup failed: %v)", message, err)
+	}
+	migrationsEnded.Add(status, 1)
+	return e.exec(conn, sqlEndMigration, status, message, m.id)
+}
+
+// cleanup drops the triggers, the shadow table and the old table of a
+// migration. The triggers are gone once the cut-over is done, since
+// they are dropped with the old table.
+func (e *Executor) cleanup(conn *dbconnpool.DBConnection, m *migration) error {
+	queries := []string{
+		"DROP TRIGGER IF EXISTS " + sqlescape.EscapeID(m.trigger("ins")),
+		"DROP TRIGGER IF EXISTS " + sqlescape.EscapeID(m.trigger("upd")),
+		"DROP TRIGGER IF EXISTS " + sqlescape.EscapeID(m.trigger("del")),
+		"DROP TABLE IF EXISTS " + sqlescape.EscapeID(m.shadowTable()),
+		"DROP TABLE IF EXISTS " + sqlescape.EscapeID(m.oldTable()),
+	}
+	for _, query := range queries {
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrate runs the remaining stages of a migration.
+func (e *Executor) migrate(ctx context.Context, conn *dbconnpool.DBConnection, m *migration) error {
+	table, alterOptions, err := parseAlterTable(m.sql)
+	if err != nil {
+		return err
+	}
+	if table != m.table {
+		return fmt.Errorf("migration statement alters table %v, not %v", table, m.table)
+	}
+
+	if m.stage == stagePrepare {
+		if err := e.prepare(conn, m, alterOptions); err != nil {
+			return err
+		}
+		if err := e.setStage(conn, m, stageCopy); err != nil {
+			return err
+		}
+	}
+	if m.stage == stageCopy {
+		if err := e.copyRows(ctx, conn, m); err != nil {
+			return err
+		}
+		if err := e.setStage(conn, m, stageCutover); err != nil {
+			return err
+		}
+	}
+	if m.stage != stageCutover {
+		return fmt.Errorf("unknown migration stage %q", m.stage)
+	}
+	return e.cutover(ctx, conn, m)
+}
+
+func (e *Executor) setStage(conn *dbconnpool.DBConnection, m *migration, stage string) error {
+	if err := e.exec(conn, sqlUpdateStage, stage, m.id); err != nil {
+		return err
+	}
+	m.stage = stage
+	return nil
+}
+
+// prepare creates the shadow table, applies the ALTER to it, and
+// creates the triggers that keep it in sync. Anything left by a
+// previous attempt is dropped first.
+func (e *Executor) prepare(conn *dbconnpool.DBConnection, m *migration, alterOptions string) error {
+	if err := e.cleanup(conn, m); err != nil {
+		return err
+	}
+	shadow := sqlescape.EscapeID(m.shadowTable())
+	queries := []string{
+		fmt.Sprintf("CREATE TABLE %s LIKE %s", shadow, sqlescape.EscapeID(m.table)),
+		fmt.Sprintf("ALTER TABLE %s %s", shadow, alterOptions),
+	}
+	for _, query := range queries {
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			return err
+		}
+	}
+	columns, pkColumns, err := e.sharedColumns(conn, m)
+	if err != nil {
+		return err
+	}
+	for _, query := range triggerQueries(m, columns, pkColumns) {
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sharedColumns returns the columns that exist in both the original
+// and the shadow tables, and the primary key columns of the original
+// table. The primary key must be kept by the migration.
+func (e *Executor) sharedColumns(conn *dbconnpool.DBConnection, m *migration) ([]string, []string, error) {
+	columns, err := e.fetchColumn(conn, sqlSelectColumns, m.table)
+	if err != nil {
+		return nil, nil, err
+	}
+	shadowColumns, err := e.fetchColumn(conn, sqlSelectColumns, m.shadowTable())
+	if err != nil {
+		return nil, nil, err
+	}
+	pkColumns, err := e.fetchColumn(conn, sqlSelectPKColumns, m.table)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(pkColumns) == 0 {
+		return nil, nil, fmt.Errorf("table %v has no primary key", m.table)
+	}
+
+	inShadow := make(map[string]bool, len(shadowColumns))
+	for _, column := range shadowColumns {
+		inShadow[strings.ToLower(column)] = true
+	}
+	var shared []string
+	for _, column := range columns {
+		if inShadow[strings.ToLower(column)] {
+			shared = append(shared, column)
+		}
+	}
+	for _, column := range pkColumns {
+		if !inShadow[strings.ToLower(column)] {
+			return nil, nil, fmt.Errorf("primary key column %v of table %v is dropped by the migration", column, m.table)
+		}
+	}
+	return shared, pkColumns, nil
+}
+
+// triggerQueries returns the statements that create the triggers
+// copying the changes of the original table to the shadow table.
+func triggerQueries(m *migration, columns, pkColumns []string) []string {
+	table := sqlescape.EscapeID(m.table)
+	shadow := sqlescape.EscapeID(m.shadowTable())
+	replace := fmt.Sprintf("REPLACE INTO %s (%s) VALUES (%s)", shadow, columnList(columns, ""), columnList(columns, "NEW."))
+	conditions := make([]string, len(pkColumns))
+	for i, column := range pkColumns {
+		column = sqlescape.EscapeID(column)
+		conditions[i] = fmt.Sprintf("%s.%s <=> OLD.%s", shadow, column, column)
+	}
+	deleteOld := fmt.Sprintf("DELETE IGNORE FROM %s WHERE %s", shadow, strings.Join(conditions, " AND "))
+
+	return []string{
+		fmt.Sprintf("CREATE TRIGGER %s AFTER INSERT ON %s FOR EACH ROW %s", sqlescape.EscapeID(m.trigger("ins")), table, replace),
+		fmt.Sprintf("CREATE TRIGGER %s AFTER UPDATE ON %s FOR EACH ROW BEGIN %s; %s; END", sqlescape.EscapeID(m.trigger("upd")), table, deleteOld, replace),
+		fmt.Sprintf("CREATE TRIGGER %s AFTER DELETE ON %s FOR EACH ROW %s", sqlescape.EscapeID(m.trigger("del")), table, deleteOld),
+	}
+}
+
+// columnList returns the escaped columns, separated by commas.
+// Each column is prefixed with prefix.
+func columnList(columns []string, prefix string) string {
+	escaped := make([]string, len(columns))
+	for i, column := range columns {
+		escaped[i] = prefix + sqlescape.EscapeID(column)
+	}
+	return strings.Join(escaped, ", ")
+}
+
+// copyRows copies the rows of the original table to the shadow table,
+// in chunks of primary key ranges, starting after the last copied
+// primary key. The progress is saved after each chunk.
+func (e *Executor) copyRows(ctx context.Context, conn *dbconnpool.DBConnection, m *migration) error {
+	columns, pkColumns, err := e.sharedColumns(conn, m)
+	if err != nil {
+		return err
+	}
+	t, err := throttler.NewThrottler(fmt.Sprintf("OnlineDDL-%s-%d", e.dbconfigs.App.DbName, m.id), "chunks", 1, e.maxRate, e.maxReplicationLag)
+	if err != nil {
+		return err
+	}
+	defer t.Close()
+	defer t.ThreadFinished(0)
+	if e.maxReplicationLag != throttler.ReplicationLagModuleDisabled {
+		stop := e.watchReplicationLag(t)
+		defer stop()
+	}
+
+	for {
+		if err := e.checkCancelled(ctx, conn, m); err != nil {
+			return err
+		}
+		for {
+			backoff := t.Throttle(0)
+			if backoff == throttler.NotThrottled {
+				break
+			}
+			throttledChunks.Add(1)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		qr, err := conn.ExecuteFetch(chunkEndQuery(m, pkColumns, e.chunkSize), 1, false)
+		if err != nil {
+			return err
+		}
+		last := len(qr.Rows) == 0
+		end := ""
+		if !last {
+			end = encodeTuple(qr.Rows[0])
+		}
+		qr, err = conn.ExecuteFetch(copyChunkQuery(m, columns, pkColumns, end), 0, false)
+		if err != nil {
+			return err
+		}
+		if !last {
+			m.lastPK = end
+		}
+		m.rowsCopied += int64(qr.RowsAffected)
+		rowsCopied.Add(int64(qr.RowsAffected))
+		if err := e.exec(conn, sqlUpdateProgress, m.lastPK, m.rowsCopied, m.id); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// watchReplicationLag feeds t with the replication lag of the replicas
+// of the shard, found in the health check cells. The returned function
+// stops the health checks.
+func (e *Executor) watchReplicationLag(t *throttler.Throttler) (stop func()) {
+	e.mu.Lock()
+	keyspace, shard := e.keyspace, e.shard
+	e.mu.Unlock()
+
+	hc := discovery.NewDefaultHealthCheck()
+	hc.SetListener(&lagRecorder{throttler: t}, false /* sendDownEvents */)
+	watchers := make([]*discovery.TopologyWatcher, 0, len(e.healthCheckCells))
+	for _, cell := range e.healthCheckCells {
+		watchers = append(watchers, discovery.NewShardReplicationWatcher(e.topoServer, hc, cell, keyspace, shard, discovery.DefaultTopologyWatcherRefreshInterval, discovery.DefaultTopoReadConcurrency))
+	}
+	return func() {
+		for _, w := range watchers {
+			w.Stop()
+		}
+		hc.Close()
+	}
+}
+
+// lagRecorder records the replication lag of the replicas in a throttler.
+type lagRecorder struct {
+	throttler *throttler.Throttler
+}
+
+// StatsUpdate implements discovery.HealthCheckStatsListener.
+func (r *lagRecorder) StatsUpdate(ts *discovery.TabletStats) {
+	if ts.Target.TabletType == topodatapb.TabletType_MASTER {
+		return
+	}
+	r.throttler.RecordReplicationLag(time.Now(), ts)
+}
+
+// checkCancelled returns an error if the Executor was closed, or if
+// the migration was cancelled.
+func (e *Executor) checkCancelled(ctx context.Context, conn *dbconnpool.DBConnection, m *migration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	qr, err := e.fetch(conn, sqlSelectStatus, m.id)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 1 && qr.Rows[0][0].ToString() == StatusCancelling {
+		return errCancelled
+	}
+	return nil
+}
+
+// chunkEndQuery returns the query that finds the last primary key of
+// the next chunk. It returns no row if the next chunk is the last one.
+func chunkEndQuery(m *migration, pkColumns []string, chunkSize int) string {
+	pkList := columnList(pkColumns, "")
+	return fmt.Sprintf("SELECT %s FROM %s%s ORDER BY %s LIMIT 1 OFFSET %d", pkList, sqlescape.EscapeID(m.table), afterLastPK(m, pkList), pkList, chunkSize-1)
+}
+
+// copyChunkQuery returns the query that copies the rows after the last
+// copied primary key, and up to end, if set. INSERT IGNORE keeps the
+// rows that were already written to the shadow table by the triggers,
+// which are more recent.
+func copyChunkQuery(m *migration, columns, pkColumns []string, end string) string {
+	pkList := columnList(pkColumns, "")
+	where := afterLastPK(m, pkList)
+	if end != "" {
+		if where == "" {
+			where = " WHERE "
+		} else {
+			where += " AND "
+		}
+		where += fmt.Sprintf("(%s) <= %s", pkList, end)
+	}
+	list := columnList(columns, "")
+	return fmt.Sprintf("INSERT IGNORE INTO %s (%s) SELECT %s FROM %s FORCE INDEX (PRIMARY)%s LOCK IN SHARE MODE", sqlescape.EscapeID(m.shadowTable()), list, list, sqlescape.EscapeID(m.table), where)
+}
+
+func afterLastPK(m *migration, pkList string) string {
+	if m.lastPK == "" {
+		return ""
+	}
+	return fmt.Sprintf(" WHERE (%s) > %s", pkList, m.lastPK)
+}
+
+// encodeTuple returns the SQL tuple of the values, like (1, 'a').
+func encodeTuple(row []sqltypes.Value) string {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('(')
+	for i, v := range row {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		v.EncodeSQL(buf)
+	}
+	buf.WriteByte(')')
+	return buf.String()
+}
+
+// cutover swaps the original and the shadow tables, and drops the
+// original table. The RENAME is atomic, so if the shadow table doesn't
+// exist anymore, it was already done.
+func (e *Executor) cutover(ctx context.Context, conn *dbconnpool.DBConnection, m *migration) error {
+	if err := e.checkCancelled(ctx, conn, m); err != nil {
+		return err
+	}
+	qr, err := e.fetch(conn, sqlSelectTableCount, m.shadowTable())
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 1 && qr.Rows[0][0].ToString() != "0" {
+		table := sqlescape.EscapeID(m.table)
+		rename := fmt.Sprintf("RENAME TABLE %s TO %s, %s TO %s", table, sqlescape.EscapeID(m.oldTable()), sqlescape.EscapeID(m.shadowTable()), table)
+		if _, err := conn.ExecuteFetch(rename, 0, false); err != nil {
+			return err
+		}
+	}
+	if err := e.cleanup(conn, m); err != nil {
+		return err
+	}
+	if e.reloadSchema != nil {
+		if err := e.reloadSchema(ctx); err != nil {
+			log.Warningf("Cannot reload the schema after online schema migration %v: %v", m.uuid, err)
+		}
+	}
+	return nil
+}
+
+// parseAlterTable returns the table of an ALTER TABLE statement, and the
+// text that follows the table name.
+func parseAlterTable(sql string) (table, alterOptions string, err error) {
+	tokenizer := sqlparser.NewStringTokenizer(sql)
+	scan := func() (int, []byte) {
+		for {
+			typ, val := tokenizer.Scan()
+			if typ != sqlparser.COMMENT {
+				return typ, val
+			}
+		}
+	}
+	if typ, _ := scan(); typ != sqlparser.ALTER {
+		return "", "", fmt.Errorf("not an ALTER TABLE statement: %v", sql)
+	}
+	typ, _ := scan()
+	if typ == sqlparser.IGNORE {
+		typ, _ = scan()
+	}
+	if typ != sqlparser.TABLE {
+		return "", "", fmt.Errorf("not an ALTER TABLE statement: %v", sql)
+	}
+	typ, val := scan()
+	if typ != sqlparser.ID {
+		return "", "", fmt.Errorf("cannot find the table name of: %v", sql)
+	}
+	// The tokenizer has read one character past the table name.
+	end := tokenizer.Position - 1
+	if typ, _ := scan(); typ == '.' {
+		return "", "", fmt.Errorf("the table of an online schema migration cannot be qualified with a database: %v", sql)
+	}
+	table = string(val)
+
+	alterOptions = strings.TrimSpace(sql[end:])
+	if alterOptions == "" {
+		return "", "", fmt.Errorf("nothing to alter in: %v", sql)
+	}
+	tokenizer = sqlparser.NewStringTokenizer(alterOptions)
+	for {
+		typ, _ := scan()
+		if typ == 0 {
+			break
+		}
+		switch typ {
+		case sqlparser.RENAME:
+			return "", "", fmt.Errorf("online schema migrations cannot rename tables: %v", sql)
+		case sqlparser.LEX_ERROR:
+			return "", "", fmt.Errorf("cannot parse: %v", sql)
+		}
+	}
+	return table, alterOptions, nil
+}
+
+func (e *Executor) fetch(conn *dbconnpool.DBConnection, query string, args ...interface{}) (*sqltypes.Result, error) {
+	bound, err := generateQuery(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return conn.ExecuteFetch(bound, 10000, false)
+}
+
+func (e *Executor) fetchColumn(conn *dbconnpool.DBConnection, query string, args ...interface{}) ([]string, error) {
+	qr, err := e.fetch(conn, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(qr.Rows))
+	for i, row := range qr.Rows {
+		values[i] = row[0].ToString()
+	}
+	return values, nil
+}
+
+func (e *Executor) exec(conn *dbconnpool.DBConnection, query string, args ...interface{}) error {
+	_, err := e.fetch(conn, query, args...)
+	return err
+}
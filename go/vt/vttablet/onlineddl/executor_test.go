@@ -0,0 +1,260 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/throttler"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func TestParseAlterTable(t *testing.T) {
+	testcases := []struct {
+		sql     string
+		table   string
+		options string
+		err     string
+	}{{
+		sql:     "alter table t add column c int",
+		table:   "t",
+		options: "add column c int",
+	}, {
+		sql:     "/* comment */ ALTER IGNORE TABLE `my table` ADD INDEX (c), DROP COLUMN d",
+		table:   "my table",
+		options: "ADD INDEX (c), DROP COLUMN d",
+	}, {
+		sql: "alter table db.t add column c int",
+		err: "cannot be qualified with a database",
+	}, {
+		sql: "alter table t rename to u",
+		err: "cannot rename tables",
+	}, {
+		sql: "alter table t",
+		err: "nothing to alter",
+	}, {
+		sql: "create table t (id int)",
+		err: "not an ALTER TABLE statement",
+	}}
+	for _, tcase := range testcases {
+		table, options, err := parseAlterTable(tcase.sql)
+		if tcase.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.err) {
+				t.Errorf("parseAlterTable(%v): %v, want %v", tcase.sql, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseAlterTable(%v): %v", tcase.sql, err)
+			continue
+		}
+		if table != tcase.table || options != tcase.options {
+			t.Errorf("parseAlterTable(%v): %q, %q, want %q, %q", tcase.sql, table, options, tcase.table, tcase.options)
+		}
+	}
+}
+
+func TestTriggerQueries(t *testing.T) {
+	m := &migration{id: 3, table: "t"}
+	got := triggerQueries(m, []string{"a", "b", "c"}, []string{"a", "b"})
+	want := []string{
+		"CREATE TRIGGER `_vt_omg_3_ins` AFTER INSERT ON `t` FOR EACH ROW REPLACE INTO `_vt_omg_3_new` (`a`, `b`, `c`) VALUES (NEW.`a`, NEW.`b`, NEW.`c`)",
+		"CREATE TRIGGER `_vt_omg_3_upd` AFTER UPDATE ON `t` FOR EACH ROW BEGIN " +
+			"DELETE IGNORE FROM `_vt_omg_3_new` WHERE `_vt_omg_3_new`.`a` <=> OLD.`a` AND `_vt_omg_3_new`.`b` <=> OLD.`b`; " +
+			"REPLACE INTO `_vt_omg_3_new` (`a`, `b`, `c`) VALUES (NEW.`a`, NEW.`b`, NEW.`c`); END",
+		"CREATE TRIGGER `_vt_omg_3_del` AFTER DELETE ON `t` FOR EACH ROW DELETE IGNORE FROM `_vt_omg_3_new` WHERE `_vt_omg_3_new`.`a` <=> OLD.`a` AND `_vt_omg_3_new`.`b` <=> OLD.`b`",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("triggerQueries:\n%v\nwant:\n%v", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestQueries(t *testing.T) {
+	got, err := SubmitQueries("abc", "t", "alter table t add column c int")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "INSERT INTO _vt.schema_migrations (migration_uuid, mysql_table, migration_statement, status) VALUES ('abc', 't', 'alter table t add column c int', 'queued')"
+	if len(got) != 3 || got[2] != want {
+		t.Errorf("SubmitQueries: %v, want the creation of the table and %v", got, want)
+	}
+
+	query, err := ShowQuery("")
+	if err != nil || !strings.HasSuffix(query, "FROM _vt.schema_migrations ORDER BY id") {
+		t.Errorf("ShowQuery(): %v %v", query, err)
+	}
+	query, err = ShowQuery("abc")
+	if err != nil || !strings.HasSuffix(query, "FROM _vt.schema_migrations WHERE migration_uuid = 'abc'") {
+		t.Errorf("ShowQuery(abc): %v %v", query, err)
+	}
+	query, err = CancelQuery("a'b")
+	if want := "UPDATE _vt.schema_migrations SET status = 'cancelling' WHERE migration_uuid = 'a\\'b' AND status IN ('queued', 'running')"; err != nil || query != want {
+		t.Errorf("CancelQuery: %v %v, want %v", query, err, want)
+	}
+}
+
+func TestNewExecutor(t *testing.T) {
+	config := tabletenv.DefaultQsConfig
+	config.OnlineDDLEnable = true
+
+	// The replication lag is monitored in the cell of the tablet.
+	e := NewExecutor(config, memorytopo.NewServer("cell1"), "cell1", nil)
+	if !e.enabled || e.maxReplicationLag != 10 || !reflect.DeepEqual(e.healthCheckCells, []string{"cell1"}) {
+		t.Errorf("NewExecutor: enabled %v, maxReplicationLag %v, healthCheckCells %v, want true, 10, [cell1]", e.enabled, e.maxReplicationLag, e.healthCheckCells)
+	}
+
+	// It is not without a topo server.
+	e = NewExecutor(config, nil, "cell1", nil)
+	if e.maxReplicationLag != throttler.ReplicationLagModuleDisabled {
+		t.Errorf("NewExecutor without topo server: maxReplicationLag %v, want disabled", e.maxReplicationLag)
+	}
+
+	// An invalid chunk size disables the migrations.
+	config.OnlineDDLChunkSize = 0
+	if e := NewExecutor(config, nil, "cell1", nil); e.enabled {
+		t.Errorf("NewExecutor with a chunk size of 0 is enabled")
+	}
+}
+
+func newTestExecutor(db *fakesqldb.DB) (*Executor, *int) {
+	config := tabletenv.DefaultQsConfig
+	config.OnlineDDLEnable = true
+	config.OnlineDDLCheckInterval = time.Hour
+	config.OnlineDDLChunkSize = 2
+	reloads := 0
+	e := NewExecutor(config, nil /* topoServer */, "cell1", func(context.Context) error {
+		reloads++
+		return nil
+	})
+	e.InitDBConfig(dbconfigs.DBConfigs{
+		App: *db.ConnParams(),
+		Dba: *db.ConnParams(),
+	})
+	return e, &reloads
+}
+
+func addCleanupQueries(db *fakesqldb.DB) {
+	for _, query := range []string{
+		"DROP TRIGGER IF EXISTS `_vt_omg_1_ins`",
+		"DROP TRIGGER IF EXISTS `_vt_omg_1_upd`",
+		"DROP TRIGGER IF EXISTS `_vt_omg_1_del`",
+		"DROP TABLE IF EXISTS `_vt_omg_1_new`",
+		"DROP TABLE IF EXISTS `_vt_omg_1_old`",
+	} {
+		db.AddQuery(query, &sqltypes.Result{})
+	}
+}
+
+func migrationResult(status, stage, lastPK string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|migration_uuid|mysql_table|migration_statement|status|stage|last_pk|rows_copied",
+			"int64|varbinary|varbinary|text|varbinary|varbinary|blob|int64"),
+		"1|abc|t|alter table t add column c int|"+status+"|"+stage+"|"+lastPK+"|0")
+}
+
+func TestRunMigration(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e, reloads := newTestExecutor(db)
+
+	columns := sqltypes.MakeTestFields("column_name", "varchar")
+	db.AddQuery(sqlCreateSidecarDB, &sqltypes.Result{})
+	db.AddQuery(sqlCreateMigrationsTable, &sqltypes.Result{})
+	db.AddQuery(sqlSelectNextMigration, migrationResult(StatusQueued, "", ""))
+	db.AddQuery("SELECT table_rows FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = 't'", sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_rows", "int64"), "3"))
+	db.AddQuery("UPDATE _vt.schema_migrations SET status = 'running', started_timestamp = NOW(), table_rows = 3 WHERE id = 1", &sqltypes.Result{})
+	addCleanupQueries(db)
+
+	// Preparation.
+	db.AddQuery("CREATE TABLE `_vt_omg_1_new` LIKE `t`", &sqltypes.Result{})
+	db.AddQuery("ALTER TABLE `_vt_omg_1_new` add column c int", &sqltypes.Result{})
+	db.AddQuery("SELECT column_name FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 't' ORDER BY ordinal_position", sqltypes.MakeTestResult(columns, "id", "name"))
+	db.AddQuery("SELECT column_name FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = '_vt_omg_1_new' ORDER BY ordinal_position", sqltypes.MakeTestResult(columns, "id", "name", "c"))
+	db.AddQuery("SELECT column_name FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = 't' AND index_name = 'PRIMARY' ORDER BY seq_in_index", sqltypes.MakeTestResult(columns, "id"))
+	for _, query := range triggerQueries(&migration{id: 1, table: "t"}, []string{"id", "name"}, []string{"id"}) {
+		db.AddQuery(query, &sqltypes.Result{})
+	}
+	db.AddQuery("UPDATE _vt.schema_migrations SET stage = 'copy' WHERE id = 1", &sqltypes.Result{})
+
+	// Copy, in two chunks.
+	db.AddQuery("SELECT status FROM _vt.schema_migrations WHERE id = 1", sqltypes.MakeTestResult(sqltypes.MakeTestFields("status", "varbinary"), StatusRunning))
+	pk := sqltypes.MakeTestFields("id", "int64")
+	db.AddQuery("SELECT `id` FROM `t` ORDER BY `id` LIMIT 1 OFFSET 1", sqltypes.MakeTestResult(pk, "2"))
+	db.AddQuery("INSERT IGNORE INTO `_vt_omg_1_new` (`id`, `name`) SELECT `id`, `name` FROM `t` FORCE INDEX (PRIMARY) WHERE (`id`) <= (2) LOCK IN SHARE MODE", &sqltypes.Result{RowsAffected: 2})
+	db.AddQuery("UPDATE _vt.schema_migrations SET last_pk = '(2)', rows_copied = 2 WHERE id = 1", &sqltypes.Result{})
+	db.AddQuery("SELECT `id` FROM `t` WHERE (`id`) > (2) ORDER BY `id` LIMIT 1 OFFSET 1", sqltypes.MakeTestResult(pk))
+	db.AddQuery("INSERT IGNORE INTO `_vt_omg_1_new` (`id`, `name`) SELECT `id`, `name` FROM `t` FORCE INDEX (PRIMARY) WHERE (`id`) > (2) LOCK IN SHARE MODE", &sqltypes.Result{RowsAffected: 1})
+	db.AddQuery("UPDATE _vt.schema_migrations SET last_pk = '(2)', rows_copied = 3 WHERE id = 1", &sqltypes.Result{})
+	db.AddQuery("UPDATE _vt.schema_migrations SET stage = 'cutover' WHERE id = 1", &sqltypes.Result{})
+
+	// Cut-over.
+	db.AddQuery("SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = '_vt_omg_1_new'", sqltypes.MakeTestResult(sqltypes.MakeTestFields("count", "int64"), "1"))
+	rename := "RENAME TABLE `t` TO `_vt_omg_1_old`, `_vt_omg_1_new` TO `t`"
+	db.AddQuery(rename, &sqltypes.Result{})
+	end := "UPDATE _vt.schema_migrations SET status = 'complete', message = '', completed_timestamp = NOW() WHERE id = 1"
+	db.AddQuery(end, &sqltypes.Result{})
+
+	if err := e.runNextMigration(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.GetQueryCalledNum(rename); got != 1 {
+		t.Errorf("rename was called %v times, want 1", got)
+	}
+	if got := db.GetQueryCalledNum(end); got != 1 {
+		t.Errorf("the migration was completed %v times, want 1", got)
+	}
+	if *reloads != 1 {
+		t.Errorf("the schema was reloaded %v times, want 1", *reloads)
+	}
+}
+
+func TestRunMigrationCancelled(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e, _ := newTestExecutor(db)
+
+	db.AddQuery(sqlCreateSidecarDB, &sqltypes.Result{})
+	db.AddQuery(sqlCreateMigrationsTable, &sqltypes.Result{})
+	db.AddQuery(sqlSelectNextMigration, migrationResult(StatusRunning, stageCopy, "(2)"))
+	db.AddQuery("SELECT column_name FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 't' ORDER BY ordinal_position", sqltypes.MakeTestResult(sqltypes.MakeTestFields("column_name", "varchar"), "id"))
+	db.AddQuery("SELECT column_name FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = '_vt_omg_1_new' ORDER BY ordinal_position", sqltypes.MakeTestResult(sqltypes.MakeTestFields("column_name", "varchar"), "id"))
+	db.AddQuery("SELECT column_name FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = 't' AND index_name = 'PRIMARY' ORDER BY seq_in_index", sqltypes.MakeTestResult(sqltypes.MakeTestFields("column_name", "varchar"), "id"))
+	db.AddQuery("SELECT status FROM _vt.schema_migrations WHERE id = 1", sqltypes.MakeTestResult(sqltypes.MakeTestFields("status", "varbinary"), StatusCancelling))
+	addCleanupQueries(db)
+	end := "UPDATE _vt.schema_migrations SET status = 'cancelled', message = 'migration cancelled', completed_timestamp = NOW() WHERE id = 1"
+	db.AddQuery(end, &sqltypes.Result{})
+
+	if err := e.runNextMigration(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.GetQueryCalledNum(end); got != 1 {
+		t.Errorf("the migration was cancelled %v times, want 1", got)
+	}
+	if got := db.GetQueryCalledNum("DROP TABLE IF EXISTS `_vt_omg_1_new`"); got != 1 {
+		t.Errorf("the shadow table was dropped %v times, want 1", got)
+	}
+}
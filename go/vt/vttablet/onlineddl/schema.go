@@ -0,0 +1,131 @@
+/*
+Copyright 2018 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreedto in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The status of a migration, as stored in _vt.schema_migrations.
+const (
+	StatusQueued     = "queued"
+	StatusRunning    = "running"
+	StatusCancelling = "cancelling"
+	StatusCancelled  = "cancelled"
+	StatusFailed     = "failed"
+	StatusComplete   = "complete"
+)
+
+// The stages of a running migration. The stage is saved after each
+// step, so a migration can resume where it stopped.
+const (
+	stagePrepare = ""
+	stageCopy    = "copy"
+	stageCutover = "cutover"
+)
+
+// The _vt.schema_migrations table is created and written with the
+// binlogs enabled, so the migrations replicate. If a replica is
+// promoted, it resumes the migrations of the previous master.
+const (
+	sqlCreateSidecarDB       = "CREATE DATABASE IF NOT EXISTS _vt"
+	sqlCreateMigrationsTable = `CREATE TABLE IF NOT EXISTS _vt.schema_migrations (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  migration_uuid VARBINARY(64) NOT NULL,
+  mysql_table VARBINARY(128) NOT NULL,
+  migration_statement TEXT NOT NULL,
+  status VARBINARY(16) NOT NULL,
+  stage VARBINARY(16) NOT NULL DEFAULT '',
+  last_pk BLOB,
+  rows_copied BIGINT UNSIGNED NOT NULL DEFAULT 0,
+  table_rows BIGINT UNSIGNED NOT NULL DEFAULT 0,
+  message TEXT,
+  added_timestamp TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  started_timestamp TIMESTAMP NULL DEFAULT NULL,
+  completed_timestamp TIMESTAMP NULL DEFAULT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY (migration_uuid)
+) ENGINE=InnoDB`
+	sqlInsertMigration = "INSERT INTO _vt.schema_migrations (migration_uuid, mysql_table, migration_statement, status) VALUES (%a, %a, %a, %a)"
+
+	sqlSelectNextMigration = "SELECT id, migration_uuid, mysql_table, migration_statement, status, stage, last_pk, rows_copied FROM _vt.schema_migrations WHERE status IN ('queued', 'running', 'cancelling') ORDER BY id LIMIT 1"
+	sqlSelectStatus        = "SELECT status FROM _vt.schema_migrations WHERE id = %a"
+	sqlStartMigration      = "UPDATE _vt.schema_migrations SET status = 'running', started_timestamp = NOW(), table_rows = %a WHERE id = %a"
+	sqlUpdateStage         = "UPDATE _vt.schema_migrations SET stage = %a WHERE id = %a"
+	sqlUpdateProgress      = "UPDATE _vt.schema_migrations SET last_pk = %a, rows_copied = %a WHERE id = %a"
+	sqlEndMigration        = "UPDATE _vt.schema_migrations SET status = %a, message = %a, completed_timestamp = NOW() WHERE id = %a"
+
+	sqlShowMigrations  = "SELECT migration_uuid, mysql_table, migration_statement, status, stage, rows_copied, table_rows, message, added_timestamp, started_timestamp, completed_timestamp FROM _vt.schema_migrations"
+	sqlCancelMigration = "UPDATE _vt.schema_migrations SET status = 'cancelling' WHERE migration_uuid = %a AND status IN ('queued', 'running')"
+
+	sqlSelectTableRows  = "SELECT table_rows FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = %a"
+	sqlSelectColumns    = "SELECT column_name FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = %a ORDER BY ordinal_position"
+	sqlSelectPKColumns  = "SELECT column_name FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = %a AND index_name = 'PRIMARY' ORDER BY seq_in_index"
+	sqlSelectTableCount = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = %a"
+)
+
+// SubmitQueries returns the queries that queue a migration on a master.
+// The migration is identified by the uuid, which is the same for all
+// the shards of a keyspace.
+func SubmitQueries(uuid, table, sql string) ([]string, error) {
+	insert, err := generateQuery(sqlInsertMigration, uuid, table, sql, StatusQueued)
+	if err != nil {
+		return nil, err
+	}
+	return []string{sqlCreateSidecarDB, sqlCreateMigrationsTable, insert}, nil
+}
+
+// ShowQuery returns the query that lists the migrations of a master.
+// If uuid is empty, all the migrations are returned.
+func ShowQuery(uuid string) (string, error) {
+	if uuid == "" {
+		return sqlShowMigrations + " ORDER BY id", nil
+	}
+	return generateQuery(sqlShowMigrations+" WHERE migration_uuid = %a", uuid)
+}
+
+// CancelQuery returns the query that requests the cancellation of a
+// migration. The master cancels it before its next step.
+func CancelQuery(uuid string) (string, error) {
+	return generateQuery(sqlCancelMigration, uuid)
+}
+
+// generateQuery replaces the %a placeholders of the query with
+// the properly encoded args.
+func generateQuery(query string, args ...interface{}) (string, error) {
+	names := make([]interface{}, len(args))
+	bindVars := make(map[string]*querypb.BindVariable, len(args))
+	for i, arg := range args {
+		name := fmt.Sprintf("a%d", i)
+		bv, err := sqltypes.BuildBindVariable(arg)
+		if err != nil {
+			return "", err
+		}
+		names[i] = ":" + name
+		bindVars[name] = bv
+	}
+	bound, err := sqlparser.BuildParsedQuery(query, names...).GenerateQuery(bindVars, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(bound), nil
+}
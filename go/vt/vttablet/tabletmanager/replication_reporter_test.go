@@ -21,8 +21,18 @@ import (
 	"testing"
 	"time"
 
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/health"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 func TestBasicMySQLReplicationLag(t *testing.T) {
@@ -110,3 +120,88 @@ func TestNoExtrapolatedMySQLReplicationLag(t *testing.T) {
 		t.Fatalf("wrong Report error: %v", err)
 	}
 }
+
+func TestNoRepairAfterRecovery(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	masterAlias := &topodatapb.TabletAlias{Cell: "cell1", Uid: 1}
+	master := &topodatapb.Tablet{
+		Alias:         masterAlias,
+		Keyspace:      "ks",
+		Shard:         "0",
+		Type:          topodatapb.TabletType_MASTER,
+		MysqlHostname: "master",
+	}
+	topoproto.SetMysqlPort(master, 3306)
+	if err := ts.CreateTablet(ctx, master); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatalf("CreateShard failed: %v", err)
+	}
+	if _, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = masterAlias
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+
+	pos, err := mysql.DecodePosition("MariaDB/0-1-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	targetPos, err := mysql.DecodePosition("MariaDB/0-1-20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+	mysqld.SetSlavePositionPos = pos
+	mysqld.SetMasterInput = "master:3306"
+	mysqld.StartSlaveUntilAfterPos = targetPos
+	mysqld.WaitMasterPosition = targetPos
+	mysqld.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+		"RESET SLAVE ALL",
+		"FAKE SET SLAVE POSITION",
+		"FAKE SET MASTER",
+		"FAKE START SLAVE UNTIL AFTER",
+		"STOP SLAVE",
+		"RESET SLAVE ALL",
+		// These would be executed if replication was repaired.
+		"FAKE SET MASTER",
+		"START SLAVE",
+	}
+	agent := &ActionAgent{
+		TopoServer:  ts,
+		TabletAlias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 2},
+		MysqlDaemon: mysqld,
+		batchCtx:    ctx,
+		_tablet: &topodatapb.Tablet{
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     topodatapb.TabletType_REPLICA,
+		},
+	}
+	if err := agent.replayBinlogs(ctx, logutil.NewConsoleLogger(), pos, mysqlctl.RecoveryTarget{Position: targetPos}, ""); err != nil {
+		t.Fatalf("replayBinlogs failed: %v", err)
+	}
+	if mysqld.ExpectedExecuteSuperQueryCurrent != 7 {
+		t.Fatalf("replayBinlogs executed %v queries, want 7", mysqld.ExpectedExecuteSuperQueryCurrent)
+	}
+
+	// The data is from the past: the replication reporter
+	// must not reconnect the tablet to the master.
+	rep := &replicationReporter{
+		agent: agent,
+		now:   time.Now,
+	}
+	if _, err := rep.Report(true, true); err != health.ErrSlaveNotRunning {
+		t.Errorf("wrong Report error: %v", err)
+	}
+	if mysqld.ExpectedExecuteSuperQueryCurrent != 7 {
+		t.Errorf("Report repaired replication after a recovery")
+	}
+}
@@ -24,6 +24,8 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/netutil"
+	"vitess.io/vitess/go/vt/binlog"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -48,10 +50,14 @@ func (agent *ActionAgent) RestoreData(ctx context.Context, logger logutil.Logger
 		return err
 	}
 	defer agent.unlock()
-	return agent.restoreDataLocked(ctx, logger, deleteBeforeRestore)
+	return agent.restoreDataLocked(ctx, logger, deleteBeforeRestore, mysqlctl.RecoveryTarget{}, "")
 }
 
-func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.Logger, deleteBeforeRestore bool) error {
+// restoreDataLocked restores the most recent backup, and starts
+// replication. If target is set, it restores the most recent backup
+// before target instead, and replays the binlogs of binlogSource
+// up to target. The tablet is then left DRAINED, without replication.
+func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.Logger, deleteBeforeRestore bool, target mysqlctl.RecoveryTarget, binlogSource string) error {
 	// change type to RESTORE (using UpdateTabletFields so it's
 	// always authorized)
 	var originalType topodatapb.TabletType
@@ -75,16 +81,27 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 	localMetadata := agent.getLocalMetadataValues(originalType)
 	tablet := agent.Tablet()
 	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
-	pos, err := mysqlctl.Restore(ctx, agent.MysqlDaemon, dir, *restoreConcurrency, agent.hookExtraEnv(), localMetadata, logger, deleteBeforeRestore, topoproto.TabletDbName(tablet))
+	pos, err := mysqlctl.Restore(ctx, agent.MysqlDaemon, dir, *restoreConcurrency, agent.hookExtraEnv(), localMetadata, logger, deleteBeforeRestore, topoproto.TabletDbName(tablet), target)
 	switch err {
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
 
-		// Reconnect to master.
-		if err := agent.startReplication(context.Background(), pos, originalType); err != nil {
+		if target.IsZero() {
+			// Reconnect to master.
+			if err := agent.startReplication(context.Background(), pos, originalType); err != nil {
+				return err
+			}
+			break
+		}
+
+		// Replay the binlogs up to the target. The data is now
+		// from the past: the tablet must not serve it as a
+		// regular replica.
+		if err := agent.replayBinlogs(context.Background(), logger, pos, target, binlogSource); err != nil {
 			return err
 		}
+		originalType = topodatapb.TabletType_DRAINED
 	case mysqlctl.ErrNoBackup:
 		// No-op, starting with empty database.
 	case mysqlctl.ErrExistingDB:
@@ -174,6 +191,95 @@ func (agent *ActionAgent) startReplication(ctx context.Context, pos mysql.Positi
 	return nil
 }
 
+// replayBinlogs replays the binlogs of a mysqld on top of a backup
+// restored at pos, up to target. source is the host:port of the mysqld,
+// or empty to use the shard master. Replication is reset after that,
+// and marked as stopped, so it can't be restarted past target.
+func (agent *ActionAgent) replayBinlogs(ctx context.Context, logger logutil.Logger, pos mysql.Position, target mysqlctl.RecoveryTarget, source string) error {
+	host, port, err := agent.binlogSource(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	// MySQL can only stop replication at a position,
+	// so we look for the position of the target time.
+	targetPos := target.Position
+	if targetPos.IsZero() {
+		logger.Infof("Restore: looking for the %v in the binlogs of %v:%v", target, host, port)
+		cp := agent.DBConfigs.Repl
+		cp.Host = host
+		cp.Port = port
+		cp.UnixSocket = ""
+		targetPos, err = binlog.PositionAtTimestamp(ctx, &cp, pos, target.Time.Unix())
+		if err != nil {
+			return fmt.Errorf("can't find the %v in the binlogs: %v", target, err)
+		}
+	}
+	if pos.AtLeast(targetPos) {
+		logger.Infof("Restore: backup is already at %v, no binlogs to replay", targetPos)
+		return nil
+	}
+
+	logger.Infof("Restore: replaying the binlogs of %v:%v from %v to %v", host, port, pos, targetPos)
+
+	// Replication is stopped on purpose from now on: the replication
+	// reporter must not reconnect the tablet to the master, neither
+	// while the binlogs are replayed, nor after that.
+	agent.setSlaveStopped(true)
+	cmds := []string{
+		"STOP SLAVE",
+		"RESET SLAVE ALL",
+	}
+	if err := agent.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds); err != nil {
+		return fmt.Errorf("failed to reset slave: %v", err)
+	}
+	if err := agent.MysqlDaemon.SetSlavePosition(ctx, pos); err != nil {
+		return fmt.Errorf("failed to set slave position: %v", err)
+	}
+	if err := agent.MysqlDaemon.SetMaster(ctx, host, port, false /* slaveStopBefore */, false /* slaveStartAfter */); err != nil {
+		return fmt.Errorf("MysqlDaemon.SetMaster failed: %v", err)
+	}
+	if err := agent.MysqlDaemon.StartSlaveUntilAfter(ctx, targetPos); err != nil {
+		return fmt.Errorf("failed to start slave until %v: %v", targetPos, err)
+	}
+	if err := agent.MysqlDaemon.WaitMasterPos(ctx, targetPos); err != nil {
+		return fmt.Errorf("failed to replay the binlogs up to %v: %v", targetPos, err)
+	}
+
+	// Forget about the source, so replication isn't restarted.
+	logger.Infof("Restore: binlogs replayed up to %v", targetPos)
+	if err := agent.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds); err != nil {
+		return fmt.Errorf("failed to reset slave: %v", err)
+	}
+	return nil
+}
+
+// binlogSource returns the host and port of the mysqld to replay
+// the binlogs from: source if it's set, or the shard master.
+func (agent *ActionAgent) binlogSource(ctx context.Context, source string) (string, int, error) {
+	if source != "" {
+		host, port, err := netutil.SplitHostPort(source)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid binlog source %v: %v", source, err)
+		}
+		return host, port, nil
+	}
+
+	tablet := agent.Tablet()
+	si, err := agent.TopoServer.GetShard(ctx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		return "", 0, fmt.Errorf("can't read shard: %v", err)
+	}
+	if si.MasterAlias == nil || topoproto.TabletAliasEqual(si.MasterAlias, tablet.Alias) {
+		return "", 0, fmt.Errorf("shard %v/%v has no master to replay the binlogs from, a binlog source is required", tablet.Keyspace, tablet.Shard)
+	}
+	ti, err := agent.TopoServer.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return "", 0, fmt.Errorf("Cannot read master tablet %v: %v", si.MasterAlias, err)
+	}
+	return topoproto.MysqlHostname(ti.Tablet), int(topoproto.MysqlPort(ti.Tablet)), nil
+}
+
 func (agent *ActionAgent) getLocalMetadataValues(tabletType topodatapb.TabletType) map[string]string {
 	tablet := agent.Tablet()
 	values := map[string]string{
@@ -123,7 +123,7 @@ type RPCAgent interface {
 
 	Backup(ctx context.Context, concurrency int, logger logutil.Logger) error
 
-	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
+	RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTime time.Time, binlogSource string) error
 
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
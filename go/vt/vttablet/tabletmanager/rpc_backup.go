@@ -21,8 +21,10 @@ import (
 	"time"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 
@@ -36,7 +38,13 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 	}
 	defer agent.unlock()
 
-	// update our type to BACKUP
+	engine, err := mysqlctl.GetBackupEngine()
+	if err != nil {
+		return err
+	}
+
+	// update our type to BACKUP, if the engine can't take the
+	// backup while we serve
 	tablet, err := agent.TopoServer.GetTablet(ctx, agent.TabletAlias)
 	if err != nil {
 		return err
@@ -45,13 +53,15 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 		return fmt.Errorf("type MASTER cannot take backup, if you really need to do this, restart vttablet in replica mode")
 	}
 	originalType := tablet.Type
-	if _, err := topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, topodatapb.TabletType_BACKUP); err != nil {
-		return err
-	}
+	if engine.ShouldDrainForBackup() {
+		if _, err := topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, topodatapb.TabletType_BACKUP); err != nil {
+			return err
+		}
 
-	// let's update our internal state (stop query service and other things)
-	if err := agent.refreshTablet(ctx, "before backup"); err != nil {
-		return err
+		// let's update our internal state (stop query service and other things)
+		if err := agent.refreshTablet(ctx, "before backup"); err != nil {
+			return err
+		}
 	}
 
 	// create the loggers: tee to console and source
@@ -59,23 +69,28 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 
 	// now we can run the backup
 	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
-	name := fmt.Sprintf("%v.%v", time.Now().UTC().Format("2006-01-02.150405"), topoproto.TabletAliasString(tablet.Alias))
+	name := fmt.Sprintf("%v.%v", time.Now().UTC().Format(mysqlctl.BackupTimestampFormat), topoproto.TabletAliasString(tablet.Alias))
 	returnErr := mysqlctl.Backup(ctx, agent.MysqlDaemon, l, dir, name, concurrency, agent.hookExtraEnv())
+	if returnErr == nil {
+		agent.pruneBackups(ctx, l, dir)
+	}
 
-	// change our type back to the original value
-	_, err = topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, originalType)
-	if err != nil {
-		// failure in changing the topology type is probably worse,
-		// so returning that (we logged the snapshot error anyway)
-		if returnErr != nil {
-			l.Errorf("mysql backup command returned error: %v", returnErr)
+	if engine.ShouldDrainForBackup() {
+		// change our type back to the original value
+		_, err = topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, originalType)
+		if err != nil {
+			// failure in changing the topology type is probably worse,
+			// so returning that (we logged the snapshot error anyway)
+			if returnErr != nil {
+				l.Errorf("mysql backup command returned error: %v", returnErr)
+			}
+			returnErr = err
 		}
-		returnErr = err
-	}
 
-	// let's update our internal state (start query service and other things)
-	if err := agent.refreshTablet(ctx, "after backup"); err != nil {
-		return err
+		// let's update our internal state (start query service and other things)
+		if err := agent.refreshTablet(ctx, "after backup"); err != nil {
+			return err
+		}
 	}
 
 	// and re-run health check to be sure to capture any replication delay
@@ -84,8 +99,31 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 	return returnErr
 }
 
+// pruneBackups removes the backups of the shard not retained by the
+// -backup_retention_* flags, if any is set. Failing to prune doesn't
+// fail the backup: it is only logged.
+func (agent *ActionAgent) pruneBackups(ctx context.Context, logger logutil.Logger, dir string) {
+	policy := mysqlctl.RetentionPolicyFromFlags()
+	if policy.IsZero() {
+		return
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		logger.Errorf("cannot prune backups: %v", err)
+		return
+	}
+	defer bs.Close()
+	logger.Infof("Pruning backups of %v (%v)", dir, policy)
+	if _, err := mysqlctl.PruneBackups(ctx, bs, logger, dir, policy, false /* dryRun */); err != nil {
+		logger.Errorf("cannot prune backups: %v", err)
+	}
+}
+
 // RestoreFromBackup deletes all local data and restores anew from the latest backup.
-func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+// If restoreToPos or restoreToTime is set, it recovers to that point in time
+// instead: it restores the latest backup before it, and replays the binlogs of
+// binlogSource (host:port) or of the shard master up to it.
+func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTime time.Time, binlogSource string) error {
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
@@ -98,12 +136,23 @@ func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.
 	if tablet.Type == topodatapb.TabletType_MASTER {
 		return fmt.Errorf("type MASTER cannot restore from backup, if you really need to do this, restart vttablet in replica mode")
 	}
+	var target mysqlctl.RecoveryTarget
+	if restoreToPos != "" {
+		if !restoreToTime.IsZero() {
+			return fmt.Errorf("only one of the position and the time to restore to can be set")
+		}
+		target.Position, err = mysql.DecodePosition(restoreToPos)
+		if err != nil {
+			return err
+		}
+	}
+	target.Time = restoreToTime
 
 	// create the loggers: tee to console and source
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run restore
-	err = agent.restoreDataLocked(ctx, l, true /* deleteBeforeRestore */)
+	err = agent.restoreDataLocked(ctx, l, true /* deleteBeforeRestore */, target, binlogSource)
 
 	// re-run health check to be sure to capture any replication delay
 	agent.runHealthCheckLocked()
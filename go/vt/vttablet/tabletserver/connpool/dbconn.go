@@ -17,7 +17,9 @@ limitations under the License.
 package connpool
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -30,9 +32,12 @@ import (
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // BinlogFormat is used for for specifying the binlog format.
@@ -56,6 +61,14 @@ type DBConn struct {
 	dbaPool *dbconnpool.ConnectionPool
 	pool    *Pool
 	current sync2.AtomicString
+
+	// systemVariables are the session variables set on the
+	// connection, and nextSystemVariables the ones its next
+	// query must use. The connection keeps its variables when
+	// it goes back to the pool: they are only changed right
+	// before a query that needs other ones.
+	systemVariables     map[string]string
+	nextSystemVariables map[string]string
 }
 
 // NewDBConn creates a new DBConn. It triggers a CheckMySQL if creation fails.
@@ -139,6 +152,9 @@ func (dbc *DBConn) execOnce(ctx context.Context, query string, maxrows int, want
 			wg.Wait()
 		}()
 	}
+	if err := dbc.applySystemVariables(); err != nil {
+		return nil, err
+	}
 	// Uncomment this line for manual testing.
 	// defer time.Sleep(20 * time.Second)
 	return dbc.conn.ExecuteFetch(query, maxrows, wantfields)
@@ -210,9 +226,112 @@ func (dbc *DBConn) streamOnce(ctx context.Context, query string, callback func(*
 			wg.Wait()
 		}()
 	}
+	if err := dbc.applySystemVariables(); err != nil {
+		return err
+	}
 	return dbc.conn.ExecuteStreamFetch(query, callback, streamBufferSize)
 }
 
+// SetSystemVariables makes the next queries of the connection use
+// the provided session variables. The variables that were set before,
+// and are not in vars, are reset to their default value. Nothing is
+// sent to mysql: the variables are changed right before the next
+// query, under its deadline, and only if the connection doesn't
+// already use them.
+func (dbc *DBConn) SetSystemVariables(vars map[string]string) error {
+	dbc.nextSystemVariables = nil
+	if len(vars) != 0 {
+		if _, err := systemVariablesQuery(nil, vars); err != nil {
+			return err
+		}
+		dbc.nextSystemVariables = make(map[string]string, len(vars))
+		for name, value := range vars {
+			dbc.nextSystemVariables[name] = value
+		}
+	}
+	return nil
+}
+
+// applySystemVariables changes the session variables of the connection
+// to nextSystemVariables, if they differ.
+func (dbc *DBConn) applySystemVariables() error {
+	if systemVariablesEqual(dbc.systemVariables, dbc.nextSystemVariables) {
+		return nil
+	}
+	query, err := systemVariablesQuery(dbc.systemVariables, dbc.nextSystemVariables)
+	if err != nil {
+		return err
+	}
+	if _, err := dbc.conn.ExecuteFetch(query, 1, false); err != nil {
+		return err
+	}
+	dbc.systemVariables = dbc.nextSystemVariables
+	return nil
+}
+
+func systemVariablesEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if other, ok := b[name]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
+
+// systemVariablesQuery returns the statement that changes the session
+// variables from current to vars. Only the variables vtgate passes
+// through are accepted. The statement is generated back from its
+// parsed form, which guarantees that it only contains session
+// variable assignments. The values must be literals or default: an
+// expression, like a subquery, could read data the session is not
+// allowed to read.
+func systemVariablesQuery(current, vars map[string]string) (string, error) {
+	names := make([]string, 0, len(current)+len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	for name := range current {
+		if _, ok := vars[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	buf := bytes.NewBufferString("set session ")
+	for i, name := range names {
+		if !sqlparser.IsPassthroughSystemVariable(name) {
+			return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported system variable: %s", name)
+		}
+		value, ok := vars[name]
+		if !ok {
+			value = "default"
+		}
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "%s = %s", name, value)
+	}
+	stmt, err := sqlparser.Parse(buf.String())
+	if err != nil {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid system variables: %v", err)
+	}
+	set, ok := stmt.(*sqlparser.Set)
+	if !ok || len(set.Exprs) != len(names) {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid system variables: %s", buf.String())
+	}
+	for _, expr := range set.Exprs {
+		switch expr.Expr.(type) {
+		case *sqlparser.SQLVal, *sqlparser.NullVal, *sqlparser.Default:
+		default:
+			return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid value for system variable %v: %s", expr.Name, sqlparser.String(expr.Expr))
+		}
+	}
+	return sqlparser.String(set), nil
+}
+
 var (
 	getModeSQL    = "select @@global.sql_mode"
 	getAutocommit = "select @@autocommit"
@@ -337,6 +456,9 @@ func (dbc *DBConn) reconnect() error {
 		return err
 	}
 	dbc.conn = newConn
+	// The variables are lost with the old connection: they
+	// are set again before the next query.
+	dbc.systemVariables = nil
 	return nil
 }
 
@@ -29,8 +29,10 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 func TestDBConnExec(t *testing.T) {
@@ -79,6 +81,163 @@ func TestDBConnExec(t *testing.T) {
 	}
 }
 
+func TestDBConnSetSystemVariables(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	sql := "select 1"
+	setBoth := "set session sql_mode = 'ANSI', time_zone = '+00:00'"
+	setTimeZone := "set session sql_mode = default, time_zone = 'UTC'"
+	reset := "set session time_zone = default"
+	db.AddQuery(sql, &sqltypes.Result{})
+	db.AddQuery(setBoth, &sqltypes.Result{})
+	db.AddQuery(setTimeZone, &sqltypes.Result{})
+	db.AddQuery(reset, &sqltypes.Result{})
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	ctx := context.Background()
+	dbConn, err := NewDBConn(connPool, db.ConnParams())
+	if err != nil {
+		t.Fatalf("should not get an error, err: %v", err)
+	}
+	defer dbConn.Close()
+
+	vars := map[string]string{
+		"sql_mode":  "'ANSI'",
+		"time_zone": "'+00:00'",
+	}
+	if err := dbConn.SetSystemVariables(vars); err != nil {
+		t.Fatal(err)
+	}
+	// The variables are set right before the next query.
+	if got := db.GetQueryCalledNum(setBoth); got != 0 {
+		t.Errorf("%s called %d times, want 0", setBoth, got)
+	}
+	if _, err := dbConn.Exec(ctx, sql, 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.GetQueryCalledNum(setBoth); got != 1 {
+		t.Errorf("%s called %d times, want 1", setBoth, got)
+	}
+	// Setting the same variables again is a no-op.
+	if err := dbConn.SetSystemVariables(vars); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbConn.Exec(ctx, sql, 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.GetQueryCalledNum(setBoth); got != 1 {
+		t.Errorf("%s called %d times, want 1", setBoth, got)
+	}
+
+	// The variables that are not set anymore are reset.
+	if err := dbConn.SetSystemVariables(map[string]string{"time_zone": "'UTC'"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbConn.Exec(ctx, sql, 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.GetQueryCalledNum(setTimeZone); got != 1 {
+		t.Errorf("%s called %d times, want 1", setTimeZone, got)
+	}
+	if err := dbConn.SetSystemVariables(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbConn.Exec(ctx, sql, 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.GetQueryCalledNum(reset); got != 1 {
+		t.Errorf("%s called %d times, want 1", reset, got)
+	}
+	if dbConn.systemVariables != nil {
+		t.Errorf("systemVariables: %v, want nil", dbConn.systemVariables)
+	}
+
+	for _, vars := range []map[string]string{
+		// Only the variables vtgate passes through are accepted.
+		{"sql_log_bin": "0"},
+		{"gtid_next": "'3e11fa47-71ca-11e1-9e33-c80aa9429562:23'"},
+		{"autocommit": "0"},
+		{"sql_auto_is_null": "1"},
+		// Only variable assignments are accepted.
+		{"sql_mode = 'ANSI'; drop table a; set a": "1"},
+		{"sql_mode": "'ANSI'; drop table a"},
+		{"sql_mode": "'ANSI', time_zone = 'UTC'"},
+		// Only literals are accepted as values.
+		{"sql_mode": "(select authentication_string from mysql.user limit 1)"},
+		{"time_zone": "@@global.time_zone"},
+		{"sql_mode": "concat('AN', 'SI')"},
+	} {
+		err := dbConn.SetSystemVariables(vars)
+		if code := vterrors.Code(err); code != vtrpcpb.Code_INVALID_ARGUMENT {
+			t.Errorf("SetSystemVariables(%v): %v, want %v", vars, err, vtrpcpb.Code_INVALID_ARGUMENT)
+		}
+	}
+}
+
+func TestDBConnPooledSystemVariables(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	sql := "select 1"
+	set := "set session time_zone = 'UTC'"
+	reset := "set session time_zone = default"
+	db.AddQuery(sql, &sqltypes.Result{})
+	db.AddQuery(set, &sqltypes.Result{})
+	db.AddQuery(reset, &sqltypes.Result{})
+	connPool := New("TestDBConnPooledSystemVariables", 1, 10*time.Second, checker)
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	ctx := context.Background()
+	vars := map[string]string{"time_zone": "'UTC'"}
+
+	dbConn, err := connPool.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dbConn.SetSystemVariables(vars); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbConn.Exec(ctx, sql, 1, false); err != nil {
+		t.Fatal(err)
+	}
+	// The connection keeps its variables in the pool: the next
+	// session that uses the same ones doesn't set them again.
+	dbConn.Recycle()
+	dbConn, err = connPool.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dbConn.SetSystemVariables(vars); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dbConn.Exec(ctx, sql, 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.GetQueryCalledNum(set); got != 1 {
+		t.Errorf("%s called %d times, want 1", set, got)
+	}
+	if got := db.GetQueryCalledNum(reset); got != 0 {
+		t.Errorf("%s called %d times, want 0", reset, got)
+	}
+
+	// The other users of the pool don't inherit them.
+	dbConn.Recycle()
+	dbConn, err = connPool.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbConn.Recycle()
+	if _, err := dbConn.Exec(ctx, sql, 1, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.GetQueryCalledNum(reset); got != 1 {
+		t.Errorf("%s called %d times, want 1", reset, got)
+	}
+	if dbConn.systemVariables != nil {
+		t.Errorf("systemVariables: %v, want nil", dbConn.systemVariables)
+	}
+}
+
 func TestDBConnKill(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
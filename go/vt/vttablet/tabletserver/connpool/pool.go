@@ -131,7 +131,8 @@ func (cp *Pool) Close() {
 	cp.dbaPool.Close()
 }
 
-// Get returns a connection.
+// Get returns a connection. Its queries use the default session
+// variables: see DBConn.SetSystemVariables.
 // You must call Recycle on DBConn once done.
 func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 	if cp.isCallerIDAppDebug(ctx) {
@@ -145,7 +146,9 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 	if err != nil {
 		return nil, err
 	}
-	return r.(*DBConn), nil
+	conn := r.(*DBConn)
+	conn.nextSystemVariables = nil
+	return conn, nil
 }
 
 // Put puts a connection into the pool.
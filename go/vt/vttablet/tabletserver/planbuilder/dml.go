@@ -157,6 +157,14 @@ func analyzeSet(set *sqlparser.Set) (plan *Plan) {
 	}
 }
 
+func analyzeSavepoint(planID PlanType, stmt sqlparser.Statement, name sqlparser.ColIdent) *Plan {
+	return &Plan{
+		PlanID:        planID,
+		FullQuery:     GenerateFullQuery(stmt),
+		SavepointName: name.Lowered(),
+	}
+}
+
 func analyzeUpdateExpressions(exprs sqlparser.UpdateExprs, pkIndex *schema.Index) (pkValues []sqltypes.PlanValue, err error) {
 	for _, expr := range exprs {
 		index := pkIndex.FindColumn(expr.Name.Name)
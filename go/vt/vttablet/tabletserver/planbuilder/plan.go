@@ -83,6 +83,12 @@ const (
 	PlanOtherAdmin
 	// PlanMessageStream is used for streaming messages.
 	PlanMessageStream
+	// PlanSavepoint is for SAVEPOINT statements.
+	PlanSavepoint
+	// PlanSRollback is for ROLLBACK TO SAVEPOINT statements.
+	PlanSRollback
+	// PlanRelease is for RELEASE SAVEPOINT statements.
+	PlanRelease
 	// NumPlans stores the total number of plans
 	NumPlans
 )
@@ -105,6 +111,9 @@ var planName = [NumPlans]string{
 	"OTHER_READ",
 	"OTHER_ADMIN",
 	"MESSAGE_STREAM",
+	"SAVEPOINT",
+	"SAVEPOINT_ROLLBACK",
+	"RELEASE",
 }
 
 func (pt PlanType) String() string {
@@ -156,6 +165,9 @@ var tableACLRoles = map[PlanType]tableacl.Role{
 	PlanUpsertPK:       tableacl.WRITER,
 	PlanNextval:        tableacl.WRITER,
 	PlanMessageStream:  tableacl.WRITER,
+	PlanSavepoint:      tableacl.READER,
+	PlanSRollback:      tableacl.READER,
+	PlanRelease:        tableacl.READER,
 }
 
 //_______________________________________________
@@ -245,6 +257,10 @@ type Plan struct {
 
 	// For PlanInsertSubquery: pk columns in the subquery result.
 	SubqueryPKColumns []int
+
+	// SavepointName is the lowered name of the savepoint, for
+	// PlanSavepoint, PlanSRollback and PlanRelease.
+	SavepointName string
 }
 
 // TableName returns the table name for the plan.
@@ -295,6 +311,12 @@ func Build(sql string, tables map[string]*schema.Table) (*Plan, error) {
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
 	case *sqlparser.OtherAdmin:
 		plan, err = &Plan{PlanID: PlanOtherAdmin}, nil
+	case *sqlparser.Savepoint:
+		plan, err = analyzeSavepoint(PlanSavepoint, stmt, stmt.Name), nil
+	case *sqlparser.SRollback:
+		plan, err = analyzeSavepoint(PlanSRollback, stmt, stmt.Name), nil
+	case *sqlparser.Release:
+		plan, err = analyzeSavepoint(PlanRelease, stmt, stmt.Name), nil
 	default:
 		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "invalid SQL")
 	}
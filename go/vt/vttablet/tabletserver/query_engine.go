@@ -150,6 +150,7 @@ type QueryEngine struct {
 	warnResultSize     sync2.AtomicInt64
 	maxDMLRows         sync2.AtomicInt64
 	passthroughDMLs    sync2.AtomicBool
+	sequenceCacheSize  sync2.AtomicInt64
 	allowUnsafeDMLs    bool
 	streamBufferSize   sync2.AtomicInt64
 	// tableaclExemptCount count the number of accesses allowed
@@ -227,6 +228,7 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 	qe.warnResultSize = sync2.NewAtomicInt64(int64(config.WarnResultSize))
 	qe.maxDMLRows = sync2.NewAtomicInt64(int64(config.MaxDMLRows))
 	qe.streamBufferSize = sync2.NewAtomicInt64(int64(config.StreamBufferSize))
+	qe.sequenceCacheSize = sync2.NewAtomicInt64(int64(config.SequenceCacheSize))
 
 	qe.passthroughDMLs = sync2.NewAtomicBool(config.PassthroughDMLs)
 	planbuilder.PassthroughDMLs = config.PassthroughDMLs
@@ -238,6 +240,7 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		stats.Publish("WarnResultSize", stats.IntFunc(qe.warnResultSize.Get))
 		stats.Publish("MaxDMLRows", stats.IntFunc(qe.maxDMLRows.Get))
 		stats.Publish("StreamBufferSize", stats.IntFunc(qe.streamBufferSize.Get))
+		stats.Publish("SequenceCacheSize", stats.IntFunc(qe.sequenceCacheSize.Get))
 		stats.Publish("TableACLExemptCount", stats.IntFunc(qe.tableaclExemptCount.Get))
 		stats.Publish("QueryPoolWaiters", stats.IntFunc(qe.queryPoolWaiters.Get))
 
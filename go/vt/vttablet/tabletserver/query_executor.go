@@ -22,6 +22,7 @@ import (
 	"io"
 	"strings"
 	"time"
+	"unicode"
 
 	log "github.com/golang/glog"
 	"golang.org/x/net/context"
@@ -60,6 +61,11 @@ type QueryExecutor struct {
 	ctx              context.Context
 	logStats         *tabletenv.LogStats
 	tsv              *TabletServer
+
+	// rules are the query rules that throttle or rewrite the query.
+	rules []*rules.Rule
+	// hints are the optimizer hints added to the query by rules.
+	hints string
 }
 
 var sequenceFields = []*querypb.Field{
@@ -92,6 +98,18 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	if qre.writesSequence() {
+		defer func() {
+			if err == nil {
+				qre.plan.Table.SequenceInfo.Invalidated.Set(true)
+			}
+		}()
+	}
+	release, err := qre.applyRules()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	switch qre.plan.PlanID {
 	case planbuilder.PlanDDL:
@@ -107,6 +125,11 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return nil, err
 		}
 		defer conn.Recycle()
+		// The session variables may have changed since the
+		// transaction began.
+		if err := conn.SetSystemVariables(qre.options.GetSystemVariables()); err != nil {
+			return nil, err
+		}
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassDML:
 			if !qre.tsv.qe.allowUnsafeDMLs && (qre.tsv.qe.binlogFormat != connpool.BinlogFormatRow) {
@@ -131,6 +154,8 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return qre.txFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, nil, false, true)
 		case planbuilder.PlanPassSelect, planbuilder.PlanSelectLock:
 			return qre.execDirect(conn)
+		case planbuilder.PlanSavepoint, planbuilder.PlanSRollback, planbuilder.PlanRelease:
+			return qre.execSavepoint(conn)
 		default:
 			// handled above:
 			// planbuilder.PlanNextval
@@ -145,7 +170,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassSelect:
 			return qre.execSelect()
-		case planbuilder.PlanSelectLock:
+		case planbuilder.PlanSelectLock, planbuilder.PlanSavepoint, planbuilder.PlanSRollback, planbuilder.PlanRelease:
 			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s disallowed outside transaction", qre.plan.PlanID.String())
 		case planbuilder.PlanSet:
 			return qre.execSet()
@@ -200,6 +225,11 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	release, err := qre.applyRules()
+	if err != nil {
+		return err
+	}
+	defer release()
 
 	conn, err := qre.getStreamConn()
 	if err != nil {
@@ -310,13 +340,17 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars)
-	switch action {
-	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
-	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+	fired := qre.plan.Rules.GetRules(remoteAddr, username, qre.bindVars)
+	if len(fired) == 1 {
+		switch qr := fired[0]; qr.Action() {
+		case rules.QRFail:
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", qr.Description)
+		case rules.QRFailRetry:
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", qr.Description)
+		}
 	}
+	// The other actions are applied by applyRules.
+	qre.rules = fired
 
 	// Skip the ACL check if the connecting user is an exempted superuser.
 	// Necessary to whitelist e.g. direct vtworker access.
@@ -354,6 +388,37 @@ func (qre *QueryExecutor) checkPermissions() error {
 	return nil
 }
 
+// applyRules throttles or rewrites the query according to the query rules
+// found by checkPermissions, in order. On success, the returned function
+// must be called once the query is done.
+func (qre *QueryExecutor) applyRules() (func(), error) {
+	var releases []func()
+	release := func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+	var hints []string
+	for _, qr := range qre.rules {
+		statsKey := []string{qr.Name, qr.Action().String()}
+		start := time.Now()
+		r, err := qr.Wait(qre.ctx)
+		tabletenv.QueryRuleWaitTimesNs.Add(statsKey, int64(time.Now().Sub(start)))
+		if err != nil {
+			tabletenv.QueryRuleRejects.Add(statsKey, 1)
+			release()
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "throttled due to rule: %s: %v", qr.Description, err)
+		}
+		tabletenv.QueryRuleActions.Add(statsKey, 1)
+		releases = append(releases, r)
+		if h := qr.Hints(); h != "" {
+			hints = append(hints, h)
+		}
+	}
+	qre.hints = strings.Join(hints, " ")
+	return release, nil
+}
+
 func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName string, callerID *querypb.VTGateCallerID) error {
 	statsKey := []string{tableName, authorized.GroupName, qre.plan.PlanID.String(), callerID.Username}
 	if !authorized.IsMember(callerID) {
@@ -428,9 +493,19 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 	}
 
 	t := qre.plan.Table
+	if t.SequenceInfo.Uncached && qre.transactionID != 0 {
+		return qre.execNextvalInTransaction(inc)
+	}
 	t.SequenceInfo.Lock()
 	defer t.SequenceInfo.Unlock()
-	if t.SequenceInfo.NextVal == 0 || t.SequenceInfo.NextVal+inc > t.SequenceInfo.LastVal {
+	// Discard the cached values if the sequence table was modified
+	// since they were reserved.
+	if t.SequenceInfo.Invalidated.CompareAndSwap(true, false) {
+		t.SequenceInfo.NextVal = 0
+		t.SequenceInfo.LastVal = 0
+	}
+	// Sequences in uncached mode reserve exactly the values they hand out.
+	if t.SequenceInfo.Uncached || t.SequenceInfo.NextVal == 0 || t.SequenceInfo.NextVal+inc > t.SequenceInfo.LastVal {
 		_, err := qre.execAsTransaction(func(conn *TxConnection) (*sqltypes.Result, error) {
 			query := fmt.Sprintf("select next_id, cache from %s where id = 0 for update", sqlparser.String(tableName))
 			qr, err := qre.execSQL(conn, query, false)
@@ -444,8 +519,10 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 			if err != nil {
 				return nil, fmt.Errorf("error loading sequence %s: %v", tableName, err)
 			}
-			// Initialize SequenceInfo.NextVal if it wasn't already.
-			if t.SequenceInfo.NextVal == 0 {
+			// Initialize SequenceInfo.NextVal if it wasn't already,
+			// or restart from next_id if the values following the
+			// cached ones were reserved by someone else.
+			if t.SequenceInfo.NextVal == 0 || nextID != t.SequenceInfo.LastVal {
 				t.SequenceInfo.NextVal = nextID
 			}
 			cache, err := sqltypes.ToInt64(qr.Rows[0][1])
@@ -455,6 +532,12 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 			if cache < 1 {
 				return nil, fmt.Errorf("invalid cache value for sequence %s: %d", tableName, cache)
 			}
+			if size := qre.tsv.qe.sequenceCacheSize.Get(); size > 0 {
+				cache = size
+			}
+			if t.SequenceInfo.Uncached {
+				cache = inc
+			}
 			newLast := nextID + cache
 			for newLast < t.SequenceInfo.NextVal+inc {
 				newLast += cache
@@ -471,7 +554,9 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 		if err != nil {
 			return nil, err
 		}
+		tabletenv.SequenceReservations.Add(tableName.String(), 1)
 	}
+	tabletenv.SequenceValues.Add(tableName.String(), inc)
 	ret := t.SequenceInfo.NextVal
 	t.SequenceInfo.NextVal += inc
 	return &sqltypes.Result{
@@ -483,6 +568,63 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 	}, nil
 }
 
+// execNextvalInTransaction reserves the values of an uncached sequence
+// in the transaction of the caller. The row of the sequence stays locked
+// until that transaction ends, and the values are released if it's
+// rolled back, so no value is lost. The values cached by the sequence
+// are not used: they're discarded by the next reservation because
+// next_id moved.
+func (qre *QueryExecutor) execNextvalInTransaction(inc int64) (*sqltypes.Result, error) {
+	tableName := qre.plan.TableName()
+	conn, err := qre.tsv.te.txPool.Get(qre.transactionID, "for nextval")
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+
+	query := fmt.Sprintf("select next_id, cache from %s where id = 0 for update", sqlparser.String(tableName))
+	qr, err := qre.execSQL(conn, query, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 1 {
+		return nil, fmt.Errorf("unexpected rows from reading sequence %s (possible mis-route): %d", tableName, len(qr.Rows))
+	}
+	nextID, err := sqltypes.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return nil, fmt.Errorf("error loading sequence %s: %v", tableName, err)
+	}
+	query = fmt.Sprintf("update %s set next_id = %d where id = 0", sqlparser.String(tableName), nextID+inc)
+	conn.RecordQuery(query)
+	if _, err := qre.execSQL(conn, query, false); err != nil {
+		return nil, err
+	}
+	tabletenv.SequenceReservations.Add(tableName.String(), 1)
+	tabletenv.SequenceValues.Add(tableName.String(), inc)
+	return &sqltypes.Result{
+		Fields: sequenceFields,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(nextID),
+		}},
+		RowsAffected: 1,
+	}, nil
+}
+
+// writesSequence returns true if the query modifies a sequence table
+// directly, which invalidates the values cached by the sequence.
+func (qre *QueryExecutor) writesSequence() bool {
+	if qre.plan.Table == nil || qre.plan.Table.SequenceInfo == nil {
+		return false
+	}
+	switch qre.plan.PlanID {
+	case planbuilder.PlanPassDML, planbuilder.PlanDMLPK, planbuilder.PlanDMLSubquery,
+		planbuilder.PlanInsertPK, planbuilder.PlanInsertSubquery, planbuilder.PlanUpsertPK,
+		planbuilder.PlanOtherAdmin:
+		return true
+	}
+	return false
+}
+
 // execDirect is for reads inside transactions. Always send to MySQL.
 func (qre *QueryExecutor) execDirect(conn *TxConnection) (*sqltypes.Result, error) {
 	if qre.plan.Fields != nil {
@@ -740,6 +882,25 @@ func (qre *QueryExecutor) execDMLPKRows(conn *TxConnection, query *sqlparser.Par
 	return result, nil
 }
 
+// execSavepoint runs a savepoint statement in the transaction, and
+// records it in the connection, so the pending message changes follow
+// ROLLBACK TO SAVEPOINT.
+func (qre *QueryExecutor) execSavepoint(conn *TxConnection) (*sqltypes.Result, error) {
+	qr, err := qre.txFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, nil, false, true)
+	if err != nil {
+		return nil, err
+	}
+	switch qre.plan.PlanID {
+	case planbuilder.PlanSavepoint:
+		conn.Savepoint(qre.plan.SavepointName)
+	case planbuilder.PlanSRollback:
+		conn.RollbackToSavepoint(qre.plan.SavepointName)
+	case planbuilder.PlanRelease:
+		conn.ReleaseSavepoint(qre.plan.SavepointName)
+	}
+	return qr, nil
+}
+
 func (qre *QueryExecutor) execSet() (*sqltypes.Result, error) {
 	conn, err := qre.getConn()
 	if err != nil {
@@ -759,6 +920,9 @@ func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Now().Sub(start)
+		if err := qre.setSystemVariables(conn); err != nil {
+			return nil, err
+		}
 		return conn, nil
 	case connpool.ErrConnPoolClosed:
 		return nil, err
@@ -776,6 +940,9 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Now().Sub(start)
+		if err := qre.setSystemVariables(conn); err != nil {
+			return nil, err
+		}
 		return conn, nil
 	case connpool.ErrConnPoolClosed:
 		return nil, err
@@ -783,11 +950,32 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	return nil, err
 }
 
+// setSystemVariables makes the pooled connection use the session
+// variables of the query. The connection is recycled if they are
+// invalid.
+func (qre *QueryExecutor) setSystemVariables(conn *connpool.DBConn) error {
+	if err := conn.SetSystemVariables(qre.options.GetSystemVariables()); err != nil {
+		conn.Recycle()
+		return err
+	}
+	return nil
+}
+
 func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(parsedQuery, bindVars, nil, nil)
 	if err != nil {
 		return nil, err
 	}
+	if len(qre.options.GetSystemVariables()) != 0 {
+		// The result depends on the session variables, it cannot
+		// be shared with other sessions.
+		conn, err := qre.getConn()
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Recycle()
+		return qre.execSQL(conn, sql, false)
+	}
 	q, ok := qre.tsv.qe.consolidator.Create(string(sqlWithoutComments))
 	if ok {
 		defer q.Broadcast()
@@ -852,6 +1040,9 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 	if err != nil {
 		return "", "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
 	}
+	if qre.hints != "" {
+		sql = addOptimizerHints(sql, qre.hints)
+	}
 	if buildStreamComment != nil {
 		sql = append(sql, buildStreamComment...)
 	}
@@ -859,6 +1050,26 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 	return hack.String(fullSQL), hack.String(sql), nil
 }
 
+// addOptimizerHints inserts an optimizer hints comment after the first
+// keyword of sql, which is where MySQL expects it.
+func addOptimizerHints(sql []byte, hints string) []byte {
+	trimmed := strings.TrimRightFunc(string(sql), unicode.IsSpace)
+	start := len(trimmed) - len(sqlparser.StripLeadingComments(trimmed))
+	end := start
+	for end < len(sql) && (sql[end] >= 'a' && sql[end] <= 'z' || sql[end] >= 'A' && sql[end] <= 'Z') {
+		end++
+	}
+	if end == start {
+		return sql
+	}
+	newSQL := make([]byte, 0, len(sql)+len(hints)+7)
+	newSQL = append(newSQL, sql[:end]...)
+	newSQL = append(newSQL, " /*+ "...)
+	newSQL = append(newSQL, hints...)
+	newSQL = append(newSQL, " */"...)
+	return append(newSQL, sql[end:]...)
+}
+
 func (qre *QueryExecutor) getLimit(query *sqlparser.ParsedQuery) int64 {
 	maxRows := qre.tsv.qe.maxResultSize.Get()
 	sqlLimit := qre.options.GetSqlSelectLimit()
@@ -1217,6 +1217,41 @@ func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanSavepoint(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("savepoint a", &sqltypes.Result{})
+	db.AddQuery("rollback to a", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// Outside a transaction.
+	qre := newTestQueryExecutor(ctx, tsv, "savepoint a", 0)
+	checkPlanID(t, planbuilder.PlanSavepoint, qre.plan.PlanID)
+	_, err := qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Fatalf("qre.Execute: %v, want %v", code, vtrpcpb.Code_FAILED_PRECONDITION)
+	}
+
+	// Within a transaction.
+	txid := newTransaction(tsv, nil)
+	qre = newTestQueryExecutor(ctx, tsv, "savepoint a", txid)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	qre = newTestQueryExecutor(ctx, tsv, "rollback to savepoint a", txid)
+	defer testCommitHelper(t, tsv, qre)
+	checkPlanID(t, planbuilder.PlanSRollback, qre.plan.PlanID)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	want := []string{"savepoint a", "rollback to a"}
+	if gotqueries := fetchRecordedQueries(qre); !reflect.DeepEqual(gotqueries, want) {
+		t.Errorf("queries: %v, want %v", gotqueries, want)
+	}
+}
+
 func TestQueryExecutorPlanPassSelect(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1269,6 +1304,78 @@ func TestQueryExecutorPlanPassSelectSqlSelectLimit(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorSystemVariables(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	setTimeZone := "set session time_zone = 'UTC'"
+	setSQLMode := "set session sql_mode = 'ANSI', time_zone = default"
+	resetTimeZone := "set session time_zone = default"
+	db.AddQuery(setTimeZone, &sqltypes.Result{})
+	db.AddQuery(setSQLMode, &sqltypes.Result{})
+	db.AddQuery(resetTimeZone, &sqltypes.Result{})
+	db.AddQuery("set session sql_mode = default", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	options := &querypb.ExecuteOptions{
+		SystemVariables: map[string]string{"time_zone": "'UTC'"},
+	}
+
+	// Outside a transaction, the variables are set on the pooled
+	// connection.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = options
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	if got := db.GetQueryCalledNum(setTimeZone); got != 1 {
+		t.Errorf("%s called %d times, want 1", setTimeZone, got)
+	}
+	// The connection keeps them when it goes back to the pool:
+	// they are only reset before a query that needs other ones.
+	if got := db.GetQueryCalledNum(resetTimeZone); got != 0 {
+		t.Errorf("%s called %d times, want 0", resetTimeZone, got)
+	}
+
+	// In a transaction, they are set when it begins, and changed
+	// when they change.
+	txid := newTransaction(tsv, options)
+	if got := db.GetQueryCalledNum(setTimeZone); got != 2 {
+		t.Errorf("%s called %d times, want 2", setTimeZone, got)
+	}
+	qre = newTestQueryExecutor(ctx, tsv, query, txid)
+	qre.options = options
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if got := db.GetQueryCalledNum(setTimeZone); got != 2 {
+		t.Errorf("%s called %d times, want 2", setTimeZone, got)
+	}
+	qre = newTestQueryExecutor(ctx, tsv, query, txid)
+	qre.options = &querypb.ExecuteOptions{
+		SystemVariables: map[string]string{"sql_mode": "'ANSI'"},
+	}
+	defer testCommitHelper(t, tsv, qre)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if got := db.GetQueryCalledNum(setSQLMode); got != 1 {
+		t.Errorf("%s called %d times, want 1", setSQLMode, got)
+	}
+}
+
 func TestQueryExecutorPlanSet(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1462,6 +1569,161 @@ func TestQueryExecutorPlanNextval(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanNextvalUncached(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	selQuery := "select next_id, cache from seq where id = 0 for update"
+	db.AddQuery(selQuery, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(1),
+			sqltypes.NewInt64(3),
+		}},
+	})
+	// Only the values handed out are reserved.
+	db.AddQuery("update seq set next_id = 3 where id = 0", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	qre := newTestQueryExecutor(ctx, tsv, "select next 2 values from seq", 0)
+	qre.plan.Table.SequenceInfo.Uncached = true
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if want := sqltypes.NewInt64(1); !reflect.DeepEqual(got.Rows[0][0], want) {
+		t.Errorf("qre.Execute() = %v, want %v", got.Rows[0][0], want)
+	}
+
+	// Nothing is cached: the next value is read from the table.
+	db.AddQuery(selQuery, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(3),
+			sqltypes.NewInt64(3),
+		}},
+	})
+	db.AddQuery("update seq set next_id = 4 where id = 0", &sqltypes.Result{})
+	qre = newTestQueryExecutor(ctx, tsv, "select next value from seq", 0)
+	got, err = qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if want := sqltypes.NewInt64(3); !reflect.DeepEqual(got.Rows[0][0], want) {
+		t.Errorf("qre.Execute() = %v, want %v", got.Rows[0][0], want)
+	}
+}
+
+func TestQueryExecutorPlanNextvalUncachedInTransaction(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("select next_id, cache from seq where id = 0 for update", &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(5),
+			sqltypes.NewInt64(3),
+		}},
+	})
+	updateQuery := "update seq set next_id = 7 where id = 0"
+	db.AddQuery(updateQuery, &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	txid := newTransaction(tsv, nil)
+	qre := newTestQueryExecutor(ctx, tsv, "select next 2 values from seq", txid)
+	qre.plan.Table.SequenceInfo.Uncached = true
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if want := sqltypes.NewInt64(5); !reflect.DeepEqual(got.Rows[0][0], want) {
+		t.Errorf("qre.Execute() = %v, want %v", got.Rows[0][0], want)
+	}
+	// The values are reserved in the transaction of the caller.
+	wantqueries := []string{updateQuery}
+	gotqueries := fetchRecordedQueries(qre)
+	if !reflect.DeepEqual(gotqueries, wantqueries) {
+		t.Errorf("queries: %v, want %v", gotqueries, wantqueries)
+	}
+	if nextVal := qre.plan.Table.SequenceInfo.NextVal; nextVal != 0 {
+		t.Errorf("SequenceInfo.NextVal: %d, want 0", nextVal)
+	}
+	testCommitHelper(t, tsv, qre)
+}
+
+func TestQueryExecutorPlanNextvalInvalidated(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	selQuery := "select next_id, cache from seq where id = 0 for update"
+	db.AddQuery(selQuery, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(1),
+			sqltypes.NewInt64(3),
+		}},
+	})
+	// The cache size of the tablet overrides the one of the table.
+	db.AddQuery("update seq set next_id = 11 where id = 0", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.SetSequenceCacheSize(10)
+	qre := newTestQueryExecutor(ctx, tsv, "select next value from seq", 0)
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if want := sqltypes.NewInt64(1); !reflect.DeepEqual(got.Rows[0][0], want) {
+		t.Errorf("qre.Execute() = %v, want %v", got.Rows[0][0], want)
+	}
+
+	// Modifying the sequence table invalidates the cached values.
+	qre = newTestQueryExecutor(ctx, tsv, "update seq set next_id = 100 where id = 0", 0)
+	if !qre.writesSequence() {
+		t.Errorf("writesSequence(%s): false, want true", qre.query)
+	}
+	qre.plan.Table.SequenceInfo.Invalidated.Set(true)
+	db.AddQuery(selQuery, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(100),
+			sqltypes.NewInt64(3),
+		}},
+	})
+	db.AddQuery("update seq set next_id = 110 where id = 0", &sqltypes.Result{})
+	qre = newTestQueryExecutor(ctx, tsv, "select next value from seq", 0)
+	got, err = qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if want := sqltypes.NewInt64(100); !reflect.DeepEqual(got.Rows[0][0], want) {
+		t.Errorf("qre.Execute() = %v, want %v", got.Rows[0][0], want)
+	}
+	if qre.plan.Table.SequenceInfo.Invalidated.Get() {
+		t.Errorf("SequenceInfo.Invalidated: true, want false")
+	}
+}
+
 func TestQueryExecutorMessageStream(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1940,6 +2202,192 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorQRAddHints(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	rewrittenQuery := "select /*+ MAX_EXECUTION_TIME(1000) */ * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(rewrittenQuery, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	hintsRule := rules.NewQueryRule("limit selects", "limit selects", rules.QRAddHints)
+	hintsRule.SetMaxExecutionTime(time.Second)
+	hintsRule.AddPlanCond(planbuilder.PlanPassSelect)
+
+	rulesName := "hintsRules"
+	rules := rules.New()
+	rules.Add(hintsRule)
+
+	ctx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+
+	if err := tsv.qe.queryRuleSources.SetRules(rulesName, rules); err != nil {
+		t.Fatalf("failed to set rule, error: %v", err)
+	}
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	defer tsv.StopService()
+
+	statsKey := []string{"limit selects", "ADD_HINTS"}
+	count := tabletenv.QueryRuleActions.Counts()[strings.Join(statsKey, ".")]
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	if newCount := tabletenv.QueryRuleActions.Counts()[strings.Join(statsKey, ".")]; newCount != count+1 {
+		t.Errorf("QueryRuleActions: %v, want %v", newCount, count+1)
+	}
+}
+
+func TestQueryExecutorStackedRules(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	rewrittenQuery := "select /*+ MAX_EXECUTION_TIME(1000) */ * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(rewrittenQuery, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	delayRule := rules.NewQueryRule("delay selects", "delay selects", rules.QRDelay)
+	delayRule.SetDelay(10 * time.Millisecond)
+	hintsRule := rules.NewQueryRule("limit selects", "limit selects", rules.QRAddHints)
+	hintsRule.SetMaxExecutionTime(time.Second)
+	failRule := rules.NewQueryRule("ban u2", "ban u2", rules.QRFail)
+	failRule.SetUserCond("u2")
+
+	rulesName := "stackedRules"
+	rules := rules.New()
+	rules.Add(delayRule)
+	rules.Add(hintsRule)
+	rules.Add(failRule)
+
+	ctx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{User: "u1"})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+
+	if err := tsv.qe.queryRuleSources.SetRules(rulesName, rules); err != nil {
+		t.Fatalf("failed to set rule, error: %v", err)
+	}
+	defer tsv.StopService()
+
+	// The delay and the hints both apply.
+	start := time.Now()
+	got, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	if elapsed := time.Now().Sub(start); elapsed < 10*time.Millisecond {
+		t.Errorf("qre.Execute() returned after %v, want at least 10ms", elapsed)
+	}
+
+	// The FAIL rule wins over the DELAY rule that comes before it.
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	ctx = callinfo.NewContext(timeoutCtx, &fakecallinfo.FakeCallInfo{User: "u2"})
+	delayRule.SetDelay(time.Hour)
+	if err := tsv.qe.queryRuleSources.SetRules(rulesName, rules); err != nil {
+		t.Fatalf("failed to set rule, error: %v", err)
+	}
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_INVALID_ARGUMENT {
+		t.Fatalf("qre.Execute: %v, want %v", code, vtrpcpb.Code_INVALID_ARGUMENT)
+	}
+}
+
+func TestQueryExecutorQRLimitConcurrency(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	limitRule := rules.NewQueryRule("limit selects", "limit selects", rules.QRLimitConcurrency)
+	limitRule.SetMaxConcurrency(1)
+	limitRule.AddPlanCond(planbuilder.PlanPassSelect)
+
+	rulesName := "limitRules"
+	rules := rules.New()
+	rules.Add(limitRule)
+
+	ctx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+
+	if err := tsv.qe.queryRuleSources.SetRules(rulesName, rules); err != nil {
+		t.Fatalf("failed to set rule, error: %v", err)
+	}
+	defer tsv.StopService()
+
+	// Take the only slot, as if a query was running.
+	release, err := limitRule.Wait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shortCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	qre := newTestQueryExecutor(shortCtx, tsv, query, 0)
+	_, err = qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Fatalf("qre.Execute: %v, want %v", err, vtrpcpb.Code_RESOURCE_EXHAUSTED)
+	}
+
+	release()
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+}
+
+func TestAddOptimizerHints(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{{
+		in:  "select * from t",
+		out: "select /*+ HINT */ * from t",
+	}, {
+		in:  "/* comment */ update t set a = 1",
+		out: "/* comment */ update /*+ HINT */ t set a = 1",
+	}, {
+		in:  "select(1)",
+		out: "select /*+ HINT */(1)",
+	}, {
+		in:  "/*!40000 select 1 */",
+		out: "/*!40000 select 1 */",
+	}}
+	for _, tcase := range testcases {
+		if got := string(addOptimizerHints([]byte(tcase.in), "HINT")); got != tcase.out {
+			t.Errorf("addOptimizerHints(%q): %q, want %q", tcase.in, got, tcase.out)
+		}
+	}
+}
+
 type executorFlags int64
 
 const (
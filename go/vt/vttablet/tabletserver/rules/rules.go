@@ -22,6 +22,11 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -143,12 +148,29 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
 func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) (action Action, desc string) {
+	fired := qrs.GetRules(ip, user, bindVars)
+	if len(fired) == 0 {
+		return QRContinue, ""
+	}
+	return fired[0].act, fired[0].Description
+}
+
+// GetRules runs the input against the rules engine and returns the rules
+// that fire. A QRFail or QRFailRetry rule takes precedence over all the
+// others: if one fires, it is the only rule returned. Otherwise, all the
+// rules that fire are returned in order, and their actions stack.
+func (qrs *Rules) GetRules(ip, user string, bindVars map[string]*querypb.BindVariable) []*Rule {
+	var fired []*Rule
 	for _, qr := range qrs.rules {
-		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
-			return act, qr.Description
+		switch qr.GetAction(ip, user, bindVars) {
+		case QRContinue:
+		case QRFail, QRFailRetry:
+			return []*Rule{qr}
+		default:
+			fired = append(fired, qr)
 		}
 	}
-	return QRContinue, ""
+	return fired
 }
 
 //-----------------------------------------------
@@ -181,6 +203,20 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// Parameters of the actions that throttle or rewrite the query.
+	maxConcurrency   int
+	maxQPS           float64
+	burst            int
+	delay            time.Duration
+	hints            string
+	maxExecutionTime time.Duration
+
+	// slots and limiter hold the state of the QRLimitConcurrency and
+	// QRLimitQPS actions. They are shared by the copies of the rule,
+	// so the limits apply to all the plans the rule was filtered into.
+	slots   chan struct{}
+	limiter *rate.Limiter
 }
 
 type namedRegexp struct {
@@ -202,12 +238,20 @@ func NewQueryRule(description, name string, act Action) (qr *Rule) {
 // Copy performs a deep copy of a Rule.
 func (qr *Rule) Copy() (newqr *Rule) {
 	newqr = &Rule{
-		Description: qr.Description,
-		Name:        qr.Name,
-		requestIP:   qr.requestIP,
-		user:        qr.user,
-		query:       qr.query,
-		act:         qr.act,
+		Description:      qr.Description,
+		Name:             qr.Name,
+		requestIP:        qr.requestIP,
+		user:             qr.user,
+		query:            qr.query,
+		act:              qr.act,
+		maxConcurrency:   qr.maxConcurrency,
+		maxQPS:           qr.maxQPS,
+		burst:            qr.burst,
+		delay:            qr.delay,
+		hints:            qr.hints,
+		maxExecutionTime: qr.maxExecutionTime,
+		slots:            qr.slots,
+		limiter:          qr.limiter,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -250,6 +294,22 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.maxConcurrency != 0 {
+		safeEncode(b, `,"MaxConcurrency":`, qr.maxConcurrency)
+	}
+	if qr.maxQPS != 0 {
+		safeEncode(b, `,"MaxQPS":`, qr.maxQPS)
+		safeEncode(b, `,"Burst":`, qr.burst)
+	}
+	if qr.delay != 0 {
+		safeEncode(b, `,"Delay":`, qr.delay.String())
+	}
+	if qr.hints != "" {
+		safeEncode(b, `,"Hints":`, qr.hints)
+	}
+	if qr.maxExecutionTime != 0 {
+		safeEncode(b, `,"MaxExecutionTime":`, qr.maxExecutionTime.String())
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -291,6 +351,69 @@ func (qr *Rule) SetQueryCond(pattern string) (err error) {
 	return
 }
 
+// SetMaxConcurrency sets the maximum number of queries that can run
+// concurrently for the QRLimitConcurrency action. The other queries
+// wait for a slot.
+func (qr *Rule) SetMaxConcurrency(maxConcurrency int) error {
+	if maxConcurrency <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxConcurrency must be positive: %d", maxConcurrency)
+	}
+	qr.maxConcurrency = maxConcurrency
+	qr.slots = make(chan struct{}, maxConcurrency)
+	return nil
+}
+
+// SetMaxQPS sets the rate of the token bucket for the QRLimitQPS action,
+// and its size. If burst is 0, the bucket holds one second of tokens.
+func (qr *Rule) SetMaxQPS(maxQPS float64, burst int) error {
+	if maxQPS <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxQPS must be positive: %v", maxQPS)
+	}
+	if burst < 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Burst must not be negative: %d", burst)
+	}
+	if burst == 0 {
+		burst = int(maxQPS)
+		if float64(burst) < maxQPS {
+			burst++
+		}
+	}
+	qr.maxQPS = maxQPS
+	qr.burst = burst
+	qr.limiter = rate.NewLimiter(rate.Limit(maxQPS), burst)
+	return nil
+}
+
+// SetDelay sets how long queries are held for the QRDelay action.
+func (qr *Rule) SetDelay(delay time.Duration) error {
+	if delay <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Delay must be positive: %v", delay)
+	}
+	qr.delay = delay
+	return nil
+}
+
+// SetHints sets the optimizer hints added to queries by the
+// QRAddHints action, without the enclosing /*+ */.
+func (qr *Rule) SetHints(hints string) error {
+	if strings.Contains(hints, "*/") {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Hints cannot contain */: %s", hints)
+	}
+	qr.hints = strings.TrimSpace(hints)
+	return nil
+}
+
+// SetMaxExecutionTime sets the MAX_EXECUTION_TIME hint added to queries
+// by the QRAddHints action. MySQL only honors it for SELECT statements,
+// with a millisecond precision.
+func (qr *Rule) SetMaxExecutionTime(maxExecutionTime time.Duration) error {
+	if maxExecutionTime < time.Millisecond {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxExecutionTime must be at least 1ms: %v", maxExecutionTime)
+	}
+	qr.maxExecutionTime = maxExecutionTime
+	return nil
+}
+
 // makeExact forces a full string match for the regex instead of substring
 func makeExact(pattern string) string {
 	return fmt.Sprintf("^%s$", pattern)
@@ -391,6 +514,61 @@ func (qr *Rule) GetAction(ip, user string, bindVars map[string]*querypb.BindVari
 	return qr.act
 }
 
+// Action returns the action of the rule.
+func (qr *Rule) Action() Action {
+	return qr.act
+}
+
+// Wait applies the QRDelay, QRLimitQPS and QRLimitConcurrency actions:
+// it holds the query until it can run, or until ctx is done. On success,
+// the returned function must be called once the query is done, to
+// release its concurrency slot.
+func (qr *Rule) Wait(ctx context.Context) (release func(), err error) {
+	release = func() {}
+	switch qr.act {
+	case QRDelay:
+		timer := time.NewTimer(qr.delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	case QRLimitQPS:
+		if qr.limiter != nil {
+			if err := qr.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+	case QRLimitConcurrency:
+		if qr.slots != nil {
+			select {
+			case qr.slots <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			release = func() { <-qr.slots }
+		}
+	}
+	return release, nil
+}
+
+// Hints returns the content of the optimizer hints comment to add to
+// the queries for the QRAddHints action, or "" for the other actions.
+func (qr *Rule) Hints() string {
+	if qr.act != QRAddHints {
+		return ""
+	}
+	hints := qr.hints
+	if qr.maxExecutionTime != 0 {
+		if hints != "" {
+			hints += " "
+		}
+		hints += fmt.Sprintf("MAX_EXECUTION_TIME(%d)", qr.maxExecutionTime/time.Millisecond)
+	}
+	return hints
+}
+
 func reMatch(re *regexp.Regexp, val string) bool {
 	return re == nil || re.MatchString(val)
 }
@@ -442,21 +620,32 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	QRLimitConcurrency
+	QRLimitQPS
+	QRDelay
+	QRAddHints
 )
 
+var actionNames = map[Action]string{
+	QRFail:             "FAIL",
+	QRFailRetry:        "FAIL_RETRY",
+	QRLimitConcurrency: "LIMIT_CONCURRENCY",
+	QRLimitQPS:         "LIMIT_QPS",
+	QRDelay:            "DELAY",
+	QRAddHints:         "ADD_HINTS",
+}
+
+// String returns the JSON name of the action.
+func (act Action) String() string {
+	if str, ok := actionNames[act]; ok {
+		return str
+	}
+	return "INVALID"
+}
+
 // MarshalJSON marshals to JSON.
 func (act Action) MarshalJSON() ([]byte, error) {
-	// If we add more actions, we'll need to use a map.
-	var str string
-	switch act {
-	case QRFail:
-		str = "FAIL"
-	case QRFailRetry:
-		str = "FAIL_RETRY"
-	default:
-		str = "INVALID"
-	}
-	return json.Marshal(str)
+	return json.Marshal(act.String())
 }
 
 // BindVarCond represents a bind var condition.
@@ -741,13 +930,19 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
+		var nv json.Number
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "Delay", "Hints", "MaxExecutionTime":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
 			}
+		case "MaxConcurrency", "MaxQPS", "Burst":
+			nv, ok = v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for %s", k)
+			}
 		case "Plans", "BindVarConds", "TableNames":
 			lv, ok = v.([]interface{})
 			if !ok {
@@ -813,14 +1008,92 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "LIMIT_CONCURRENCY":
+				qr.act = QRLimitConcurrency
+			case "LIMIT_QPS":
+				qr.act = QRLimitQPS
+			case "DELAY":
+				qr.act = QRDelay
+			case "ADD_HINTS":
+				qr.act = QRAddHints
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "MaxConcurrency":
+			maxConcurrency, err := nv.Int64()
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int for MaxConcurrency: %s", nv)
+			}
+			if err := qr.SetMaxConcurrency(int(maxConcurrency)); err != nil {
+				return nil, err
+			}
+		case "MaxQPS":
+			if qr.maxQPS, err = nv.Float64(); err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for MaxQPS: %s", nv)
+			}
+		case "Burst":
+			burst, err := nv.Int64()
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int for Burst: %s", nv)
+			}
+			qr.burst = int(burst)
+		case "Delay":
+			delay, err := time.ParseDuration(sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want duration for Delay: %s", sv)
+			}
+			if err := qr.SetDelay(delay); err != nil {
+				return nil, err
+			}
+		case "Hints":
+			if err := qr.SetHints(sv); err != nil {
+				return nil, err
+			}
+		case "MaxExecutionTime":
+			maxExecutionTime, err := time.ParseDuration(sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want duration for MaxExecutionTime: %s", sv)
+			}
+			if err := qr.SetMaxExecutionTime(maxExecutionTime); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if qr.maxQPS != 0 || qr.burst != 0 {
+		// MaxQPS and Burst can come in any order.
+		if err := qr.SetMaxQPS(qr.maxQPS, qr.burst); err != nil {
+			return nil, err
 		}
 	}
+	if err := qr.checkActionParameters(); err != nil {
+		return nil, err
+	}
 	return qr, nil
 }
 
+// checkActionParameters makes sure the rule has the parameters
+// of its action, and no parameters of the other actions.
+func (qr *Rule) checkActionParameters() error {
+	params := []struct {
+		act Action
+		set bool
+	}{
+		{QRLimitConcurrency, qr.maxConcurrency != 0},
+		{QRLimitQPS, qr.maxQPS != 0},
+		{QRDelay, qr.delay != 0},
+		{QRAddHints, qr.hints != "" || qr.maxExecutionTime != 0},
+	}
+	for _, p := range params {
+		if p.act == qr.act && !p.set {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "missing parameters for Action %v", qr.act)
+		}
+		if p.act != qr.act && p.set {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "parameters of Action %v not allowed for Action %v", p.act, qr.act)
+		}
+	}
+	return nil
+}
+
 func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch bool, op Operator, value interface{}, err error) {
 	bvcinfo, ok := bvc.(map[string]interface{})
 	if !ok {
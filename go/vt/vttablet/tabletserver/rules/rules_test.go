@@ -23,6 +23,9 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
+
+	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -520,6 +523,47 @@ func TestAction(t *testing.T) {
 	if desc != "rule 3" {
 		t.Errorf("want rule 2, got %s", desc)
 	}
+	if got := qrs.GetRules("1234", "user", bv); len(got) != 1 || got[0] != qr2 {
+		t.Errorf("GetRules: %v, want rule 2", got)
+	}
+	if got := qrs.GetRules("1234", "user1", map[string]*querypb.BindVariable{"a": sqltypes.Uint64BindVariable(0)}); len(got) != 0 {
+		t.Errorf("GetRules: %v, want none", got)
+	}
+}
+
+func TestActionPrecedence(t *testing.T) {
+	qrs := New()
+
+	delay := NewQueryRule("delay", "delay", QRDelay)
+	if err := delay.SetDelay(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	hints := NewQueryRule("hints", "hints", QRAddHints)
+	if err := hints.SetHints("NO_ICP(t)"); err != nil {
+		t.Fatal(err)
+	}
+	fail := NewQueryRule("fail", "fail", QRFail)
+	if err := fail.SetUserCond("bad"); err != nil {
+		t.Fatal(err)
+	}
+
+	qrs.Add(delay)
+	qrs.Add(hints)
+	qrs.Add(fail)
+
+	// A FAIL rule wins over the rules that come before it.
+	if got := qrs.GetRules("123", "bad", nil); len(got) != 1 || got[0] != fail {
+		t.Errorf("GetRules(bad): %v, want fail", got)
+	}
+	action, desc := qrs.GetAction("123", "bad", nil)
+	if action != QRFail || desc != "fail" {
+		t.Errorf("GetAction(bad): %v, %s, want FAIL, fail", action, desc)
+	}
+
+	// Otherwise, all the matching rules apply.
+	if got := qrs.GetRules("123", "good", nil); len(got) != 2 || got[0] != delay || got[1] != hints {
+		t.Errorf("GetRules(good): %v, want delay, hints", got)
+	}
 }
 
 func TestImport(t *testing.T) {
@@ -561,6 +605,123 @@ func TestImport(t *testing.T) {
 	}
 }
 
+func TestImportActions(t *testing.T) {
+	var qrs = New()
+	jsondata := `[{
+		"Description": "desc1",
+		"Name": "name1",
+		"Action": "LIMIT_CONCURRENCY",
+		"MaxConcurrency": 2
+	},{
+		"Description": "desc2",
+		"Name": "name2",
+		"Action": "LIMIT_QPS",
+		"MaxQPS": 0.5,
+		"Burst": 1
+	},{
+		"Description": "desc3",
+		"Name": "name3",
+		"Action": "DELAY",
+		"Delay": "100ms"
+	},{
+		"Description": "desc4",
+		"Name": "name4",
+		"Action": "ADD_HINTS",
+		"Hints": "NO_INDEX_MERGE(t)",
+		"MaxExecutionTime": "1s"
+	}]`
+	err := qrs.UnmarshalJSON([]byte(jsondata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := marshalled(qrs)
+	want := compacted(jsondata)
+	if got != want {
+		t.Errorf("qrs:\n%s, want\n%s", got, want)
+	}
+}
+
+func TestRuleWait(t *testing.T) {
+	shortCtx := func() (context.Context, context.CancelFunc) {
+		return context.WithTimeout(context.Background(), 10*time.Millisecond)
+	}
+
+	// Concurrency limit, shared by the copies of the rule.
+	qr := NewQueryRule("rule 1", "r1", QRLimitConcurrency)
+	if err := qr.SetMaxConcurrency(1); err != nil {
+		t.Fatal(err)
+	}
+	release, err := qr.Wait(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := shortCtx()
+	_, err = qr.Copy().Wait(ctx)
+	cancel()
+	if err != context.DeadlineExceeded {
+		t.Errorf("Wait with a full rule: %v, want %v", err, context.DeadlineExceeded)
+	}
+	release()
+	release, err = qr.Copy().Wait(context.Background())
+	if err != nil {
+		t.Errorf("Wait after release: %v", err)
+	}
+	release()
+
+	// QPS limit.
+	qr = NewQueryRule("rule 2", "r2", QRLimitQPS)
+	if err := qr.SetMaxQPS(1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := qr.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel = shortCtx()
+	_, err = qr.Wait(ctx)
+	cancel()
+	if err == nil {
+		t.Errorf("Wait without tokens succeeded")
+	}
+
+	// Delay.
+	qr = NewQueryRule("rule 3", "r3", QRDelay)
+	if err := qr.SetDelay(20 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	if _, err := qr.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Now().Sub(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Wait returned after %v, want at least 20ms", elapsed)
+	}
+	ctx, cancel = shortCtx()
+	_, err = qr.Wait(ctx)
+	cancel()
+	if err != context.DeadlineExceeded {
+		t.Errorf("Wait with short context: %v, want %v", err, context.DeadlineExceeded)
+	}
+	if hints := qr.Hints(); hints != "" {
+		t.Errorf("Hints: %q, want empty", hints)
+	}
+
+	// Hints.
+	qr = NewQueryRule("rule 4", "r4", QRAddHints)
+	if err := qr.SetHints(" NO_INDEX_MERGE(t) "); err != nil {
+		t.Fatal(err)
+	}
+	if err := qr.SetMaxExecutionTime(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := qr.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	want := "NO_INDEX_MERGE(t) MAX_EXECUTION_TIME(1000)"
+	if hints := qr.Hints(); hints != want {
+		t.Errorf("Hints: %q, want %q", hints, want)
+	}
+}
+
 type ValidJSONCase struct {
 	input string
 	op    Operator
@@ -670,6 +831,19 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"MaxConcurrency": "1" }]`, "want number for MaxConcurrency"},
+	{`[{"Delay": 1 }]`, "want string for Delay"},
+	{`[{"Action": "LIMIT_CONCURRENCY", "MaxConcurrency": 1.5 }]`, "want int for MaxConcurrency: 1.5"},
+	{`[{"Action": "LIMIT_CONCURRENCY", "MaxConcurrency": 0 }]`, "MaxConcurrency must be positive: 0"},
+	{`[{"Action": "LIMIT_CONCURRENCY" }]`, "missing parameters for Action LIMIT_CONCURRENCY"},
+	{`[{"Action": "LIMIT_QPS", "Burst": 5 }]`, "MaxQPS must be positive: 0"},
+	{`[{"Action": "LIMIT_QPS", "MaxQPS": 5, "Burst": -1 }]`, "Burst must not be negative: -1"},
+	{`[{"Action": "DELAY", "Delay": "1x" }]`, "want duration for Delay: 1x"},
+	{`[{"Action": "DELAY", "Delay": "-1s" }]`, "Delay must be positive: -1s"},
+	{`[{"Action": "ADD_HINTS", "Hints": "a */ b" }]`, "Hints cannot contain */: a */ b"},
+	{`[{"Action": "ADD_HINTS", "MaxExecutionTime": "10us" }]`, "MaxExecutionTime must be at least 1ms: 10µs"},
+	{`[{"Action": "ADD_HINTS" }]`, "missing parameters for Action ADD_HINTS"},
+	{`[{"Action": "FAIL", "Delay": "1s" }]`, "parameters of Action DELAY not allowed for Action FAIL"},
 }
 
 func TestInvalidJSON(t *testing.T) {
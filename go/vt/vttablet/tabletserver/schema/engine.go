@@ -83,6 +83,7 @@ func NewEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *En
 		_ = stats.NewMultiCountersFunc("IndexLength", []string{"Table"}, se.getIndexLength)
 		_ = stats.NewMultiCountersFunc("DataFree", []string{"Table"}, se.getDataFree)
 		_ = stats.NewMultiCountersFunc("MaxDataLength", []string{"Table"}, se.getMaxDataLength)
+		_ = stats.NewMultiCountersFunc("SequenceCachedValues", []string{"Table"}, se.getSequenceCachedValues)
 
 		http.Handle("/debug/schema", se)
 		http.HandleFunc("/schemaz", func(w http.ResponseWriter, r *http.Request) {
@@ -485,6 +486,27 @@ func (se *Engine) getMaxDataLength() map[string]int64 {
 	return tstats
 }
 
+// getSequenceCachedValues returns the number of values each sequence
+// has reserved and not handed out yet.
+func (se *Engine) getSequenceCachedValues() map[string]int64 {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	tstats := make(map[string]int64)
+	for k, v := range se.tables {
+		if v.SequenceInfo == nil {
+			continue
+		}
+		v.SequenceInfo.Lock()
+		if v.SequenceInfo.NextVal != 0 && !v.SequenceInfo.Invalidated.Get() {
+			tstats[k] = v.SequenceInfo.LastVal - v.SequenceInfo.NextVal
+		} else {
+			tstats[k] = 0
+		}
+		v.SequenceInfo.Unlock()
+	}
+	return tstats
+}
+
 func (se *Engine) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
 		acl.SendError(response, err)
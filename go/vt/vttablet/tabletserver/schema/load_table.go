@@ -44,8 +44,10 @@ func LoadTable(conn *connpool.DBConn, tableName string, tableType string, commen
 	}
 	switch {
 	case strings.Contains(comment, "vitess_sequence"):
+		if err := loadSequenceInfo(ta, comment); err != nil {
+			return nil, err
+		}
 		ta.Type = Sequence
-		ta.SequenceInfo = &SequenceInfo{}
 	case strings.Contains(comment, "vitess_message"):
 		if err := loadMessageInfo(ta, comment); err != nil {
 			return nil, err
@@ -147,16 +149,7 @@ func loadMessageInfo(ta *Table, comment string) error {
 	}
 
 	ta.MessageInfo = &MessageInfo{}
-	// Extract keyvalues.
-	keyvals := make(map[string]string)
-	inputs := strings.Split(comment, ",")
-	for _, input := range inputs {
-		kv := strings.Split(input, "=")
-		if len(kv) != 2 {
-			continue
-		}
-		keyvals[kv[0]] = kv[1]
-	}
+	keyvals := commentKeyvals(comment)
 	var err error
 	if ta.MessageInfo.AckWaitDuration, err = getDuration(keyvals, "vt_ack_wait"); err != nil {
 		return err
@@ -217,6 +210,32 @@ func loadMessageInfo(ta *Table, comment string) error {
 	return nil
 }
 
+func loadSequenceInfo(ta *Table, comment string) error {
+	ta.SequenceInfo = &SequenceInfo{}
+	switch mode := commentKeyvals(comment)["vt_sequence_mode"]; mode {
+	case "", SequenceModeHighThroughput:
+	case SequenceModeUncached:
+		ta.SequenceInfo.Uncached = true
+	default:
+		return fmt.Errorf("invalid vt_sequence_mode for sequence table %s: %s", ta.Name.String(), mode)
+	}
+	return nil
+}
+
+// commentKeyvals extracts the key=value pairs of a table comment.
+func commentKeyvals(comment string) map[string]string {
+	keyvals := make(map[string]string)
+	inputs := strings.Split(comment, ",")
+	for _, input := range inputs {
+		kv := strings.Split(input, "=")
+		if len(kv) != 2 {
+			continue
+		}
+		keyvals[kv[0]] = kv[1]
+	}
+	return keyvals
+}
+
 func getDuration(in map[string]string, key string) (time.Duration, error) {
 	sv := in[key]
 	if sv == "" {
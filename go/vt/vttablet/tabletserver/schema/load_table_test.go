@@ -124,6 +124,35 @@ func TestLoadTableSequence(t *testing.T) {
 	}
 }
 
+func TestLoadTableSequenceMode(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range getTestLoadTableQueries() {
+		db.AddQuery(query, result)
+	}
+	table, err := newTestLoadTable("USER_TABLE", "vitess_sequence,vt_sequence_mode=uncached", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !table.SequenceInfo.Uncached {
+		t.Errorf("SequenceInfo.Uncached: false, want true")
+	}
+
+	table, err = newTestLoadTable("USER_TABLE", "vitess_sequence,vt_sequence_mode=high_throughput", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.SequenceInfo.Uncached {
+		t.Errorf("SequenceInfo.Uncached: true, want false")
+	}
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_sequence,vt_sequence_mode=fast", db)
+	want := "invalid vt_sequence_mode for sequence table test_table: fast"
+	if err == nil || err.Error() != want {
+		t.Errorf("newTestLoadTable: %v, want %s", err, want)
+	}
+}
+
 func TestLoadTableMessage(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
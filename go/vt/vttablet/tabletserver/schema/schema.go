@@ -85,8 +85,27 @@ type SequenceInfo struct {
 	sync.Mutex
 	NextVal int64
 	LastVal int64
+
+	// Uncached is set for sequences in uncached mode: every value
+	// is reserved in the sequence table when it's handed out, so no
+	// value is lost if the tablet restarts or the master changes.
+	// If nextval is executed in a transaction, the values are
+	// reserved in that transaction, and released if it's rolled back.
+	// Otherwise, blocks of values are cached (high_throughput mode).
+	Uncached bool
+
+	// Invalidated is set when the sequence table was modified by
+	// something else than the sequence itself. The cached values are
+	// then discarded on the next use.
+	Invalidated sync2.AtomicBool
 }
 
+// Sequence modes, set in the table comment with vt_sequence_mode.
+const (
+	SequenceModeHighThroughput = "high_throughput"
+	SequenceModeUncached       = "uncached"
+)
+
 // MessageInfo contains info specific to message tables.
 type MessageInfo struct {
 	// IDPKIndex is the index of the ID column
@@ -57,6 +57,7 @@ func init() {
 	flag.IntVar(&Config.WarnResultSize, "queryserver-config-warn-result-size", DefaultQsConfig.WarnResultSize, "query server result size warning threshold, warn if number of rows returned from vttablet for non-streaming queries exceeds this")
 	flag.IntVar(&Config.MaxDMLRows, "queryserver-config-max-dml-rows", DefaultQsConfig.MaxDMLRows, "query server max dml rows per statement, maximum number of rows allowed to return at a time for an update or delete with either 1) an equality where clauses on primary keys, or 2) a subselect statement. For update and delete statements in above two categories, vttablet will split the original query into multiple small queries based on this configuration value. ")
 	flag.BoolVar(&Config.PassthroughDMLs, "queryserver-config-passthrough-dmls", DefaultQsConfig.PassthroughDMLs, "query server pass through all dml statements without rewriting")
+	flag.IntVar(&Config.SequenceCacheSize, "queryserver-config-sequence-cache-size", DefaultQsConfig.SequenceCacheSize, "query server sequence cache size, number of values of a high_throughput sequence reserved at a time by a vttablet. If 0, the cache column of the sequence table is used.")
 
 	flag.IntVar(&Config.StreamBufferSize, "queryserver-config-stream-buffer-size", DefaultQsConfig.StreamBufferSize, "query server stream buffer size, the maximum number of bytes sent from vttablet for each stream call. It's recommended to keep this value in sync with vtgate's stream_buffer_size.")
 	flag.IntVar(&Config.QueryPlanCacheSize, "queryserver-config-query-cache-size", DefaultQsConfig.QueryPlanCacheSize, "query server query cache size, maximum number of queries to be cached. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
@@ -99,6 +100,13 @@ func init() {
 	flag.BoolVar(&Config.HeartbeatEnable, "heartbeat_enable", DefaultQsConfig.HeartbeatEnable, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
 	flag.DurationVar(&Config.HeartbeatInterval, "heartbeat_interval", DefaultQsConfig.HeartbeatInterval, "How frequently to read and write replication heartbeat.")
 
+	flag.BoolVar(&Config.OnlineDDLEnable, "enable_online_ddl", DefaultQsConfig.OnlineDDLEnable, "If true, the master vttablet runs the online schema migrations submitted with 'vtctl ApplySchema -online_ddl', and recorded in the table _vt.schema_migrations.")
+	flag.DurationVar(&Config.OnlineDDLCheckInterval, "online_ddl_check_interval", DefaultQsConfig.OnlineDDLCheckInterval, "How frequently to look for new online schema migrations.")
+	flag.IntVar(&Config.OnlineDDLChunkSize, "online_ddl_chunk_size", DefaultQsConfig.OnlineDDLChunkSize, "Number of rows copied at once by an online schema migration.")
+	flag.Int64Var(&Config.OnlineDDLMaxRate, "online_ddl_max_rate", DefaultQsConfig.OnlineDDLMaxRate, "Maximum number of chunks copied per second by an online schema migration. 0 means unlimited. The rate can be changed at runtime with the throttler RPCs.")
+	flag.Int64Var(&Config.OnlineDDLMaxReplicationLagSec, "online_ddl_max_replication_lag_sec", DefaultQsConfig.OnlineDDLMaxReplicationLagSec, "The online schema migrations slow down the copy of the rows to keep the replication lag of the replicas of the shard below this value, in seconds. 0 disables the replication lag throttling.")
+	flagutil.StringListVar(&Config.OnlineDDLHealthCheckCells, "online_ddl_healthcheck_cells", DefaultQsConfig.OnlineDDLHealthCheckCells, "A comma-separated list of cells. Only the replicas in these cells are monitored for replication lag by the online schema migrations. Defaults to the cell of the tablet.")
+
 	flag.BoolVar(&Config.EnforceStrictTransTables, "enforce_strict_trans_tables", DefaultQsConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
 }
 
@@ -134,6 +142,7 @@ type TabletConfig struct {
 	WarnResultSize          int
 	MaxDMLRows              int
 	PassthroughDMLs         bool
+	SequenceCacheSize       int
 	StreamBufferSize        int
 	QueryPlanCacheSize      int
 	SchemaReloadTime        float64
@@ -169,6 +178,13 @@ type TabletConfig struct {
 	HeartbeatEnable   bool
 	HeartbeatInterval time.Duration
 
+	OnlineDDLEnable               bool
+	OnlineDDLCheckInterval        time.Duration
+	OnlineDDLChunkSize            int
+	OnlineDDLMaxRate              int64
+	OnlineDDLMaxReplicationLagSec int64
+	OnlineDDLHealthCheckCells     []string
+
 	EnforceStrictTransTables bool
 }
 
@@ -204,6 +220,7 @@ var DefaultQsConfig = TabletConfig{
 	WarnResultSize:          0,
 	MaxDMLRows:              500,
 	PassthroughDMLs:         false,
+	SequenceCacheSize:       0,
 	QueryPlanCacheSize:      5000,
 	SchemaReloadTime:        30 * 60,
 	QueryTimeout:            30,
@@ -242,6 +259,13 @@ var DefaultQsConfig = TabletConfig{
 	HeartbeatEnable:   false,
 	HeartbeatInterval: 1 * time.Second,
 
+	OnlineDDLEnable:               false,
+	OnlineDDLCheckInterval:        10 * time.Second,
+	OnlineDDLChunkSize:            1000,
+	OnlineDDLMaxRate:              0,
+	OnlineDDLMaxReplicationLagSec: 10,
+	OnlineDDLHealthCheckCells:     []string{},
+
 	EnforceStrictTransTables: true,
 }
 
@@ -328,5 +352,11 @@ func VerifyConfig() error {
 	if v := Config.HotRowProtectionConcurrentTransactions; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if v := Config.OnlineDDLChunkSize; v <= 0 {
+		return fmt.Errorf("-online_ddl_chunk_size must be > 0 (specified value: %v)", v)
+	}
+	if v := Config.OnlineDDLMaxReplicationLagSec; v != 0 && v < 2 {
+		return fmt.Errorf("-online_ddl_max_replication_lag_sec must be 0 or >= 2 (specified value: %v)", v)
+	}
 	return nil
 }
@@ -86,6 +86,17 @@ var (
 	TableaclDenied = stats.NewMultiCounters("TableACLDenied", []string{"TableName", "TableGroup", "PlanID", "Username"})
 	// TableaclPseudoDenied tracks the number of pseudo denies.
 	TableaclPseudoDenied = stats.NewMultiCounters("TableACLPseudoDenied", []string{"TableName", "TableGroup", "PlanID", "Username"})
+	// QueryRuleActions shows the number of queries each query rule throttled or rewrote.
+	QueryRuleActions = stats.NewMultiCounters("QueryRuleActions", []string{"Rule", "Action"})
+	// QueryRuleRejects shows the number of queries that failed waiting for a query rule.
+	QueryRuleRejects = stats.NewMultiCounters("QueryRuleRejects", []string{"Rule", "Action"})
+	// QueryRuleWaitTimesNs shows the total time queries waited for each query rule.
+	QueryRuleWaitTimesNs = stats.NewMultiCounters("QueryRuleWaitTimesNs", []string{"Rule", "Action"})
+	// SequenceValues shows the number of values handed out by each sequence.
+	SequenceValues = stats.NewCounters("SequenceValues")
+	// SequenceReservations shows the number of times each sequence reserved
+	// values in its table.
+	SequenceReservations = stats.NewCounters("SequenceReservations")
 	// Infof can be overridden during tests
 	Infof = log.Infof
 	// Warningf can be overridden during tests
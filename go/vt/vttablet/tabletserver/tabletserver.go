@@ -45,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/heartbeat"
+	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/messager"
@@ -162,6 +163,7 @@ type TabletServer struct {
 	qe               *QueryEngine
 	te               *TxEngine
 	hw               *heartbeat.Writer
+	ddl              *onlineddl.Executor
 	hr               *heartbeat.Reader
 	messager         *messager.Engine
 	watcher          *ReplicationWatcher
@@ -230,6 +232,7 @@ func NewTabletServer(config tabletenv.TabletConfig, topoServer *topo.Server, ali
 	tsv.te = NewTxEngine(tsv, config)
 	tsv.hw = heartbeat.NewWriter(tsv, alias, config)
 	tsv.hr = heartbeat.NewReader(tsv, config)
+	tsv.ddl = onlineddl.NewExecutor(config, topoServer, alias.Cell, tsv.se.Reload)
 	tsv.txThrottler = txthrottler.CreateTxThrottlerFromTabletConfig(topoServer)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, config)
 	tsv.watcher = NewReplicationWatcher(tsv.se, config)
@@ -342,6 +345,7 @@ func (tsv *TabletServer) InitDBConfig(target querypb.Target, dbcfgs dbconfigs.DB
 	tsv.te.InitDBConfig(tsv.dbconfigs)
 	tsv.hw.InitDBConfig(tsv.dbconfigs)
 	tsv.hr.InitDBConfig(tsv.dbconfigs)
+	tsv.ddl.InitDBConfig(tsv.dbconfigs)
 	tsv.messager.InitDBConfig(tsv.dbconfigs)
 	tsv.watcher.InitDBConfig(tsv.dbconfigs)
 	return nil
@@ -492,7 +496,9 @@ func (tsv *TabletServer) serveNewType() (err error) {
 		tsv.messager.Open()
 		tsv.hr.Close()
 		tsv.hw.Open()
+		tsv.ddl.Open(tsv.target.Keyspace, tsv.target.Shard)
 	} else {
+		tsv.ddl.Close()
 		tsv.messager.Close()
 		tsv.hr.Open()
 		tsv.hw.Close()
@@ -554,6 +560,7 @@ func (tsv *TabletServer) waitForShutdown() {
 	// will be allowed. They will enable the conclusion of outstanding
 	// transactions.
 	tsv.txRequests.Wait()
+	tsv.ddl.Close()
 	tsv.messager.Close()
 	tsv.te.Close(false)
 	tsv.qe.streamQList.TerminateAll()
@@ -566,6 +573,7 @@ func (tsv *TabletServer) waitForShutdown() {
 // closeAll is called if TabletServer fails to start.
 // It forcibly shuts down everything.
 func (tsv *TabletServer) closeAll() {
+	tsv.ddl.Close()
 	tsv.messager.Close()
 	tsv.hr.Close()
 	tsv.hw.Close()
@@ -1991,6 +1999,13 @@ func (tsv *TabletServer) MaxDMLRows() int {
 	return int(tsv.qe.maxDMLRows.Get())
 }
 
+// SetSequenceCacheSize changes the number of values of high_throughput
+// sequences reserved at a time. If 0, the cache column of the sequence
+// table is used.
+func (tsv *TabletServer) SetSequenceCacheSize(val int) {
+	tsv.qe.sequenceCacheSize.Set(int64(val))
+}
+
 // SetPassthroughDMLs changes the setting to pass through all DMLs
 // It should only be used for testing
 func (tsv *TabletServer) SetPassthroughDMLs(val bool) {
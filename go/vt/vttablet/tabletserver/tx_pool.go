@@ -232,6 +232,10 @@ func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (
 		return 0, err
 	}
 
+	if err := conn.SetSystemVariables(options.GetSystemVariables()); err != nil {
+		return 0, err
+	}
+
 	if query, ok := txIsolations[options.GetTransactionIsolation()]; ok {
 		if _, err := conn.Exec(ctx, query, 1, false); err != nil {
 			return 0, err
@@ -368,6 +372,19 @@ type TxConnection struct {
 	LogToFile         sync2.AtomicInt32
 	ImmediateCallerID *querypb.VTGateCallerID
 	EffectiveCallerID *vtrpcpb.CallerID
+
+	// savepoints are the active savepoints of the transaction,
+	// in the order they were set.
+	savepoints []*txSavepoint
+}
+
+// txSavepoint records the pending message changes of a transaction
+// when a savepoint is set, so ROLLBACK TO SAVEPOINT can discard the
+// ones made after it.
+type txSavepoint struct {
+	name            string
+	newMessages     map[string]int
+	changedMessages map[string]int
 }
 
 func newTxConnection(conn *connpool.DBConn, transactionID int64, pool *TxPool, immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID) *TxConnection {
@@ -406,12 +423,77 @@ func (txc *TxConnection) BeginAgain(ctx context.Context) error {
 	if _, err := txc.DBConn.Exec(ctx, "commit", 1, false); err != nil {
 		return err
 	}
+	// The commit released the savepoints.
+	txc.savepoints = nil
 	if _, err := txc.DBConn.Exec(ctx, "begin", 1, false); err != nil {
 		return err
 	}
 	return nil
 }
 
+// Savepoint records a savepoint that was set in the transaction.
+// Like in MySQL, it replaces an existing savepoint with the same name.
+func (txc *TxConnection) Savepoint(name string) {
+	if i := txc.findSavepoint(name); i != -1 {
+		txc.savepoints = append(txc.savepoints[:i], txc.savepoints[i+1:]...)
+	}
+	sp := &txSavepoint{
+		name:            name,
+		newMessages:     make(map[string]int, len(txc.NewMessages)),
+		changedMessages: make(map[string]int, len(txc.ChangedMessages)),
+	}
+	for table, rows := range txc.NewMessages {
+		sp.newMessages[table] = len(rows)
+	}
+	for table, ids := range txc.ChangedMessages {
+		sp.changedMessages[table] = len(ids)
+	}
+	txc.savepoints = append(txc.savepoints, sp)
+}
+
+// RollbackToSavepoint records that the transaction was rolled back to
+// the savepoint. The message changes made after it are discarded, as
+// well as the savepoints set after it.
+func (txc *TxConnection) RollbackToSavepoint(name string) {
+	i := txc.findSavepoint(name)
+	if i == -1 {
+		return
+	}
+	sp := txc.savepoints[i]
+	for table, rows := range txc.NewMessages {
+		if n, ok := sp.newMessages[table]; ok {
+			txc.NewMessages[table] = rows[:n]
+		} else {
+			delete(txc.NewMessages, table)
+		}
+	}
+	for table, ids := range txc.ChangedMessages {
+		if n, ok := sp.changedMessages[table]; ok {
+			txc.ChangedMessages[table] = ids[:n]
+		} else {
+			delete(txc.ChangedMessages, table)
+		}
+	}
+	txc.savepoints = txc.savepoints[:i+1]
+}
+
+// ReleaseSavepoint records that the savepoint was released. The
+// savepoints set after it are released too.
+func (txc *TxConnection) ReleaseSavepoint(name string) {
+	if i := txc.findSavepoint(name); i != -1 {
+		txc.savepoints = txc.savepoints[:i]
+	}
+}
+
+func (txc *TxConnection) findSavepoint(name string) int {
+	for i, sp := range txc.savepoints {
+		if sp.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
 // Recycle returns the connection to the pool. The transaction remains
 // active.
 func (txc *TxConnection) Recycle() {
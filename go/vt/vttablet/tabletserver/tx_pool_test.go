@@ -19,6 +19,7 @@ package tabletserver
 import (
 	"fmt"
 	"math/rand"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -265,6 +266,69 @@ func TestTxPoolTransactionIsolation(t *testing.T) {
 	}
 }
 
+func TestTxPoolSavepoints(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+
+	transactionID, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer txPool.Rollback(ctx, transactionID)
+	txConn, err := txPool.Get(transactionID, "for query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer txConn.Recycle()
+
+	txConn.NewMessages["msg"] = []*messager.MessageRow{{}}
+	txConn.ChangedMessages["msg"] = []string{"1"}
+	txConn.Savepoint("a")
+	txConn.NewMessages["msg"] = append(txConn.NewMessages["msg"], &messager.MessageRow{})
+	txConn.NewMessages["msg2"] = []*messager.MessageRow{{}}
+	txConn.Savepoint("b")
+	txConn.ChangedMessages["msg"] = append(txConn.ChangedMessages["msg"], "2")
+	txConn.Savepoint("c")
+
+	// Rolling back to b discards c, and the changes made after b.
+	txConn.RollbackToSavepoint("b")
+	if got, want := len(txConn.NewMessages["msg"]), 2; got != want {
+		t.Errorf("len(NewMessages[msg]): %d, want %d", got, want)
+	}
+	if got, want := txConn.ChangedMessages["msg"], []string{"1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedMessages[msg]: %v, want %v", got, want)
+	}
+	if i := txConn.findSavepoint("c"); i != -1 {
+		t.Errorf("savepoint c still active at %d", i)
+	}
+
+	// Rolling back to a discards the messages of the tables that
+	// were not used yet.
+	txConn.RollbackToSavepoint("a")
+	if got, want := len(txConn.NewMessages["msg"]), 1; got != want {
+		t.Errorf("len(NewMessages[msg]): %d, want %d", got, want)
+	}
+	if _, ok := txConn.NewMessages["msg2"]; ok {
+		t.Errorf("NewMessages[msg2] was not discarded")
+	}
+
+	// Setting a again replaces it, releasing a releases it.
+	txConn.Savepoint("a")
+	if got, want := len(txConn.savepoints), 1; got != want {
+		t.Errorf("len(savepoints): %d, want %d", got, want)
+	}
+	txConn.ReleaseSavepoint("a")
+	if got, want := len(txConn.savepoints), 0; got != want {
+		t.Errorf("len(savepoints): %d, want %d", got, want)
+	}
+}
+
 // TestTxPoolBeginWithPoolConnectionError_TransientErrno2006 tests the case
 // where we see a transient errno 2006 e.g. because MySQL killed the
 // db connection. DBConn.Exec() is going to reconnect and retry automatically
@@ -202,8 +202,11 @@ type TabletManagerClient interface {
 	// Backup creates a database backup
 	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) (logutil.EventStream, error)
 
-	// RestoreFromBackup deletes local data and restores database from backup
-	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// If restoreToPos or restoreToTime is set, it recovers to that point in
+	// time, replaying the binlogs of binlogSource (host:port) or of the
+	// shard master after the backup.
+	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTime time.Time, binlogSource string) (logutil.EventStream, error)
 
 	//
 	// Management methods
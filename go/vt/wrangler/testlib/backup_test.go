@@ -17,9 +17,11 @@ limitations under the License.
 package testlib
 
 import (
+	"flag"
 	"io/ioutil"
 	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -184,3 +186,318 @@ func TestBackupRestore(t *testing.T) {
 	}
 
 }
+
+func TestRestoreToPosition(t *testing.T) {
+	// Initialize our environment
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	// Set up mock query results.
+	db.AddQuery("CREATE DATABASE IF NOT EXISTS _vt", &sqltypes.Result{})
+	db.AddQuery("BEGIN", &sqltypes.Result{})
+	db.AddQuery("COMMIT", &sqltypes.Result{})
+	db.AddQueryPattern(`SET @@session\.sql_log_bin = .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.shard_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.local_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`INSERT INTO _vt\.local_metadata .*`, &sqltypes.Result{})
+
+	// Initialize our temp dirs
+	root, err := ioutil.TempDir("", "backuptest")
+	if err != nil {
+		t.Fatalf("os.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	// Initialize BackupStorage
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "fbs")
+	*backupstorage.BackupStorageImplementation = "file"
+
+	// Initialize the fake mysql root directories
+	sourceInnodbDataDir := path.Join(root, "source_innodb_data")
+	sourceInnodbLogDir := path.Join(root, "source_innodb_log")
+	sourceDataDir := path.Join(root, "source_data")
+	sourceDataDbDir := path.Join(sourceDataDir, "vt_db")
+	for _, s := range []string{sourceInnodbDataDir, sourceInnodbLogDir, sourceDataDbDir} {
+		if err := os.MkdirAll(s, os.ModePerm); err != nil {
+			t.Fatalf("failed to create directory %v: %v", s, err)
+		}
+	}
+	if err := ioutil.WriteFile(path.Join(sourceDataDbDir, "db.opt"), []byte("db opt file"), os.ModePerm); err != nil {
+		t.Fatalf("failed to write file db.opt: %v", err)
+	}
+
+	// create a master tablet, not started, just for shard health
+	NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+
+	// create a tablet, and take a backup at 2-123-457
+	backupPosition := mysql.Position{
+		GTIDSet: mysql.MariadbGTID{
+			Domain:   2,
+			Server:   123,
+			Sequence: 457,
+		},
+	}
+	sourceTablet := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	sourceTablet.FakeMysqlDaemon.ReadOnly = true
+	sourceTablet.FakeMysqlDaemon.Replicating = true
+	sourceTablet.FakeMysqlDaemon.CurrentMasterPosition = backupPosition
+	sourceTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+		"START SLAVE",
+	}
+	sourceTablet.FakeMysqlDaemon.Mycnf = &mysqlctl.Mycnf{
+		DataDir:               sourceDataDir,
+		InnodbDataHomeDir:     sourceInnodbDataDir,
+		InnodbLogGroupHomeDir: sourceInnodbLogDir,
+	}
+	sourceTablet.StartActionLoop(t, wr)
+	defer sourceTablet.StopActionLoop(t)
+
+	if err := vp.Run([]string{"Backup", topoproto.TabletAliasString(sourceTablet.Tablet.Alias)}); err != nil {
+		t.Fatalf("Backup failed: %v", err)
+	}
+
+	// Recovering to a position before the backup fails.
+	destTablet := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, db)
+	destTablet.FakeMysqlDaemon.ReadOnly = true
+	destTablet.FakeMysqlDaemon.Replicating = true
+	destTablet.FakeMysqlDaemon.Mycnf = &mysqlctl.Mycnf{
+		DataDir:               path.Join(root, "dest_data"),
+		InnodbDataHomeDir:     path.Join(root, "dest_innodb_data"),
+		InnodbLogGroupHomeDir: path.Join(root, "dest_innodb_log"),
+		BinLogPath:            path.Join(root, "bin-logs/filename_prefix"),
+		RelayLogPath:          path.Join(root, "relay-logs/filename_prefix"),
+		RelayLogIndexPath:     path.Join(root, "relay-log.index"),
+		RelayLogInfoPath:      path.Join(root, "relay-log.info"),
+	}
+	destTablet.StartActionLoop(t, wr)
+	defer destTablet.StopActionLoop(t)
+
+	err = vp.Run([]string{"RestoreFromBackup", "-to_pos=MariaDB/2-123-400", topoproto.TabletAliasString(destTablet.Tablet.Alias)})
+	want := "no backup to restore before the position 2-123-400 to recover to"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("RestoreFromBackup before the backup: %v, want %v", err, want)
+	}
+
+	// Recovering to a position after the backup replays
+	// the binlogs of the binlog server up to it.
+	targetPosition := mysql.Position{
+		GTIDSet: mysql.MariadbGTID{
+			Domain:   2,
+			Server:   123,
+			Sequence: 460,
+		},
+	}
+	destTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+		"RESET SLAVE ALL",
+		"FAKE SET SLAVE POSITION",
+		"FAKE SET MASTER",
+		"FAKE START SLAVE UNTIL AFTER",
+		"STOP SLAVE",
+		"RESET SLAVE ALL",
+	}
+	destTablet.FakeMysqlDaemon.SetSlavePositionPos = backupPosition
+	destTablet.FakeMysqlDaemon.SetMasterInput = "binlog-server:3306"
+	destTablet.FakeMysqlDaemon.StartSlaveUntilAfterPos = targetPosition
+	destTablet.FakeMysqlDaemon.WaitMasterPosition = targetPosition
+
+	if err := vp.Run([]string{"RestoreFromBackup", "-to_pos=MariaDB/2-123-460", "-binlog_server=binlog-server:3306", topoproto.TabletAliasString(destTablet.Tablet.Alias)}); err != nil {
+		t.Fatalf("RestoreFromBackup failed: %v", err)
+	}
+
+	// verify the full status
+	if err := destTablet.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("destTablet.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+	if destTablet.FakeMysqlDaemon.Replicating {
+		t.Errorf("destTablet.FakeMysqlDaemon.Replicating set")
+	}
+	if !destTablet.FakeMysqlDaemon.Running {
+		t.Errorf("destTablet.FakeMysqlDaemon.Running not set")
+	}
+	ti, err := ts.GetTablet(ctx, destTablet.Tablet.Alias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if ti.Type != topodatapb.TabletType_DRAINED {
+		t.Errorf("destTablet type: %v, want DRAINED", ti.Type)
+	}
+}
+
+func TestLogicalBackupRestore(t *testing.T) {
+	// Initialize our environment
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	flag.Set("backup_engine_implementation", "logical")
+	defer flag.Set("backup_engine_implementation", "builtin")
+
+	// Set up mock query results.
+	db.AddQuery("CREATE DATABASE IF NOT EXISTS _vt", &sqltypes.Result{})
+	db.AddQuery("BEGIN", &sqltypes.Result{})
+	db.AddQuery("COMMIT", &sqltypes.Result{})
+	db.AddQueryPattern(`SET @@session\.sql_log_bin = .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.shard_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`CREATE TABLE IF NOT EXISTS _vt\.local_metadata .*`, &sqltypes.Result{})
+	db.AddQueryPattern(`INSERT INTO _vt\.local_metadata .*`, &sqltypes.Result{})
+
+	// The database dumped by the backup: a table with a generated
+	// column, and a view.
+	for _, query := range []string{
+		"SET SESSION TRANSACTION ISOLATION LEVEL REPEATABLE READ",
+		"FLUSH TABLES WITH READ LOCK",
+		"START TRANSACTION WITH CONSISTENT SNAPSHOT",
+		"UNLOCK TABLES",
+	} {
+		db.AddQuery(query, &sqltypes.Result{})
+	}
+	db.AddQuery("SHOW DATABASES", sqltypes.MakeTestResult(sqltypes.MakeTestFields("Database", "varchar"), "mysql", "vt_db"))
+	db.AddQuery("SHOW CREATE DATABASE `vt_db`", sqltypes.MakeTestResult(sqltypes.MakeTestFields("Database|Create Database", "varchar|varchar"),
+		"vt_db|CREATE DATABASE `vt_db` /*!40100 DEFAULT CHARACTER SET utf8 */"))
+	db.AddQuery("SHOW FULL TABLES FROM `vt_db`", sqltypes.MakeTestResult(sqltypes.MakeTestFields("Tables_in_vt_db|Table_type", "varchar|varchar"),
+		"t1|BASE TABLE",
+		"v1|VIEW"))
+	db.AddQuery("SHOW CREATE TABLE `vt_db`.`t1`", sqltypes.MakeTestResult(sqltypes.MakeTestFields("Table|Create Table", "varchar|varchar"),
+		"t1|CREATE TABLE `t1` (\n  `id` bigint(20) NOT NULL,\n  `name` varchar(64) DEFAULT NULL,\n  `name_length` int(11) GENERATED ALWAYS AS (length(`name`)) VIRTUAL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8"))
+	db.AddQuery("SELECT COLUMN_NAME, EXTRA FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = 'vt_db' AND TABLE_NAME = 't1' ORDER BY ORDINAL_POSITION", sqltypes.MakeTestResult(sqltypes.MakeTestFields("COLUMN_NAME|EXTRA", "varchar|varchar"),
+		"id|",
+		"name|",
+		"name_length|VIRTUAL GENERATED"))
+	db.AddQuery("SELECT `id`, `name` FROM `vt_db`.`t1`", sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"),
+		"1|a\nb",
+		"2|it's",
+		"3|null"))
+	db.AddQuery("SHOW CREATE VIEW `vt_db`.`v1`", sqltypes.MakeTestResult(sqltypes.MakeTestFields("View|Create View|character_set_client|collation_connection", "varchar|varchar|varchar|varchar"),
+		"v1|CREATE ALGORITHM=UNDEFINED DEFINER=`vt_dba`@`localhost` SQL SECURITY DEFINER VIEW `v1` AS select `t1`.`id` AS `id` from `t1`|utf8|utf8_general_ci"))
+	// It has no routines, triggers or events.
+	for _, query := range []string{
+		"SELECT ROUTINE_NAME FROM information_schema.ROUTINES WHERE ROUTINE_TYPE = 'PROCEDURE' AND ROUTINE_SCHEMA = 'vt_db'",
+		"SELECT ROUTINE_NAME FROM information_schema.ROUTINES WHERE ROUTINE_TYPE = 'FUNCTION' AND ROUTINE_SCHEMA = 'vt_db'",
+		"SELECT TRIGGER_NAME FROM information_schema.TRIGGERS WHERE TRIGGER_SCHEMA = 'vt_db'",
+		"SELECT EVENT_NAME FROM information_schema.EVENTS WHERE EVENT_SCHEMA = 'vt_db'",
+	} {
+		db.AddQuery(query, &sqltypes.Result{})
+	}
+
+	// The statements executed by the restore.
+	restoreQueries := []string{
+		"SET SESSION sql_log_bin = 0",
+		"SET SESSION foreign_key_checks = 0",
+		"SET SESSION unique_checks = 0",
+		"DROP DATABASE `vt_db`",
+		"CREATE DATABASE `vt_db` /*!40100 DEFAULT CHARACTER SET utf8 */",
+		"USE `vt_db`",
+		"CREATE TABLE `t1` (\n  `id` bigint(20) NOT NULL,\n  `name` varchar(64) DEFAULT NULL,\n  `name_length` int(11) GENERATED ALWAYS AS (length(`name`)) VIRTUAL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8",
+		"INSERT INTO `t1` (`id`, `name`) VALUES (1, 'a\\nb'), (2, 'it\\'s'), (3, null)",
+		"CREATE ALGORITHM=UNDEFINED DEFINER=`vt_dba`@`localhost` SQL SECURITY DEFINER VIEW `v1` AS select `t1`.`id` AS `id` from `t1`",
+	}
+	for _, query := range restoreQueries {
+		db.AddQuery(query, &sqltypes.Result{})
+	}
+
+	// Initialize our temp dirs
+	root, err := ioutil.TempDir("", "backuptest")
+	if err != nil {
+		t.Fatalf("os.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	// Initialize BackupStorage
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "fbs")
+	*backupstorage.BackupStorageImplementation = "file"
+
+	// create a master tablet, not started, just for shard health
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+
+	// create a tablet, and take a logical backup: mysqld keeps
+	// running and replicating, and the tablet keeps serving.
+	backupPosition := mysql.Position{
+		GTIDSet: mysql.MariadbGTID{
+			Domain:   2,
+			Server:   123,
+			Sequence: 457,
+		},
+	}
+	sourceTablet := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	sourceTablet.FakeMysqlDaemon.ReadOnly = true
+	sourceTablet.FakeMysqlDaemon.Replicating = true
+	sourceTablet.FakeMysqlDaemon.CurrentMasterPosition = backupPosition
+	sourceTablet.StartActionLoop(t, wr)
+	defer sourceTablet.StopActionLoop(t)
+
+	db.SetBeforeFunc("FLUSH TABLES WITH READ LOCK", func() {
+		ti, err := ts.GetTablet(ctx, sourceTablet.Tablet.Alias)
+		if err != nil {
+			t.Errorf("GetTablet failed: %v", err)
+			return
+		}
+		if ti.Type != topodatapb.TabletType_REPLICA {
+			t.Errorf("sourceTablet type during the backup: %v, want REPLICA", ti.Type)
+		}
+	})
+	if err := vp.Run([]string{"Backup", topoproto.TabletAliasString(sourceTablet.Tablet.Alias)}); err != nil {
+		t.Fatalf("Backup failed: %v", err)
+	}
+	if got := db.GetQueryCalledNum("UNLOCK TABLES"); got != 1 {
+		t.Errorf("UNLOCK TABLES was called %v times, want 1", got)
+	}
+	if !sourceTablet.FakeMysqlDaemon.Replicating || !sourceTablet.FakeMysqlDaemon.Running {
+		t.Errorf("sourceTablet.FakeMysqlDaemon stopped: Replicating=%v Running=%v", sourceTablet.FakeMysqlDaemon.Replicating, sourceTablet.FakeMysqlDaemon.Running)
+	}
+	if err := vp.Run([]string{"VerifyBackup", "test_keyspace/0"}); err != nil {
+		t.Errorf("VerifyBackup failed: %v", err)
+	}
+
+	// restore it on another tablet, without restarting mysqld.
+	destTablet := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, db)
+	destTablet.FakeMysqlDaemon.ReadOnly = true
+	destTablet.FakeMysqlDaemon.Replicating = true
+	destTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+		"STOP SLAVE",
+		"RESET SLAVE ALL",
+		"FAKE SET SLAVE POSITION",
+		"FAKE SET MASTER",
+		"START SLAVE",
+	}
+	destTablet.FakeMysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW DATABASES": {},
+	}
+	destTablet.FakeMysqlDaemon.SetSlavePositionPos = backupPosition
+	destTablet.FakeMysqlDaemon.SetMasterInput = topoproto.MysqlAddr(master.Tablet)
+	destTablet.StartActionLoop(t, wr)
+	defer destTablet.StopActionLoop(t)
+
+	if err := destTablet.Agent.RestoreData(ctx, logutil.NewConsoleLogger(), false /* deleteBeforeRestore */); err != nil {
+		t.Fatalf("RestoreData failed: %v", err)
+	}
+
+	// verify the full status
+	if err := destTablet.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("destTablet.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+	for _, query := range restoreQueries[3:] {
+		want := 1
+		if query == "USE `vt_db`" {
+			// Once for the table, once for the view.
+			want = 2
+		}
+		if got := db.GetQueryCalledNum(query); got != want {
+			t.Errorf("restore query %v was called %v times, want %v", query, got, want)
+		}
+	}
+	if !destTablet.FakeMysqlDaemon.Replicating {
+		t.Errorf("destTablet.FakeMysqlDaemon.Replicating not set")
+	}
+}